@@ -12,6 +12,15 @@ For example, calculating checksums:
 	var tzSum Checksum
 	Calculate(&tzSum, TZ, payload) // tzSum contains TZ hash of the payload
 
+TZ hash of the data stream can be calculated incrementally by TZHasher and
+combined from the hashes of the stream parts:
+
+	h := NewTZHasher()
+	_, _ = io.Copy(h, stream)
+	whole := h.Checksum()
+
+	err := VerifyTZ(whole, part1, part2) // nil if stream consists of part1 and part2
+
 Using package types in an application is recommended to potentially work with
 different protocol versions with which these types are compatible.
 */
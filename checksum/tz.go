@@ -0,0 +1,182 @@
+package checksum
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// TZHasher calculates Tillich-Zémor homomorphic hash of the data stream
+// incrementally. TZHasher implements hash.Hash, so it is also an io.Writer.
+//
+// Unlike tz.New, TZHasher may continue calculation for the data prefix which
+// hash is already known (see NewTZHasherFrom). This allows to resume hashing
+// without the processed data since hasher state can't be exported.
+type TZHasher struct {
+	prefix []byte
+
+	h hash.Hash
+}
+
+// NewTZHasher constructs new TZHasher.
+func NewTZHasher() *TZHasher {
+	return &TZHasher{h: tz.New()}
+}
+
+// NewTZHasherFrom constructs new TZHasher continuing calculation for the data
+// prefix with the given hash: resulting hash is the hash of the prefix
+// followed by written data.
+func NewTZHasherFrom(prefix [tz.Size]byte) *TZHasher {
+	return &TZHasher{prefix: prefix[:], h: tz.New()}
+}
+
+// Write implements io.Writer. Never returns an error.
+func (x *TZHasher) Write(p []byte) (int, error) {
+	return x.h.Write(p)
+}
+
+// Sum implements hash.Hash.
+func (x *TZHasher) Sum(b []byte) []byte {
+	if x.prefix == nil {
+		return x.h.Sum(b)
+	}
+
+	res, err := tz.Concat([][]byte{x.prefix, x.h.Sum(nil)})
+	if err != nil {
+		// both hashes are correct, so it can't happen
+		panic(fmt.Sprintf("unexpected concatenation failure of the homomorphic hashes: %v", err))
+	}
+
+	return append(b, res...)
+}
+
+// Reset implements hash.Hash. Prefix set by NewTZHasherFrom is reset too.
+func (x *TZHasher) Reset() {
+	x.prefix = nil
+	x.h.Reset()
+}
+
+// Size implements hash.Hash.
+func (x *TZHasher) Size() int {
+	return tz.Size
+}
+
+// BlockSize implements hash.Hash.
+func (x *TZHasher) BlockSize() int {
+	return x.h.BlockSize()
+}
+
+// Checksum returns TZ Checksum of the data written so far.
+func (x *TZHasher) Checksum() Checksum {
+	return tzChecksum(x.Sum(nil))
+}
+
+func checkTZ(cs Checksum) error {
+	if cs.Type() != TZ {
+		return fmt.Errorf("checksum type is %s instead of %s", cs.Type(), TZ)
+	}
+
+	if len(cs.Value()) != tz.Size {
+		return fmt.Errorf("invalid TZ checksum length %d", len(cs.Value()))
+	}
+
+	return nil
+}
+
+func tzChecksum(b []byte) Checksum {
+	var res Checksum
+	var v [tz.Size]byte
+
+	copy(v[:], b)
+	res.SetTillichZemor(v)
+
+	return res
+}
+
+// CombineTZ returns TZ Checksum of the data concatenated from the parts with
+// the given TZ checksums in the same order. Returns an error if any checksum
+// is not a TZ one.
+func CombineTZ(parts ...Checksum) (Checksum, error) {
+	if len(parts) == 0 {
+		return NewTZHasher().Checksum(), nil
+	}
+
+	hs := make([][]byte, len(parts))
+	for i := range parts {
+		if err := checkTZ(parts[i]); err != nil {
+			return Checksum{}, fmt.Errorf("part #%d: %w", i, err)
+		}
+
+		hs[i] = parts[i].Value()
+	}
+
+	res, err := tz.Concat(hs)
+	if err != nil {
+		return Checksum{}, err
+	}
+
+	return tzChecksum(res), nil
+}
+
+// VerifyTZ checks whether whole is the TZ Checksum of the data concatenated
+// from the parts with the given TZ checksums in the same order.
+func VerifyTZ(whole Checksum, parts ...Checksum) error {
+	if err := checkTZ(whole); err != nil {
+		return err
+	}
+
+	res, err := CombineTZ(parts...)
+	if err != nil {
+		return err
+	}
+
+	if string(res.Value()) != string(whole.Value()) {
+		return errors.New("checksum mismatch")
+	}
+
+	return nil
+}
+
+// SplitTZPrefix returns TZ Checksum of the data prefix by TZ checksums of the
+// whole data and its suffix.
+//
+// See also SplitTZSuffix.
+func SplitTZPrefix(whole, suffix Checksum) (Checksum, error) {
+	if err := checkTZ(whole); err != nil {
+		return Checksum{}, fmt.Errorf("whole: %w", err)
+	}
+
+	if err := checkTZ(suffix); err != nil {
+		return Checksum{}, fmt.Errorf("suffix: %w", err)
+	}
+
+	res, err := tz.SubtractR(whole.Value(), suffix.Value())
+	if err != nil {
+		return Checksum{}, err
+	}
+
+	return tzChecksum(res), nil
+}
+
+// SplitTZSuffix returns TZ Checksum of the data suffix by TZ checksums of the
+// whole data and its prefix.
+//
+// See also SplitTZPrefix.
+func SplitTZSuffix(whole, prefix Checksum) (Checksum, error) {
+	if err := checkTZ(whole); err != nil {
+		return Checksum{}, fmt.Errorf("whole: %w", err)
+	}
+
+	if err := checkTZ(prefix); err != nil {
+		return Checksum{}, fmt.Errorf("prefix: %w", err)
+	}
+
+	res, err := tz.SubtractL(whole.Value(), prefix.Value())
+	if err != nil {
+		return Checksum{}, err
+	}
+
+	return tzChecksum(res), nil
+}
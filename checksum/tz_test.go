@@ -0,0 +1,71 @@
+package checksum
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTZHasher(t *testing.T) {
+	data := make([]byte, 1000)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	expected := tz.Sum(data)
+
+	h := NewTZHasher()
+	for i := 0; i < len(data); i += 100 {
+		_, err = h.Write(data[i : i+100])
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, expected[:], h.Sum(nil))
+	require.Equal(t, TZ, h.Checksum().Type())
+	require.Equal(t, expected[:], h.Checksum().Value())
+
+	prefix := tz.Sum(data[:300])
+	h = NewTZHasherFrom(prefix)
+	_, err = h.Write(data[300:])
+	require.NoError(t, err)
+	require.Equal(t, expected[:], h.Sum(nil))
+
+	h.Reset()
+	empty := tz.Sum(nil)
+	require.Equal(t, empty[:], h.Sum(nil))
+}
+
+func TestCombineTZ(t *testing.T) {
+	data := make([]byte, 1000)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	var whole, prefix, suffix, sha Checksum
+	Calculate(&whole, TZ, data)
+	Calculate(&prefix, TZ, data[:400])
+	Calculate(&suffix, TZ, data[400:])
+	Calculate(&sha, SHA256, data)
+
+	res, err := CombineTZ(prefix, suffix)
+	require.NoError(t, err)
+	require.Equal(t, whole, res)
+
+	require.NoError(t, VerifyTZ(whole, prefix, suffix))
+	require.Error(t, VerifyTZ(whole, suffix, prefix))
+	require.Error(t, VerifyTZ(sha, prefix, suffix))
+
+	_, err = CombineTZ(prefix, sha)
+	require.Error(t, err)
+
+	res, err = SplitTZPrefix(whole, suffix)
+	require.NoError(t, err)
+	require.Equal(t, prefix, res)
+
+	res, err = SplitTZSuffix(whole, prefix)
+	require.NoError(t, err)
+	require.Equal(t, suffix, res)
+
+	_, err = SplitTZSuffix(whole, sha)
+	require.Error(t, err)
+}
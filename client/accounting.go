@@ -72,7 +72,7 @@ func (c *Client) BalanceGet(ctx context.Context, prm PrmBalanceGet) (accounting.
 		res accounting.Decimal
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
@@ -61,6 +62,9 @@ type contextCall struct {
 	// ==================================================
 	// shared parameters which are set uniformly on all calls
 
+	// context of the call
+	ctx context.Context
+
 	// request signer
 	signer neofscrypto.Signer
 
@@ -151,7 +155,7 @@ func (x *contextCall) writeRequest() bool {
 	x.req.SetVerificationHeader(nil)
 
 	// sign the request
-	x.err = signServiceMessage(x.signer, x.req)
+	x.err = signServiceMessage(x.ctx, x.signer, x.req)
 	if x.err != nil {
 		x.err = fmt.Errorf("sign request: %w", x.err)
 		return false
@@ -275,10 +279,11 @@ func (x *contextCall) processCall() bool {
 }
 
 // initializes static cross-call parameters inherited from client.
-func (c *Client) initCallContext(ctx *contextCall) {
-	ctx.signer = c.prm.signer
-	ctx.callbackResp = c.prm.cbRespInfo
-	ctx.netMagic = c.prm.netMagic
+func (c *Client) initCallContext(ctx context.Context, cc *contextCall) {
+	cc.ctx = ctx
+	cc.signer = c.prm.signer
+	cc.callbackResp = c.prm.cbRespInfo
+	cc.netMagic = c.prm.netMagic
 }
 
 // ExecRaw executes f with underlying github.com/nspcc-dev/neofs-api-go/v2/rpc/client.Client
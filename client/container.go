@@ -13,7 +13,9 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofspolicy "github.com/nspcc-dev/neofs-sdk-go/crypto/policy"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/nspcc-dev/neofs-sdk-go/stat"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -36,6 +38,8 @@ type PrmContainerPut struct {
 
 	sessionSet bool
 	session    session.Container
+
+	verifyID bool
 }
 
 // WithinSession specifies session within which container should be saved.
@@ -51,6 +55,14 @@ func (x *PrmContainerPut) WithinSession(s session.Container) {
 	x.sessionSet = true
 }
 
+// VerifyID makes ContainerPut to check that the container identifier returned
+// by the server matches the one calculated from the container (see
+// [container.Container.PrecalculateID]). Mismatch is returned as an invalid
+// response error.
+func (x *PrmContainerPut) VerifyID() {
+	x.verifyID = true
+}
+
 // ContainerPut sends request to save container in NeoFS.
 //
 // Any errors (local or remote, including returned status codes) are returned as Go errors,
@@ -60,7 +72,9 @@ func (x *PrmContainerPut) WithinSession(s session.Container) {
 // The required time is also not predictable.
 //
 // Success can be verified by reading [Client.ContainerGet] using the returned
-// identifier (notice that it needs some time to succeed).
+// identifier (notice that it needs some time to succeed). The identifier can
+// be obtained before the call using [container.Container.PrecalculateID], see
+// also [PrmContainerPut.VerifyID].
 //
 // Context is required and must not be nil. It is used for network communication.
 //
@@ -83,7 +97,9 @@ func (c *Client) ContainerPut(ctx context.Context, cont container.Container, sig
 	cont.WriteToV2(&cnr)
 
 	var sig neofscrypto.Signature
-	err = cont.CalculateSignature(&sig, signer)
+	err = cont.CalculateSignatureContext(neofspolicy.WithOperation(ctx, neofspolicy.Operation{
+		Method: neofspolicy.MethodContainerPut,
+	}), &sig, signer)
 	if err != nil {
 		err = fmt.Errorf("calculate container signature: %w", err)
 		return cid.ID{}, err
@@ -122,7 +138,7 @@ func (c *Client) ContainerPut(ctx context.Context, cont container.Container, sig
 		res cid.ID
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
 		return rpcAPIPutContainer(&c.c, &req, client.WithContext(ctx))
@@ -141,6 +157,8 @@ func (c *Client) ContainerPut(ctx context.Context, cont container.Container, sig
 		cc.err = res.ReadFromV2(*cidV2)
 		if cc.err != nil {
 			cc.err = newErrInvalidResponseField(fieldCnrID, cc.err)
+		} else if prm.verifyID && !cont.AssertID(res) {
+			cc.err = newErrInvalidResponseField(fieldCnrID, errors.New("mismatches the container"))
 		}
 	}
 
@@ -189,7 +207,7 @@ func (c *Client) ContainerGet(ctx context.Context, id cid.ID, prm PrmContainerGe
 		res container.Container
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
@@ -255,7 +273,7 @@ func (c *Client) ContainerList(ctx context.Context, ownerID user.ID, prm PrmCont
 		res []cid.ID
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
@@ -340,7 +358,10 @@ func (c *Client) ContainerDelete(ctx context.Context, id cid.ID, signer neofscry
 	data := cidV2.GetValue()
 
 	var sig neofscrypto.Signature
-	err = sig.Calculate(signer, data)
+	err = sig.CalculateContext(neofspolicy.WithOperation(ctx, neofspolicy.Operation{
+		Method:    neofspolicy.MethodContainerDelete,
+		Container: &id,
+	}), signer, data)
 	if err != nil {
 		err = fmt.Errorf("calculate signature: %w", err)
 		return err
@@ -378,7 +399,7 @@ func (c *Client) ContainerDelete(ctx context.Context, id cid.ID, signer neofscry
 		cc contextCall
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
 		return rpcAPIDeleteContainer(&c.c, &req, client.WithContext(ctx))
@@ -405,6 +426,32 @@ type PrmContainerEACL struct {
 //
 // Context is required and must not be nil. It is used for network communication.
 func (c *Client) ContainerEACL(ctx context.Context, id cid.ID, prm PrmContainerEACL) (eacl.Table, error) {
+	res, _, _, err := c.containerEACL(ctx, id, prm, false)
+	return res, err
+}
+
+// ContainerEACLVerified works like ContainerEACL but additionally checks that
+// the received eACL table is authentically set by the given container owner
+// (see eacl.Table.VerifySignature). Verification failure is returned as an
+// error.
+//
+// Owner can be obtained from the container returned by ContainerGet.
+func (c *Client) ContainerEACLVerified(ctx context.Context, id cid.ID, owner user.ID, prm PrmContainerEACL) (eacl.Table, error) {
+	res, sig, tok, err := c.containerEACL(ctx, id, prm, true)
+	if err != nil {
+		return eacl.Table{}, err
+	}
+
+	if err = res.VerifySignature(sig, owner, tok); err != nil {
+		return eacl.Table{}, fmt.Errorf("verify eACL: %w", err)
+	}
+
+	return res, nil
+}
+
+// containerEACL reads eACL table of the container. If withSignature is set,
+// signature of the table and optional session token are also decoded.
+func (c *Client) containerEACL(ctx context.Context, id cid.ID, prm PrmContainerEACL, withSignature bool) (eacl.Table, neofscrypto.Signature, *session.Container, error) {
 	var err error
 	defer func() {
 		c.sendStatistic(stat.MethodContainerEACL, err)()
@@ -427,33 +474,62 @@ func (c *Client) ContainerEACL(ctx context.Context, id cid.ID, prm PrmContainerE
 	var (
 		cc  contextCall
 		res eacl.Table
+		sig neofscrypto.Signature
+		tok *session.Container
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
 		return rpcAPIGetEACL(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
-		resp := r.(*v2container.GetExtendedACLResponse)
+		body := r.(*v2container.GetExtendedACLResponse).GetBody()
 
-		eACL := resp.GetBody().GetEACL()
+		eACL := body.GetEACL()
 		if eACL == nil {
 			cc.err = newErrMissingResponseField("eACL")
 			return
 		}
 
 		res = *eacl.NewTableFromV2(eACL)
+
+		if !withSignature {
+			return
+		}
+
+		sigV2Ptr := body.GetSignature()
+		if sigV2Ptr == nil {
+			cc.err = newErrMissingResponseField("signature")
+			return
+		}
+
+		// eACL signature is transmitted without scheme, see ContainerSetEACL
+		sigV2 := *sigV2Ptr
+		sigV2.SetScheme(refs.ECDSA_RFC6979_SHA256)
+
+		if cc.err = sig.ReadFromV2(sigV2); cc.err != nil {
+			cc.err = newErrInvalidResponseField("signature", cc.err)
+			return
+		}
+
+		if tokV2 := body.GetSessionToken(); tokV2 != nil {
+			tok = new(session.Container)
+			if cc.err = tok.ReadFromV2(*tokV2); cc.err != nil {
+				cc.err = newErrInvalidResponseField("session token", cc.err)
+				return
+			}
+		}
 	}
 
 	// process call
 	if !cc.processCall() {
 		err = cc.err
-		return eacl.Table{}, cc.err
+		return eacl.Table{}, sig, nil, cc.err
 	}
 
-	return res, nil
+	return res, sig, tok, nil
 }
 
 // PrmContainerSetEACL groups optional parameters of ContainerSetEACL operation.
@@ -462,6 +538,14 @@ type PrmContainerSetEACL struct {
 
 	sessionSet bool
 	session    session.Container
+
+	checkLimits bool
+}
+
+// CheckTableLimits makes ContainerSetEACL to check the table limits (see
+// [eacl.Table.CheckLimits]) before sending the request.
+func (x *PrmContainerSetEACL) CheckTableLimits() {
+	x.checkLimits = true
 }
 
 // WithinSession specifies session within which extended ACL of the container
@@ -496,6 +580,7 @@ func (x *PrmContainerSetEACL) WithinSession(s session.Container) {
 // Return errors:
 //   - [ErrMissingEACLContainer]
 //   - [ErrMissingSigner]
+//   - [eacl.ErrLimitExceeded] if [PrmContainerSetEACL.CheckTableLimits] is set
 //
 // Context is required and must not be nil. It is used for network communication.
 func (c *Client) ContainerSetEACL(ctx context.Context, table eacl.Table, signer user.Signer, prm PrmContainerSetEACL) error {
@@ -508,17 +593,26 @@ func (c *Client) ContainerSetEACL(ctx context.Context, table eacl.Table, signer
 		return ErrMissingSigner
 	}
 
-	_, isCIDSet := table.CID()
+	cnr, isCIDSet := table.CID()
 	if !isCIDSet {
 		err = ErrMissingEACLContainer
 		return err
 	}
 
+	if prm.checkLimits {
+		if err = table.CheckLimits(); err != nil {
+			return err
+		}
+	}
+
 	// sign the eACL table
 	eaclV2 := table.ToV2()
 
 	var sig neofscrypto.Signature
-	err = sig.CalculateMarshalled(signer, eaclV2)
+	err = sig.CalculateMarshalledContext(neofspolicy.WithOperation(ctx, neofspolicy.Operation{
+		Method:    neofspolicy.MethodContainerSetExtendedACL,
+		Container: &cnr,
+	}), signer, eaclV2)
 	if err != nil {
 		err = fmt.Errorf("calculate signature: %w", err)
 		return err
@@ -556,7 +650,7 @@ func (c *Client) ContainerSetEACL(ctx context.Context, table eacl.Table, signer
 		cc contextCall
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
 		return rpcAPISetEACL(&c.c, &req, client.WithContext(ctx))
@@ -624,7 +718,7 @@ func (c *Client) ContainerAnnounceUsedSpace(ctx context.Context, announcements [
 		cc contextCall
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
@@ -660,3 +754,63 @@ func SyncContainerWithNetwork(ctx context.Context, cnr *container.Container, c N
 
 	return nil
 }
+
+// ContainerEstimationExecutor describes methods to get data related to the
+// container size estimations.
+type ContainerEstimationExecutor interface {
+	ContainerGet(ctx context.Context, id cid.ID, prm PrmContainerGet) (container.Container, error)
+	NetMapSnapshot(ctx context.Context, prm PrmNetMapSnapshot) (netmap.NetMap, error)
+}
+
+// ContainerEstimationInfo groups network data related to the size
+// estimations of the particular container in the current epoch.
+type ContainerEstimationInfo struct {
+	epoch uint64
+
+	nodes [][]netmap.NodeInfo
+}
+
+// Epoch returns current epoch of the network.
+func (x ContainerEstimationInfo) Epoch() uint64 {
+	return x.epoch
+}
+
+// Nodes returns container nodes in the current epoch as
+// [netmap.NetMap.ContainerNodes] does. These nodes store the container
+// objects and announce their volume.
+func (x ContainerEstimationInfo) Nodes() [][]netmap.NodeInfo {
+	return x.nodes
+}
+
+// Aggregator returns new [container.SizeAggregator] of the announcements made
+// in the current epoch.
+func (x ContainerEstimationInfo) Aggregator() *container.SizeAggregator {
+	return container.NewSizeAggregator(x.epoch)
+}
+
+// GetContainerEstimationInfo requests the container and the current network
+// map using passed [ContainerEstimationExecutor] and returns data related to
+// the container size estimations.
+//
+// Returns any network errors and errors of the container placement policy
+// application.
+//
+// See also [client.Client.ContainerGet], [client.Client.NetMapSnapshot].
+func GetContainerEstimationInfo(ctx context.Context, c ContainerEstimationExecutor, id cid.ID) (ContainerEstimationInfo, error) {
+	cnr, err := c.ContainerGet(ctx, id, PrmContainerGet{})
+	if err != nil {
+		return ContainerEstimationInfo{}, fmt.Errorf("container get call: %w", err)
+	}
+
+	nm, err := c.NetMapSnapshot(ctx, PrmNetMapSnapshot{})
+	if err != nil {
+		return ContainerEstimationInfo{}, fmt.Errorf("netmap snapshot call: %w", err)
+	}
+
+	nodes, err := nm.ContainerNodes(cnr.PlacementPolicy(), id)
+	if err != nil {
+		return ContainerEstimationInfo{}, fmt.Errorf("container nodes: %w", err)
+	}
+
+	return ContainerEstimationInfo{epoch: nm.Epoch(), nodes: nodes}, nil
+}
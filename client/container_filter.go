@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// DefaultContainerListConcurrency is a default number of containers requested
+// concurrently by ContainerListFiltered.
+const DefaultContainerListConcurrency = 10
+
+// ContainerListExecutor describes methods to list containers and get them.
+type ContainerListExecutor interface {
+	ContainerList(ctx context.Context, ownerID user.ID, prm PrmContainerList) ([]cid.ID, error)
+	ContainerGet(ctx context.Context, id cid.ID, prm PrmContainerGet) (container.Container, error)
+}
+
+// PrmContainerListFiltered groups optional parameters of
+// ContainerListFiltered operation. Zero PrmContainerListFiltered selects all
+// containers.
+type PrmContainerListFiltered struct {
+	concurrency int
+
+	namePrefix string
+
+	domain *container.Domain
+
+	attrs map[string]string
+}
+
+// SetConcurrency sets number of containers requested concurrently.
+// Non-positive value means DefaultContainerListConcurrency.
+func (x *PrmContainerListFiltered) SetConcurrency(n int) {
+	x.concurrency = n
+}
+
+// SetNamePrefix selects containers with names starting with the given prefix.
+//
+// See also [container.Container.Name].
+func (x *PrmContainerListFiltered) SetNamePrefix(prefix string) {
+	x.namePrefix = prefix
+}
+
+// SetDomain selects containers bound to the given domain.
+//
+// See also [container.Container.ReadDomain].
+func (x *PrmContainerListFiltered) SetDomain(d container.Domain) {
+	x.domain = &d
+}
+
+// SetAttribute selects containers having attribute with the given key and
+// value. Empty value selects containers having the attribute with any value.
+// All attributes set by SetAttribute are required.
+//
+// See also [container.Container.Attribute].
+func (x *PrmContainerListFiltered) SetAttribute(key, value string) {
+	if x.attrs == nil {
+		x.attrs = make(map[string]string)
+	}
+
+	x.attrs[key] = value
+}
+
+// match checks whether the container satisfies all filters.
+func (x PrmContainerListFiltered) match(cnr container.Container) bool {
+	if x.namePrefix != "" && !strings.HasPrefix(cnr.Name(), x.namePrefix) {
+		return false
+	}
+
+	if x.domain != nil {
+		d := cnr.ReadDomain()
+		if d.Name() != x.domain.Name() || d.Zone() != x.domain.Zone() {
+			return false
+		}
+	}
+
+	for k, v := range x.attrs {
+		if val := cnr.Attribute(k); val == "" || v != "" && val != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContainerListFiltered lists containers of the given owner using passed
+// [ContainerListExecutor], requests them concurrently and passes the ones
+// matching the filters into f in order of the list. Iteration is stopped when
+// f returns true, the remaining requests are cancelled. ContainerListFiltered
+// returns after all started requests are finished. The handler MUST NOT be
+// nil.
+//
+// Returns any network errors.
+//
+// See also [client.Client.ContainerList], [client.Client.ContainerGet].
+func ContainerListFiltered(ctx context.Context, c ContainerListExecutor, ownerID user.ID, prm PrmContainerListFiltered, f func(cid.ID, container.Container) bool) error {
+	ids, err := c.ContainerList(ctx, ownerID, PrmContainerList{})
+	if err != nil {
+		return fmt.Errorf("container list call: %w", err)
+	}
+
+	concurrency := prm.concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultContainerListConcurrency
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		cnr container.Container
+		err error
+	}
+
+	results := make([]chan result, len(ids))
+	for i := range results {
+		results[i] = make(chan result, 1)
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		sem := make(chan struct{}, concurrency)
+
+		for i := range ids {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			wg.Add(1)
+
+			go func(i int) {
+				defer func() { <-sem; wg.Done() }()
+
+				cnr, err := c.ContainerGet(ctx, ids[i], PrmContainerGet{})
+				results[i] <- result{cnr: cnr, err: err}
+			}(i)
+		}
+	}()
+
+	for i := range ids {
+		var res result
+
+		select {
+		case res = <-results[i]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if res.err != nil {
+			return fmt.Errorf("container get call for %s: %w", ids[i], res.err)
+		}
+
+		if prm.match(res.cnr) && f(ids[i], res.cnr) {
+			return nil
+		}
+	}
+
+	return nil
+}
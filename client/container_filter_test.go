@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testListExecutor struct {
+	ids []cid.ID
+
+	cnrs map[cid.ID]container.Container
+
+	failOn cid.ID
+
+	// number of concurrent and maximum concurrent ContainerGet calls
+	active, maxActive int32
+}
+
+func (x *testListExecutor) ContainerList(context.Context, user.ID, PrmContainerList) ([]cid.ID, error) {
+	return x.ids, nil
+}
+
+func (x *testListExecutor) ContainerGet(ctx context.Context, id cid.ID, _ PrmContainerGet) (container.Container, error) {
+	n := atomic.AddInt32(&x.active, 1)
+	defer atomic.AddInt32(&x.active, -1)
+
+	for {
+		m := atomic.LoadInt32(&x.maxActive)
+		if n <= m || atomic.CompareAndSwapInt32(&x.maxActive, m, n) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(time.Duration(rand.Intn(1000)) * time.Microsecond):
+	case <-ctx.Done():
+		return container.Container{}, ctx.Err()
+	}
+
+	if id == x.failOn {
+		return container.Container{}, errors.New("any error")
+	}
+
+	return x.cnrs[id], nil
+}
+
+func TestContainerListFiltered(t *testing.T) {
+	ctx := context.Background()
+	owner := *usertest.ID(t)
+
+	e := &testListExecutor{cnrs: make(map[cid.ID]container.Container)}
+
+	var d container.Domain
+	d.SetName("domain")
+
+	for i := 0; i < 50; i++ {
+		var cnr container.Container
+
+		switch i % 5 {
+		case 0:
+			cnr.SetName("prod-" + string(rune('a'+i%26)))
+		case 1:
+			cnr.SetName("test")
+			cnr.SetAttribute("tag", "x")
+		case 2:
+			cnr.WriteDomain(d)
+			cnr.SetAttribute("tag", "y")
+		case 3:
+			cnr.SetName("prod-tagged")
+			cnr.SetAttribute("tag", "x")
+		}
+
+		id := cidtest.ID()
+		e.ids = append(e.ids, id)
+		e.cnrs[id] = cnr
+	}
+
+	collect := func(t *testing.T, prm PrmContainerListFiltered) []cid.ID {
+		var res []cid.ID
+
+		err := ContainerListFiltered(ctx, e, owner, prm, func(id cid.ID, cnr container.Container) bool {
+			require.Equal(t, e.cnrs[id], cnr)
+			res = append(res, id)
+			return false
+		})
+		require.NoError(t, err)
+
+		return res
+	}
+
+	expected := func(f func(i int) bool) []cid.ID {
+		var res []cid.ID
+		for i := range e.ids {
+			if f(i) {
+				res = append(res, e.ids[i])
+			}
+		}
+
+		return res
+	}
+
+	t.Run("all", func(t *testing.T) {
+		var prm PrmContainerListFiltered
+		prm.SetConcurrency(3)
+
+		require.Equal(t, e.ids, collect(t, prm))
+		require.LessOrEqual(t, atomic.LoadInt32(&e.maxActive), int32(3))
+	})
+
+	t.Run("name prefix", func(t *testing.T) {
+		var prm PrmContainerListFiltered
+		prm.SetNamePrefix("prod-")
+
+		require.Equal(t, expected(func(i int) bool { return i%5 == 0 || i%5 == 3 }), collect(t, prm))
+	})
+
+	t.Run("domain", func(t *testing.T) {
+		var prm PrmContainerListFiltered
+		prm.SetDomain(d)
+
+		require.Equal(t, expected(func(i int) bool { return i%5 == 2 }), collect(t, prm))
+
+		var other container.Domain
+		other.SetName("domain")
+		other.SetZone("other")
+		prm.SetDomain(other)
+
+		require.Empty(t, collect(t, prm))
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		var prm PrmContainerListFiltered
+		prm.SetAttribute("tag", "")
+
+		require.Equal(t, expected(func(i int) bool { return i%5 != 0 && i%5 != 4 }), collect(t, prm))
+
+		prm.SetAttribute("tag", "x")
+		require.Equal(t, expected(func(i int) bool { return i%5 == 1 || i%5 == 3 }), collect(t, prm))
+
+		prm.SetNamePrefix("prod-")
+		require.Equal(t, expected(func(i int) bool { return i%5 == 3 }), collect(t, prm))
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		var n int
+
+		err := ContainerListFiltered(ctx, e, owner, PrmContainerListFiltered{}, func(id cid.ID, _ container.Container) bool {
+			require.Equal(t, e.ids[n], id)
+			n++
+			return n == 7
+		})
+		require.NoError(t, err)
+		require.Equal(t, 7, n)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		e.failOn = e.ids[10]
+		defer func() { e.failOn = cid.ID{} }()
+
+		var n int
+
+		err := ContainerListFiltered(ctx, e, owner, PrmContainerListFiltered{}, func(cid.ID, container.Container) bool {
+			n++
+			return false
+		})
+		require.ErrorContains(t, err, e.failOn.EncodeToString())
+		require.Equal(t, 10, n)
+	})
+}
@@ -164,7 +164,7 @@ func TestClientStatistic_AccountBalance(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		err := signServiceMessage(signer, &resp)
+		err := signServiceMessage(context.Background(), signer, &resp)
 		if err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
@@ -198,7 +198,7 @@ func TestClientStatistic_ContainerPut(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		err := signServiceMessage(signer, &resp)
+		err := signServiceMessage(context.Background(), signer, &resp)
 		if err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
@@ -250,7 +250,7 @@ func TestClientStatistic_ContainerGet(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err = signServiceMessage(signer, &resp); err != nil {
+		if err = signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -280,7 +280,7 @@ func TestClientStatistic_ContainerList(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -310,7 +310,7 @@ func TestClientStatistic_ContainerDelete(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -343,7 +343,7 @@ func TestClientStatistic_ContainerEacl(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -373,7 +373,7 @@ func TestClientStatistic_ContainerSetEacl(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -404,7 +404,7 @@ func TestClientStatistic_ContainerAnnounceUsedSpace(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -450,7 +450,7 @@ func TestClientStatistic_ContainerSyncContainerWithNetwork(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -492,7 +492,7 @@ func TestClientStatistic_ContainerEndpointInfo(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -524,7 +524,7 @@ func TestClientStatistic_ContainerNetMapSnapshot(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -560,7 +560,7 @@ func TestClientStatistic_CreateSession(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -652,7 +652,7 @@ func TestClientStatistic_ObjectDelete(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -722,7 +722,7 @@ func TestClientStatistic_ObjectHead(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -793,7 +793,7 @@ func TestClientStatistic_ObjectHash(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -863,7 +863,7 @@ func TestClientStatistic_AnnounceIntermediateTrust(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
@@ -895,7 +895,7 @@ func TestClientStatistic_MethodAnnounceLocalTrust(t *testing.T) {
 		resp.SetBody(&body)
 		resp.SetMetaHeader(&meta)
 
-		if err := signServiceMessage(signer, &resp); err != nil {
+		if err := signServiceMessage(context.Background(), signer, &resp); err != nil {
 			panic(fmt.Sprintf("sign response: %v", err))
 		}
 
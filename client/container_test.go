@@ -4,9 +4,19 @@ import (
 	"context"
 	"testing"
 
+	v2container "github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	rpcapi "github.com/nspcc-dev/neofs-api-go/v2/rpc"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,3 +57,184 @@ func TestClient_Container(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_ContainerEACLVerified(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t, nil)
+	owner := test.RandomSignerRFC6979(t)
+	cnr := cidtest.ID()
+	table := eacl.PublicReadTable(cnr)
+
+	respond := func(sig *neofscrypto.Signature) {
+		rpcAPIGetEACL = func(_ *client.Client, _ *v2container.GetExtendedACLRequest, _ ...client.CallOption) (*v2container.GetExtendedACLResponse, error) {
+			var body v2container.GetExtendedACLResponseBody
+			body.SetEACL(table.ToV2())
+
+			if sig != nil {
+				var sigV2 refs.Signature
+				sig.WriteToV2(&sigV2)
+				body.SetSignature(&sigV2)
+			}
+
+			var resp v2container.GetExtendedACLResponse
+			resp.SetBody(&body)
+
+			return &resp, signServiceMessage(ctx, owner, &resp)
+		}
+	}
+
+	t.Cleanup(func() { rpcAPIGetEACL = rpcapi.GetEACL })
+
+	t.Run("missing signature", func(t *testing.T) {
+		respond(nil)
+
+		_, err := c.ContainerEACL(ctx, cnr, PrmContainerEACL{})
+		require.NoError(t, err)
+
+		_, err = c.ContainerEACLVerified(ctx, cnr, owner.UserID(), PrmContainerEACL{})
+		require.Error(t, err)
+	})
+
+	sig, err := table.Sign(owner)
+	require.NoError(t, err)
+	respond(&sig)
+
+	t.Run("owner", func(t *testing.T) {
+		res, err := c.ContainerEACLVerified(ctx, cnr, owner.UserID(), PrmContainerEACL{})
+		require.NoError(t, err)
+		require.True(t, eacl.EqualTables(*table, res))
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, err := c.ContainerEACLVerified(ctx, cnr, *usertest.ID(t), PrmContainerEACL{})
+		require.Error(t, err)
+	})
+}
+
+type testEstimationExecutor struct {
+	cnr container.Container
+	nm  netmap.NetMap
+}
+
+func (x testEstimationExecutor) ContainerGet(context.Context, cid.ID, PrmContainerGet) (container.Container, error) {
+	return x.cnr, nil
+}
+
+func (x testEstimationExecutor) NetMapSnapshot(context.Context, PrmNetMapSnapshot) (netmap.NetMap, error) {
+	return x.nm, nil
+}
+
+func TestGetContainerEstimationInfo(t *testing.T) {
+	ctx := context.Background()
+	id := cidtest.ID()
+
+	var p netmap.PlacementPolicy
+	require.NoError(t, p.DecodeString("REP 2"))
+
+	var e testEstimationExecutor
+	e.cnr.SetPlacementPolicy(p)
+	e.nm.SetEpoch(13)
+	e.nm.SetNodes([]netmap.NodeInfo{netmaptest.NodeInfo(), netmaptest.NodeInfo(), netmaptest.NodeInfo()})
+
+	res, err := GetContainerEstimationInfo(ctx, e, id)
+	require.NoError(t, err)
+	require.EqualValues(t, 13, res.Epoch())
+
+	exp, err := e.nm.ContainerNodes(p, id)
+	require.NoError(t, err)
+	require.Equal(t, exp, res.Nodes())
+
+	var est container.SizeEstimation
+	est.SetEpoch(13)
+	est.SetContainer(id)
+	require.NoError(t, res.Aggregator().Add(est))
+
+	e.nm.SetNodes(e.nm.Nodes()[:1])
+
+	_, err = GetContainerEstimationInfo(ctx, e, id)
+	require.Error(t, err)
+}
+
+func TestClient_ContainerPut(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t, nil)
+	signer := test.RandomSignerRFC6979(t)
+	cnr := prepareContainer(*randAccount(signer))
+
+	exp, err := cnr.PrecalculateID()
+	require.NoError(t, err)
+
+	respond := func(id *refs.ContainerID) {
+		rpcAPIPutContainer = func(*client.Client, *v2container.PutRequest, ...client.CallOption) (*v2container.PutResponse, error) {
+			var body v2container.PutResponseBody
+			body.SetContainerID(id)
+
+			var resp v2container.PutResponse
+			resp.SetBody(&body)
+
+			return &resp, signServiceMessage(ctx, signer, &resp)
+		}
+	}
+
+	t.Cleanup(func() { rpcAPIPutContainer = rpcapi.PutContainer })
+
+	var idV2 refs.ContainerID
+	exp.WriteToV2(&idV2)
+	respond(&idV2)
+
+	id, err := c.ContainerPut(ctx, cnr, signer, PrmContainerPut{})
+	require.NoError(t, err)
+	require.Equal(t, exp, id)
+
+	var prm PrmContainerPut
+	prm.VerifyID()
+
+	id, err = c.ContainerPut(ctx, cnr, signer, prm)
+	require.NoError(t, err)
+	require.Equal(t, exp, id)
+
+	respond(randRefsContainerID())
+
+	// server ID is trusted by default
+	id, err = c.ContainerPut(ctx, cnr, signer, PrmContainerPut{})
+	require.NoError(t, err)
+	require.NotEqual(t, exp, id)
+
+	_, err = c.ContainerPut(ctx, cnr, signer, prm)
+	require.ErrorContains(t, err, "mismatches the container")
+}
+
+func TestClient_ContainerSetEACL_Limits(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t, nil)
+	signer := test.RandomSignerRFC6979(t)
+
+	var sent int
+	rpcAPISetEACL = func(*client.Client, *v2container.SetExtendedACLRequest, ...client.CallOption) (*v2container.PutResponse, error) {
+		sent++
+
+		var resp v2container.PutResponse
+		resp.SetBody(new(v2container.PutResponseBody))
+
+		return &resp, signServiceMessage(ctx, signer, &resp)
+	}
+
+	t.Cleanup(func() { rpcAPISetEACL = rpcapi.SetEACL })
+
+	table := eacl.CreateTable(cidtest.ID())
+	r := eacl.CreateRecord(eacl.ActionDeny, eacl.OperationGet)
+	eacl.AddFormedTarget(r, eacl.RoleOthers)
+	for n := eacl.MaxTableSize/len(r.ToV2().StableMarshal(nil)) + 1; n > 0; n-- {
+		table.AddRecord(r)
+	}
+
+	// limits are not checked by default
+	require.NoError(t, c.ContainerSetEACL(ctx, *table, signer, PrmContainerSetEACL{}))
+	require.Equal(t, 1, sent)
+
+	var prm PrmContainerSetEACL
+	prm.CheckTableLimits()
+
+	require.ErrorIs(t, c.ContainerSetEACL(ctx, *table, signer, prm), eacl.ErrLimitExceeded)
+	require.Equal(t, 1, sent)
+}
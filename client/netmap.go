@@ -73,7 +73,7 @@ func (c *Client) EndpointInfo(ctx context.Context, prm PrmEndpointInfo) (*ResEnd
 		res ResEndpointInfo
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
@@ -151,7 +151,7 @@ func (c *Client) NetworkInfo(ctx context.Context, prm PrmNetworkInfo) (netmap.Ne
 		res netmap.NetworkInfo
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
@@ -213,7 +213,7 @@ func (c *Client) NetMapSnapshot(ctx context.Context, _ PrmNetMapSnapshot) (netma
 	req.SetBody(&body)
 	c.prepareRequest(&req, &meta)
 
-	err = signServiceMessage(c.prm.signer, &req)
+	err = signServiceMessage(ctx, c.prm.signer, &req)
 	if err != nil {
 		err = fmt.Errorf("sign request: %w", err)
 		return netmap.NetMap{}, err
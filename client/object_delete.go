@@ -100,7 +100,7 @@ func (c *Client) ObjectDelete(ctx context.Context, containerID cid.ID, objectID
 	req.SetBody(&body)
 	c.prepareRequest(&req, &prm.meta)
 
-	err = signServiceMessage(signer, &req)
+	err = signServiceMessage(ctx, signer, &req)
 	if err != nil {
 		err = fmt.Errorf("sign request: %w", err)
 		return oid.ID{}, err
@@ -302,7 +302,7 @@ func (c *Client) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID
 	req.SetBody(&body)
 	c.prepareRequest(&req, &prm.meta)
 
-	err = signServiceMessage(signer, &req)
+	err = signServiceMessage(ctx, signer, &req)
 	if err != nil {
 		err = fmt.Errorf("sign request: %w", err)
 		return hdr, nil, err
@@ -417,7 +417,7 @@ func (c *Client) ObjectHead(ctx context.Context, containerID cid.ID, objectID oi
 	c.prepareRequest(&req, &prm.meta)
 
 	// sign the request
-	err = signServiceMessage(signer, &req)
+	err = signServiceMessage(ctx, signer, &req)
 	if err != nil {
 		err = fmt.Errorf("sign request: %w", err)
 		return nil, err
@@ -670,7 +670,7 @@ func (c *Client) ObjectRangeInit(ctx context.Context, containerID cid.ID, object
 	req.SetBody(&body)
 	c.prepareRequest(&req, &prm.meta)
 
-	err = signServiceMessage(signer, &req)
+	err = signServiceMessage(ctx, signer, &req)
 	if err != nil {
 		err = fmt.Errorf("sign request: %w", err)
 		return nil, err
@@ -145,7 +145,7 @@ func (c *Client) ObjectHash(ctx context.Context, containerID cid.ID, objectID oi
 	c.prepareRequest(&req, &prm.meta)
 	req.SetBody(&prm.body)
 
-	err = signServiceMessage(signer, &req)
+	err = signServiceMessage(ctx, signer, &req)
 	if err != nil {
 		err = fmt.Errorf("sign request: %w", err)
 		return nil, err
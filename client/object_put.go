@@ -65,6 +65,7 @@ type ObjectWriter interface {
 //
 // Must be initialized using [Client.ObjectPutInit], any other usage is unsafe.
 type DefaultObjectWriter struct {
+	ctx             context.Context
 	cancelCtxStream context.CancelFunc
 
 	client *Client
@@ -126,7 +127,10 @@ func (x *DefaultObjectWriter) writeHeader(hdr object.Object) error {
 	x.req.GetBody().SetObjectPart(&x.partInit)
 	x.req.SetVerificationHeader(nil)
 
-	x.err = signServiceMessage(x.signer, &x.req)
+	// payload chunks are signed for the same container
+	x.ctx = withRequestOperation(x.ctx, &x.req)
+
+	x.err = signServiceMessage(x.ctx, x.signer, &x.req)
 	if x.err != nil {
 		x.err = fmt.Errorf("sign message: %w", x.err)
 		return x.err
@@ -176,7 +180,7 @@ func (x *DefaultObjectWriter) Write(chunk []byte) (n int, err error) {
 		x.partChunk.SetChunk(chunk[:ln])
 		x.req.SetVerificationHeader(nil)
 
-		x.err = signServiceMessage(x.signer, &x.req)
+		x.err = signServiceMessage(x.ctx, x.signer, &x.req)
 		if x.err != nil {
 			x.err = fmt.Errorf("sign message: %w", x.err)
 			return writtenBytes, x.err
@@ -296,6 +300,7 @@ func (c *Client) ObjectPutInit(ctx context.Context, hdr object.Object, signer us
 	}
 
 	w.signer = signer
+	w.ctx = ctx
 	w.cancelCtxStream = cancel
 	w.client = c
 	w.stream = stream
@@ -225,7 +225,7 @@ func (c *Client) ObjectSearchInit(ctx context.Context, containerID cid.ID, signe
 	req.SetBody(&body)
 	c.prepareRequest(&req, &prm.meta)
 
-	err = signServiceMessage(signer, &req)
+	err = signServiceMessage(ctx, signer, &req)
 	if err != nil {
 		err = fmt.Errorf("sign request: %w", err)
 		return nil, err
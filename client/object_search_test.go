@@ -160,7 +160,7 @@ func (s *singleStreamResponder) Read(resp *v2object.SearchResponse) error {
 	}
 	resp.SetBody(&body)
 
-	err := signServiceMessage(s.signer, resp)
+	err := signServiceMessage(context.Background(), s.signer, resp)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/reputation"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofspolicy "github.com/nspcc-dev/neofs-sdk-go/crypto/policy"
+)
+
+// withRequestOperation returns a copy of the parent context describing the
+// request for the signers (see neofspolicy.WithOperation). If the container
+// is unknown from the request (e.g. object payload chunk), it is inherited
+// from the parent context describing the same method.
+func withRequestOperation(parent context.Context, req any) context.Context {
+	op := requestOperation(req)
+
+	if op.Container == nil {
+		if prev, ok := neofspolicy.OperationFromContext(parent); ok && prev.Method == op.Method {
+			return parent
+		}
+	}
+
+	return neofspolicy.WithOperation(parent, op)
+}
+
+func requestOperation(req any) neofspolicy.Operation {
+	switch v := req.(type) {
+	default:
+		return neofspolicy.Operation{}
+
+		/* Accounting */
+	case *accounting.BalanceRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodAccountingBalance}
+
+		/* Session */
+	case *session.CreateRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodSessionCreate}
+
+		/* Container */
+	case *container.PutRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodContainerPut}
+	case *container.DeleteRequest:
+		return containerOperation(neofspolicy.MethodContainerDelete, v.GetBody().GetContainerID())
+	case *container.GetRequest:
+		return containerOperation(neofspolicy.MethodContainerGet, v.GetBody().GetContainerID())
+	case *container.ListRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodContainerList}
+	case *container.SetExtendedACLRequest:
+		return containerOperation(neofspolicy.MethodContainerSetExtendedACL, v.GetBody().GetEACL().GetContainerID())
+	case *container.GetExtendedACLRequest:
+		return containerOperation(neofspolicy.MethodContainerGetExtendedACL, v.GetBody().GetContainerID())
+	case *container.AnnounceUsedSpaceRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodContainerAnnounceUsedSpace}
+
+		/* Object */
+	case *object.PutRequest:
+		var cnr *refs.ContainerID
+		if init, ok := v.GetBody().GetObjectPart().(*object.PutObjectPartInit); ok {
+			cnr = init.GetHeader().GetContainerID()
+		}
+
+		return containerOperation(neofspolicy.MethodObjectPut, cnr)
+	case *object.GetRequest:
+		return containerOperation(neofspolicy.MethodObjectGet, v.GetBody().GetAddress().GetContainerID())
+	case *object.HeadRequest:
+		return containerOperation(neofspolicy.MethodObjectHead, v.GetBody().GetAddress().GetContainerID())
+	case *object.SearchRequest:
+		return containerOperation(neofspolicy.MethodObjectSearch, v.GetBody().GetContainerID())
+	case *object.DeleteRequest:
+		return containerOperation(neofspolicy.MethodObjectDelete, v.GetBody().GetAddress().GetContainerID())
+	case *object.GetRangeRequest:
+		return containerOperation(neofspolicy.MethodObjectGetRange, v.GetBody().GetAddress().GetContainerID())
+	case *object.GetRangeHashRequest:
+		return containerOperation(neofspolicy.MethodObjectGetRangeHash, v.GetBody().GetAddress().GetContainerID())
+
+		/* Netmap */
+	case *netmap.LocalNodeInfoRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodNetmapLocalNodeInfo}
+	case *netmap.NetworkInfoRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodNetmapNetworkInfo}
+	case *netmap.SnapshotRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodNetmapNetmapSnapshot}
+
+		/* Reputation */
+	case *reputation.AnnounceLocalTrustRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodReputationAnnounceLocalTrust}
+	case *reputation.AnnounceIntermediateResultRequest:
+		return neofspolicy.Operation{Method: neofspolicy.MethodReputationAnnounceIntermediateResult}
+	}
+}
+
+func containerOperation(method neofspolicy.Method, m *refs.ContainerID) neofspolicy.Operation {
+	op := neofspolicy.Operation{Method: method}
+
+	if m != nil {
+		var id cid.ID
+		if id.ReadFromV2(*m) == nil {
+			op.Container = &id
+		}
+	}
+
+	return op
+}
@@ -73,7 +73,7 @@ func (c *Client) AnnounceLocalTrust(ctx context.Context, epoch uint64, trusts []
 		cc contextCall
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
@@ -145,7 +145,7 @@ func (c *Client) AnnounceIntermediateTrust(ctx context.Context, epoch uint64, tr
 		cc contextCall
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.call = func() (responseV2, error) {
@@ -94,7 +94,7 @@ func (c *Client) SessionCreate(ctx context.Context, signer user.Signer, prm PrmS
 		res ResSessionCreate
 	)
 
-	c.initCallContext(&cc)
+	c.initCallContext(ctx, &cc)
 	cc.signer = signer
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
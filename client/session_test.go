@@ -28,7 +28,7 @@ func (m sessionAPIServer) createSession(*client.Client, *session.CreateRequest,
 	var resp session.CreateResponse
 	resp.SetBody(&body)
 
-	if err := signServiceMessage(m.signer, &resp); err != nil {
+	if err := signServiceMessage(context.Background(), m.signer, &resp); err != nil {
 		return nil, err
 	}
 
@@ -1,8 +1,13 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"sync"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/accounting"
 	"github.com/nspcc-dev/neofs-api-go/v2/container"
@@ -11,7 +16,6 @@ import (
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	"github.com/nspcc-dev/neofs-api-go/v2/reputation"
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
-	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 )
 
@@ -134,7 +138,7 @@ func (s stableMarshalerWrapper) SignedDataSize() int {
 // signServiceMessage signing request or response messages which can be sent or received from neofs endpoint.
 // Return errors:
 //   - [ErrSign]
-func signServiceMessage(signer neofscrypto.Signer, msg interface{}) error {
+func signServiceMessage(ctx context.Context, signer neofscrypto.Signer, msg interface{}) error {
 	var (
 		body, meta, verifyOrigin stableMarshaler
 		verifyHdr                verificationHeader
@@ -145,6 +149,7 @@ func signServiceMessage(signer neofscrypto.Signer, msg interface{}) error {
 	case nil:
 		return nil
 	case serviceRequest:
+		ctx = withRequestOperation(ctx, v)
 		body = serviceMessageBody(v)
 		meta = v.GetMetaHeader()
 		verifyHdr = &requestVerificationHeader{new(session.RequestVerificationHeader)}
@@ -172,18 +177,18 @@ func signServiceMessage(signer neofscrypto.Signer, msg interface{}) error {
 
 	if verifyOrigin == nil {
 		// sign session message body
-		if err := signServiceMessagePart(signer, body, verifyHdr.SetBodySignature); err != nil {
+		if err := signServiceMessagePart(ctx, signer, body, verifyHdr.SetBodySignature); err != nil {
 			return NewSignError(fmt.Errorf("body: %w", err))
 		}
 	}
 
 	// sign meta header
-	if err := signServiceMessagePart(signer, meta, verifyHdr.SetMetaSignature); err != nil {
+	if err := signServiceMessagePart(ctx, signer, meta, verifyHdr.SetMetaSignature); err != nil {
 		return NewSignError(fmt.Errorf("meta header: %w", err))
 	}
 
 	// sign verification header origin
-	if err := signServiceMessagePart(signer, verifyOrigin, verifyHdr.SetOriginSignature); err != nil {
+	if err := signServiceMessagePart(ctx, signer, verifyOrigin, verifyHdr.SetOriginSignature); err != nil {
 		return NewSignError(fmt.Errorf("origin of verification header: %w", err))
 	}
 
@@ -196,11 +201,19 @@ func signServiceMessage(signer neofscrypto.Signer, msg interface{}) error {
 	return nil
 }
 
-func signServiceMessagePart(signer neofscrypto.Signer, part stableMarshaler, sigWrite func(*refs.Signature)) error {
+func signServiceMessagePart(ctx context.Context, signer neofscrypto.Signer, part stableMarshaler, sigWrite func(*refs.Signature)) error {
 	var sig neofscrypto.Signature
 	var sigv2 refs.Signature
 
-	if err := sig.CalculateMarshalled(signer, part); err != nil {
+	if r := streamedPart(signer, part); r != nil {
+		if err := sig.CalculateStreamContext(ctx, signer, r); err != nil {
+			return fmt.Errorf("calculate %w", err)
+		}
+	} else if isPutHeaderPart(signer, part) {
+		if err := calculatePooled(ctx, &sig, signer, part); err != nil {
+			return fmt.Errorf("calculate %w", err)
+		}
+	} else if err := sig.CalculateMarshalledContext(ctx, signer, part); err != nil {
 		return fmt.Errorf("calculate %w", err)
 	}
 
@@ -210,6 +223,79 @@ func signServiceMessagePart(signer neofscrypto.Signer, part stableMarshaler, sig
 	return nil
 }
 
+// putHeaderBuffers pools buffers for the bodies of the object PUT requests
+// carrying the header.
+var putHeaderBuffers = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 1024)
+		return &b
+	},
+}
+
+// isPutHeaderPart checks whether the message part is a body of the object PUT
+// request carrying the header. Such bodies are signed for each object, so
+// they are encoded into the pooled buffers. Static signers do not need the
+// encoding at all.
+func isPutHeaderPart(signer neofscrypto.Signer, part stableMarshaler) bool {
+	if _, ok := signer.(*neofscrypto.StaticSigner); ok {
+		return false
+	}
+
+	body, ok := part.(*object.PutRequestBody)
+	if !ok {
+		return false
+	}
+
+	_, ok = body.GetObjectPart().(*object.PutObjectPartInit)
+	return ok
+}
+
+// calculatePooled signs stable encoding of the message part made in the
+// buffer from putHeaderBuffers.
+func calculatePooled(ctx context.Context, sig *neofscrypto.Signature, signer neofscrypto.Signer, part stableMarshaler) error {
+	buf := putHeaderBuffers.Get().(*[]byte)
+	defer putHeaderBuffers.Put(buf)
+
+	if size := part.StableSize(); cap(*buf) < size {
+		*buf = make([]byte, size)
+	} else {
+		*buf = (*buf)[:size]
+	}
+
+	*buf = part.StableMarshal(*buf)
+
+	return sig.CalculateContext(ctx, signer, *buf)
+}
+
+// streamedPart returns stable encoding of the message part as io.Reader if
+// signer implements [neofscrypto.StreamSigner] and the part is worth
+// streaming. Returns nil otherwise. Currently, body of the object payload
+// chunk request is streamed only: it is the largest signed part and its
+// encoding is a plain prefix with the payload.
+func streamedPart(signer neofscrypto.Signer, part stableMarshaler) io.Reader {
+	if _, ok := signer.(neofscrypto.StreamSigner); !ok {
+		return nil
+	}
+
+	body, ok := part.(*object.PutRequestBody)
+	if !ok {
+		return nil
+	}
+
+	chunk, ok := body.GetObjectPart().(*object.PutObjectPartChunk)
+	if !ok || len(chunk.GetChunk()) == 0 {
+		return nil
+	}
+
+	const chunkField = 2 // see neo.fs.v2.object.PutRequest.Body
+
+	prefix := make([]byte, 2*binary.MaxVarintLen64)
+	n := binary.PutUvarint(prefix, chunkField<<3|2) // length-delimited
+	n += binary.PutUvarint(prefix[n:], uint64(len(chunk.GetChunk())))
+
+	return io.MultiReader(bytes.NewReader(prefix[:n]), bytes.NewReader(chunk.GetChunk()))
+}
+
 func verifyServiceMessage(msg interface{}) error {
 	var (
 		meta   metaHeader
@@ -278,12 +364,39 @@ func verifyMatryoshkaLevel(body stableMarshaler, meta metaHeader, verify verific
 	return verifyMatryoshkaLevel(body, meta.getOrigin(), origin, buf)
 }
 
+// verifyServiceMessagePart checks signature of the message part using
+// neofscrypto.Signature, so decoded public keys of the same nodes are cached
+// (see neofscrypto.SetPublicKeyCacheSize) instead of being decompressed on
+// each response.
 func verifyServiceMessagePart(part stableMarshaler, sigRdr func() *refs.Signature, buf []byte) error {
-	return signature.VerifyDataWithSource(
-		&stableMarshalerWrapper{part},
-		sigRdr,
-		signature.WithBuffer(buf),
-	)
+	m := sigRdr()
+	if m == nil {
+		return errors.New("missing signature")
+	}
+
+	var sig neofscrypto.Signature
+	if err := sig.ReadFromV2(*m); err != nil {
+		return err
+	}
+
+	src := stableMarshalerWrapper{part}
+
+	if size := src.SignedDataSize(); cap(buf) < size {
+		buf = make([]byte, size)
+	} else {
+		buf = buf[:size]
+	}
+
+	data, err := src.ReadSignedData(buf)
+	if err != nil {
+		return fmt.Errorf("read signed data: %w", err)
+	}
+
+	if !sig.Verify(data) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
 }
 
 func serviceMessageBody(req any) stableMarshaler {
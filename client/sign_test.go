@@ -1,13 +1,17 @@
 package client
 
 import (
+	"context"
 	"crypto/rand"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/accounting"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofspolicy "github.com/nspcc-dev/neofs-sdk-go/crypto/policy"
 	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	"github.com/stretchr/testify/require"
 )
@@ -30,7 +34,7 @@ func testRequestSign(t *testing.T, signer neofscrypto.Signer, meta *session.Requ
 	require.Error(t, verifyServiceMessage(req))
 
 	// sign request
-	require.NoError(t, signServiceMessage(signer, req))
+	require.NoError(t, signServiceMessage(context.Background(), signer, req))
 
 	// verification must pass
 	require.NoError(t, verifyServiceMessage(req))
@@ -39,7 +43,7 @@ func testRequestSign(t *testing.T, signer neofscrypto.Signer, meta *session.Requ
 	req.SetMetaHeader(meta)
 
 	// sign request
-	require.NoError(t, signServiceMessage(signer, req))
+	require.NoError(t, signServiceMessage(context.Background(), signer, req))
 
 	// verification must pass
 	require.NoError(t, verifyServiceMessage(req))
@@ -66,7 +70,7 @@ func testResponseSign(t *testing.T, signer neofscrypto.Signer, meta *session.Res
 	require.Error(t, verifyServiceMessage(resp))
 
 	// sign request
-	require.NoError(t, signServiceMessage(signer, resp))
+	require.NoError(t, signServiceMessage(context.Background(), signer, resp))
 
 	// verification must pass
 	require.NoError(t, verifyServiceMessage(resp))
@@ -75,7 +79,7 @@ func testResponseSign(t *testing.T, signer neofscrypto.Signer, meta *session.Res
 	resp.SetMetaHeader(meta)
 
 	// sign request
-	require.NoError(t, signServiceMessage(signer, resp))
+	require.NoError(t, signServiceMessage(context.Background(), signer, resp))
 
 	// verification must pass
 	require.NoError(t, verifyServiceMessage(resp))
@@ -102,7 +106,7 @@ func TestEmptyMessage(t *testing.T) {
 	signer := test.RandomSignerRFC6979(t)
 
 	require.NoError(t, verifyServiceMessage(nil))
-	require.NoError(t, signServiceMessage(signer, nil))
+	require.NoError(t, signServiceMessage(context.Background(), signer, nil))
 }
 
 func TestBalanceRequest(t *testing.T) {
@@ -240,3 +244,191 @@ func TestCreateResponse(t *testing.T) {
 
 	testResponseMeta(t, meta, req)
 }
+
+// hides neofscrypto.StreamSigner implementation.
+type nonStreamSigner struct {
+	neofscrypto.Signer
+}
+
+func TestPutChunkRequest(t *testing.T) {
+	for _, signer := range []neofscrypto.Signer{
+		test.RandomSignerRFC6979(t),
+		test.RandomSigner(t),
+		nonStreamSigner{test.RandomSignerRFC6979(t)},
+	} {
+		for _, size := range []int{0, 1, 127, 128, 1 << 20} {
+			chunk := make([]byte, size)
+			_, _ = rand.Read(chunk)
+
+			var part object.PutObjectPartChunk
+			part.SetChunk(chunk)
+
+			var body object.PutRequestBody
+			body.SetObjectPart(&part)
+
+			meta := &session.RequestMetaHeader{}
+			meta.SetTTL(1)
+
+			var req object.PutRequest
+			req.SetBody(&body)
+			req.SetMetaHeader(meta)
+
+			require.NoError(t, signServiceMessage(context.Background(), signer, &req))
+			require.NoError(t, verifyServiceMessage(&req), "signer %T, size %d", signer, size)
+
+			if size > 0 {
+				chunk[0]++
+				require.Error(t, verifyServiceMessage(&req), "signer %T, size %d", signer, size)
+			}
+		}
+	}
+}
+
+func newPutHeaderBody(tb testing.TB) *object.PutRequestBody {
+	cnr := cidtest.ID()
+	var cnrV2 refs.ContainerID
+	cnr.WriteToV2(&cnrV2)
+
+	attrs := make([]object.Attribute, 3)
+	for i := range attrs {
+		attrs[i].SetKey("key")
+		attrs[i].SetValue("value")
+	}
+
+	var hdr object.Header
+	hdr.SetContainerID(&cnrV2)
+	hdr.SetPayloadLength(1 << 20)
+	hdr.SetAttributes(attrs)
+
+	var init object.PutObjectPartInit
+	init.SetHeader(&hdr)
+
+	var body object.PutRequestBody
+	body.SetObjectPart(&init)
+
+	return &body
+}
+
+func TestPutHeaderRequest(t *testing.T) {
+	for _, signer := range []neofscrypto.Signer{
+		test.RandomSignerRFC6979(t),
+		test.RandomSigner(t),
+	} {
+		body := newPutHeaderBody(t)
+
+		for i := 0; i < 3; i++ {
+			var req object.PutRequest
+			req.SetBody(body)
+			req.SetMetaHeader(new(session.RequestMetaHeader))
+
+			require.NoError(t, signServiceMessage(context.Background(), signer, &req))
+			require.NoError(t, verifyServiceMessage(&req), "signer %T", signer)
+
+			// signature covers the header encoded in the pooled buffer
+			body.GetObjectPart().(*object.PutObjectPartInit).GetHeader().SetPayloadLength(uint64(i))
+			require.Error(t, verifyServiceMessage(&req), "signer %T", signer)
+		}
+	}
+}
+
+func TestSignServiceMessagePolicy(t *testing.T) {
+	cnr := cidtest.ID()
+	var cnrV2 refs.ContainerID
+	cnr.WriteToV2(&cnrV2)
+
+	var p neofspolicy.Policy
+	p.Allow(neofspolicy.MethodObjectPut, cnr)
+	signer := neofspolicy.NewSigner(test.RandomSignerRFC6979(t), p)
+
+	var hdr object.Header
+	hdr.SetContainerID(&cnrV2)
+
+	var init object.PutObjectPartInit
+	init.SetHeader(&hdr)
+
+	var body object.PutRequestBody
+	body.SetObjectPart(&init)
+
+	var req object.PutRequest
+	req.SetBody(&body)
+	req.SetMetaHeader(new(session.RequestMetaHeader))
+
+	ctx := withRequestOperation(context.Background(), &req)
+	require.NoError(t, signServiceMessage(ctx, signer, &req))
+	require.NoError(t, verifyServiceMessage(&req))
+
+	var chunk object.PutObjectPartChunk
+	chunk.SetChunk([]byte("Hello, world!"))
+	body.SetObjectPart(&chunk)
+	req.SetVerificationHeader(nil)
+
+	require.NoError(t, signServiceMessage(ctx, signer, &req))
+	require.NoError(t, verifyServiceMessage(&req))
+
+	req.SetVerificationHeader(nil)
+	require.ErrorIs(t, signServiceMessage(context.Background(), signer, &req), neofspolicy.ErrDenied)
+
+	hdr.SetContainerID(nil)
+	body.SetObjectPart(&init)
+	require.ErrorIs(t, signServiceMessage(context.Background(), signer, &req), neofspolicy.ErrDenied)
+
+	var searchBody object.SearchRequestBody
+	searchBody.SetContainerID(&cnrV2)
+
+	var search object.SearchRequest
+	search.SetBody(&searchBody)
+	search.SetMetaHeader(new(session.RequestMetaHeader))
+	require.ErrorIs(t, signServiceMessage(ctx, signer, &search), neofspolicy.ErrDenied)
+}
+
+func BenchmarkVerifyServiceMessage(b *testing.B) {
+	signer := test.RandomSigner(b)
+
+	var body accounting.BalanceResponseBody
+	body.SetBalance(new(accounting.Decimal))
+
+	var resp accounting.BalanceResponse
+	resp.SetBody(&body)
+	resp.SetMetaHeader(new(session.ResponseMetaHeader))
+
+	require.NoError(b, signServiceMessage(context.Background(), signer, &resp))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := verifyServiceMessage(&resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSignPutHeader(b *testing.B) {
+	signer := test.RandomSignerRFC6979(b)
+	body := newPutHeaderBody(b)
+	ctx := context.Background()
+
+	b.Run("new buffer", func(b *testing.B) {
+		var sig neofscrypto.Signature
+
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			if err := sig.CalculateMarshalledContext(ctx, signer, body); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("pooled buffer", func(b *testing.B) {
+		var sig neofscrypto.Signature
+
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			if err := calculatePooled(ctx, &sig, signer, body); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -121,28 +121,39 @@ func isReplicationOp(op Op) bool {
 //	OpObjectSearch
 //	OpObjectHash
 //
-// See also IsOpAllowed.
+// See also IsOpAllowed, DisallowOp.
 func (x *Basic) AllowOp(op Op, role Role) {
-	var bitPos uint8
+	setOpBit((*uint32)(x), op, opRoleBitPos(op, role))
+}
 
+// DisallowOp is a reverse action to AllowOp: it disallows the parties with the
+// given role to the given operation. Op and role MUST satisfy the same
+// requirements as for AllowOp.
+//
+// See also IsOpAllowed.
+func (x *Basic) DisallowOp(op Op, role Role) {
+	resetOpBit((*uint32)(x), op, opRoleBitPos(op, role))
+}
+
+// returns position of the op bit for the given role in Basic. Panics if rules
+// of the role can't be modified.
+func opRoleBitPos(op Op, role Role) uint8 {
 	switch role {
 	default:
 		panic(fmt.Sprintf("unable to set rules for unsupported role %v", role))
 	case RoleInnerRing:
 		panic("basic ACL MUST NOT be modified for Inner Ring")
 	case RoleOwner:
-		bitPos = opBitPosOwner
+		return opBitPosOwner
 	case RoleContainer:
 		if isReplicationOp(op) {
 			panic("basic ACL for container replication ops MUST NOT be modified")
 		}
 
-		bitPos = opBitPosContainer
+		return opBitPosContainer
 	case RoleOthers:
-		bitPos = opBitPosOthers
+		return opBitPosOthers
 	}
-
-	setOpBit((*uint32)(x), op, bitPos)
 }
 
 // IsOpAllowed checks if parties with the given role are allowed to the given op
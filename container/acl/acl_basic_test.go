@@ -94,6 +94,32 @@ func TestBasic_AllowOp(t *testing.T) {
 	require.True(t, val.IsOpAllowed(op, role))
 	val2.FromBits(val.Bits())
 	require.True(t, val2.IsOpAllowed(op, role))
+
+	val.DisallowOp(op, role)
+
+	require.False(t, val.IsOpAllowed(op, role))
+	val2.FromBits(val.Bits())
+	require.False(t, val2.IsOpAllowed(op, role))
+}
+
+func TestBasic_DisallowOp(t *testing.T) {
+	val := PublicRWExtended
+
+	for op := opZero + 1; op < opLast; op++ {
+		require.Panics(t, func() { val.DisallowOp(op, RoleInnerRing) })
+
+		if isReplicationOp(op) {
+			require.Panics(t, func() { val.DisallowOp(op, RoleContainer) })
+		}
+
+		for _, role := range []Role{RoleOwner, RoleOthers} {
+			val.DisallowOp(op, role)
+			require.False(t, val.IsOpAllowed(op, role))
+		}
+	}
+
+	require.True(t, val.Extendable())
+	require.True(t, val.AllowedBearerRules(OpObjectGet))
 }
 
 type opsExpected struct {
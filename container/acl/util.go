@@ -34,6 +34,16 @@ func setOpBit(num *uint32, op Op, opBitPos uint8) {
 	setBit(num, n*bitsPerOp+opBitPos)
 }
 
+// resets n-th bit in num for the given op. Panics if op is unsupported.
+func resetOpBit(num *uint32, op Op, opBitPos uint8) {
+	n, ok := mOrder[op]
+	if !ok {
+		panic(fmt.Sprintf("op is unsupported %v", op))
+	}
+
+	resetBit(num, n*bitsPerOp+opBitPos)
+}
+
 // checks if n-th bit in num for the given op is set. Panics if op is unsupported.
 func isOpBitSet(num uint32, op Op, n uint8) bool {
 	off, ok := mOrder[op]
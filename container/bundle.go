@@ -0,0 +1,210 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// field numbers of the Bundle binary format.
+const (
+	_ = iota
+	fieldBundleContainer
+	fieldBundleEACL
+	fieldBundleSignature
+)
+
+// Bundle is a complete definition of the container: its structure including
+// placement policy and attributes, and optional extended ACL. Bundle is
+// intended to export container from one NeoFS network and re-create it in
+// another one, e.g. for disaster-recovery and environment promotion. Bundle
+// is signed by the exporter to protect it from changes.
+//
+// Instances should be created using NewBundle.
+type Bundle struct {
+	cnr Container
+
+	eACL *eacl.Table
+
+	sig *neofscrypto.Signature
+}
+
+// NewBundle constructs unsigned Bundle of the given container and its
+// extended ACL. Nil eACL means its absence. If set, eACL is bound to the
+// container, so any container reference in it is overwritten.
+func NewBundle(cnr Container, eACL *eacl.Table) Bundle {
+	res := Bundle{cnr: cnr}
+
+	if eACL != nil {
+		var id cid.ID
+		cnr.CalculateID(&id)
+
+		res.eACL = eacl.NewTableFromV2(eACL.ToV2())
+		res.eACL.SetCID(id)
+	}
+
+	return res
+}
+
+// Container returns container of the Bundle.
+func (x Bundle) Container() Container {
+	return x.cnr
+}
+
+// EACL returns extended ACL of the container. Returns false if there is no
+// eACL in the Bundle.
+func (x Bundle) EACL() (eacl.Table, bool) {
+	if x.eACL == nil {
+		return eacl.Table{}, false
+	}
+
+	return *eacl.NewTableFromV2(x.eACL.ToV2()), true
+}
+
+// signedData returns binary format of the Bundle without signature.
+func (x Bundle) signedData() []byte {
+	res := protowire.AppendTag(nil, fieldBundleContainer, protowire.BytesType)
+	res = protowire.AppendBytes(res, x.cnr.Marshal())
+
+	if x.eACL != nil {
+		b, _ := x.eACL.Marshal() // never returns an error
+		res = protowire.AppendTag(res, fieldBundleEACL, protowire.BytesType)
+		res = protowire.AppendBytes(res, b)
+	}
+
+	return res
+}
+
+// Sign calculates and writes signature of the Bundle. Sign MUST be called
+// after all changes, otherwise the signature becomes invalid.
+//
+// See also VerifySignature.
+func (x *Bundle) Sign(signer neofscrypto.Signer) error {
+	var sig neofscrypto.Signature
+
+	err := sig.Calculate(signer, x.signedData())
+	if err != nil {
+		return err
+	}
+
+	x.sig = &sig
+
+	return nil
+}
+
+// Signature returns Bundle signature calculated using Sign. Returns false if
+// the Bundle is unsigned.
+func (x Bundle) Signature() (neofscrypto.Signature, bool) {
+	if x.sig == nil {
+		return neofscrypto.Signature{}, false
+	}
+
+	return *x.sig, true
+}
+
+// VerifySignature checks whether the Bundle is signed and the signature is
+// correct. The signer's public key is available via Signature.
+func (x Bundle) VerifySignature() bool {
+	return x.sig != nil && x.sig.Verify(x.signedData())
+}
+
+// Marshal encodes Bundle into a Protocol Buffers V3 binary format of the
+// message with the following fields:
+//
+//  1. container in the NeoFS API binary format
+//  2. optional eACL in the NeoFS API binary format
+//  3. optional signature of the fields 1 and 2 as refs.Signature
+//
+// See also Unmarshal.
+func (x Bundle) Marshal() []byte {
+	res := x.signedData()
+
+	if x.sig != nil {
+		var m refs.Signature
+		x.sig.WriteToV2(&m)
+
+		res = protowire.AppendTag(res, fieldBundleSignature, protowire.BytesType)
+		res = protowire.AppendBytes(res, m.StableMarshal(nil))
+	}
+
+	return res
+}
+
+// Unmarshal decodes Bundle from the Protocol Buffers V3 binary format.
+// Returns an error describing a format violation. Container MUST be set, eACL,
+// if set, MUST reference this container. Unmarshal does not verify the
+// signature, use VerifySignature for this.
+//
+// See also Marshal.
+func (x *Bundle) Unmarshal(data []byte) error {
+	var res Bundle
+	var cnrSet bool
+
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return fmt.Errorf("invalid field tag: %w", protowire.ParseError(n))
+		}
+
+		data = data[n:]
+
+		if typ != protowire.BytesType {
+			return fmt.Errorf("invalid field #%d: wrong wire type %d", num, typ)
+		}
+
+		b, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return fmt.Errorf("invalid field #%d: %w", num, protowire.ParseError(n))
+		}
+
+		data = data[n:]
+
+		switch num {
+		default:
+			return fmt.Errorf("unknown field #%d", num)
+		case fieldBundleContainer:
+			if err := res.cnr.Unmarshal(b); err != nil {
+				return fmt.Errorf("invalid container: %w", err)
+			}
+
+			cnrSet = true
+		case fieldBundleEACL:
+			res.eACL = new(eacl.Table)
+			if err := res.eACL.Unmarshal(b); err != nil {
+				return fmt.Errorf("invalid eACL: %w", err)
+			}
+		case fieldBundleSignature:
+			var m refs.Signature
+			if err := m.Unmarshal(b); err != nil {
+				return fmt.Errorf("invalid signature: %w", err)
+			}
+
+			res.sig = new(neofscrypto.Signature)
+			if err := res.sig.ReadFromV2(m); err != nil {
+				return fmt.Errorf("invalid signature: %w", err)
+			}
+		}
+	}
+
+	if !cnrSet {
+		return errors.New("missing container")
+	}
+
+	if res.eACL != nil {
+		var id cid.ID
+		res.cnr.CalculateID(&id)
+
+		if eACLCnr, ok := res.eACL.CID(); !ok || eACLCnr != id {
+			return fmt.Errorf("eACL does not reference container %s", id)
+		}
+	}
+
+	*x = res
+
+	return nil
+}
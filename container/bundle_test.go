@@ -0,0 +1,105 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	eacltest "github.com/nspcc-dev/neofs-sdk-go/eacl/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestBundle(t *testing.T) {
+	cnr := containertest.Container(t)
+	table := eacltest.Table(t)
+
+	var id cid.ID
+	cnr.CalculateID(&id)
+
+	b := container.NewBundle(cnr, table)
+	require.Equal(t, cnr, b.Container())
+
+	res, ok := b.EACL()
+	require.True(t, ok)
+	cnrID, ok := res.CID()
+	require.True(t, ok)
+	require.Equal(t, id, cnrID)
+	require.Equal(t, table.ToV2().GetRecords(), res.ToV2().GetRecords())
+
+	_, ok = b.Signature()
+	require.False(t, ok)
+	require.False(t, b.VerifySignature())
+
+	signer := test.RandomSignerRFC6979(t)
+	require.NoError(t, b.Sign(signer))
+	require.True(t, b.VerifySignature())
+
+	sig, ok := b.Signature()
+	require.True(t, ok)
+
+	var sigV2 refs.Signature
+	sig.WriteToV2(&sigV2)
+	pub := make([]byte, signer.Public().MaxEncodedSize())
+	require.Equal(t, pub[:signer.Public().Encode(pub)], sigV2.GetKey())
+
+	var b2 container.Bundle
+	require.NoError(t, b2.Unmarshal(b.Marshal()))
+	require.True(t, b2.VerifySignature())
+	require.Equal(t, b.Marshal(), b2.Marshal())
+	require.Equal(t, cnr.Marshal(), b2.Container().Marshal())
+
+	t.Run("without eACL", func(t *testing.T) {
+		b := container.NewBundle(cnr, nil)
+		_, ok := b.EACL()
+		require.False(t, ok)
+
+		var b2 container.Bundle
+		require.NoError(t, b2.Unmarshal(b.Marshal()))
+		_, ok = b2.EACL()
+		require.False(t, ok)
+		require.False(t, b2.VerifySignature())
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		b := container.NewBundle(cnr, table)
+		require.NoError(t, b.Sign(signer))
+
+		sig, _ := b.Signature()
+
+		var m refs.Signature
+		sig.WriteToV2(&m)
+
+		data := container.NewBundle(containertest.Container(t), nil).Marshal()
+		data = protowire.AppendTag(data, 3, protowire.BytesType)
+		data = protowire.AppendBytes(data, m.StableMarshal(nil))
+
+		var b2 container.Bundle
+		require.NoError(t, b2.Unmarshal(data))
+		require.False(t, b2.VerifySignature())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var b container.Bundle
+
+		require.ErrorContains(t, b.Unmarshal(nil), "missing container")
+		require.Error(t, b.Unmarshal([]byte{1, 2, 3}))
+		require.Error(t, b.Unmarshal(protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1)))
+		require.Error(t, b.Unmarshal(protowire.AppendBytes(protowire.AppendTag(nil, 4, protowire.BytesType), nil)))
+
+		foreign := *table
+		foreign.SetCID(cidtest.ID())
+		eACLData, err := foreign.Marshal()
+		require.NoError(t, err)
+
+		data := container.NewBundle(cnr, nil).Marshal()
+		data = protowire.AppendTag(data, 2, protowire.BytesType)
+		data = protowire.AppendBytes(data, eACLData)
+
+		require.ErrorContains(t, b.Unmarshal(data), "eACL does not reference container")
+	})
+}
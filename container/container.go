@@ -1,6 +1,7 @@
 package container
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -454,15 +455,21 @@ func (x Container) ReadDomain() (res Domain) {
 // [neofscrypto.ECDSA_DETERMINISTIC_SHA256] scheme, for example, [neofsecdsa.SignerRFC6979]
 // can be used.
 //
-// See also VerifySignature.
+// See also VerifySignature, CalculateSignatureContext.
 //
 // Returned errors:
 //   - [neofscrypto.ErrIncorrectSigner]
 func (x Container) CalculateSignature(dst *neofscrypto.Signature, signer neofscrypto.Signer) error {
+	return x.CalculateSignatureContext(context.Background(), dst, signer)
+}
+
+// CalculateSignatureContext is the same as CalculateSignature but passes the
+// context to the signer (see [neofscrypto.Signature.CalculateContext]).
+func (x Container) CalculateSignatureContext(ctx context.Context, dst *neofscrypto.Signature, signer neofscrypto.Signer) error {
 	if signer.Scheme() != neofscrypto.ECDSA_DETERMINISTIC_SHA256 {
 		return fmt.Errorf("%w: expected ECDSA_DETERMINISTIC_SHA256 scheme", neofscrypto.ErrIncorrectSigner)
 	}
-	return dst.Calculate(signer, x.Marshal())
+	return dst.CalculateContext(ctx, signer, x.Marshal())
 }
 
 // VerifySignature verifies Container signature calculated using CalculateSignature.
@@ -478,6 +485,28 @@ func (x Container) CalculateID(dst *cid.ID) {
 	dst.FromBinary(x.Marshal())
 }
 
+// PrecalculateID checks that the Container is fully specified according to
+// NeoFS API protocol and returns its identifier which will be assigned by
+// NeoFS after the Container is saved. PrecalculateID allows to prepare
+// references to the Container, e.g. eACL and bearer tokens, before it is
+// saved. Container MUST NOT be changed after the call, otherwise the ID
+// becomes invalid: in particular, network configuration should be applied
+// beforehand.
+//
+// See also CalculateID, ApplyNetworkConfig.
+func (x Container) PrecalculateID() (cid.ID, error) {
+	var tmp Container
+
+	if err := tmp.readFromV2(x.v2, true); err != nil {
+		return cid.ID{}, fmt.Errorf("incomplete container: %w", err)
+	}
+
+	var id cid.ID
+	x.CalculateID(&id)
+
+	return id, nil
+}
+
 // AssertID checks if the given Container matches its identifier in CAS of the
 // NeoFS containers.
 //
@@ -1,6 +1,7 @@
 package container_test
 
 import (
+	"context"
 	"crypto/sha256"
 	"strconv"
 	"testing"
@@ -323,3 +324,45 @@ func TestCalculateSignature(t *testing.T) {
 
 	require.True(t, val.VerifySignature(sig2))
 }
+
+func TestContainer_CalculateSignatureContext(t *testing.T) {
+	val := containertest.Container(t)
+	signer := test.RandomSignerRFC6979(t)
+
+	var sig neofscrypto.Signature
+
+	require.ErrorIs(t, val.CalculateSignatureContext(context.Background(), &sig, test.RandomSigner(t)), neofscrypto.ErrIncorrectSigner)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, val.CalculateSignatureContext(canceled, &sig, signer), context.Canceled)
+
+	require.NoError(t, val.CalculateSignatureContext(context.Background(), &sig, signer))
+	require.True(t, val.VerifySignature(sig))
+}
+
+func TestContainer_PrecalculateID(t *testing.T) {
+	var val container.Container
+
+	_, err := val.PrecalculateID()
+	require.Error(t, err)
+
+	val = containertest.Container(t)
+
+	id, err := val.PrecalculateID()
+	require.NoError(t, err)
+	require.True(t, val.AssertID(id))
+
+	val.SetName("changed")
+	require.False(t, val.AssertID(id))
+
+	var msg v2container.Container
+	val.WriteToV2(&msg)
+	msg.SetPlacementPolicy(nil)
+
+	require.NoError(t, val.Unmarshal(msg.StableMarshal(nil)))
+
+	_, err = val.PrecalculateID()
+	require.ErrorContains(t, err, "missing placement policy")
+}
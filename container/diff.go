@@ -0,0 +1,203 @@
+package container
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+)
+
+// ChangeType enumerates types of the Change.
+type ChangeType uint8
+
+const (
+	// ChangeModified means the field is present in both versions with
+	// different values.
+	ChangeModified ChangeType = iota
+
+	// ChangeAdded means the field is present in the new version only.
+	ChangeAdded
+
+	// ChangeRemoved means the field is present in the old version only.
+	ChangeRemoved
+)
+
+// String implements fmt.Stringer.
+func (x ChangeType) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case ChangeModified:
+		return "MODIFIED"
+	case ChangeAdded:
+		return "ADDED"
+	case ChangeRemoved:
+		return "REMOVED"
+	}
+}
+
+// Diff fields of the Container and eACL table.
+const (
+	DiffFieldOwner           = "owner"
+	DiffFieldBasicACL        = "basic ACL"
+	DiffFieldPlacementPolicy = "placement policy"
+	DiffFieldContainer       = "container"
+	DiffFieldAttributePrefix = "attribute "
+	DiffFieldRecordPrefix    = "record #"
+)
+
+// Change describes single difference between two versions of the Container
+// or eACL table. Changes are returned by Diff and DiffEACL.
+type Change struct {
+	typ ChangeType
+
+	field string
+
+	old, new string
+}
+
+// Type returns type of the change.
+func (x Change) Type() ChangeType {
+	return x.typ
+}
+
+// Field returns name of the changed field. It is one of the DiffField*
+// constants, attributes and eACL records are reported with the corresponding
+// prefixes followed by the attribute key and record index, e.g.
+// "attribute Name" and "record #0".
+func (x Change) Field() string {
+	return x.field
+}
+
+// Old returns text representation of the old field value. Empty for
+// ChangeAdded.
+func (x Change) Old() string {
+	return x.old
+}
+
+// New returns text representation of the new field value. Empty for
+// ChangeRemoved.
+func (x Change) New() string {
+	return x.new
+}
+
+// String returns human-readable description of the change, e.g.
+//
+//	~ basic ACL: 1fbf8cff -> 1fbfbfff
+//	+ attribute Tag: prod
+//	- record #2: deny put others
+func (x Change) String() string {
+	switch x.typ {
+	case ChangeAdded:
+		return fmt.Sprintf("+ %s: %s", x.field, x.new)
+	case ChangeRemoved:
+		return fmt.Sprintf("- %s: %s", x.field, x.old)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", x.field, x.old, x.new)
+	}
+}
+
+// Diff compares old and new versions of the Container and returns their
+// changes: owner, basic ACL, placement policy and attributes. Changes of the
+// attributes are ordered by keys. Placement policies are compared semantically
+// (see netmap.PlacementPolicy.Equivalent). Nonce and protocol version are not
+// compared. Returns nil if there are no changes.
+//
+// See also DiffEACL.
+func Diff(old, new Container) []Change {
+	var res []Change
+
+	if o, n := old.Owner(), new.Owner(); !o.Equals(n) {
+		res = append(res, modified(DiffFieldOwner, o.EncodeToString(), n.EncodeToString()))
+	}
+
+	if o, n := old.BasicACL(), new.BasicACL(); o != n {
+		res = append(res, modified(DiffFieldBasicACL, o.EncodeToString(), n.EncodeToString()))
+	}
+
+	if o, n := old.PlacementPolicy(), new.PlacementPolicy(); !o.Equivalent(n) {
+		res = append(res, modified(DiffFieldPlacementPolicy, o.EncodeToString(), n.EncodeToString()))
+	}
+
+	oldAttrs, newAttrs := old.Attributes(), new.Attributes()
+	keys := make([]string, 0, len(oldAttrs)+len(newAttrs))
+
+	for k := range oldAttrs {
+		keys = append(keys, k)
+	}
+
+	for k := range newAttrs {
+		if _, ok := oldAttrs[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		o, inOld := oldAttrs[k]
+		n, inNew := newAttrs[k]
+
+		switch {
+		case !inOld:
+			res = append(res, Change{typ: ChangeAdded, field: DiffFieldAttributePrefix + k, new: n})
+		case !inNew:
+			res = append(res, Change{typ: ChangeRemoved, field: DiffFieldAttributePrefix + k, old: o})
+		case o != n:
+			res = append(res, modified(DiffFieldAttributePrefix+k, o, n))
+		}
+	}
+
+	return res
+}
+
+// DiffEACL compares old and new versions of the eACL table and returns their
+// changes: container and records. Records are compared by their positions
+// since their order matters. Records are represented in neofs-cli format
+// (see eacl.Record.EncodeToString). Returns nil if there are no changes.
+//
+// See also Diff.
+func DiffEACL(old, new eacl.Table) []Change {
+	var res []Change
+
+	if o, n := cnrString(old), cnrString(new); o != n {
+		switch {
+		case o == "":
+			res = append(res, Change{typ: ChangeAdded, field: DiffFieldContainer, new: n})
+		case n == "":
+			res = append(res, Change{typ: ChangeRemoved, field: DiffFieldContainer, old: o})
+		default:
+			res = append(res, modified(DiffFieldContainer, o, n))
+		}
+	}
+
+	oldRecs, newRecs := old.Records(), new.Records()
+
+	for i := 0; i < len(oldRecs) || i < len(newRecs); i++ {
+		field := fmt.Sprintf("%s%d", DiffFieldRecordPrefix, i)
+
+		switch {
+		case i >= len(oldRecs):
+			res = append(res, Change{typ: ChangeAdded, field: field, new: newRecs[i].EncodeToString()})
+		case i >= len(newRecs):
+			res = append(res, Change{typ: ChangeRemoved, field: field, old: oldRecs[i].EncodeToString()})
+		case !bytes.Equal(oldRecs[i].ToV2().StableMarshal(nil), newRecs[i].ToV2().StableMarshal(nil)):
+			res = append(res, modified(field, oldRecs[i].EncodeToString(), newRecs[i].EncodeToString()))
+		}
+	}
+
+	return res
+}
+
+func modified(field, old, new string) Change {
+	return Change{typ: ChangeModified, field: field, old: old, new: new}
+}
+
+func cnrString(t eacl.Table) string {
+	if id, ok := t.CID(); ok {
+		return id.EncodeToString()
+	}
+
+	return ""
+}
@@ -0,0 +1,135 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	a := containertest.Container(t)
+	a.SetBasicACL(acl.Private)
+	a.SetAttribute("Removed", "1")
+	a.SetAttribute("Modified", "old")
+
+	var b container.Container
+	require.NoError(t, b.Unmarshal(a.Marshal()))
+
+	require.Empty(t, container.Diff(a, b))
+
+	b.Init() // nonce and version are not compared
+
+	require.Empty(t, container.Diff(a, b))
+
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString("REP 3\nSELECT 1 IN City FROM * AS X"))
+	a.SetPlacementPolicy(policy)
+
+	require.NoError(t, policy.DecodeString("REP 3\nCBF 3\nSELECT 1 IN DISTINCT City FROM * AS X"))
+	b.SetPlacementPolicy(policy)
+
+	require.Empty(t, container.Diff(a, b)) // policies are equivalent
+
+	owner := *usertest.ID(t)
+
+	require.NoError(t, policy.DecodeString("REP 2"))
+
+	b = container.Container{}
+	b.Init()
+	b.SetOwner(owner)
+	b.SetBasicACL(acl.PublicRW)
+	b.SetPlacementPolicy(policy)
+	b.SetAttribute("some attribute", "value")
+	b.SetAttribute("Modified", "new")
+	b.SetAttribute("Added", "2")
+
+	oldOwner := a.Owner()
+
+	changes := container.Diff(a, b)
+	require.Len(t, changes, 6)
+
+	for i, exp := range []struct {
+		typ      container.ChangeType
+		field    string
+		old, new string
+	}{
+		{container.ChangeModified, container.DiffFieldOwner, oldOwner.EncodeToString(), owner.EncodeToString()},
+		{container.ChangeModified, container.DiffFieldBasicACL, acl.Private.EncodeToString(), acl.PublicRW.EncodeToString()},
+		{container.ChangeModified, container.DiffFieldPlacementPolicy, a.PlacementPolicy().EncodeToString(), policy.EncodeToString()},
+		{container.ChangeAdded, "attribute Added", "", "2"},
+		{container.ChangeModified, "attribute Modified", "old", "new"},
+		{container.ChangeRemoved, "attribute Removed", "1", ""},
+	} {
+		require.Equal(t, exp.typ, changes[i].Type(), i)
+		require.Equal(t, exp.field, changes[i].Field(), i)
+		require.Equal(t, exp.old, changes[i].Old(), i)
+		require.Equal(t, exp.new, changes[i].New(), i)
+	}
+
+	require.Equal(t, "+ attribute Added: 2", changes[3].String())
+	require.Equal(t, "~ attribute Modified: old -> new", changes[4].String())
+	require.Equal(t, "- attribute Removed: 1", changes[5].String())
+}
+
+func TestDiffEACL(t *testing.T) {
+	cnr := cidtest.ID()
+
+	allowGet := eacl.CreateRecord(eacl.ActionAllow, eacl.OperationGet)
+	eacl.AddFormedTarget(allowGet, eacl.RoleOthers)
+
+	denyPut := eacl.CreateRecord(eacl.ActionDeny, eacl.OperationPut)
+	eacl.AddFormedTarget(denyPut, eacl.RoleOthers)
+
+	denyGet := eacl.CreateRecord(eacl.ActionDeny, eacl.OperationGet)
+	eacl.AddFormedTarget(denyGet, eacl.RoleOthers)
+
+	a := eacl.CreateTable(cnr)
+	a.AddRecord(allowGet)
+	a.AddRecord(denyPut)
+
+	b := eacl.CreateTable(cnr)
+	b.AddRecord(allowGet)
+	b.AddRecord(denyPut)
+
+	require.Empty(t, container.DiffEACL(*a, *b))
+
+	b = eacl.NewTable()
+	b.AddRecord(allowGet)
+	b.AddRecord(denyGet)
+	b.AddRecord(denyPut)
+
+	changes := container.DiffEACL(*a, *b)
+	require.Len(t, changes, 3)
+
+	require.Equal(t, container.ChangeRemoved, changes[0].Type())
+	require.Equal(t, container.DiffFieldContainer, changes[0].Field())
+	require.Equal(t, cnr.EncodeToString(), changes[0].Old())
+
+	require.Equal(t, container.ChangeModified, changes[1].Type())
+	require.Equal(t, "record #1", changes[1].Field())
+	require.Equal(t, denyPut.EncodeToString(), changes[1].Old())
+	require.Equal(t, denyGet.EncodeToString(), changes[1].New())
+
+	require.Equal(t, container.ChangeAdded, changes[2].Type())
+	require.Equal(t, "record #2", changes[2].Field())
+	require.Equal(t, "+ record #2: "+denyPut.EncodeToString(), changes[2].String())
+
+	changes = container.DiffEACL(*b, *a)
+	require.Len(t, changes, 3)
+	require.Equal(t, container.ChangeAdded, changes[0].Type())
+	require.Equal(t, container.ChangeRemoved, changes[2].Type())
+}
+
+func TestChangeType_String(t *testing.T) {
+	require.Equal(t, "MODIFIED", container.ChangeModified.String())
+	require.Equal(t, "ADDED", container.ChangeAdded.String())
+	require.Equal(t, "REMOVED", container.ChangeRemoved.String())
+	require.Equal(t, "UNKNOWN", container.ChangeType(100).String())
+}
@@ -8,8 +8,21 @@ Container instance should be initialized
 	cnr.Init()
 	// fill all the fields
 
+	err := cnr.ApplyMetadata(Metadata{
+		Name:         "my-container",
+		CreationTime: time.Now(),
+	})
+	// ...
+
 	// encode cnr and send
 
+Containers for the typical use cases can be constructed using NewFromPreset
+
+	cnr, err := NewFromPreset(PresetPublicWeb, owner, PresetParams{
+		Metadata: Metadata{Name: "my-site"},
+	})
+	// ...
+
 After the container is persisted in the NeoFS network, applications can process
 it using the instance of Container types
 
@@ -0,0 +1,131 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+)
+
+// Metadata groups commonly used Container settings to apply them at once
+// using ApplyMetadata. Zero fields are not applied.
+type Metadata struct {
+	// Name is a human-readable name of the Container, see SetName.
+	Name string
+
+	// Domain is a container domain registered in the NNS, see WriteDomain.
+	// Applied if name is set.
+	Domain Domain
+
+	// CreationTime is a creation time of the Container, see SetCreationTime.
+	CreationTime time.Time
+
+	// DisableHomomorphicHashing disables homomorphic hashing of the Container
+	// data, see DisableHomomorphicHashing.
+	DisableHomomorphicHashing bool
+
+	// Attributes are custom attributes of the Container, see SetAttributes.
+	Attributes map[string]string
+}
+
+// ApplyMetadata validates the given Metadata and applies it to the Container.
+// The Container is not changed if Metadata is invalid. Custom attributes
+// MUST NOT have empty keys and values, MUST NOT have reserved Name and
+// Timestamp keys and keys with system prefix: use corresponding Metadata
+// fields instead. Domain with zone MUST have name.
+func (x *Container) ApplyMetadata(m Metadata) error {
+	if m.Domain.name == "" && m.Domain.zone != "" {
+		return fmt.Errorf("domain zone %s without name", m.Domain.zone)
+	}
+
+	keys, err := customAttributeKeys(m.Attributes)
+	if err != nil {
+		return err
+	}
+
+	if m.Name != "" {
+		x.SetName(m.Name)
+	}
+
+	if m.Domain.name != "" {
+		x.WriteDomain(m.Domain)
+	}
+
+	if !m.CreationTime.IsZero() {
+		x.SetCreationTime(m.CreationTime)
+	}
+
+	if m.DisableHomomorphicHashing {
+		x.DisableHomomorphicHashing()
+	}
+
+	for i := range keys {
+		x.SetAttribute(keys[i], m.Attributes[keys[i]])
+	}
+
+	return nil
+}
+
+// SetAttributes validates and sets custom attributes of the Container. The
+// attributes are applied in lexicographical order of the keys, so the result
+// is deterministic. The Container is not changed if any attribute is invalid.
+// See ApplyMetadata for the attribute requirements.
+//
+// See also SetAttribute, Attributes.
+func (x *Container) SetAttributes(attrs map[string]string) error {
+	keys, err := customAttributeKeys(attrs)
+	if err != nil {
+		return err
+	}
+
+	for i := range keys {
+		x.SetAttribute(keys[i], attrs[keys[i]])
+	}
+
+	return nil
+}
+
+// Attributes returns all Container attributes including the system ones.
+// Returns nil if there are no attributes.
+//
+// See also SetAttributes, IterateAttributes.
+func (x Container) Attributes() map[string]string {
+	attrs := x.v2.GetAttributes()
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	res := make(map[string]string, len(attrs))
+	for i := range attrs {
+		res[attrs[i].GetKey()] = attrs[i].GetValue()
+	}
+
+	return res
+}
+
+// customAttributeKeys checks custom attributes and returns their keys sorted.
+func customAttributeKeys(attrs map[string]string) ([]string, error) {
+	keys := make([]string, 0, len(attrs))
+
+	for k, v := range attrs {
+		switch {
+		case k == "":
+			return nil, errors.New("empty attribute key")
+		case v == "":
+			return nil, fmt.Errorf("empty attribute value %s", k)
+		case k == attributeName, k == attributeTimestamp:
+			return nil, fmt.Errorf("reserved attribute %s", k)
+		case strings.HasPrefix(k, container.SysAttributePrefix):
+			return nil, fmt.Errorf("system attribute %s", k)
+		}
+
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
@@ -0,0 +1,101 @@
+package container_test
+
+import (
+	"testing"
+	"time"
+
+	v2container "github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainer_ApplyMetadata(t *testing.T) {
+	var d container.Domain
+	d.SetName("domain")
+
+	creat := time.Unix(1700000000, 0)
+
+	m := container.Metadata{
+		Name:                      "name",
+		Domain:                    d,
+		CreationTime:              creat,
+		DisableHomomorphicHashing: true,
+		Attributes: map[string]string{
+			"b": "2",
+			"a": "1",
+		},
+	}
+
+	val := containertest.Container(t)
+	require.NoError(t, val.ApplyMetadata(m))
+
+	require.Equal(t, "name", val.Name())
+	require.Equal(t, d.Name(), val.ReadDomain().Name())
+	require.Equal(t, d.Zone(), val.ReadDomain().Zone())
+	require.Equal(t, creat, val.CreatedAt())
+	require.True(t, val.IsHomomorphicHashingDisabled())
+	require.Equal(t, "1", val.Attribute("a"))
+	require.Equal(t, "2", val.Attribute("b"))
+
+	var msg v2container.Container
+	val.WriteToV2(&msg)
+
+	var val2 container.Container
+	require.NoError(t, val2.ReadFromV2(msg))
+	require.Equal(t, val.Attributes(), val2.Attributes())
+
+	t.Run("zero", func(t *testing.T) {
+		val := containertest.Container(t)
+		attrs := val.Attributes()
+
+		require.NoError(t, val.ApplyMetadata(container.Metadata{}))
+		require.Equal(t, attrs, val.Attributes())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var zoneOnly container.Domain
+		zoneOnly.SetZone("zone")
+
+		for _, tc := range []struct {
+			name string
+			m    container.Metadata
+		}{
+			{name: "zone without name", m: container.Metadata{Domain: zoneOnly}},
+			{name: "empty key", m: container.Metadata{Attributes: map[string]string{"": "v"}}},
+			{name: "empty value", m: container.Metadata{Attributes: map[string]string{"k": ""}}},
+			{name: "name attribute", m: container.Metadata{Attributes: map[string]string{"Name": "v"}}},
+			{name: "timestamp attribute", m: container.Metadata{Attributes: map[string]string{"Timestamp": "1"}}},
+			{name: "system attribute", m: container.Metadata{Attributes: map[string]string{v2container.SysAttributeZone: "v"}}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				var val container.Container
+
+				tc.m.Name = "name"
+
+				require.Error(t, val.ApplyMetadata(tc.m))
+				require.Nil(t, val.Attributes())
+			})
+		}
+	})
+}
+
+func TestContainer_SetAttributes(t *testing.T) {
+	var val container.Container
+
+	require.Nil(t, val.Attributes())
+	require.Error(t, val.SetAttributes(map[string]string{"a": "1", "b": ""}))
+	require.Nil(t, val.Attributes())
+
+	require.NoError(t, val.SetAttributes(map[string]string{"c": "3", "a": "1", "b": "2"}))
+	require.Equal(t, map[string]string{"a": "1", "b": "2", "c": "3"}, val.Attributes())
+
+	var keys []string
+	val.IterateAttributes(func(key, _ string) {
+		keys = append(keys, key)
+	})
+	require.Equal(t, []string{"a", "b", "c"}, keys)
+
+	require.NoError(t, val.SetAttributes(map[string]string{"a": "4"}))
+	require.Equal(t, "4", val.Attribute("a"))
+}
@@ -1,6 +1,7 @@
 package container
 
 import (
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 )
 
@@ -18,3 +19,16 @@ func (x *Container) ApplyNetworkConfig(cfg netmap.NetworkInfo) {
 func (x Container) AssertNetworkConfig(cfg netmap.NetworkInfo) bool {
 	return x.IsHomomorphicHashingDisabled() == cfg.HomomorphicHashingDisabled()
 }
+
+// CheckPlacement applies placement policy of the container to the given
+// network map and reports whether the policy can be satisfied, how many nodes
+// are matched by each filter and selector, and the resulting container nodes.
+// The container ID is calculated from the container itself.
+//
+// See also netmap.NetMap.CheckPolicy.
+func (x Container) CheckPlacement(nm netmap.NetMap) netmap.PolicyReport {
+	var id cid.ID
+	x.CalculateID(&id)
+
+	return nm.CheckPolicy(x.PlacementPolicy(), id)
+}
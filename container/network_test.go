@@ -3,7 +3,9 @@ package container_test
 import (
 	"testing"
 
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
 	"github.com/stretchr/testify/require"
 )
@@ -30,3 +32,32 @@ func TestContainer_NetworkConfig(t *testing.T) {
 		require.True(t, c.IsHomomorphicHashingDisabled())
 	})
 }
+
+func TestContainer_CheckPlacement(t *testing.T) {
+	c := containertest.Container(t)
+
+	var p netmap.PlacementPolicy
+	require.NoError(t, p.DecodeString("REP 2"))
+	c.SetPlacementPolicy(p)
+
+	var nm netmap.NetMap
+	nm.SetNodes([]netmap.NodeInfo{netmaptest.NodeInfo(), netmaptest.NodeInfo()})
+
+	var id cid.ID
+	c.CalculateID(&id)
+
+	exp, err := nm.ContainerNodes(p, id)
+	require.NoError(t, err)
+
+	r := c.CheckPlacement(nm)
+	require.True(t, r.Feasible())
+	require.Equal(t, exp, r.Vectors())
+
+	nm.SetNodes(nm.Nodes()[:1])
+
+	r = c.CheckPlacement(nm)
+	require.False(t, r.Feasible())
+	require.Error(t, r.Err())
+	require.Equal(t, 1, r.Selectors()[0].Buckets())
+	require.Equal(t, 2, r.Selectors()[0].RequiredBuckets())
+}
@@ -0,0 +1,177 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Preset enumerates typical use cases of the containers. Each Preset defines
+// consistent combination of the basic ACL, placement policy and attributes.
+//
+// See also NewFromPreset.
+type Preset uint8
+
+const (
+	// PresetPrivate is for private storage: only the owner can read and write
+	// the data. Default number of object replicas is 2.
+	PresetPrivate Preset = iota
+
+	// PresetPublicWeb is for public web hosting: the owner writes the data, any
+	// other user can only read it. Default number of object replicas is 3.
+	PresetPublicWeb
+
+	// PresetS3 is for S3 gateway buckets: the gateway controls access using
+	// the extended ACL, so basic ACL is public read-write with extended ACL
+	// enabled. Container name MUST be a valid S3 bucket name, it is also used as
+	// the domain name if no domain is specified. Default number of object
+	// replicas is 3.
+	PresetS3
+)
+
+// Names of the Preset values.
+const (
+	NamePresetPrivate   = "private"
+	NamePresetPublicWeb = "public-web"
+	NamePresetS3        = "s3"
+)
+
+// String implements fmt.Stringer.
+func (x Preset) String() string {
+	switch x {
+	default:
+		return fmt.Sprintf("UNKNOWN#%d", x)
+	case PresetPrivate:
+		return NamePresetPrivate
+	case PresetPublicWeb:
+		return NamePresetPublicWeb
+	case PresetS3:
+		return NamePresetS3
+	}
+}
+
+// DecodeString decodes Preset from its name (NamePreset* constants).
+//
+// See also String.
+func (x *Preset) DecodeString(s string) error {
+	switch s {
+	default:
+		return fmt.Errorf("unknown preset %s", s)
+	case NamePresetPrivate:
+		*x = PresetPrivate
+	case NamePresetPublicWeb:
+		*x = PresetPublicWeb
+	case NamePresetS3:
+		*x = PresetS3
+	}
+
+	return nil
+}
+
+// PresetParams groups optional parameters of NewFromPreset.
+type PresetParams struct {
+	// Metadata is applied to the Container, see ApplyMetadata. Zero creation
+	// time is replaced with the current time.
+	Metadata Metadata
+
+	// Replicas is a number of object replicas. Zero means the Preset default.
+	// Ignored if Policy is set.
+	Replicas uint32
+
+	// Policy is a custom placement policy overriding the Preset one.
+	Policy *netmap.PlacementPolicy
+}
+
+// NewFromPreset constructs initialized Container of the given owner according
+// to the Preset. Returns an error if Preset is unknown or parameters are
+// invalid for it.
+func NewFromPreset(p Preset, owner user.ID, prm PresetParams) (Container, error) {
+	var basicACL acl.Basic
+	replicas := uint32(3)
+	m := prm.Metadata
+
+	switch p {
+	default:
+		return Container{}, fmt.Errorf("unknown preset %d", p)
+	case PresetPrivate:
+		basicACL = acl.Private
+		replicas = 2
+	case PresetPublicWeb:
+		basicACL = acl.PublicRO
+	case PresetS3:
+		if err := verifyBucketName(m.Name); err != nil {
+			return Container{}, fmt.Errorf("invalid bucket name %q: %w", m.Name, err)
+		}
+
+		if m.Domain.Name() == "" {
+			m.Domain.SetName(m.Name)
+		}
+
+		basicACL = acl.PublicRWExtended
+	}
+
+	var policy netmap.PlacementPolicy
+
+	if prm.Policy != nil {
+		policy = *prm.Policy
+	} else {
+		if prm.Replicas > 0 {
+			replicas = prm.Replicas
+		}
+
+		var rd netmap.ReplicaDescriptor
+		rd.SetNumberOfObjects(replicas)
+
+		policy.AddReplicas(rd)
+	}
+
+	if m.CreationTime.IsZero() {
+		m.CreationTime = time.Now()
+	}
+
+	var res Container
+	res.Init()
+	res.SetOwner(owner)
+	res.SetBasicACL(basicACL)
+	res.SetPlacementPolicy(policy)
+
+	if err := res.ApplyMetadata(m); err != nil {
+		return Container{}, err
+	}
+
+	return res, nil
+}
+
+// verifyBucketName checks whether the name follows S3 bucket naming rules:
+// 3-63 lowercase letters, digits, dots and hyphens starting and ending with a
+// letter or digit and not formatted as an IP address.
+func verifyBucketName(name string) error {
+	if len(name) < 3 || len(name) > 63 {
+		return errors.New("length must be from 3 to 63 characters")
+	}
+
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		alnum := c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+
+		switch {
+		case (i == 0 || i == len(name)-1) && !alnum:
+			return errors.New("must start and end with a lowercase letter or digit")
+		case !alnum && c != '.' && c != '-':
+			return fmt.Errorf("invalid character %q", c)
+		case c == '.' && name[i-1] == '.':
+			return errors.New("two adjacent dots")
+		}
+	}
+
+	if net.ParseIP(name) != nil {
+		return errors.New("IP address format")
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package container_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromPreset(t *testing.T) {
+	owner := *usertest.ID(t)
+
+	for _, tc := range []struct {
+		preset   container.Preset
+		name     string
+		basicACL acl.Basic
+		replicas uint32
+	}{
+		{container.PresetPrivate, "private", acl.Private, 2},
+		{container.PresetPublicWeb, "public-web", acl.PublicRO, 3},
+		{container.PresetS3, "s3", acl.PublicRWExtended, 3},
+	} {
+		require.Equal(t, tc.name, tc.preset.String())
+
+		var p container.Preset
+		require.NoError(t, p.DecodeString(tc.name))
+		require.Equal(t, tc.preset, p)
+
+		cnr, err := container.NewFromPreset(tc.preset, owner, container.PresetParams{
+			Metadata: container.Metadata{Name: "my-container"},
+		})
+		require.NoError(t, err, tc.name)
+
+		require.True(t, cnr.Owner().Equals(owner), tc.name)
+		require.Equal(t, tc.basicACL, cnr.BasicACL(), tc.name)
+		require.Equal(t, "my-container", cnr.Name(), tc.name)
+		require.False(t, cnr.CreatedAt().IsZero(), tc.name)
+
+		policy := cnr.PlacementPolicy()
+		require.Equal(t, 1, policy.NumberOfReplicas(), tc.name)
+		require.Equal(t, tc.replicas, policy.ReplicaNumberByIndex(0), tc.name)
+
+		_, err = cnr.PrecalculateID()
+		require.NoError(t, err, tc.name)
+	}
+
+	var p container.Preset
+	require.Error(t, p.DecodeString("unknown"))
+	require.Equal(t, "UNKNOWN#100", container.Preset(100).String())
+
+	_, err := container.NewFromPreset(100, owner, container.PresetParams{})
+	require.Error(t, err)
+
+	t.Run("parameters", func(t *testing.T) {
+		var policy netmap.PlacementPolicy
+		require.NoError(t, policy.DecodeString("REP 1\nREP 2"))
+
+		created := time.Unix(1700000000, 0)
+
+		cnr, err := container.NewFromPreset(container.PresetPrivate, owner, container.PresetParams{
+			Metadata: container.Metadata{
+				CreationTime: created,
+				Attributes:   map[string]string{"Tag": "prod"},
+			},
+			Replicas: 5, // ignored
+			Policy:   &policy,
+		})
+		require.NoError(t, err)
+		require.Equal(t, policy.EncodeToString(), cnr.PlacementPolicy().EncodeToString())
+		require.Equal(t, created.Unix(), cnr.CreatedAt().Unix())
+		require.Equal(t, "prod", cnr.Attribute("Tag"))
+		require.Empty(t, cnr.Name())
+
+		cnr, err = container.NewFromPreset(container.PresetPublicWeb, owner, container.PresetParams{Replicas: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, 5, cnr.PlacementPolicy().ReplicaNumberByIndex(0))
+
+		_, err = container.NewFromPreset(container.PresetPrivate, owner, container.PresetParams{
+			Metadata: container.Metadata{Attributes: map[string]string{"Name": "forbidden"}},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("S3", func(t *testing.T) {
+		cnr, err := container.NewFromPreset(container.PresetS3, owner, container.PresetParams{
+			Metadata: container.Metadata{Name: "my.bucket-1"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "my.bucket-1", cnr.ReadDomain().Name())
+
+		var d container.Domain
+		d.SetName("custom")
+
+		cnr, err = container.NewFromPreset(container.PresetS3, owner, container.PresetParams{
+			Metadata: container.Metadata{Name: "my-bucket", Domain: d},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "custom", cnr.ReadDomain().Name())
+
+		for _, name := range []string{
+			"",
+			"ab",
+			string(make([]byte, 64)),
+			"My-bucket",
+			"-bucket",
+			"bucket.",
+			"my_bucket",
+			"my..bucket",
+			"192.168.1.1",
+		} {
+			_, err := container.NewFromPreset(container.PresetS3, owner, container.PresetParams{
+				Metadata: container.Metadata{Name: name},
+			})
+			require.Error(t, err, name)
+		}
+	})
+}
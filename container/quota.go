@@ -0,0 +1,121 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+)
+
+// Container quota attributes. Quotas are not enforced by the NeoFS storage
+// nodes, they are conventions for the applications storing objects in the
+// container, e.g. gateways.
+const (
+	// AttributeMaxSize is a key of the system attribute holding maximum total
+	// size of the container objects' payloads in bytes.
+	AttributeMaxSize = container.SysAttributePrefix + "MAX_SIZE"
+
+	// AttributeMaxObjects is a key of the system attribute holding maximum
+	// number of the container objects.
+	AttributeMaxObjects = container.SysAttributePrefix + "MAX_OBJECTS"
+)
+
+// ErrQuotaExceeded is returned by CheckQuota when object cannot be stored in the
+// Container without exceeding its quota.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrQuotaExceeded = errors.New("container quota exceeded")
+
+// SetMaxSize sets maximum total size of the container objects' payloads in
+// bytes. Zero means no limit.
+//
+// See also MaxSize, CheckQuota.
+func (x *Container) SetMaxSize(size uint64) {
+	x.setQuota(AttributeMaxSize, size)
+}
+
+// MaxSize returns maximum total size of the Container objects' payloads set
+// using SetMaxSize. Zero means no limit. Returns an error if the attribute
+// value is not a decimal number.
+//
+// Zero Container has no limit.
+func (x Container) MaxSize() (uint64, error) {
+	return x.quota(AttributeMaxSize)
+}
+
+// SetMaxObjects sets maximum number of the container objects. Zero means no
+// limit.
+//
+// See also MaxObjects, CheckQuota.
+func (x *Container) SetMaxObjects(n uint64) {
+	x.setQuota(AttributeMaxObjects, n)
+}
+
+// MaxObjects returns maximum number of the container objects set using
+// SetMaxObjects. Zero means no limit. Returns an error if the attribute value
+// is not a decimal number.
+//
+// Zero Container has no limit.
+func (x Container) MaxObjects() (uint64, error) {
+	return x.quota(AttributeMaxObjects)
+}
+
+// CheckQuota checks whether new object with the given payload size can be
+// stored in the Container that already has the given number of objects with
+// the given total payload size. Returns [ErrQuotaExceeded] otherwise.
+// CheckQuota is intended to reject uploads locally before contacting storage
+// nodes. The usage can be obtained, for example, from the container size
+// estimations (see SizeAggregator). Returns an error if any quota attribute
+// is invalid (see MaxSize, MaxObjects).
+func (x Container) CheckQuota(usedSize, usedObjects, size uint64) error {
+	limit, err := x.MaxSize()
+	if err != nil {
+		return err
+	}
+
+	if limit > 0 && (usedSize > limit || size > limit-usedSize) {
+		return fmt.Errorf("%w: %d bytes of %d are used, %d more requested", ErrQuotaExceeded, usedSize, limit, size)
+	}
+
+	limit, err = x.MaxObjects()
+	if err != nil {
+		return err
+	}
+
+	if limit > 0 && usedObjects >= limit {
+		return fmt.Errorf("%w: %d objects of %d are stored", ErrQuotaExceeded, usedObjects, limit)
+	}
+
+	return nil
+}
+
+func (x *Container) setQuota(key string, val uint64) {
+	if val > 0 {
+		x.SetAttribute(key, strconv.FormatUint(val, 10))
+		return
+	}
+
+	attrs := x.v2.GetAttributes()
+	for i := range attrs {
+		if attrs[i].GetKey() == key {
+			x.v2.SetAttributes(append(attrs[:i], attrs[i+1:]...))
+			return
+		}
+	}
+}
+
+func (x Container) quota(key string) (uint64, error) {
+	attr := x.Attribute(key)
+	if attr == "" {
+		return 0, nil
+	}
+
+	res, err := strconv.ParseUint(attr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid attribute value %s: %s (%w)", key, attr, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,89 @@
+package container_test
+
+import (
+	"math"
+	"testing"
+
+	v2container "github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	"github.com/stretchr/testify/require"
+)
+
+func requireQuota(t *testing.T, cnr container.Container, size, objects uint64) {
+	n, err := cnr.MaxSize()
+	require.NoError(t, err)
+	require.Equal(t, size, n)
+
+	n, err = cnr.MaxObjects()
+	require.NoError(t, err)
+	require.Equal(t, objects, n)
+}
+
+func TestContainer_Quota(t *testing.T) {
+	var val container.Container
+
+	requireQuota(t, val, 0, 0)
+	require.NoError(t, val.CheckQuota(math.MaxUint64, math.MaxUint64, math.MaxUint64))
+
+	val = containertest.Container(t)
+
+	val.SetMaxSize(100)
+	val.SetMaxObjects(3)
+
+	var msg v2container.Container
+	val.WriteToV2(&msg)
+
+	assertContainsAttribute(t, msg, "__NEOFS__MAX_SIZE", "100")
+	assertContainsAttribute(t, msg, "__NEOFS__MAX_OBJECTS", "3")
+
+	var val2 container.Container
+	require.NoError(t, val2.ReadFromV2(msg))
+	requireQuota(t, val2, 100, 3)
+
+	require.NoError(t, val.CheckQuota(0, 0, 100))
+	require.NoError(t, val.CheckQuota(60, 2, 40))
+	require.ErrorIs(t, val.CheckQuota(60, 2, 41), container.ErrQuotaExceeded)
+	require.ErrorIs(t, val.CheckQuota(101, 0, 0), container.ErrQuotaExceeded)
+	require.ErrorIs(t, val.CheckQuota(0, 3, 1), container.ErrQuotaExceeded)
+
+	val.SetMaxSize(0)
+	requireQuota(t, val, 0, 3)
+	require.Empty(t, val.Attribute(container.AttributeMaxSize))
+	require.NoError(t, val.CheckQuota(math.MaxUint64, 2, math.MaxUint64))
+
+	t.Run("custom attributes", func(t *testing.T) {
+		// plain attributes with the same names are not quotas
+		val := containertest.Container(t)
+		val.SetAttribute("MaxSize", "unlimited")
+		val.SetAttribute("MaxObjects", "1")
+
+		var msg v2container.Container
+		val.WriteToV2(&msg)
+
+		var val2 container.Container
+		require.NoError(t, val2.ReadFromV2(msg))
+		requireQuota(t, val2, 0, 0)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var val container.Container
+
+		require.Error(t, val.SetAttributes(map[string]string{container.AttributeMaxSize: "1"}))
+
+		for _, key := range []string{container.AttributeMaxSize, container.AttributeMaxObjects} {
+			val = containertest.Container(t)
+			val.SetAttribute(key, "many")
+
+			// decoding must not fail on the existing containers
+			var msg v2container.Container
+			val.WriteToV2(&msg)
+			require.NoError(t, val2.ReadFromV2(msg))
+
+			require.Error(t, val2.CheckQuota(0, 0, 0), key)
+		}
+
+		_, err := val2.MaxObjects()
+		require.Error(t, err)
+	})
+}
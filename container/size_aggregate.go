@@ -0,0 +1,162 @@
+package container
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// SizeAggregator collects SizeEstimation announcements of the storage nodes
+// made in particular epoch and aggregates them per container. Aggregation
+// is resistant to the single incorrect announcements, so its results are
+// suitable for billing.
+//
+// Instances should be created using NewSizeAggregator. SizeAggregator is not
+// safe for concurrent use.
+type SizeAggregator struct {
+	epoch uint64
+
+	// containers in order of the first announcement
+	cnrs []cid.ID
+
+	values map[cid.ID][]uint64
+}
+
+// NewSizeAggregator constructs SizeAggregator of the announcements made in
+// the given epoch.
+func NewSizeAggregator(epoch uint64) *SizeAggregator {
+	return &SizeAggregator{
+		epoch:  epoch,
+		values: make(map[cid.ID][]uint64),
+	}
+}
+
+// Add adds announcements to the SizeAggregator. Add returns an error if any of
+// the announcements is made in another epoch or is not bound to a container.
+// In this case, none of the announcements are added.
+func (x *SizeAggregator) Add(es ...SizeEstimation) error {
+	for i := range es {
+		if ep := es[i].Epoch(); ep != x.epoch {
+			return fmt.Errorf("announcement #%d is made in epoch %d instead of %d", i, ep, x.epoch)
+		}
+
+		if es[i].Container() == (cid.ID{}) {
+			return fmt.Errorf("announcement #%d: missing container", i)
+		}
+	}
+
+	for i := range es {
+		cnr := es[i].Container()
+
+		vals, ok := x.values[cnr]
+		if !ok {
+			x.cnrs = append(x.cnrs, cnr)
+		}
+
+		x.values[cnr] = append(vals, es[i].Value())
+	}
+
+	return nil
+}
+
+// Containers returns containers having announcements in order of the first
+// announcement.
+func (x *SizeAggregator) Containers() []cid.ID {
+	return append([]cid.ID(nil), x.cnrs...)
+}
+
+// Announcements returns number of the announced values for the given
+// container.
+func (x *SizeAggregator) Announcements(cnr cid.ID) int {
+	return len(x.values[cnr])
+}
+
+// Medians returns estimations of all containers with medians of the announced
+// values. For even numbers of values, the median is the mean of two middle
+// ones. Result is ordered according to Containers.
+//
+// See also FilteredMeans.
+func (x *SizeAggregator) Medians() []SizeEstimation {
+	return x.aggregate(median)
+}
+
+// FilteredMeans returns estimations of all containers with means of the
+// announced values without outliers. Value is an outlier if its absolute
+// deviation from the median exceeds the median absolute deviation multiplied by
+// k. Non-positive k is treated as 3. Result is ordered according to Containers.
+//
+// See also Medians.
+func (x *SizeAggregator) FilteredMeans(k float64) []SizeEstimation {
+	if k <= 0 {
+		k = 3
+	}
+
+	return x.aggregate(func(vals []uint64) uint64 {
+		fs := make([]float64, len(vals))
+		for i := range vals {
+			fs[i] = float64(vals[i])
+		}
+
+		m := medianFloat(fs)
+
+		devs := make([]float64, len(fs))
+		for i := range fs {
+			devs[i] = math.Abs(fs[i] - m)
+		}
+
+		limit := k * medianFloat(append([]float64(nil), devs...))
+
+		var sum, n float64
+
+		for i := range fs {
+			if devs[i] <= limit {
+				sum += fs[i]
+				n++
+			}
+		}
+
+		return uint64(math.Round(sum / n))
+	})
+}
+
+func (x *SizeAggregator) aggregate(f func([]uint64) uint64) []SizeEstimation {
+	res := make([]SizeEstimation, len(x.cnrs))
+
+	for i := range x.cnrs {
+		vals := append([]uint64(nil), x.values[x.cnrs[i]]...)
+
+		res[i].SetEpoch(x.epoch)
+		res[i].SetContainer(x.cnrs[i])
+		res[i].SetValue(f(vals))
+	}
+
+	return res
+}
+
+// median returns median of the non-empty list. The list is sorted in place.
+func median(vals []uint64) uint64 {
+	sort.Slice(vals, func(i, j int) bool { return vals[i] < vals[j] })
+
+	mid := len(vals) / 2
+	if len(vals)%2 == 1 {
+		return vals[mid]
+	}
+
+	a, b := vals[mid-1], vals[mid]
+
+	return a/2 + b/2 + (a%2+b%2)/2
+}
+
+// medianFloat works like median for float numbers.
+func medianFloat(vals []float64) float64 {
+	sort.Float64s(vals)
+
+	mid := len(vals) / 2
+	if len(vals)%2 == 1 {
+		return vals[mid]
+	}
+
+	return (vals[mid-1] + vals[mid]) / 2
+}
@@ -0,0 +1,79 @@
+package container_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func sizeEstimation(epoch uint64, cnr cid.ID, value uint64) container.SizeEstimation {
+	var res container.SizeEstimation
+	res.SetEpoch(epoch)
+	res.SetContainer(cnr)
+	res.SetValue(value)
+
+	return res
+}
+
+func TestSizeAggregator(t *testing.T) {
+	const epoch = 13
+	cnr1, cnr2, cnr3 := cidtest.ID(), cidtest.ID(), cidtest.ID()
+
+	a := container.NewSizeAggregator(epoch)
+	require.Empty(t, a.Containers())
+	require.Empty(t, a.Medians())
+
+	require.Error(t, a.Add(sizeEstimation(epoch, cnr1, 1), sizeEstimation(epoch+1, cnr1, 1)))
+	require.Error(t, a.Add(sizeEstimation(epoch, cnr1, 1), sizeEstimation(epoch, cid.ID{}, 1)))
+	require.Empty(t, a.Containers())
+
+	require.NoError(t, a.Add(
+		sizeEstimation(epoch, cnr2, 100),
+		sizeEstimation(epoch, cnr1, 10),
+		sizeEstimation(epoch, cnr2, 102),
+		sizeEstimation(epoch, cnr1, 11),
+	))
+	require.NoError(t, a.Add(
+		sizeEstimation(epoch, cnr2, 1000000),
+		sizeEstimation(epoch, cnr2, 98),
+		sizeEstimation(epoch, cnr3, 5),
+		sizeEstimation(epoch, cnr2, 101),
+	))
+
+	require.Equal(t, []cid.ID{cnr2, cnr1, cnr3}, a.Containers())
+	require.Equal(t, 5, a.Announcements(cnr2))
+	require.Equal(t, 2, a.Announcements(cnr1))
+	require.Equal(t, 1, a.Announcements(cnr3))
+	require.Zero(t, a.Announcements(cidtest.ID()))
+
+	check := func(t *testing.T, res []container.SizeEstimation, exp []uint64) {
+		require.Len(t, res, len(exp))
+
+		for i := range res {
+			require.EqualValues(t, epoch, res[i].Epoch())
+			require.Equal(t, a.Containers()[i], res[i].Container())
+			require.Equal(t, exp[i], res[i].Value(), i)
+		}
+	}
+
+	t.Run("medians", func(t *testing.T) {
+		check(t, a.Medians(), []uint64{101, 10, 5})
+	})
+
+	t.Run("filtered means", func(t *testing.T) {
+		// 1000000 is an outlier for cnr2: median 101, MAD 1
+		check(t, a.FilteredMeans(0), []uint64{100, 11, 5})
+		check(t, a.FilteredMeans(1), []uint64{101, 11, 5})
+	})
+
+	t.Run("large values", func(t *testing.T) {
+		const max = ^uint64(0)
+
+		a := container.NewSizeAggregator(epoch)
+		require.NoError(t, a.Add(sizeEstimation(epoch, cnr1, max), sizeEstimation(epoch, cnr1, max)))
+		require.Equal(t, max, a.Medians()[0].Value())
+	})
+}
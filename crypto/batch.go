@@ -0,0 +1,114 @@
+package neofscrypto
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// ErrInvalidSignature is returned by VerifyBatch for the items with incorrect
+// signatures. This variable is intended to be used as documentation and for
+// [errors.Is] purposes and MUST NOT be changed.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// SignedItem groups data and its signature for batch verification.
+type SignedItem struct {
+	// Data is the signed data.
+	Data []byte
+	// Signature is the data signature.
+	Signature Signature
+}
+
+// VerifyBatch verifies signatures of all the items sequentially. Returns
+// slice of the same length as items: nil element means valid signature of the
+// corresponding item. Public keys repeated within the batch (e.g. signatures
+// of the same node) are decoded only once.
+//
+// See also VerifyBatchParallel, Signature.Verify.
+func VerifyBatch(items []SignedItem) []error {
+	return VerifyBatchParallel(items, 1)
+}
+
+// VerifyBatchParallel is the same as VerifyBatch but distributes items
+// between the given number of goroutines. Non-positive value means
+// runtime.GOMAXPROCS.
+func VerifyBatchParallel(items []SignedItem, workers int) []error {
+	errs := make([]error, len(items))
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	if workers <= 1 {
+		verifyRange(items, errs)
+		return errs
+	}
+
+	var wg sync.WaitGroup
+
+	chunk := (len(items) + workers - 1) / workers
+
+	for from := 0; from < len(items); from += chunk {
+		to := from + chunk
+		if to > len(items) {
+			to = len(items)
+		}
+
+		wg.Add(1)
+
+		go func(from, to int) {
+			defer wg.Done()
+			verifyRange(items[from:to], errs[from:to])
+		}(from, to)
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// verifyRange verifies items writing results to the corresponding elements
+// of errs. Decoded public keys are shared between the items.
+func verifyRange(items []SignedItem, errs []error) {
+	type cachedKey struct {
+		scheme Scheme
+		key    string
+	}
+
+	keys := make(map[cachedKey]PublicKey)
+
+	for i := range items {
+		m := (*refs.Signature)(&items[i].Signature)
+		scheme := Scheme(m.GetScheme())
+		ck := cachedKey{scheme: scheme, key: string(m.GetKey())}
+
+		key, ok := keys[ck]
+		if !ok {
+			var err error
+
+			key, err = NewPublicKey(scheme)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+
+			if err := key.Decode(m.GetKey()); err != nil {
+				errs[i] = fmt.Errorf("decode public key: %w", err)
+				continue
+			}
+
+			keys[ck] = key
+		}
+
+		if !key.Verify(items[i].Data, m.GetSign()) {
+			errs[i] = ErrInvalidSignature
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package neofscrypto_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func signedItems(tb testing.TB, n int) []neofscrypto.SignedItem {
+	k, err := keys.NewPrivateKey()
+	require.NoError(tb, err)
+
+	signer := neofsecdsa.SignerRFC6979(k.PrivateKey)
+	items := make([]neofscrypto.SignedItem, n)
+
+	for i := range items {
+		items[i].Data = make([]byte, 64)
+		rand.Read(items[i].Data)
+
+		require.NoError(tb, items[i].Signature.Calculate(signer, items[i].Data))
+	}
+
+	return items
+}
+
+func TestVerifyBatch(t *testing.T) {
+	items := signedItems(t, 10)
+
+	items[3].Data = append(items[3].Data, 1)
+
+	var m refs.Signature
+	items[5].Signature.WriteToV2(&m)
+	m.SetScheme(100)
+	items[5].Signature = neofscrypto.Signature(m)
+
+	items[7].Signature.WriteToV2(&m)
+	m.SetKey([]byte{1, 2, 3})
+	items[7].Signature = neofscrypto.Signature(m)
+
+	for _, errs := range [][]error{
+		neofscrypto.VerifyBatch(items),
+		neofscrypto.VerifyBatchParallel(items, 3),
+		neofscrypto.VerifyBatchParallel(items, 0),
+	} {
+		require.Len(t, errs, len(items))
+
+		for i := range errs {
+			switch i {
+			default:
+				require.NoError(t, errs[i], i)
+			case 3:
+				require.ErrorIs(t, errs[i], neofscrypto.ErrInvalidSignature)
+			case 5, 7:
+				require.Error(t, errs[i])
+				require.NotErrorIs(t, errs[i], neofscrypto.ErrInvalidSignature)
+			}
+		}
+	}
+
+	require.Empty(t, neofscrypto.VerifyBatch(nil))
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	items := signedItems(b, 100)
+
+	b.Run("sequential Verify", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := range items {
+				if !items[j].Signature.Verify(items[j].Data) {
+					b.Fatal("invalid signature")
+				}
+			}
+		}
+	})
+
+	for _, workers := range []int{1, 4} {
+		b.Run(fmt.Sprintf("VerifyBatch, %d workers", workers), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, err := range neofscrypto.VerifyBatchParallel(items, workers) {
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
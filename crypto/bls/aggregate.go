@@ -0,0 +1,52 @@
+package neofsbls
+
+import (
+	"errors"
+	"fmt"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// AggregateSignatures combines BLS signatures of different signers into the
+// single signature of the same size. Resulting signature can be verified
+// using VerifyAggregate.
+//
+// Returns an error if list is empty or any signature is malformed.
+func AggregateSignatures(signatures ...[]byte) ([]byte, error) {
+	if len(signatures) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	g2 := bls12381.NewG2()
+	res := g2.Zero()
+
+	for i := range signatures {
+		sig, err := g2.FromCompressed(signatures[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature #%d: %w", i, err)
+		}
+
+		g2.Add(res, res, sig)
+	}
+
+	return g2.ToCompressed(res), nil
+}
+
+// VerifyAggregate checks aggregated signature of the data calculated by
+// signers with the given public keys (see AggregateSignatures). Returns false
+// if key list is empty or contains uninitialized key.
+//
+// Keys MUST be checked using PublicKey.VerifyPossession beforehand.
+func VerifyAggregate(data, signature []byte, keys ...PublicKey) bool {
+	ps := make([]*bls12381.PointG1, len(keys))
+
+	for i := range keys {
+		if keys[i].p == nil {
+			return false
+		}
+
+		ps[i] = keys[i].p
+	}
+
+	return verify(ps, data, signature, signatureDST)
+}
@@ -0,0 +1,118 @@
+package neofsbls_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsbls "github.com/nspcc-dev/neofs-sdk-go/crypto/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func randomSigner(t testing.TB) *neofsbls.Signer {
+	s, err := neofsbls.GenerateSigner()
+	require.NoError(t, err)
+	return s
+}
+
+func publicKey(s *neofsbls.Signer) neofsbls.PublicKey {
+	return *s.Public().(*neofsbls.PublicKey)
+}
+
+func TestSigner(t *testing.T) {
+	signer := randomSigner(t)
+	data := []byte("Hello, world!")
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+	require.Len(t, sig, 96)
+
+	pub := signer.Public()
+	require.True(t, pub.Verify(data, sig))
+	require.False(t, pub.Verify(append(data, 1), sig))
+	require.False(t, randomSigner(t).Public().Verify(data, sig))
+
+	restored, err := neofsbls.NewSigner(signer.Bytes())
+	require.NoError(t, err)
+
+	sig2, err := restored.Sign(data)
+	require.NoError(t, err)
+	require.Equal(t, sig, sig2, "BLS signatures are deterministic")
+
+	buf := make([]byte, pub.MaxEncodedSize())
+	buf = buf[:pub.Encode(buf)]
+
+	var decoded neofsbls.PublicKey
+	require.NoError(t, decoded.Decode(buf))
+	require.True(t, decoded.Verify(data, sig))
+
+	_, err = neofsbls.NewSigner(make([]byte, neofsbls.PrivateKeySize))
+	require.Error(t, err)
+}
+
+func TestPublicKey_Decode(t *testing.T) {
+	var pub neofsbls.PublicKey
+
+	infinity := make([]byte, 48)
+	infinity[0] = 0xc0
+	require.Error(t, pub.Decode(infinity))
+	require.Error(t, pub.Decode(make([]byte, 47)))
+	require.False(t, pub.Verify(nil, make([]byte, 96)))
+}
+
+func TestAggregation(t *testing.T) {
+	data := []byte("block header")
+
+	signers := []*neofsbls.Signer{randomSigner(t), randomSigner(t), randomSigner(t)}
+	sigs := make([][]byte, len(signers))
+	keys := make([]neofsbls.PublicKey, len(signers))
+
+	for i := range signers {
+		var err error
+
+		sigs[i], err = signers[i].Sign(data)
+		require.NoError(t, err)
+
+		keys[i] = publicKey(signers[i])
+
+		proof, err := signers[i].ProvePossession()
+		require.NoError(t, err)
+		require.True(t, keys[i].VerifyPossession(proof))
+		require.False(t, publicKey(randomSigner(t)).VerifyPossession(proof))
+	}
+
+	agg, err := neofsbls.AggregateSignatures(sigs...)
+	require.NoError(t, err)
+
+	require.True(t, neofsbls.VerifyAggregate(data, agg, keys...))
+	require.False(t, neofsbls.VerifyAggregate(data, agg, keys[:2]...))
+	require.False(t, neofsbls.VerifyAggregate([]byte("other"), agg, keys...))
+	require.False(t, neofsbls.VerifyAggregate(data, agg))
+
+	_, err = neofsbls.AggregateSignatures()
+	require.Error(t, err)
+	_, err = neofsbls.AggregateSignatures(sigs[0], []byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestSignature(t *testing.T) {
+	data := []byte("Hello, world!")
+
+	signer := randomSigner(t)
+
+	// scheme is not supported by the NeoFS API protocol yet
+	var s neofscrypto.Signature
+	require.ErrorIs(t, s.Calculate(signer, data), neofscrypto.ErrIncorrectSigner)
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+
+	pub := signer.Public()
+	key := make([]byte, pub.MaxEncodedSize())
+
+	var m refs.Signature
+	m.SetScheme(refs.SignatureScheme(neofscrypto.BLS12_381))
+	m.SetKey(key[:pub.Encode(key)])
+	m.SetSign(sig)
+	require.Error(t, s.ReadFromV2(m))
+}
@@ -0,0 +1,28 @@
+/*
+Package neofsbls collects BLS primitives for NeoFS cryptography.
+
+Signer and PublicKey support BLS signature algorithm over BLS12-381 curve
+in the minimal public key size variant: public keys are G1 points (48 bytes
+compressed), signatures are G2 points (96 bytes compressed). Messages are
+hashed to G2 according to BLS12381G2_XMD:SHA-256_SSWU_RO_ suite. These types
+provide corresponding interfaces from neofscrypto package.
+
+Signatures of different signers over the same message can be combined into
+single one:
+
+	sig, err := neofsbls.AggregateSignatures(sig1, sig2, sig3)
+	// ...
+	ok := neofsbls.VerifyAggregate(data, sig, pub1, pub2, pub3)
+
+Aggregation of the signatures over the same message is secure only if every
+public key has proven possession of the corresponding private key (otherwise
+rogue key attack is possible), see Signer.ProvePossession and
+PublicKey.VerifyPossession.
+
+Note that BLS is not yet supported by the NeoFS API protocol, so the
+signers cannot be used for neofscrypto.Signature for now.
+
+Package import causes registration of next signature schemes via neofscrypto.RegisterScheme:
+  - neofscrypto.BLS12_381
+*/
+package neofsbls
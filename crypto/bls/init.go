@@ -0,0 +1,9 @@
+package neofsbls
+
+import neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+
+func init() {
+	neofscrypto.RegisterScheme(neofscrypto.BLS12_381, func() neofscrypto.PublicKey {
+		return new(PublicKey)
+	})
+}
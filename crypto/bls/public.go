@@ -0,0 +1,115 @@
+package neofsbls
+
+import (
+	"errors"
+	"fmt"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+const (
+	publicKeySize = 48
+	signatureSize = 96
+)
+
+// PublicKey represents BLS public key (G1 point) used for NeoFS needs.
+// Provides neofscrypto.PublicKey interface.
+//
+// Instances MUST be initialized using Signer.Public or Decode.
+type PublicKey struct {
+	p *bls12381.PointG1
+}
+
+// MaxEncodedSize returns size of the compressed BLS public key.
+func (x PublicKey) MaxEncodedSize() int {
+	return publicKeySize
+}
+
+// Encode encodes BLS public key in compressed form into buf.
+// Uses exactly MaxEncodedSize bytes of the buf.
+//
+// Encode panics if buf length is less than MaxEncodedSize. Returns -1 for
+// uninitialized key.
+//
+// See also Decode.
+func (x PublicKey) Encode(buf []byte) int {
+	if len(buf) < publicKeySize {
+		panic(fmt.Sprintf("too short buffer %d", len(buf)))
+	}
+
+	if x.p == nil {
+		return -1
+	}
+
+	return copy(buf, bls12381.NewG1().ToCompressed(x.p))
+}
+
+// Decode decodes compressed binary representation of the PublicKey. Point at
+// infinity and points out of the prime-order subgroup are rejected.
+//
+// See also Encode.
+func (x *PublicKey) Decode(data []byte) error {
+	g1 := bls12381.NewG1()
+
+	p, err := g1.FromCompressed(data)
+	if err != nil {
+		return err
+	}
+
+	if g1.IsZero(p) {
+		return errors.New("public key is a point at infinity")
+	}
+
+	x.p = p
+
+	return nil
+}
+
+// Verify verifies data signature calculated by BLS algorithm.
+func (x PublicKey) Verify(data, signature []byte) bool {
+	return x.p != nil && verify([]*bls12381.PointG1{x.p}, data, signature, signatureDST)
+}
+
+// VerifyPossession checks proof of the private key possession calculated by
+// Signer.ProvePossession.
+func (x PublicKey) VerifyPossession(proof []byte) bool {
+	if x.p == nil {
+		return false
+	}
+
+	buf := make([]byte, publicKeySize)
+
+	return verify([]*bls12381.PointG1{x.p}, buf[:x.Encode(buf)], proof, possessionDST)
+}
+
+// verify checks the signature of the data against the sum of the public keys.
+func verify(keys []*bls12381.PointG1, data, signature, dst []byte) bool {
+	if len(keys) == 0 || len(signature) != signatureSize {
+		return false
+	}
+
+	g2 := bls12381.NewG2()
+
+	sig, err := g2.FromCompressed(signature)
+	if err != nil || g2.IsZero(sig) {
+		return false
+	}
+
+	h, err := g2.HashToCurve(data, dst)
+	if err != nil {
+		return false
+	}
+
+	g1 := bls12381.NewG1()
+
+	pub := g1.New().Set(keys[0])
+	for i := 1; i < len(keys); i++ {
+		g1.Add(pub, pub, keys[i])
+	}
+
+	// e(pub, H(m)) == e(G, sig)
+	return bls12381.NewEngine().
+		AddPair(pub, h).
+		AddPairInv(g1.One(), sig).
+		Check()
+}
@@ -0,0 +1,107 @@
+package neofsbls
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// domain separation tags of the proof-of-possession ciphersuite.
+var (
+	signatureDST  = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	possessionDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+// PrivateKeySize is the size of the binary BLS private key.
+const PrivateKeySize = 32
+
+// Signer represents signer based on BLS algorithm over BLS12-381 curve.
+// Provides neofscrypto.Signer interface.
+//
+// Instances MUST be initialized using GenerateSigner or NewSigner.
+type Signer struct {
+	sk *big.Int
+}
+
+// GenerateSigner generates new random BLS private key and returns Signer
+// based on it.
+func GenerateSigner() (*Signer, error) {
+	sk, err := rand.Int(rand.Reader, bls12381.NewG1().Q())
+	if err != nil {
+		return nil, fmt.Errorf("generate private key: %w", err)
+	}
+
+	if sk.Sign() == 0 { // negligible
+		return GenerateSigner()
+	}
+
+	return &Signer{sk: sk}, nil
+}
+
+// NewSigner returns Signer based on the given big-endian BLS private key of
+// PrivateKeySize bytes.
+//
+// See also Signer.Bytes.
+func NewSigner(key []byte) (*Signer, error) {
+	if len(key) != PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length %d, expected %d", len(key), PrivateKeySize)
+	}
+
+	sk := new(big.Int).SetBytes(key)
+	if sk.Sign() == 0 || sk.Cmp(bls12381.NewG1().Q()) >= 0 {
+		return nil, errors.New("private key is out of range")
+	}
+
+	return &Signer{sk: sk}, nil
+}
+
+// Bytes returns big-endian binary representation of the private key.
+//
+// See also NewSigner.
+func (x Signer) Bytes() []byte {
+	return x.sk.FillBytes(make([]byte, PrivateKeySize))
+}
+
+// Scheme returns neofscrypto.BLS12_381.
+// Implements neofscrypto.Signer.
+func (x Signer) Scheme() neofscrypto.Scheme {
+	return neofscrypto.BLS12_381
+}
+
+// Sign signs data using BLS algorithm. Returns compressed G2 point.
+// Implements neofscrypto.Signer.
+func (x Signer) Sign(data []byte) ([]byte, error) {
+	return x.sign(data, signatureDST)
+}
+
+// ProvePossession returns proof of the private key possession. The proof
+// SHOULD be distributed along with the public key and checked via
+// PublicKey.VerifyPossession before the key is used in aggregation.
+func (x Signer) ProvePossession() ([]byte, error) {
+	pub := x.Public()
+	buf := make([]byte, pub.MaxEncodedSize())
+
+	return x.sign(buf[:pub.Encode(buf)], possessionDST)
+}
+
+func (x Signer) sign(data, dst []byte) ([]byte, error) {
+	g2 := bls12381.NewG2()
+
+	h, err := g2.HashToCurve(data, dst)
+	if err != nil {
+		return nil, fmt.Errorf("hash to curve: %w", err)
+	}
+
+	return g2.ToCompressed(g2.MulScalarBig(g2.New(), h, x.sk)), nil
+}
+
+// Public initializes PublicKey and returns it as neofscrypto.PublicKey.
+// Implements neofscrypto.Signer.
+func (x Signer) Public() neofscrypto.PublicKey {
+	g1 := bls12381.NewG1()
+	return &PublicKey{p: g1.MulScalarBig(g1.New(), g1.One(), x.sk)}
+}
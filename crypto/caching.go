@@ -0,0 +1,131 @@
+package neofscrypto
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// CachingSigner wraps Signer and memoizes signatures of the identical data
+// for the configured time. It also encodes the public key only once.
+// Provides Signer and SignerContext interfaces.
+//
+// CachingSigner is useful when the same data is signed repeatedly (e.g. meta
+// headers of the requests in the bursty workloads). Note that the signatures
+// of the randomized schemes (like ECDSA_SHA512) become the same for the same
+// data while cached. CachingSigner does not provide user.Signer interface,
+// user.NewCachingSigner should be used to wrap user signers.
+//
+// Instances MUST be constructed using NewCachingSigner.
+type CachingSigner struct {
+	signer Signer
+	ttl    time.Duration
+	cache  *lru.Cache
+	pub    cachedPublicKey
+}
+
+type cachedSignature struct {
+	sig     []byte
+	expires time.Time
+}
+
+// NewCachingSigner constructs CachingSigner caching at most size signatures
+// of the given signer for ttl. Returns an error if size or ttl is not
+// positive.
+func NewCachingSigner(signer Signer, ttl time.Duration, size int) (*CachingSigner, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("non-positive TTL %v", ttl)
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, fmt.Errorf("init cache: %w", err)
+	}
+
+	pub := signer.Public()
+	enc := make([]byte, pub.MaxEncodedSize())
+
+	n := pub.Encode(enc)
+	if n < 0 || n > len(enc) {
+		return nil, fmt.Errorf("public key %T encoded into %d bytes while max size is %d", pub, n, len(enc))
+	}
+
+	return &CachingSigner{
+		signer: signer,
+		ttl:    ttl,
+		cache:  cache,
+		pub: cachedPublicKey{
+			PublicKey: pub,
+			encoded:   enc[:n],
+		},
+	}, nil
+}
+
+// Scheme returns scheme of the underlying Signer.
+// Implements Signer.
+func (x *CachingSigner) Scheme() Scheme {
+	return x.signer.Scheme()
+}
+
+// Sign returns cached signature of the data if present and not expired.
+// Otherwise, signs data using underlying Signer and caches the result.
+// Implements Signer.
+func (x *CachingSigner) Sign(data []byte) ([]byte, error) {
+	return x.SignContext(context.Background(), data)
+}
+
+// SignContext is the same as Sign but passes the context to the underlying
+// Signer (see SignContext).
+// Implements SignerContext.
+func (x *CachingSigner) SignContext(ctx context.Context, data []byte) ([]byte, error) {
+	key := sha256.Sum256(data)
+
+	if v, ok := x.cache.Get(key); ok {
+		if c := v.(cachedSignature); time.Now().Before(c.expires) {
+			return c.sig, nil
+		}
+
+		x.cache.Remove(key)
+	}
+
+	sig, err := SignContext(ctx, x.signer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	x.cache.Add(key, cachedSignature{sig: sig, expires: time.Now().Add(x.ttl)})
+
+	return sig, nil
+}
+
+// Public returns public key of the underlying Signer which copies encoded
+// form calculated once.
+// Implements Signer.
+func (x *CachingSigner) Public() PublicKey {
+	return x.pub
+}
+
+// cachedPublicKey is a PublicKey with cached binary encoding.
+type cachedPublicKey struct {
+	PublicKey
+	encoded []byte
+}
+
+// MaxEncodedSize returns size of the cached public key.
+func (x cachedPublicKey) MaxEncodedSize() int {
+	return len(x.encoded)
+}
+
+// Encode copies cached public key into buf.
+//
+// Encode panics if buf length is less than MaxEncodedSize.
+func (x cachedPublicKey) Encode(buf []byte) int {
+	if len(buf) < len(x.encoded) {
+		panic(fmt.Sprintf("too short buffer %d", len(buf)))
+	}
+
+	return copy(buf, x.encoded)
+}
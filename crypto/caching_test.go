@@ -0,0 +1,102 @@
+package neofscrypto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+type countingSigner struct {
+	neofscrypto.Signer
+	calls int
+}
+
+func (x *countingSigner) Sign(data []byte) ([]byte, error) {
+	x.calls++
+	return x.Signer.Sign(data)
+}
+
+// reports the given encoded size of the key.
+type wrongSizePublicKey struct {
+	neofscrypto.PublicKey
+	size int
+}
+
+func (x wrongSizePublicKey) Encode([]byte) int { return x.size }
+
+type wrongSizeSigner struct {
+	neofscrypto.Signer
+	size int
+}
+
+func (x wrongSizeSigner) Public() neofscrypto.PublicKey {
+	return wrongSizePublicKey{PublicKey: x.Signer.Public(), size: x.size}
+}
+
+func TestNewCachingSigner_PublicKey(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	base := neofsecdsa.Signer(k.PrivateKey)
+	maxSize := base.Public().MaxEncodedSize()
+
+	for _, size := range []int{-1, maxSize + 1} {
+		_, err = neofscrypto.NewCachingSigner(wrongSizeSigner{Signer: base, size: size}, time.Minute, 10)
+		require.Error(t, err, size)
+	}
+}
+
+func TestCachingSigner(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	base := &countingSigner{Signer: neofsecdsa.Signer(k.PrivateKey)}
+
+	_, err = neofscrypto.NewCachingSigner(base, 0, 10)
+	require.Error(t, err)
+	_, err = neofscrypto.NewCachingSigner(base, time.Minute, 0)
+	require.Error(t, err)
+
+	signer, err := neofscrypto.NewCachingSigner(base, 50*time.Millisecond, 10)
+	require.NoError(t, err)
+	require.Equal(t, base.Scheme(), signer.Scheme())
+
+	data := []byte("Hello, world!")
+
+	sig1, err := signer.Sign(data)
+	require.NoError(t, err)
+	require.True(t, base.Public().Verify(data, sig1))
+
+	sig2, err := signer.Sign(data)
+	require.NoError(t, err)
+	require.Equal(t, sig1, sig2)
+	require.Equal(t, 1, base.calls)
+
+	other := []byte("other data")
+
+	sig3, err := signer.Sign(other)
+	require.NoError(t, err)
+	require.True(t, base.Public().Verify(other, sig3))
+	require.Equal(t, 2, base.calls)
+
+	time.Sleep(60 * time.Millisecond)
+
+	_, err = signer.Sign(data)
+	require.NoError(t, err)
+	require.Equal(t, 3, base.calls)
+
+	var s neofscrypto.Signature
+	require.NoError(t, s.Calculate(signer, data))
+	require.True(t, s.Verify(data))
+
+	pub := signer.Public()
+	expected := make([]byte, base.Public().MaxEncodedSize())
+	expected = expected[:base.Public().Encode(expected)]
+	buf := make([]byte, pub.MaxEncodedSize())
+	require.Equal(t, expected, buf[:pub.Encode(buf)])
+	require.True(t, pub.Verify(data, sig1))
+}
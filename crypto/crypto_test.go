@@ -1,6 +1,7 @@
 package neofscrypto_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
@@ -45,3 +46,78 @@ func TestSignature(t *testing.T) {
 		require.True(t, valid, "type %T", signer)
 	}
 }
+
+type contextSigner struct {
+	neofscrypto.Signer
+	calls int
+}
+
+func (x *contextSigner) SignContext(ctx context.Context, data []byte) ([]byte, error) {
+	x.calls++
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return x.Sign(data)
+}
+
+func TestSignContext(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	data := []byte("Hello, world!")
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	t.Run("simple signer", func(t *testing.T) {
+		signer := neofsecdsa.SignerRFC6979(k.PrivateKey)
+
+		_, err := neofscrypto.SignContext(canceled, signer, data)
+		require.ErrorIs(t, err, context.Canceled)
+
+		sig, err := neofscrypto.SignContext(context.Background(), signer, data)
+		require.NoError(t, err)
+		require.True(t, signer.Public().Verify(data, sig))
+	})
+
+	t.Run("context signer", func(t *testing.T) {
+		signer := &contextSigner{Signer: neofsecdsa.SignerRFC6979(k.PrivateKey)}
+
+		var s neofscrypto.Signature
+
+		require.ErrorIs(t, s.CalculateContext(canceled, signer, data), context.Canceled)
+		require.NoError(t, s.Calculate(signer, data))
+		require.True(t, s.Verify(data))
+		require.Equal(t, 2, signer.calls)
+	})
+}
+
+func TestSignature_ReservedSchemes(t *testing.T) {
+	key := testPublicKey("key")
+
+	for _, scheme := range []neofscrypto.Scheme{
+		neofscrypto.ED25519,
+		neofscrypto.BLS12_381,
+		neofscrypto.ECDSA_SECP256K1_SHA256,
+	} {
+		// values must not collide with the current and future protocol values
+		require.GreaterOrEqual(t, int32(scheme), int32(1<<16), scheme)
+
+		restore := neofscrypto.OverrideScheme(scheme, newTestPublicKey)
+
+		signer := neofscrypto.NewStaticSigner(scheme, key, &key)
+
+		var s neofscrypto.Signature
+		require.ErrorIs(t, s.Calculate(signer, nil), neofscrypto.ErrIncorrectSigner, scheme)
+		require.ErrorIs(t, s.CalculateMarshalled(signer, nil), neofscrypto.ErrIncorrectSigner, scheme)
+
+		var m refs.Signature
+		m.SetScheme(refs.SignatureScheme(scheme))
+		m.SetKey(key)
+		m.SetSign(key)
+		require.Error(t, s.ReadFromV2(m), scheme)
+
+		restore()
+	}
+}
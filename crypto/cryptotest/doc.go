@@ -0,0 +1,20 @@
+/*
+Package cryptotest provides conformance test suite for the implementations of
+the signature schemes (see neofscrypto.Scheme).
+
+Third-party neofscrypto.Signer and neofscrypto.PublicKey implementations are
+expected to pass the suite in order to be compatible with the SDK and NeoFS
+nodes:
+
+	import "github.com/nspcc-dev/neofs-sdk-go/crypto/cryptotest"
+
+	func TestSigner(t *testing.T) {
+		cryptotest.Run(t, func(tb testing.TB) neofscrypto.Signer {
+			// return new random Signer
+		})
+	}
+
+Public key constructor of the scheme must be registered using
+neofscrypto.RegisterScheme before the suite is run.
+*/
+package cryptotest
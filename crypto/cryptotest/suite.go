@@ -0,0 +1,255 @@
+package cryptotest
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// data sizes used by the suite.
+var dataSizes = []int{0, 1, 32, 1 << 10, 1 << 20}
+
+// Run runs conformance test suite against the signature scheme implementation.
+// The newSigner function must return new random neofscrypto.Signer on each
+// call. The suite checks:
+//   - sign/verify roundtrip for data of different sizes;
+//   - binary encoding of the public key;
+//   - detection of the tampered data, signature and key;
+//   - processing of the signatures in NeoFS API V2 protocol format (or their
+//     rejection for the schemes not supported by the protocol);
+//   - optional interfaces (neofscrypto.SignerContext,
+//     neofscrypto.StreamSigner) consistency;
+//   - concurrent use of the signer and public key.
+func Run(t *testing.T, newSigner func(testing.TB) neofscrypto.Signer) {
+	t.Run("sign/verify", func(t *testing.T) {
+		testSignVerify(t, newSigner(t))
+	})
+	t.Run("encoding", func(t *testing.T) {
+		testEncoding(t, newSigner(t))
+	})
+	t.Run("tamper", func(t *testing.T) {
+		testTamper(t, newSigner(t), newSigner(t))
+	})
+	t.Run("API V2", func(t *testing.T) {
+		testAPIV2(t, newSigner(t))
+	})
+	t.Run("context", func(t *testing.T) {
+		testContext(t, newSigner(t))
+	})
+	t.Run("stream", func(t *testing.T) {
+		testStream(t, newSigner(t))
+	})
+	t.Run("concurrency", func(t *testing.T) {
+		testConcurrency(t, newSigner(t))
+	})
+}
+
+func randomData(tb testing.TB, size int) []byte {
+	data := make([]byte, size)
+	_, err := rand.Read(data)
+	require.NoError(tb, err)
+	return data
+}
+
+func encodePublicKey(tb testing.TB, pub neofscrypto.PublicKey) []byte {
+	require.NotNil(tb, pub, "missing public key")
+
+	buf := make([]byte, pub.MaxEncodedSize())
+	n := pub.Encode(buf)
+	require.Positive(tb, n, "public key encoding failure")
+
+	return buf[:n]
+}
+
+func sign(tb testing.TB, signer neofscrypto.Signer, data []byte) []byte {
+	sig, err := signer.Sign(data)
+	require.NoError(tb, err)
+	require.NotEmpty(tb, sig)
+	return sig
+}
+
+func testSignVerify(t *testing.T, signer neofscrypto.Signer) {
+	pub := signer.Public()
+
+	for _, size := range dataSizes {
+		data := randomData(t, size)
+		require.True(t, pub.Verify(data, sign(t, signer, data)), "size %d", size)
+	}
+}
+
+func testEncoding(t *testing.T, signer neofscrypto.Signer) {
+	pub := signer.Public()
+	bKey := encodePublicKey(t, pub)
+
+	require.LessOrEqual(t, len(bKey), pub.MaxEncodedSize())
+	if len(bKey) > 0 {
+		require.Panics(t, func() { pub.Encode(nil) }, "encoding to insufficient buffer must panic")
+	}
+
+	pub2 := newPublicKey(t, signer.Scheme())
+	require.NoError(t, pub2.Decode(bKey))
+	require.Equal(t, bKey, encodePublicKey(t, pub2))
+
+	require.Error(t, newPublicKey(t, signer.Scheme()).Decode(nil), "empty key must not be decoded")
+
+	// decoded key must not depend on the original buffer
+	bKeyCp := clone(bKey)
+	pub3 := newPublicKey(t, signer.Scheme())
+	require.NoError(t, pub3.Decode(bKeyCp))
+	for i := range bKeyCp {
+		bKeyCp[i]++
+	}
+
+	data := randomData(t, 32)
+	require.True(t, pub3.Verify(data, sign(t, signer, data)))
+}
+
+func testTamper(t *testing.T, signer, other neofscrypto.Signer) {
+	data := randomData(t, 32)
+	sig := sign(t, signer, data)
+	pub := signer.Public()
+
+	var tampered []byte
+
+	for i := range data {
+		tampered = clone(data)
+		tampered[i]++
+		require.False(t, pub.Verify(tampered, sig), "tampered data byte #%d", i)
+	}
+
+	require.False(t, pub.Verify(append(clone(data), 0), sig), "extended data")
+
+	for i := range sig {
+		tampered = clone(sig)
+		tampered[i]++
+		require.False(t, pub.Verify(data, tampered), "tampered signature byte #%d", i)
+	}
+
+	require.False(t, pub.Verify(data, nil), "empty signature")
+	require.False(t, pub.Verify(data, sig[:len(sig)-1]), "truncated signature")
+	require.False(t, other.Public().Verify(data, sig), "another key")
+}
+
+func testAPIV2(t *testing.T, signer neofscrypto.Signer) {
+	data := randomData(t, 32)
+
+	var sig neofscrypto.Signature
+
+	// values of such schemes are reserved by the SDK, see neofscrypto.Scheme
+	if signer.Scheme() >= neofscrypto.ED25519 {
+		require.ErrorIs(t, sig.Calculate(signer, data), neofscrypto.ErrIncorrectSigner)
+
+		sigVal, err := signer.Sign(data)
+		require.NoError(t, err)
+
+		var m refs.Signature
+		m.SetScheme(refs.SignatureScheme(signer.Scheme()))
+		m.SetKey(encodePublicKey(t, signer.Public()))
+		m.SetSign(sigVal)
+		require.Error(t, sig.ReadFromV2(m))
+
+		return
+	}
+
+	require.NoError(t, sig.Calculate(signer, data))
+	require.True(t, sig.Verify(data))
+
+	var m refs.Signature
+	sig.WriteToV2(&m)
+	require.EqualValues(t, signer.Scheme(), m.GetScheme())
+	require.Equal(t, encodePublicKey(t, signer.Public()), m.GetKey())
+
+	var sig2 neofscrypto.Signature
+	require.NoError(t, sig2.ReadFromV2(m))
+	require.True(t, sig2.Verify(data))
+
+	data[0]++
+	require.False(t, sig2.Verify(data))
+}
+
+func testContext(t *testing.T, signer neofscrypto.Signer) {
+	data := randomData(t, 32)
+
+	sig, err := neofscrypto.SignContext(context.Background(), signer, data)
+	require.NoError(t, err)
+	require.True(t, signer.Public().Verify(data, sig))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = neofscrypto.SignContext(ctx, signer, data)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func testStream(t *testing.T, signer neofscrypto.Signer) {
+	if _, ok := signer.(neofscrypto.StreamSigner); !ok {
+		t.Skip("signer does not implement neofscrypto.StreamSigner")
+	}
+
+	pub := signer.Public()
+
+	for _, size := range dataSizes {
+		data := randomData(t, size)
+
+		w := neofscrypto.NewSignatureWriter(signer)
+		for off := 0; off < len(data); off += 100 {
+			end := off + 100
+			if end > len(data) {
+				end = len(data)
+			}
+
+			_, err := w.Write(data[off:end])
+			require.NoError(t, err)
+		}
+
+		sig, err := w.Close()
+		require.NoError(t, err)
+		require.True(t, pub.Verify(data, sig), "size %d", size)
+	}
+}
+
+func testConcurrency(t *testing.T, signer neofscrypto.Signer) {
+	const n = 16
+
+	var wg sync.WaitGroup
+	pub := signer.Public()
+	errs := make([]error, n)
+	valid := make([]bool, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			data := make([]byte, 32)
+			data[0] = byte(i)
+
+			var sig []byte
+			if sig, errs[i] = signer.Sign(data); errs[i] == nil {
+				valid[i] = pub.Verify(data, sig)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i := 0; i < n; i++ {
+		require.NoError(t, errs[i], "goroutine #%d", i)
+		require.True(t, valid[i], "goroutine #%d", i)
+	}
+}
+
+func newPublicKey(tb testing.TB, scheme neofscrypto.Scheme) neofscrypto.PublicKey {
+	pub, err := neofscrypto.NewPublicKey(scheme)
+	require.NoError(tb, err, "scheme must be registered")
+	return pub
+}
+
+func clone(b []byte) []byte {
+	return append([]byte(nil), b...)
+}
@@ -0,0 +1,49 @@
+package cryptotest_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsbls "github.com/nspcc-dev/neofs-sdk-go/crypto/bls"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/cryptotest"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	neofsed25519 "github.com/nspcc-dev/neofs-sdk-go/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+func randomECDSA(tb testing.TB) keys.PrivateKey {
+	k, err := keys.NewPrivateKey()
+	require.NoError(tb, err)
+	return *k
+}
+
+func TestRun(t *testing.T) {
+	for name, f := range map[string]func(testing.TB) neofscrypto.Signer{
+		"ECDSA_SHA512": func(tb testing.TB) neofscrypto.Signer {
+			return neofsecdsa.Signer(randomECDSA(tb).PrivateKey)
+		},
+		"ECDSA_DETERMINISTIC_SHA256": func(tb testing.TB) neofscrypto.Signer {
+			return neofsecdsa.SignerRFC6979(randomECDSA(tb).PrivateKey)
+		},
+		"ECDSA_WALLETCONNECT": func(tb testing.TB) neofscrypto.Signer {
+			return neofsecdsa.SignerWalletConnect(randomECDSA(tb).PrivateKey)
+		},
+		"ED25519": func(tb testing.TB) neofscrypto.Signer {
+			_, k, err := ed25519.GenerateKey(rand.Reader)
+			require.NoError(tb, err)
+			return neofsed25519.Signer(k)
+		},
+		"BLS12_381": func(tb testing.TB) neofscrypto.Signer {
+			s, err := neofsbls.GenerateSigner()
+			require.NoError(tb, err)
+			return s
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cryptotest.Run(t, f)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package neofscrypto
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// DigestSigner is an optional interface of the Signer which is able to sign
+// pre-computed hash of the data. It allows to avoid double hashing when data
+// is already hashed by the caller (e.g. while streaming it).
+type DigestSigner interface {
+	Signer
+
+	// SignDigest signs digest of the data calculated by the hash function of
+	// the Scheme (e.g. SHA-256 for ECDSA_DETERMINISTIC_SHA256). The result is
+	// the same as Sign of the data.
+	SignDigest(digest []byte) ([]byte, error)
+}
+
+// DigestVerifier is an optional interface of the PublicKey which is able to
+// verify signatures of the pre-computed hash of the data.
+type DigestVerifier interface {
+	PublicKey
+
+	// VerifyDigest checks signature of the data by its digest calculated by
+	// the hash function of the scheme. True means correct signature.
+	VerifyDigest(digest, signature []byte) bool
+}
+
+// ErrDigestUnsupported is returned when signature scheme implementation does
+// not support operations with pre-computed hashes. This variable is intended
+// to be used as documentation and for [errors.Is] purposes and MUST NOT be
+// changed.
+var ErrDigestUnsupported = errors.New("digest is not supported by the scheme")
+
+// CalculateDigest is the same as Calculate but signs pre-computed digest of
+// the data (see DigestSigner). Returns ErrDigestUnsupported if signer does not
+// implement DigestSigner.
+//
+// See also VerifyDigest.
+func (x *Signature) CalculateDigest(signer Signer, digest []byte) error {
+	if err := checkProtocolScheme(signer.Scheme()); err != nil {
+		return err
+	}
+
+	s, ok := signer.(DigestSigner)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrDigestUnsupported, signer)
+	}
+
+	signature, err := s.SignDigest(digest)
+	if err != nil {
+		return fmt.Errorf("signer %T failure: %w", signer, err)
+	}
+
+	x.fillSignature(signer, signature)
+
+	return nil
+}
+
+// VerifyDigest is the same as Verify but checks signature of the data by its
+// pre-computed digest (see DigestVerifier). Fails if public key of the scheme
+// does not implement DigestVerifier.
+//
+// See also CalculateDigest.
+func (x Signature) VerifyDigest(digest []byte) bool {
+	m := (*refs.Signature)(&x)
+
+	key, err := decodePublicKey(Scheme(m.GetScheme()), m.GetKey())
+	if err != nil {
+		return false
+	}
+
+	v, ok := key.(DigestVerifier)
+
+	return ok && v.VerifyDigest(digest, m.GetSign())
+}
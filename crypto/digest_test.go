@@ -0,0 +1,80 @@
+package neofscrypto_test
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignature_VerifyDigest(t *testing.T) {
+	data := make([]byte, 512)
+	_, _ = rand.Read(data)
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	h256 := sha256.Sum256(data)
+	h512 := sha512.Sum512(data)
+
+	for _, tc := range []struct {
+		signer neofscrypto.Signer
+		digest []byte
+	}{
+		{signer: neofsecdsa.Signer(k.PrivateKey), digest: h512[:]},
+		{signer: neofsecdsa.SignerRFC6979(k.PrivateKey), digest: h256[:]},
+	} {
+		var s neofscrypto.Signature
+
+		require.NoError(t, s.CalculateDigest(tc.signer, tc.digest))
+		require.True(t, s.VerifyDigest(tc.digest))
+		require.True(t, s.Verify(data))
+		require.False(t, s.VerifyDigest(tc.digest[1:]))
+
+		require.NoError(t, s.Calculate(tc.signer, data))
+		require.True(t, s.VerifyDigest(tc.digest))
+
+		require.Error(t, s.CalculateDigest(tc.signer, tc.digest[1:]))
+	}
+
+	var s neofscrypto.Signature
+
+	err = s.CalculateDigest(neofsecdsa.SignerWalletConnect(k.PrivateKey), h256[:])
+	require.ErrorIs(t, err, neofscrypto.ErrDigestUnsupported)
+
+	require.NoError(t, s.Calculate(neofsecdsa.SignerWalletConnect(k.PrivateKey), data))
+	require.False(t, s.VerifyDigest(h256[:]))
+}
+
+// reservedDigestSigner is a DigestSigner of the scheme not supported by the
+// NeoFS API protocol.
+type reservedDigestSigner struct {
+	neofscrypto.Signer
+	calls int
+}
+
+func (reservedDigestSigner) Scheme() neofscrypto.Scheme {
+	return neofscrypto.ECDSA_SECP256K1_SHA256
+}
+
+func (x *reservedDigestSigner) SignDigest([]byte) ([]byte, error) {
+	x.calls++
+	return []byte("signature"), nil
+}
+
+func TestSignature_CalculateDigest(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	signer := &reservedDigestSigner{Signer: neofsecdsa.SignerRFC6979(k.PrivateKey)}
+	digest := sha256.Sum256([]byte("Hello, world!"))
+
+	var s neofscrypto.Signature
+	require.ErrorIs(t, s.CalculateDigest(signer, digest[:]), neofscrypto.ErrIncorrectSigner)
+	require.Zero(t, signer.calls)
+}
@@ -24,6 +24,16 @@ PublicKey allows to verify signatures.
 	isValid := sig.Verify(data)
 	// ...
 
+Signatures of the same data made by several parties can be collected into
+MultiSignature.
+
+Many signatures (e.g. of the search results) can be verified at once using
+VerifyBatch or VerifyBatchParallel.
+
+If the data is already hashed by the caller, Signature.CalculateDigest and
+Signature.VerifyDigest allow to avoid hashing it again for the schemes
+supporting it (see DigestSigner and DigestVerifier).
+
 Signature can be also used to process NeoFS API V2 protocol messages
 (see neo.fs.v2.refs package in https://github.com/nspcc-dev/neofs-api).
 
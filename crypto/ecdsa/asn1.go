@@ -0,0 +1,42 @@
+package neofsecdsa
+
+import (
+	"crypto/elliptic"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ConvertASN1Signature converts ASN.1 DER encoded P-256 ECDSA signature
+// (ECDSA-Sig-Value from RFC 3279) into concatenation of the fixed-size
+// big-endian r and s values used by neofscrypto.ECDSA_DETERMINISTIC_SHA256
+// scheme. Prepending 0x04 byte to the result gives neofscrypto.ECDSA_SHA512
+// signature. DER format is returned by external signing facilities like
+// hardware wallets and key management services.
+func ConvertASN1Signature(sig []byte) ([]byte, error) {
+	var v struct {
+		R, S *big.Int
+	}
+
+	rest, err := asn1.Unmarshal(sig, &v)
+	if err != nil {
+		return nil, fmt.Errorf("decode ASN.1: %w", err)
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data after ASN.1 structure")
+	}
+
+	n := elliptic.P256().Params().N
+
+	for _, c := range []*big.Int{v.R, v.S} {
+		if c.Sign() <= 0 || c.Cmp(n) >= 0 {
+			return nil, errors.New("value is out of range")
+		}
+	}
+
+	res := make([]byte, 64)
+	v.R.FillBytes(res[:32])
+	v.S.FillBytes(res[32:])
+
+	return res, nil
+}
@@ -0,0 +1,77 @@
+package neofsecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+)
+
+// Sizes of the binary P-256 public key in different encodings.
+const (
+	compressedKeySize   = 33
+	uncompressedKeySize = 65
+)
+
+// decodeKey decodes P-256 public key from compressed (0x02, 0x03 prefixes),
+// uncompressed (0x04) or hybrid (0x06, 0x07) encoding (SEC 1, section
+// 2.3.3). Point at infinity is not accepted.
+func decodeKey(data []byte) (*ecdsa.PublicKey, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty public key")
+	}
+
+	curve := elliptic.P256()
+	res := &ecdsa.PublicKey{Curve: curve}
+
+	switch prefix := data[0]; prefix {
+	default:
+		return nil, fmt.Errorf("unsupported public key format 0x%02x", prefix)
+	case 0x00:
+		return nil, errors.New("public key is a point at infinity")
+	case 0x02, 0x03:
+		if len(data) != compressedKeySize {
+			return nil, fmt.Errorf("invalid compressed public key length %d, expected %d", len(data), compressedKeySize)
+		}
+
+		if res.X, res.Y = elliptic.UnmarshalCompressed(curve, data); res.X == nil {
+			return nil, errors.New("invalid compressed public key: not a P-256 curve point")
+		}
+	case 0x04, 0x06, 0x07:
+		if len(data) != uncompressedKeySize {
+			return nil, fmt.Errorf("invalid uncompressed public key length %d, expected %d", len(data), uncompressedKeySize)
+		}
+
+		if prefix != 0x04 {
+			// hybrid form additionally encodes parity of Y in the prefix
+			uncompressed := make([]byte, uncompressedKeySize)
+			uncompressed[0] = 0x04
+			copy(uncompressed[1:], data[1:])
+
+			if data[len(data)-1]&1 != prefix&1 {
+				return nil, errors.New("invalid hybrid public key: Y parity mismatch")
+			}
+
+			data = uncompressed
+		}
+
+		//nolint:staticcheck // no other way to decode uncompressed point in Go 1.18
+		if res.X, res.Y = elliptic.Unmarshal(curve, data); res.X == nil {
+			return nil, errors.New("invalid uncompressed public key: not a P-256 curve point")
+		}
+	}
+
+	return res, nil
+}
+
+// NormalizePublicKey decodes binary P-256 public key in any of compressed,
+// uncompressed or hybrid forms and returns its compressed form used by NeoFS
+// (e.g. by PublicKey.Encode).
+func NormalizePublicKey(data []byte) ([]byte, error) {
+	pub, err := decodeKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return elliptic.MarshalCompressed(pub.Curve, pub.X, pub.Y), nil
+}
@@ -2,11 +2,9 @@ package neofsecdsa
 
 import (
 	"crypto/ecdsa"
-	"crypto/elliptic"
 	"crypto/sha256"
 	"crypto/sha512"
 	"fmt"
-	"math/big"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 )
@@ -36,11 +34,12 @@ func (x PublicKey) Encode(buf []byte) int {
 	return copy(buf, (*keys.PublicKey)(&x).Bytes())
 }
 
-// Decode decodes compressed binary representation of the PublicKey.
+// Decode decodes binary representation of the PublicKey. Compressed,
+// uncompressed and hybrid forms are accepted (see NormalizePublicKey).
 //
 // See also Encode.
 func (x *PublicKey) Decode(data []byte) error {
-	pub, err := keys.NewPublicKeyFromBytes(data, elliptic.P256())
+	pub, err := decodeKey(data)
 	if err != nil {
 		return err
 	}
@@ -50,31 +49,19 @@ func (x *PublicKey) Decode(data []byte) error {
 	return nil
 }
 
-// similar to elliptic.Unmarshal but without IsOnCurve check.
-func unmarshalXY(data []byte) (x *big.Int, y *big.Int) {
-	if len(data) != 65 {
-		return
-	} else if data[0] != 4 { // uncompressed form
-		return
-	}
-
-	p := elliptic.P256().Params().P
-	x = new(big.Int).SetBytes(data[1:33])
-	y = new(big.Int).SetBytes(data[33:])
-
-	if x.Cmp(p) >= 0 || y.Cmp(p) >= 0 {
-		x, y = nil, nil
-	}
-
-	return
-}
-
 // Verify verifies data signature calculated by ECDSA algorithm with SHA-512 hashing.
 func (x PublicKey) Verify(data, signature []byte) bool {
 	h := sha512.Sum512(data)
-	r, s := unmarshalXY(signature)
+	return x.VerifyDigest(h[:], signature)
+}
 
-	return r != nil && s != nil && ecdsa.Verify((*ecdsa.PublicKey)(&x), h[:], r, s)
+// VerifyDigest verifies signature of the data calculated by ECDSA algorithm
+// by SHA-512 hash of the data.
+// Implements neofscrypto.DigestVerifier.
+func (x PublicKey) VerifyDigest(digest, signature []byte) bool {
+	// signature is prefixed with 0x04 like uncompressed point (see Signer.Sign)
+	return len(digest) == sha512.Size && len(signature) == 1+signatureRFC6979Size && signature[0] == 4 &&
+		verifyRaw((*ecdsa.PublicKey)(&x), digest, signature[1:])
 }
 
 // PublicKeyRFC6979 is a wrapper over ecdsa.PublicKey used for NeoFS needs.
@@ -102,11 +89,12 @@ func (x PublicKeyRFC6979) Encode(buf []byte) int {
 	return copy(buf, (*keys.PublicKey)(&x).Bytes())
 }
 
-// Decode decodes binary representation of the ECDSA public key.
+// Decode decodes binary representation of the ECDSA public key. Compressed,
+// uncompressed and hybrid forms are accepted (see NormalizePublicKey).
 //
 // See also Encode.
 func (x *PublicKeyRFC6979) Decode(data []byte) error {
-	pub, err := keys.NewPublicKeyFromBytes(data, elliptic.P256())
+	pub, err := decodeKey(data)
 	if err != nil {
 		return err
 	}
@@ -122,5 +110,12 @@ func (x *PublicKeyRFC6979) Decode(data []byte) error {
 // See also RFC 6979.
 func (x PublicKeyRFC6979) Verify(data, signature []byte) bool {
 	h := sha256.Sum256(data)
-	return (*keys.PublicKey)(&x).Verify(signature, h[:])
+	return x.VerifyDigest(h[:], signature)
+}
+
+// VerifyDigest verifies signature of the data calculated by deterministic
+// ECDSA algorithm by SHA-256 hash of the data.
+// Implements neofscrypto.DigestVerifier.
+func (x PublicKeyRFC6979) VerifyDigest(digest, signature []byte) bool {
+	return len(digest) == sha256.Size && verifyRaw((*ecdsa.PublicKey)(&x), digest, signature)
 }
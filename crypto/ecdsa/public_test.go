@@ -0,0 +1,118 @@
+package neofsecdsa_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func newSigners(tb testing.TB) []neofscrypto.Signer {
+	k, err := keys.NewPrivateKey()
+	require.NoError(tb, err)
+
+	return []neofscrypto.Signer{
+		neofsecdsa.Signer(k.PrivateKey),
+		neofsecdsa.SignerRFC6979(k.PrivateKey),
+		neofsecdsa.SignerWalletConnect(k.PrivateKey),
+	}
+}
+
+func TestPublicKey_Verify(t *testing.T) {
+	data := make([]byte, 64)
+
+	for i := 0; i < 100; i++ {
+		for _, signer := range newSigners(t) {
+			_, _ = rand.Read(data)
+
+			sig, err := signer.Sign(data)
+			require.NoError(t, err)
+
+			pub := signer.Public()
+			require.True(t, pub.Verify(data, sig), signer.Scheme())
+
+			for _, corrupted := range [][]byte{
+				nil,
+				sig[:len(sig)-1],
+				append(sig, 0),
+			} {
+				require.False(t, pub.Verify(data, corrupted), signer.Scheme())
+			}
+
+			sig[len(sig)/2]++
+			require.False(t, pub.Verify(data, sig), signer.Scheme())
+		}
+	}
+}
+
+func BenchmarkPublicKey_Verify(b *testing.B) {
+	data := make([]byte, 1024)
+	_, _ = rand.Read(data)
+
+	for _, signer := range newSigners(b) {
+		sig, err := signer.Sign(data)
+		require.NoError(b, err)
+
+		pub := signer.Public()
+
+		b.Run(signer.Scheme().String(), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if !pub.Verify(data, sig) {
+					b.Fatal("invalid signature")
+				}
+			}
+		})
+	}
+}
+
+func TestPublicKey_Decode(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	compressed := k.PublicKey().Bytes()
+	uncompressed := k.PublicKey().UncompressedBytes()
+	hybrid := append([]byte{6 | uncompressed[64]&1}, uncompressed[1:]...)
+
+	for _, pub := range []neofscrypto.PublicKey{
+		new(neofsecdsa.PublicKey),
+		new(neofsecdsa.PublicKeyRFC6979),
+		new(neofsecdsa.PublicKeyWalletConnect),
+	} {
+		for _, b := range [][]byte{compressed, uncompressed, hybrid} {
+			require.NoError(t, pub.Decode(b))
+
+			buf := make([]byte, pub.MaxEncodedSize())
+			require.Equal(t, compressed, buf[:pub.Encode(buf)], "encoding must be normalized")
+
+			normalized, err := neofsecdsa.NormalizePublicKey(b)
+			require.NoError(t, err)
+			require.Equal(t, compressed, normalized)
+		}
+
+		wrongParity := append([]byte{hybrid[0] ^ 1}, hybrid[1:]...)
+		notOnCurve := append([]byte{}, uncompressed...)
+		notOnCurve[64]++
+
+		for _, tc := range []struct {
+			b   []byte
+			err string
+		}{
+			{b: nil, err: "empty public key"},
+			{b: []byte{0}, err: "point at infinity"},
+			{b: append([]byte{5}, uncompressed[1:]...), err: "unsupported public key format 0x05"},
+			{b: compressed[:32], err: "invalid compressed public key length 32, expected 33"},
+			{b: append(compressed, 0), err: "invalid compressed public key length 34, expected 33"},
+			{b: uncompressed[:64], err: "invalid uncompressed public key length 64, expected 65"},
+			{b: notOnCurve, err: "not a P-256 curve point"},
+			{b: wrongParity, err: "Y parity mismatch"},
+		} {
+			require.ErrorContains(t, pub.Decode(tc.b), tc.err)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package neofsecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// RemoteKey represents P-256 private key which is kept outside the
+// application, e.g. on the PKCS#11 token or in the key management service.
+type RemoteKey interface {
+	// SignDigest signs pre-computed 32-byte digest with the private key.
+	// Returned signature is either concatenation of the fixed-size big-endian
+	// r and s values or ASN.1 DER encoded ECDSA-Sig-Value according to the
+	// RemoteSigner settings.
+	SignDigest(digest []byte) ([]byte, error)
+}
+
+// RemoteSigner represents signer of neofscrypto.ECDSA_SHA512 or
+// neofscrypto.ECDSA_DETERMINISTIC_SHA256 scheme which hashes the data locally
+// and delegates signing of the hash to the RemoteKey. Provides
+// neofscrypto.Signer interface. RemoteSigner is intended to be a base of the
+// signers backed by the particular external facilities.
+//
+// Instances MUST be constructed using NewRemoteSigner.
+type RemoteSigner struct {
+	key    RemoteKey
+	der    bool
+	scheme neofscrypto.Scheme
+	pub    neofscrypto.PublicKey
+}
+
+// NewRemoteSigner constructs RemoteSigner of the given scheme using the
+// RemoteKey and its public part. If der is set, RemoteKey returns ASN.1 DER
+// encoded signatures which are converted using ConvertASN1Signature.
+//
+// Scheme MUST be either neofscrypto.ECDSA_SHA512 or
+// neofscrypto.ECDSA_DETERMINISTIC_SHA256.
+func NewRemoteSigner(key RemoteKey, scheme neofscrypto.Scheme, der bool, pub ecdsa.PublicKey) RemoteSigner {
+	res := RemoteSigner{
+		key:    key,
+		der:    der,
+		scheme: scheme,
+	}
+
+	switch scheme {
+	default:
+		panic(fmt.Sprintf("unsupported scheme %v", scheme))
+	case neofscrypto.ECDSA_SHA512:
+		res.pub = (*PublicKey)(&pub)
+	case neofscrypto.ECDSA_DETERMINISTIC_SHA256:
+		res.pub = (*PublicKeyRFC6979)(&pub)
+	}
+
+	return res
+}
+
+// Scheme returns signature scheme passed to NewRemoteSigner.
+// Implements neofscrypto.Signer.
+func (x RemoteSigner) Scheme() neofscrypto.Scheme {
+	return x.scheme
+}
+
+// Sign hashes data according to the scheme and signs the hash using the
+// RemoteKey. For neofscrypto.ECDSA_SHA512, leftmost 32 bytes of the SHA-512
+// hash are signed since P-256 order is 256 bits (same as ecdsa.Sign does).
+// Implements neofscrypto.Signer.
+func (x RemoteSigner) Sign(data []byte) ([]byte, error) {
+	if x.scheme == neofscrypto.ECDSA_DETERMINISTIC_SHA256 {
+		h := sha256.Sum256(data)
+		return x.signDigest(h[:])
+	}
+
+	h := sha512.Sum512(data)
+
+	sig, err := x.signDigest(h[:32])
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{4}, sig...), nil // same as Signer
+}
+
+// Public returns PublicKey or PublicKeyRFC6979 according to the scheme.
+// Implements neofscrypto.Signer.
+func (x RemoteSigner) Public() neofscrypto.PublicKey {
+	return x.pub
+}
+
+// signDigest signs the digest using the RemoteKey and returns r||s signature.
+func (x RemoteSigner) signDigest(digest []byte) ([]byte, error) {
+	sig, err := x.key.SignDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.der {
+		res, err := ConvertASN1Signature(sig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature: %w", err)
+		}
+
+		return res, nil
+	}
+
+	if len(sig) != 64 {
+		return nil, fmt.Errorf("invalid signature length %d, expected 64", len(sig))
+	}
+
+	return sig, nil
+}
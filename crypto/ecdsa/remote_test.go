@@ -0,0 +1,85 @@
+package neofsecdsa_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/cryptotest"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteKey emulates external private key in memory.
+type remoteKey struct {
+	key *ecdsa.PrivateKey
+	der bool
+}
+
+func (x remoteKey) SignDigest(digest []byte) ([]byte, error) {
+	if x.der {
+		return ecdsa.SignASN1(rand.Reader, x.key, digest)
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, x.key, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	return sig, nil
+}
+
+type staticKey struct {
+	sig []byte
+	err error
+}
+
+func (x staticKey) SignDigest([]byte) ([]byte, error) {
+	return x.sig, x.err
+}
+
+func TestRemoteSigner(t *testing.T) {
+	for _, scheme := range []neofscrypto.Scheme{neofscrypto.ECDSA_SHA512, neofscrypto.ECDSA_DETERMINISTIC_SHA256} {
+		for _, der := range []bool{false, true} {
+			cryptotest.Run(t, func(tb testing.TB) neofscrypto.Signer {
+				k, err := keys.NewPrivateKey()
+				require.NoError(tb, err)
+
+				return neofsecdsa.NewRemoteSigner(remoteKey{key: &k.PrivateKey, der: der}, scheme, der, k.PrivateKey.PublicKey)
+			})
+		}
+	}
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	require.Panics(t, func() {
+		neofsecdsa.NewRemoteSigner(remoteKey{key: &k.PrivateKey}, neofscrypto.ECDSA_WALLETCONNECT, false, k.PrivateKey.PublicKey)
+	})
+
+	errKey := errors.New("key failure")
+
+	for _, tc := range []struct {
+		name string
+		key  staticKey
+		der  bool
+	}{
+		{name: "key failure", key: staticKey{err: errKey}},
+		{name: "short raw", key: staticKey{sig: make([]byte, 63)}},
+		{name: "invalid DER", key: staticKey{sig: []byte{1, 2, 3}}, der: true},
+		{name: "zero r", key: staticKey{sig: []byte{0x30, 6, 2, 1, 0, 2, 1, 1}}, der: true},
+	} {
+		_, err := neofsecdsa.NewRemoteSigner(tc.key, neofscrypto.ECDSA_SHA512, tc.der, k.PrivateKey.PublicKey).Sign(nil)
+		require.Error(t, err, tc.name)
+	}
+
+	_, err = neofsecdsa.NewRemoteSigner(staticKey{err: errKey}, neofscrypto.ECDSA_SHA512, false, k.PrivateKey.PublicKey).Sign(nil)
+	require.ErrorIs(t, err, errKey)
+}
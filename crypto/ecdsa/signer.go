@@ -4,7 +4,10 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/sha512"
+	"fmt"
+	"hash"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
@@ -26,7 +29,31 @@ func (x Signer) Scheme() neofscrypto.Scheme {
 // Implements neofscrypto.Signer.
 func (x Signer) Sign(data []byte) ([]byte, error) {
 	h := sha512.Sum512(data)
-	r, s, err := ecdsa.Sign(rand.Reader, (*ecdsa.PrivateKey)(&x), h[:])
+	return signSHA512(&x, h[:])
+}
+
+// SignDigest signs SHA-512 hash of the data using ECDSA algorithm.
+// Implements neofscrypto.DigestSigner.
+func (x Signer) SignDigest(digest []byte) ([]byte, error) {
+	if len(digest) != sha512.Size {
+		return nil, fmt.Errorf("invalid digest length %d, expected %d", len(digest), sha512.Size)
+	}
+
+	return signSHA512(&x, digest)
+}
+
+// NewSignatureWriter returns neofscrypto.SignatureWriter calculating SHA-512
+// hash of the written data on the fly.
+// Implements neofscrypto.StreamSigner.
+func (x Signer) NewSignatureWriter() neofscrypto.SignatureWriter {
+	return &hashSignatureWriter{
+		Hash: sha512.New(),
+		sign: x.SignDigest,
+	}
+}
+
+func signSHA512(x *Signer, h []byte) ([]byte, error) {
+	r, s, err := ecdsa.Sign(rand.Reader, (*ecdsa.PrivateKey)(x), h)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +97,40 @@ func (x SignerRFC6979) Sign(data []byte) ([]byte, error) {
 	return p.Sign(data), nil
 }
 
+// SignDigest signs SHA-256 hash of the data using deterministic ECDSA
+// algorithm.
+// Implements neofscrypto.DigestSigner.
+func (x SignerRFC6979) SignDigest(digest []byte) ([]byte, error) {
+	if len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid digest length %d, expected %d", len(digest), sha256.Size)
+	}
+
+	p := keys.PrivateKey{PrivateKey: (ecdsa.PrivateKey)(x)}
+
+	return p.SignHash(*(*[sha256.Size]byte)(digest)), nil
+}
+
+// NewSignatureWriter returns neofscrypto.SignatureWriter calculating SHA-256
+// hash of the written data on the fly.
+// Implements neofscrypto.StreamSigner.
+func (x SignerRFC6979) NewSignatureWriter() neofscrypto.SignatureWriter {
+	return &hashSignatureWriter{
+		Hash: sha256.New(),
+		sign: x.SignDigest,
+	}
+}
+
+// hashSignatureWriter is a neofscrypto.SignatureWriter signing hash of the
+// written data.
+type hashSignatureWriter struct {
+	hash.Hash
+	sign func(h []byte) ([]byte, error)
+}
+
+func (x *hashSignatureWriter) Close() ([]byte, error) {
+	return x.sign(x.Sum(nil))
+}
+
 // Public initializes PublicKeyRFC6979 and returns it as neofscrypto.PublicKey.
 // Implements neofscrypto.Signer.
 func (x SignerRFC6979) Public() neofscrypto.PublicKey {
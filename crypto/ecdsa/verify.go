@@ -0,0 +1,105 @@
+package neofsecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+)
+
+// maxASN1SignatureSize is the maximum size of the ASN.1 DER encoding of the
+// ECDSA P-256 signature: sequence of two 33-byte integers.
+const maxASN1SignatureSize = 2 + 2*(2+33)
+
+// verifyRaw checks 64-byte R||S signature of the digest. Unlike ecdsa.Verify
+// accepting big.Int values, it encodes signature into the stack buffer and
+// passes it to ecdsa.VerifyASN1 directly, so there are no intermediate
+// big.Int allocations and conversions on the hot path.
+func verifyRaw(pub *ecdsa.PublicKey, digest, sig []byte) bool {
+	if len(sig) != signatureRFC6979Size {
+		return false
+	}
+
+	var buf [maxASN1SignatureSize]byte
+
+	return ecdsa.VerifyASN1(pub, digest, appendASN1Signature(buf[:0], sig[:32], sig[32:]))
+}
+
+// walletConnectDigest returns SHA-256 hash of the Wallet Connect message
+// corresponding to the NeoFS data and salt (see WalletConnectMessage and
+// walletConnectData). Unlike hashing of the resulting message, it does not
+// allocate base64-encoded data and salted message.
+func walletConnectDigest(data, salt []byte) [sha256.Size]byte {
+	var saltHex [2 * WalletConnectSaltSize]byte
+	var varLen [9]byte
+	var res [sha256.Size]byte
+
+	saltedLen := hex.EncodedLen(len(salt)) + base64.StdEncoding.EncodedLen(len(data))
+
+	h := sha256.New()
+	h.Write([]byte{0x01, 0x00, 0x01, 0xf0})
+	h.Write(varLen[:putVarUint(varLen[:], uint64(saltedLen))])
+	h.Write(saltHex[:hex.Encode(saltHex[:], salt)])
+	writeBase64(h, data)
+	h.Write([]byte{0x00, 0x00})
+	h.Sum(res[:0])
+
+	return res
+}
+
+// writeBase64 writes standard base64 encoding of data to h by chunks.
+func writeBase64(h hash.Hash, data []byte) {
+	const chunk = 3 * 64 // multiple of 3 bytes, so there is no padding inside
+
+	var buf [4 * chunk / 3]byte
+
+	for len(data) > chunk {
+		base64.StdEncoding.Encode(buf[:], data[:chunk])
+		h.Write(buf[:])
+		data = data[chunk:]
+	}
+
+	n := base64.StdEncoding.EncodedLen(len(data))
+	base64.StdEncoding.Encode(buf[:n], data)
+	h.Write(buf[:n])
+}
+
+// appendASN1Signature appends ASN.1 DER encoding of the ECDSA signature with
+// the given R and S (big-endian) to b.
+func appendASN1Signature(b, r, s []byte) []byte {
+	r, s = trimLeadingZeros(r), trimLeadingZeros(s)
+
+	b = append(b, 0x30, byte(asn1IntegerSize(r)+asn1IntegerSize(s))) // SEQUENCE
+	b = appendASN1Integer(b, r)
+	b = appendASN1Integer(b, s)
+
+	return b
+}
+
+func trimLeadingZeros(b []byte) []byte {
+	for len(b) > 0 && b[0] == 0 {
+		b = b[1:]
+	}
+
+	return b
+}
+
+// asn1IntegerSize returns size of the ASN.1 DER encoding of the non-negative
+// integer with the given big-endian representation without leading zeros.
+func asn1IntegerSize(b []byte) int {
+	if len(b) == 0 || b[0]&0x80 != 0 {
+		return 2 + len(b) + 1
+	}
+
+	return 2 + len(b)
+}
+
+func appendASN1Integer(b, v []byte) []byte {
+	b = append(b, 0x02, byte(asn1IntegerSize(v)-2)) // INTEGER
+	if len(v) == 0 || v[0]&0x80 != 0 {
+		b = append(b, 0)
+	}
+
+	return append(b, v...)
+}
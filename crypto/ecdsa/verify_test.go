@@ -0,0 +1,35 @@
+package neofsecdsa
+
+import (
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendASN1Signature(t *testing.T) {
+	for _, tc := range [][2]string{
+		{"1", "1"},
+		{"7f", "80"},
+		{"ff", "ffff"},
+		{"00ff", "0000000080"},
+		{"ffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632550", "7fffffff800000007fffffffffffffffde737d56d38bcf4279dce5617e3192a8"},
+		{"0000000000000000000000000000000000000000000000000000000000000001", "8000000000000000000000000000000000000000000000000000000000000000"},
+	} {
+		r, ok := new(big.Int).SetString(tc[0], 16)
+		require.True(t, ok)
+		s, ok := new(big.Int).SetString(tc[1], 16)
+		require.True(t, ok)
+
+		exp, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+		require.NoError(t, err)
+
+		var sig [signatureRFC6979Size]byte
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+
+		var buf [maxASN1SignatureSize]byte
+		require.Equal(t, exp, appendASN1Signature(buf[:0], sig[:32], sig[32:]), tc)
+	}
+}
@@ -2,8 +2,6 @@ package neofsecdsa
 
 import (
 	"crypto/ecdsa"
-	"crypto/elliptic"
-	"encoding/base64"
 	"fmt"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
@@ -15,6 +13,9 @@ import (
 // 1. The data is base64 encoded before signing/verifying.
 // 2. The signature is a concatenation of the signature itself and 16-byte salt.
 //
+// Signatures calculated by the Wallet Connect-compatible wallets can be
+// converted using WalletConnectSignature.
+//
 // Instances MUST be initialized from ecdsa.PrivateKey using type conversion.
 type SignerWalletConnect ecdsa.PrivateKey
 
@@ -27,9 +28,7 @@ func (x SignerWalletConnect) Scheme() neofscrypto.Scheme {
 // Sign signs data using ECDSA algorithm with SHA-512 hashing.
 // Implements neofscrypto.Signer.
 func (x SignerWalletConnect) Sign(data []byte) ([]byte, error) {
-	b64 := make([]byte, base64.StdEncoding.EncodedLen(len(data)))
-	base64.StdEncoding.Encode(b64, data)
-	return walletconnect.Sign((*ecdsa.PrivateKey)(&x), b64)
+	return walletconnect.Sign((*ecdsa.PrivateKey)(&x), walletConnectData(data))
 }
 
 // Public initializes PublicKey and returns it as neofscrypto.PublicKey.
@@ -63,11 +62,13 @@ func (x PublicKeyWalletConnect) Encode(buf []byte) int {
 	return copy(buf, (*keys.PublicKey)(&x).Bytes())
 }
 
-// Decode decodes compressed binary representation of the PublicKeyWalletConnect.
+// Decode decodes binary representation of the PublicKeyWalletConnect.
+// Compressed, uncompressed and hybrid forms are accepted (see
+// NormalizePublicKey).
 //
 // See also Encode.
 func (x *PublicKeyWalletConnect) Decode(data []byte) error {
-	pub, err := keys.NewPublicKeyFromBytes(data, elliptic.P256())
+	pub, err := decodeKey(data)
 	if err != nil {
 		return err
 	}
@@ -79,7 +80,12 @@ func (x *PublicKeyWalletConnect) Decode(data []byte) error {
 
 // Verify verifies data signature calculated by ECDSA algorithm with SHA-512 hashing.
 func (x PublicKeyWalletConnect) Verify(data, signature []byte) bool {
-	b64 := make([]byte, base64.StdEncoding.EncodedLen(len(data)))
-	base64.StdEncoding.Encode(b64, data)
-	return walletconnect.Verify((*ecdsa.PublicKey)(&x), b64, signature)
+	sig, salt, err := SplitWalletConnectSignature(signature)
+	if err != nil {
+		return false
+	}
+
+	h := walletConnectDigest(data, salt)
+
+	return verifyRaw((*ecdsa.PublicKey)(&x), h[:], sig)
 }
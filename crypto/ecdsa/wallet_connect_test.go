@@ -0,0 +1,109 @@
+package neofsecdsa_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+// signatures produced by Neon Wallet, see
+// https://github.com/CityOfZion/neon-wallet/pull/2390.
+var neonWalletVectors = []struct {
+	pub, sig, salt, msgHex, msg string
+}{
+	{
+		pub:    "02ce6228ba2cb2fc235be93aff9cd5fc0851702eb9791552f60db062f01e3d83f6",
+		sig:    "90ab1886ca0bece59b982d9ade8f5598065d651362fb9ce45ad66d0474b89c0b80913c8f0118a282acbdf200a429ba2d81bc52534a53ab41a2c6dfe2f0b4fb1b",
+		salt:   "d41e348afccc2f3ee45cd9f5128b16dc",
+		msgHex: "010001f05c6434316533343861666363633266336565343563643966353132386231366463436172616c686f2c206d756c65712c206f2062616775697520656820697373756d65726d6f2074616978206c696761646f206e61206d697373e36f3f0000",
+		msg:    "436172616c686f2c206d756c65712c206f2062616775697520656820697373756d65726d6f2074616978206c696761646f206e61206d697373e36f3f",
+	},
+	{
+		pub:    "03bd9108c0b49f657e9eee50d1399022bd1e436118e5b7529a1b7cd606652f578f",
+		sig:    "510caa8cb6db5dedf04d215a064208d64be7496916d890df59aee132db8f2b07532e06f7ea664c4a99e3bcb74b43a35eb9653891b5f8701d2aef9e7526703eaa",
+		salt:   "2c5b189569e92cce12e1c640f23e83ba",
+		msgHex: "010001f02632633562313839353639653932636365313265316336343066323365383362613132333435360000",
+		msg:    "313233343536",
+	},
+	{
+		pub:    "03bd9108c0b49f657e9eee50d1399022bd1e436118e5b7529a1b7cd606652f578f",
+		sig:    "1e13f248962d8b3b60708b55ddf448d6d6a28c6b43887212a38b00bf6bab695e61261e54451c6e3d5f1f000e5534d166c7ca30f662a296d3a9aafa6d8c173c01",
+		salt:   "58c86b2e74215b4f36b47d731236be3b",
+		msgHex: "010001f02035386338366232653734323135623466333662343764373331323336626533620000",
+		msg:    "",
+	},
+}
+
+func decodeHex(t testing.TB, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestWalletConnect_NeonWallet(t *testing.T) {
+	for _, v := range neonWalletVectors {
+		msg := decodeHex(t, v.msg)
+		salt := decodeHex(t, v.salt)
+
+		require.Equal(t, decodeHex(t, v.msgHex), neofsecdsa.WalletConnectMessage(msg, salt))
+
+		pub, err := keys.NewPublicKeyFromBytes(decodeHex(t, v.pub), elliptic.P256())
+		require.NoError(t, err)
+
+		require.True(t, neofsecdsa.VerifyWalletConnectMessage((*ecdsa.PublicKey)(pub), msg, salt, decodeHex(t, v.sig)))
+		require.False(t, neofsecdsa.VerifyWalletConnectMessage((*ecdsa.PublicKey)(pub), append(msg, 1), salt, decodeHex(t, v.sig)))
+
+		sig, err := neofsecdsa.WalletConnectSignature(v.sig, v.salt)
+		require.NoError(t, err)
+
+		rfc6979, gotSalt, err := neofsecdsa.SplitWalletConnectSignature(sig)
+		require.NoError(t, err)
+		require.Equal(t, decodeHex(t, v.sig), rfc6979)
+		require.Equal(t, salt, gotSalt)
+	}
+}
+
+func TestWalletConnectSignature(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	signer := neofsecdsa.SignerWalletConnect(k.PrivateKey)
+	data := []byte("Hello, world!")
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+
+	rfc6979, salt, err := neofsecdsa.SplitWalletConnectSignature(sig)
+	require.NoError(t, err)
+
+	// how wallet signs NeoFS data
+	b64 := []byte(base64.StdEncoding.EncodeToString(data))
+	require.True(t, neofsecdsa.VerifyWalletConnectMessage(&k.PrivateKey.PublicKey, b64, salt, rfc6979))
+
+	converted, err := neofsecdsa.WalletConnectSignature(hex.EncodeToString(rfc6979), hex.EncodeToString(salt))
+	require.NoError(t, err)
+	require.True(t, signer.Public().Verify(data, converted))
+
+	// signing without salt
+	noSalt := k.Sign(neofsecdsa.WalletConnectMessage(b64, nil))
+	require.True(t, neofsecdsa.VerifyWalletConnectMessage(&k.PrivateKey.PublicKey, b64, nil, noSalt))
+
+	salt, err = neofsecdsa.NewWalletConnectSalt()
+	require.NoError(t, err)
+	require.Len(t, salt, neofsecdsa.WalletConnectSaltSize)
+
+	_, err = neofsecdsa.WalletConnectSignature(hex.EncodeToString(rfc6979), "00")
+	require.Error(t, err)
+	_, err = neofsecdsa.WalletConnectSignature("zz", hex.EncodeToString(salt))
+	require.Error(t, err)
+	_, err = neofsecdsa.WalletConnectSignature("0011", hex.EncodeToString(salt))
+	require.Error(t, err)
+	_, _, err = neofsecdsa.SplitWalletConnectSignature(rfc6979)
+	require.Error(t, err)
+}
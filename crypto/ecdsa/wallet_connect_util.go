@@ -0,0 +1,140 @@
+package neofsecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// WalletConnectSaltSize is the size of the random salt mixed into the data
+// signed by the Wallet Connect-compatible wallets.
+const WalletConnectSaltSize = 16
+
+// size of the RFC 6979 signature.
+const signatureRFC6979Size = 64
+
+// NewWalletConnectSalt generates new random salt for the Wallet Connect
+// signing request.
+func NewWalletConnectSalt() ([]byte, error) {
+	salt := make([]byte, WalletConnectSaltSize)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, fmt.Errorf("generate random salt: %w", err)
+	}
+
+	return salt, nil
+}
+
+// WalletConnectMessage returns message signed by the Wallet Connect-compatible
+// wallets (e.g. Neon Wallet) when they sign the given message with the salt
+// (signMessage method with default version). For NeoFS data, message is
+// base64-encoded data. Salt is mixed into the message hex-encoded. Nil salt
+// corresponds to the signing without salt (e.g. signMessageWithoutSalt
+// method).
+//
+// Signing of the data with NeoFS ECDSA_WALLETCONNECT scheme is an RFC 6979
+// signature of the resulting message.
+func WalletConnectMessage(msg, salt []byte) []byte {
+	// 4-byte prefix + var-int length of the salted message + hex-encoded salt +
+	// + message + 2-byte postfix
+	saltedLen := hex.EncodedLen(len(salt)) + len(msg)
+
+	var varLen [binary.MaxVarintLen64]byte
+	varLenSize := putVarUint(varLen[:], uint64(saltedLen))
+
+	res := make([]byte, 0, 4+varLenSize+saltedLen+2)
+	res = append(res, 0x01, 0x00, 0x01, 0xf0)
+	res = append(res, varLen[:varLenSize]...)
+	res = res[:len(res)+hex.EncodedLen(len(salt))]
+	hex.Encode(res[len(res)-hex.EncodedLen(len(salt)):], salt)
+	res = append(res, msg...)
+	res = append(res, 0x00, 0x00)
+
+	return res
+}
+
+// putVarUint writes val to buf in Neo var-int format and returns number of
+// bytes written.
+func putVarUint(buf []byte, val uint64) int {
+	switch {
+	case val < 0xfd:
+		buf[0] = byte(val)
+		return 1
+	case val <= 0xffff:
+		buf[0] = 0xfd
+		binary.LittleEndian.PutUint16(buf[1:], uint16(val))
+		return 3
+	case val <= 0xffffffff:
+		buf[0] = 0xfe
+		binary.LittleEndian.PutUint32(buf[1:], uint32(val))
+		return 5
+	default:
+		buf[0] = 0xff
+		binary.LittleEndian.PutUint64(buf[1:], val)
+		return 9
+	}
+}
+
+// WalletConnectSignature converts signature response of the Wallet Connect
+// signMessage method into NeoFS ECDSA_WALLETCONNECT format: signature
+// followed by the salt. Wallets return both values hex-encoded: salt is 16
+// bytes, signature is 64-byte RFC 6979 one. Some wallets return signatures in
+// ASN.1 DER format, they are also accepted.
+//
+// See also SplitWalletConnectSignature.
+func WalletConnectSignature(sigHex, saltHex string) ([]byte, error) {
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, fmt.Errorf("decode salt from hex: %w", err)
+	} else if len(salt) != WalletConnectSaltSize {
+		return nil, fmt.Errorf("invalid salt length %d, expected %d", len(salt), WalletConnectSaltSize)
+	}
+
+	sig, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return nil, fmt.Errorf("decode signature from hex: %w", err)
+	}
+
+	if len(sig) != signatureRFC6979Size {
+		if sig, err = ConvertASN1Signature(sig); err != nil {
+			return nil, fmt.Errorf("invalid signature: %w", err)
+		}
+	}
+
+	return append(sig, salt...), nil
+}
+
+// SplitWalletConnectSignature splits NeoFS ECDSA_WALLETCONNECT signature into
+// RFC 6979 signature and salt. Returns an error if signature has invalid
+// length.
+//
+// See also WalletConnectSignature.
+func SplitWalletConnectSignature(sig []byte) (rfc6979, salt []byte, err error) {
+	if len(sig) != signatureRFC6979Size+WalletConnectSaltSize {
+		return nil, nil, fmt.Errorf("invalid signature length %d, expected %d", len(sig), signatureRFC6979Size+WalletConnectSaltSize)
+	}
+
+	return sig[:signatureRFC6979Size], sig[signatureRFC6979Size:], nil
+}
+
+// VerifyWalletConnectMessage checks RFC 6979 signature of the message signed
+// by the Wallet Connect-compatible wallet with the given salt (nil if message
+// is signed without salt). Unlike PublicKeyWalletConnect.Verify, message is
+// not base64-encoded: use it to check signatures of arbitrary wallet messages.
+func VerifyWalletConnectMessage(pub *ecdsa.PublicKey, msg, salt, sig []byte) bool {
+	h := sha256.Sum256(WalletConnectMessage(msg, salt))
+	return verifyRaw(pub, h[:], sig)
+}
+
+// walletConnectData returns message corresponding to the NeoFS data signed
+// by ECDSA_WALLETCONNECT scheme.
+func walletConnectData(data []byte) []byte {
+	b64 := make([]byte, base64.StdEncoding.EncodedLen(len(data)))
+	base64.StdEncoding.Encode(b64, data)
+	return b64
+}
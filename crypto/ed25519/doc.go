@@ -0,0 +1,13 @@
+/*
+Package neofsed25519 collects Ed25519 primitives for NeoFS cryptography.
+
+Signer and PublicKey support Ed25519 signature algorithm described in RFC 8032.
+These types provide corresponding interfaces from neofscrypto package.
+
+Note that Ed25519 is not yet supported by the NeoFS API protocol, so the
+signers cannot be used for neofscrypto.Signature for now.
+
+Package import causes registration of next signature schemes via neofscrypto.RegisterScheme:
+  - neofscrypto.ED25519
+*/
+package neofsed25519
@@ -0,0 +1,96 @@
+package neofsed25519_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsed25519 "github.com/nspcc-dev/neofs-sdk-go/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+// test vectors from RFC 8032, section 7.1.
+var rfc8032Vectors = []struct {
+	seed, pub, msg, sig string
+}{
+	{
+		seed: "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		pub:  "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		msg:  "",
+		sig:  "e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+	},
+	{
+		seed: "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		pub:  "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		msg:  "72",
+		sig:  "92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+	},
+	{
+		seed: "c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		pub:  "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		msg:  "af82",
+		sig:  "6291d657deec24024827e69c3abe01a30ce548a284743a445e3680d7db5ac3ac18ff9b538d16f290ae67f760984dc6594a7c15e9716ed28dc027beceea1ec40a",
+	},
+}
+
+func decodeHex(t testing.TB, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestSigner_Vectors(t *testing.T) {
+	for _, v := range rfc8032Vectors {
+		signer := neofsed25519.Signer(ed25519.NewKeyFromSeed(decodeHex(t, v.seed)))
+		require.Equal(t, neofscrypto.ED25519, signer.Scheme())
+
+		pub := signer.Public()
+		buf := make([]byte, pub.MaxEncodedSize())
+		require.Equal(t, decodeHex(t, v.pub), buf[:pub.Encode(buf)])
+
+		msg := decodeHex(t, v.msg)
+
+		sig, err := signer.Sign(msg)
+		require.NoError(t, err)
+		require.Equal(t, decodeHex(t, v.sig), sig)
+
+		var decoded neofsed25519.PublicKey
+		require.NoError(t, decoded.Decode(decodeHex(t, v.pub)))
+		require.True(t, decoded.Verify(msg, sig))
+
+		sig[0]++
+		require.False(t, decoded.Verify(msg, sig))
+	}
+}
+
+func TestPublicKey_Decode(t *testing.T) {
+	var pub neofsed25519.PublicKey
+	require.Error(t, pub.Decode(make([]byte, ed25519.PublicKeySize-1)))
+	require.Error(t, pub.Decode(make([]byte, ed25519.PublicKeySize+1)))
+}
+
+func TestSignature(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	data := []byte("Hello, world!")
+
+	signer := neofsed25519.Signer(key)
+
+	// scheme is not supported by the NeoFS API protocol yet
+	var s neofscrypto.Signature
+	require.ErrorIs(t, s.Calculate(signer, data), neofscrypto.ErrIncorrectSigner)
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+
+	var m refs.Signature
+	m.SetScheme(refs.SignatureScheme(neofscrypto.ED25519))
+	m.SetKey(key.Public().(ed25519.PublicKey))
+	m.SetSign(sig)
+	require.Error(t, s.ReadFromV2(m))
+
+	require.Equal(t, "ED25519", neofscrypto.ED25519.String())
+}
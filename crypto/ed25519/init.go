@@ -0,0 +1,9 @@
+package neofsed25519
+
+import neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+
+func init() {
+	neofscrypto.RegisterScheme(neofscrypto.ED25519, func() neofscrypto.PublicKey {
+		return new(PublicKey)
+	})
+}
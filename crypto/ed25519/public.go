@@ -0,0 +1,49 @@
+package neofsed25519
+
+import (
+	"crypto/ed25519"
+	"fmt"
+)
+
+// PublicKey is a wrapper over ed25519.PublicKey used for NeoFS needs.
+// Provides neofscrypto.PublicKey interface.
+//
+// Instances MUST be initialized from ed25519.PublicKey using type conversion.
+type PublicKey ed25519.PublicKey
+
+// MaxEncodedSize returns size of the Ed25519 public key.
+func (x PublicKey) MaxEncodedSize() int {
+	return ed25519.PublicKeySize
+}
+
+// Encode encodes Ed25519 public key into buf.
+// Uses exactly MaxEncodedSize bytes of the buf.
+//
+// Encode panics if buf length is less than MaxEncodedSize.
+//
+// See also Decode.
+func (x PublicKey) Encode(buf []byte) int {
+	if len(buf) < ed25519.PublicKeySize {
+		panic(fmt.Sprintf("too short buffer %d", len(buf)))
+	}
+
+	return copy(buf, x)
+}
+
+// Decode decodes binary representation of the Ed25519 public key.
+//
+// See also Encode.
+func (x *PublicKey) Decode(data []byte) error {
+	if len(data) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid public key length %d, expected %d", len(data), ed25519.PublicKeySize)
+	}
+
+	*x = append((*x)[:0], data...)
+
+	return nil
+}
+
+// Verify verifies data signature calculated by Ed25519 algorithm.
+func (x PublicKey) Verify(data, signature []byte) bool {
+	return len(x) == ed25519.PublicKeySize && ed25519.Verify(ed25519.PublicKey(x), data, signature)
+}
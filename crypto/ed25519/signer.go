@@ -0,0 +1,38 @@
+package neofsed25519
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// Signer wraps ed25519.PrivateKey and represents signer based on Ed25519
+// algorithm. Provides neofscrypto.Signer interface.
+//
+// Instances MUST be initialized from ed25519.PrivateKey using type conversion.
+type Signer ed25519.PrivateKey
+
+// Scheme returns neofscrypto.ED25519.
+// Implements neofscrypto.Signer.
+func (x Signer) Scheme() neofscrypto.Scheme {
+	return neofscrypto.ED25519
+}
+
+// Sign signs data using Ed25519 algorithm. Data is not pre-hashed since
+// Ed25519 hashes the message internally (SHA-512).
+// Implements neofscrypto.Signer.
+func (x Signer) Sign(data []byte) ([]byte, error) {
+	if len(x) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length %d, expected %d", len(x), ed25519.PrivateKeySize)
+	}
+
+	return ed25519.Sign(ed25519.PrivateKey(x), data), nil
+}
+
+// Public initializes PublicKey and returns it as neofscrypto.PublicKey.
+// Implements neofscrypto.Signer.
+func (x Signer) Public() neofscrypto.PublicKey {
+	pub := PublicKey(ed25519.PrivateKey(x).Public().(ed25519.PublicKey))
+	return &pub
+}
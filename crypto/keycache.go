@@ -0,0 +1,90 @@
+package neofscrypto
+
+import (
+	"fmt"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// DefaultPublicKeyCacheSize is the default number of decoded public keys
+// cached by Signature.Verify.
+const DefaultPublicKeyCacheSize = 1024
+
+var keyCache struct {
+	mtx sync.RWMutex
+	c   *lru.Cache // nil if disabled
+}
+
+func init() {
+	SetPublicKeyCacheSize(DefaultPublicKeyCacheSize)
+}
+
+// resetPublicKeyCache drops all cached public keys.
+func resetPublicKeyCache() {
+	keyCache.mtx.RLock()
+	if keyCache.c != nil {
+		keyCache.c.Purge()
+	}
+	keyCache.mtx.RUnlock()
+}
+
+// SetPublicKeyCacheSize sets maximum number of the decoded public keys cached
+// for the signature verification (see Signature.Verify). Repeated
+// verification of the signatures made by the same keys (e.g. responses of the
+// same node) doesn't decode the key each time then. Non-positive value
+// disables caching. Previously cached keys are dropped.
+//
+// Note that cached PublicKey instances are shared between goroutines, so
+// PublicKey.Verify implementations of the registered schemes MUST be safe for
+// concurrent use (like built-in ones are).
+//
+// By default, DefaultPublicKeyCacheSize is used.
+func SetPublicKeyCacheSize(size int) {
+	var c *lru.Cache
+
+	if size > 0 {
+		c, _ = lru.New(size) // error is returned for non-positive size only
+	}
+
+	keyCache.mtx.Lock()
+	keyCache.c = c
+	keyCache.mtx.Unlock()
+}
+
+type cachedKeyID struct {
+	scheme Scheme
+	key    string
+}
+
+// decodePublicKey decodes public key of the given scheme using the cache.
+func decodePublicKey(scheme Scheme, key []byte) (PublicKey, error) {
+	keyCache.mtx.RLock()
+	c := keyCache.c
+	keyCache.mtx.RUnlock()
+
+	var id cachedKeyID
+
+	if c != nil {
+		id = cachedKeyID{scheme: scheme, key: string(key)}
+
+		if v, ok := c.Get(id); ok {
+			return v.(PublicKey), nil
+		}
+	}
+
+	pub, err := NewPublicKey(scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pub.Decode(key); err != nil {
+		return nil, fmt.Errorf("decode public key: %w", err)
+	}
+
+	if c != nil {
+		c.Add(id, pub)
+	}
+
+	return pub, nil
+}
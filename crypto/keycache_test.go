@@ -0,0 +1,62 @@
+package neofscrypto_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicKeyCache(t *testing.T) {
+	t.Cleanup(func() { neofscrypto.SetPublicKeyCacheSize(neofscrypto.DefaultPublicKeyCacheSize) })
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	data := []byte("Hello, world!")
+
+	var sig, otherSchemeSig neofscrypto.Signature
+	require.NoError(t, sig.Calculate(neofsecdsa.SignerRFC6979(k.PrivateKey), data))
+	// same key, different scheme
+	require.NoError(t, otherSchemeSig.Calculate(neofsecdsa.Signer(k.PrivateKey), data))
+
+	for _, size := range []int{0, 1, 10} {
+		neofscrypto.SetPublicKeyCacheSize(size)
+
+		for i := 0; i < 3; i++ {
+			require.True(t, sig.Verify(data), size)
+			require.True(t, otherSchemeSig.Verify(data), size)
+			require.False(t, sig.Verify(append(data, 1)), size)
+		}
+	}
+}
+
+func BenchmarkSignature_Verify(b *testing.B) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(b, err)
+
+	data := []byte("Hello, world!")
+
+	var sig neofscrypto.Signature
+	require.NoError(b, sig.Calculate(neofsecdsa.SignerRFC6979(k.PrivateKey), data))
+
+	b.Cleanup(func() { neofscrypto.SetPublicKeyCacheSize(neofscrypto.DefaultPublicKeyCacheSize) })
+
+	for _, size := range []int{0, neofscrypto.DefaultPublicKeyCacheSize} {
+		b.Run(fmt.Sprintf("cache size %d", size), func(b *testing.B) {
+			neofscrypto.SetPublicKeyCacheSize(size)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if !sig.Verify(data) {
+					b.Fatal("invalid signature")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Package neofskms provides NeoFS signers delegating ECDSA signing to the cloud
+key management services (AWS KMS, Google Cloud KMS, Azure Key Vault, etc.).
+
+Package does not depend on the cloud SDKs: signers use Service interface which
+is implemented by the application using the client of the particular service.
+The only requirement is the P-256 key and the ability to sign pre-computed
+SHA-256 digest. Different services return signatures in different formats
+which is specified by SignatureFormat:
+
+  - AWS KMS: Sign with ECDSA_SHA_256 algorithm and DIGEST message type, FormatDER
+  - Google Cloud KMS: AsymmetricSign with EC_SIGN_P256_SHA256 key, FormatDER
+  - Azure Key Vault: Sign with ES256 algorithm, FormatRaw
+
+For example, AWS KMS:
+
+	type awsKMS struct {
+		cli   *kms.Client
+		keyID string
+	}
+
+	func (x awsKMS) SignDigest(digest []byte) ([]byte, error) {
+		res, err := x.cli.Sign(context.TODO(), &kms.SignInput{
+			KeyId:            &x.keyID,
+			Message:          digest,
+			MessageType:      types.MessageTypeDigest,
+			SigningAlgorithm: types.SigningAlgorithmSpecEcdsaSha256,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Signature, nil
+	}
+
+	// resp is the response to GetPublicKey request
+	pub, err := neofskms.ParsePublicKey(resp.PublicKey)
+	// ...
+
+	signer := neofskms.NewSignerRFC6979(awsKMS{cli, keyID}, neofskms.FormatDER, pub)
+
+Signer and SignerRFC6979 produce signatures of neofscrypto.ECDSA_SHA512 and
+neofscrypto.ECDSA_DETERMINISTIC_SHA256 schemes respectively, so they are
+verified using public keys from neofsecdsa package.
+*/
+package neofskms
@@ -0,0 +1,55 @@
+package neofskms
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// SignatureFormat is an enumerator of the ECDSA signature encodings used by
+// the key management services.
+type SignatureFormat uint8
+
+const (
+	// FormatDER is ASN.1 DER encoded ECDSA-Sig-Value structure (RFC 3279).
+	FormatDER SignatureFormat = iota
+	// FormatRaw is concatenation of the fixed-size big-endian r and s values
+	// (e.g. JWS ES256 format).
+	FormatRaw
+)
+
+// String implements fmt.Stringer.
+func (x SignatureFormat) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case FormatDER:
+		return "DER"
+	case FormatRaw:
+		return "RAW"
+	}
+}
+
+// ParsePublicKey decodes P-256 public key from the DER or PEM encoded X.509
+// SubjectPublicKeyInfo structure returned by the key management services.
+func ParsePublicKey(data []byte) (ecdsa.PublicKey, error) {
+	if b, _ := pem.Decode(data); b != nil {
+		data = b.Bytes
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(data)
+	if err != nil {
+		return ecdsa.PublicKey{}, fmt.Errorf("decode public key: %w", err)
+	}
+
+	ecPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return ecdsa.PublicKey{}, fmt.Errorf("unexpected public key type %T", pub)
+	} else if ecPub.Curve != elliptic.P256() {
+		return ecdsa.PublicKey{}, fmt.Errorf("unexpected curve %s", ecPub.Curve.Params().Name)
+	}
+
+	return *ecPub, nil
+}
@@ -0,0 +1,75 @@
+package neofskms
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+)
+
+// Service represents key management service storing the P-256 private key.
+type Service interface {
+	// SignDigest signs pre-computed 32-byte digest with the stored private key.
+	// Returned signature format depends on the service (see SignatureFormat).
+	SignDigest(digest []byte) ([]byte, error)
+}
+
+// Signer represents signer based on ECDSA with SHA-512 hashing which
+// delegates signing to the key management service. Provides
+// neofscrypto.Signer interface.
+//
+// Instances SHOULD be constructed using NewSigner.
+type Signer struct {
+	neofsecdsa.RemoteSigner
+}
+
+// NewSigner constructs Signer using the given service returning signatures
+// in the specified format and public key corresponding to the private key
+// stored in the service.
+func NewSigner(svc Service, format SignatureFormat, pub ecdsa.PublicKey) Signer {
+	return Signer{newRemoteSigner(svc, format, neofscrypto.ECDSA_SHA512, pub)}
+}
+
+// SignerRFC6979 represents signer based on ECDSA with SHA-256 hashing which
+// delegates signing to the key management service. Produces signatures of
+// neofscrypto.ECDSA_DETERMINISTIC_SHA256 scheme. Provides neofscrypto.Signer
+// interface.
+//
+// Note that the service may use random nonces, so signatures are valid but
+// not necessarily deterministic.
+//
+// Instances SHOULD be constructed using NewSignerRFC6979.
+type SignerRFC6979 struct {
+	neofsecdsa.RemoteSigner
+}
+
+// NewSignerRFC6979 constructs SignerRFC6979 using the given service returning
+// signatures in the specified format and public key corresponding to the
+// private key stored in the service.
+func NewSignerRFC6979(svc Service, format SignatureFormat, pub ecdsa.PublicKey) SignerRFC6979 {
+	return SignerRFC6979{newRemoteSigner(svc, format, neofscrypto.ECDSA_DETERMINISTIC_SHA256, pub)}
+}
+
+func newRemoteSigner(svc Service, format SignatureFormat, scheme neofscrypto.Scheme, pub ecdsa.PublicKey) neofsecdsa.RemoteSigner {
+	return neofsecdsa.NewRemoteSigner(remoteKey{svc: svc, format: format}, scheme, format == FormatDER, pub)
+}
+
+// remoteKey is a neofsecdsa.RemoteKey stored in the key management service.
+type remoteKey struct {
+	svc    Service
+	format SignatureFormat
+}
+
+func (x remoteKey) SignDigest(digest []byte) ([]byte, error) {
+	if x.format != FormatDER && x.format != FormatRaw {
+		return nil, fmt.Errorf("unsupported signature format %v", x.format)
+	}
+
+	sig, err := x.svc.SignDigest(digest)
+	if err != nil {
+		return nil, fmt.Errorf("sign in KMS: %w", err)
+	}
+
+	return sig, nil
+}
@@ -0,0 +1,109 @@
+package neofskms_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofskms "github.com/nspcc-dev/neofs-sdk-go/crypto/kms"
+	"github.com/stretchr/testify/require"
+)
+
+// localService emulates KMS in memory.
+type localService struct {
+	key    *ecdsa.PrivateKey
+	format neofskms.SignatureFormat
+}
+
+func (x localService) SignDigest(digest []byte) ([]byte, error) {
+	if x.format == neofskms.FormatDER {
+		return ecdsa.SignASN1(rand.Reader, x.key, digest)
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, x.key, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	return sig, nil
+}
+
+func TestSigners(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	data := []byte("Hello, world!")
+
+	for _, format := range []neofskms.SignatureFormat{neofskms.FormatDER, neofskms.FormatRaw} {
+		svc := localService{key: &k.PrivateKey, format: format}
+
+		for _, signer := range []neofscrypto.Signer{
+			neofskms.NewSigner(svc, format, k.PrivateKey.PublicKey),
+			neofskms.NewSignerRFC6979(svc, format, k.PrivateKey.PublicKey),
+		} {
+			var sig neofscrypto.Signature
+
+			require.NoError(t, sig.Calculate(signer, data), format)
+			require.True(t, sig.Verify(data), "type %T, format %v", signer, format)
+		}
+	}
+}
+
+type failService []byte
+
+func (x failService) SignDigest([]byte) ([]byte, error) {
+	if x == nil {
+		return nil, errors.New("access denied")
+	}
+
+	return x, nil
+}
+
+func TestSigner_InvalidResponse(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name   string
+		svc    neofskms.Service
+		format neofskms.SignatureFormat
+	}{
+		{name: "service failure", svc: failService(nil), format: neofskms.FormatDER},
+		{name: "invalid DER", svc: failService{1, 2, 3}, format: neofskms.FormatDER},
+		{name: "trailing DER data", svc: failService{0x30, 6, 2, 1, 1, 2, 1, 1, 0}, format: neofskms.FormatDER},
+		{name: "zero r", svc: failService{0x30, 6, 2, 1, 0, 2, 1, 1}, format: neofskms.FormatDER},
+		{name: "short raw", svc: failService(make([]byte, 63)), format: neofskms.FormatRaw},
+		{name: "unknown format", svc: failService(make([]byte, 64)), format: neofskms.FormatRaw + 1},
+	} {
+		_, err := neofskms.NewSignerRFC6979(tc.svc, tc.format, k.PrivateKey.PublicKey).Sign(nil)
+		require.Error(t, err, tc.name)
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&k.PrivateKey.PublicKey)
+	require.NoError(t, err)
+
+	pub, err := neofskms.ParsePublicKey(der)
+	require.NoError(t, err)
+	require.True(t, pub.Equal(&k.PrivateKey.PublicKey))
+
+	pub, err = neofskms.ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+	require.True(t, pub.Equal(&k.PrivateKey.PublicKey))
+
+	_, err = neofskms.ParsePublicKey([]byte("not a key"))
+	require.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package neofsledger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Transport represents connection to the Ledger device.
+type Transport interface {
+	// Exchange sends APDU command to the device and returns the response
+	// including trailing 2-byte status word.
+	Exchange(apdu []byte) ([]byte, error)
+}
+
+// APDU protocol of the Neo N3 Ledger application.
+const (
+	cla = 0x80
+
+	insGetPublicKey = 0x05
+	insSignMessage  = 0x08
+
+	p2More = 0x80
+	p2Last = 0x00
+
+	maxChunkSize = 255
+	// chunks of the signed data are numbered by P1 starting from 1
+	maxDataSize = 255 * maxChunkSize
+)
+
+// status words.
+const (
+	swOK     = 0x9000
+	swDenied = 0x6985
+)
+
+// ErrDenied is returned when the user rejected the operation on the device.
+var ErrDenied = errors.New("operation denied by user")
+
+// DefaultPath is the default BIP-44 derivation path of the Neo account:
+// m/44'/888'/0'/0/0.
+var DefaultPath = []uint32{44 | hardened, 888 | hardened, 0 | hardened, 0, 0}
+
+const hardened = 0x80000000
+
+func encodePath(path []uint32) []byte {
+	res := make([]byte, 4*len(path))
+	for i := range path {
+		binary.BigEndian.PutUint32(res[4*i:], path[i])
+	}
+
+	return res
+}
+
+// exchange sends single APDU command and checks status word of the response.
+func exchange(t Transport, ins, p1, p2 byte, data []byte) ([]byte, error) {
+	if len(data) > maxChunkSize {
+		panic(fmt.Sprintf("too big APDU data %d", len(data)))
+	}
+
+	apdu := make([]byte, 5, 5+len(data))
+	apdu[0], apdu[1], apdu[2], apdu[3], apdu[4] = cla, ins, p1, p2, byte(len(data))
+	apdu = append(apdu, data...)
+
+	resp, err := t.Exchange(apdu)
+	if err != nil {
+		return nil, fmt.Errorf("exchange APDU: %w", err)
+	}
+
+	if len(resp) < 2 {
+		return nil, fmt.Errorf("too short response %d", len(resp))
+	}
+
+	switch sw := binary.BigEndian.Uint16(resp[len(resp)-2:]); sw {
+	default:
+		return nil, fmt.Errorf("device returned status 0x%04X", sw)
+	case swDenied:
+		return nil, ErrDenied
+	case swOK:
+		return resp[:len(resp)-2], nil
+	}
+}
@@ -0,0 +1,23 @@
+/*
+Package neofsledger provides NeoFS signer backed by the Ledger hardware wallet
+running Neo N3 application.
+
+Package does not bind to the particular USB HID library: communication with
+the device goes through Transport interface exchanging APDU commands, e.g.
+implemented over github.com/karalabe/hid or Ledger's HID framing done by the
+application.
+
+	signer, err := neofsledger.NewSigner(transport, neofsledger.DefaultPath)
+	// ...
+
+	signer.SetConfirmationCallback(func() {
+		fmt.Println("Confirm operation on the Ledger device")
+	})
+
+Signer produces neofscrypto.ECDSA_DETERMINISTIC_SHA256 signatures verified by
+neofsecdsa.PublicKeyRFC6979. Every signing operation requires the user to
+confirm it on the device, rejection results in ErrDenied. Note that the
+device application must support signing of arbitrary messages (not only Neo
+transactions).
+*/
+package neofsledger
@@ -0,0 +1,119 @@
+package neofsledger
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+)
+
+// Signer represents signer based on deterministic ECDSA with SHA-256 hashing
+// (RFC 6979) which delegates signing to the Ledger device. Provides
+// neofscrypto.Signer interface.
+//
+// Instances MUST be constructed using NewSigner.
+type Signer struct {
+	t    Transport
+	path []byte
+	pub  ecdsa.PublicKey
+
+	onConfirm func()
+}
+
+// NewSigner constructs Signer using the key derived on the device by the
+// given BIP-44 path (e.g. DefaultPath). Requests public key from the device.
+func NewSigner(t Transport, path []uint32) (*Signer, error) {
+	res := &Signer{
+		t:    t,
+		path: encodePath(path),
+	}
+
+	if len(res.path) > maxChunkSize {
+		return nil, fmt.Errorf("too long derivation path %d", len(path))
+	}
+
+	resp, err := exchange(t, insGetPublicKey, 0, 0, res.path)
+	if err != nil {
+		return nil, fmt.Errorf("get public key: %w", err)
+	}
+
+	var pub neofsecdsa.PublicKeyRFC6979
+	if err = pub.Decode(resp); err != nil {
+		return nil, fmt.Errorf("decode public key returned by device: %w", err)
+	}
+
+	res.pub = (ecdsa.PublicKey)(pub)
+
+	return res, nil
+}
+
+// SetConfirmationCallback sets function called when the signing request is
+// sent to the device and the user is expected to confirm it. The callback
+// is intended to prompt the user, it MUST NOT block.
+func (x *Signer) SetConfirmationCallback(f func()) {
+	x.onConfirm = f
+}
+
+// Scheme returns neofscrypto.ECDSA_DETERMINISTIC_SHA256.
+// Implements neofscrypto.Signer.
+func (x *Signer) Scheme() neofscrypto.Scheme {
+	return neofscrypto.ECDSA_DETERMINISTIC_SHA256
+}
+
+// Sign sends data to the device and waits for the user to confirm signing.
+// Data is transmitted in chunks, device calculates SHA-256 hash of the data
+// and signs it. Data is limited to 255 chunks (65025 bytes), bigger data is
+// rejected without interacting with the device. Returns ErrDenied if the user
+// rejected the operation. Implements neofscrypto.Signer.
+func (x *Signer) Sign(data []byte) ([]byte, error) {
+	if len(data) > maxDataSize {
+		return nil, fmt.Errorf("too big data %d, max %d", len(data), maxDataSize)
+	}
+
+	_, err := exchange(x.t, insSignMessage, 0, p2More, x.path)
+	if err != nil {
+		return nil, fmt.Errorf("send derivation path: %w", err)
+	}
+
+	var resp []byte
+
+	for chunk := byte(1); ; chunk++ {
+		n := len(data)
+		if n > maxChunkSize {
+			n = maxChunkSize
+		}
+
+		p2 := byte(p2More)
+		if n == len(data) {
+			p2 = p2Last
+
+			if x.onConfirm != nil {
+				x.onConfirm()
+			}
+		}
+
+		resp, err = exchange(x.t, insSignMessage, chunk, p2, data[:n])
+		if err != nil {
+			return nil, fmt.Errorf("send data chunk #%d: %w", chunk, err)
+		}
+
+		data = data[n:]
+		if p2 == p2Last {
+			break
+		}
+	}
+
+	sig, err := neofsecdsa.ConvertASN1Signature(resp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature returned by device: %w", err)
+	}
+
+	return sig, nil
+}
+
+// Public returns neofsecdsa.PublicKeyRFC6979 as neofscrypto.PublicKey.
+// Implements neofscrypto.Signer.
+func (x *Signer) Public() neofscrypto.PublicKey {
+	return (*neofsecdsa.PublicKeyRFC6979)(&x.pub)
+}
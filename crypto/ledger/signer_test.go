@@ -0,0 +1,116 @@
+package neofsledger_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsledger "github.com/nspcc-dev/neofs-sdk-go/crypto/ledger"
+	"github.com/stretchr/testify/require"
+)
+
+// device emulates Neo N3 Ledger application.
+type device struct {
+	key  *keys.PrivateKey
+	deny bool
+
+	msg    bytes.Buffer
+	chunks int
+}
+
+var ok = []byte{0x90, 0x00}
+
+func (x *device) Exchange(apdu []byte) ([]byte, error) {
+	if len(apdu) < 5 || apdu[0] != 0x80 || int(apdu[4]) != len(apdu)-5 {
+		return []byte{0x6E, 0x00}, nil
+	}
+
+	data := apdu[5:]
+
+	switch apdu[1] {
+	case 0x05:
+		return append(x.key.PublicKey().UncompressedBytes(), ok...), nil
+	case 0x08:
+		if apdu[2] == 0 {
+			x.msg.Reset()
+			x.chunks = 0
+			return ok, nil
+		}
+
+		x.chunks++
+		x.msg.Write(data)
+
+		if apdu[3] == 0x80 {
+			return ok, nil
+		}
+
+		if x.deny {
+			return []byte{0x69, 0x85}, nil
+		}
+
+		h := sha256.Sum256(x.msg.Bytes())
+
+		sig, err := ecdsa.SignASN1(rand.Reader, &x.key.PrivateKey, h[:])
+		if err != nil {
+			return nil, err
+		}
+
+		return append(sig, ok...), nil
+	}
+
+	return []byte{0x6D, 0x00}, nil
+}
+
+func TestSigner(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	dev := &device{key: k}
+
+	signer, err := neofsledger.NewSigner(dev, neofsledger.DefaultPath)
+	require.NoError(t, err)
+	require.Equal(t, neofscrypto.ECDSA_DETERMINISTIC_SHA256, signer.Scheme())
+
+	var confirmations int
+	signer.SetConfirmationCallback(func() { confirmations++ })
+
+	for _, size := range []int{0, 1, 255, 256, 1024, 255 * 255} {
+		data := make([]byte, size)
+		_, _ = rand.Read(data)
+
+		var sig neofscrypto.Signature
+		require.NoError(t, sig.Calculate(signer, data))
+		require.True(t, sig.Verify(data), size)
+		require.True(t, bytes.Equal(data, dev.msg.Bytes()))
+	}
+
+	require.Equal(t, 6, confirmations)
+	require.Equal(t, 255, dev.chunks)
+
+	// chunk numbers are limited by a single byte
+	_, err = signer.Sign(make([]byte, 255*255+1))
+	require.Error(t, err)
+	require.Equal(t, 6, confirmations)
+	require.Equal(t, 255, dev.chunks)
+
+	dev.deny = true
+
+	_, err = signer.Sign([]byte("Hello, world!"))
+	require.ErrorIs(t, err, neofsledger.ErrDenied)
+}
+
+type brokenTransport struct{}
+
+func (brokenTransport) Exchange([]byte) ([]byte, error) {
+	return nil, errors.New("device disconnected")
+}
+
+func TestNewSigner(t *testing.T) {
+	_, err := neofsledger.NewSigner(brokenTransport{}, neofsledger.DefaultPath)
+	require.Error(t, err)
+}
@@ -0,0 +1,154 @@
+package neofscrypto
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// ErrDuplicateSignature is returned when MultiSignature already contains
+// signature of the same public key. This variable is intended to be used as
+// documentation and for [errors.Is] purposes and MUST NOT be changed.
+var ErrDuplicateSignature = errors.New("duplicate signature")
+
+// MultiSignature is a set of signatures of the same data made by different
+// parties, e.g. co-signed container or session token. Each public key may
+// sign the data only once.
+//
+// MultiSignature is mutually compatible with the list of
+// github.com/nspcc-dev/neofs-api-go/v2/refs.Signature messages. See
+// ReadFromV2 / WriteToV2 methods.
+//
+// Instances can be created using built-in var declaration.
+type MultiSignature struct {
+	sigs []Signature
+}
+
+// ReadFromV2 reads MultiSignature from the list of refs.Signature messages.
+// Checks if each message conforms to NeoFS API V2 protocol and there are no
+// signatures of the same public key (see also ErrDuplicateSignature).
+//
+// See also WriteToV2.
+func (x *MultiSignature) ReadFromV2(m []refs.Signature) error {
+	var res MultiSignature
+
+	for i := range m {
+		var sig Signature
+
+		err := sig.ReadFromV2(m[i])
+		if err == nil {
+			err = res.Append(sig)
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid signature #%d: %w", i, err)
+		}
+	}
+
+	*x = res
+
+	return nil
+}
+
+// WriteToV2 writes MultiSignature to the list of refs.Signature messages.
+// The list must not be nil.
+//
+// See also ReadFromV2.
+func (x MultiSignature) WriteToV2(m *[]refs.Signature) {
+	res := make([]refs.Signature, len(x.sigs))
+
+	for i := range x.sigs {
+		x.sigs[i].WriteToV2(&res[i])
+	}
+
+	*m = res
+}
+
+// Append adds the signature to the set. Returns ErrDuplicateSignature if the
+// set already contains signature of the same public key.
+//
+// See also Calculate.
+func (x *MultiSignature) Append(sig Signature) error {
+	key := (*refs.Signature)(&sig).GetKey()
+
+	for i := range x.sigs {
+		if bytes.Equal((*refs.Signature)(&x.sigs[i]).GetKey(), key) {
+			return fmt.Errorf("%w: public key %x", ErrDuplicateSignature, key)
+		}
+	}
+
+	x.sigs = append(x.sigs, sig)
+
+	return nil
+}
+
+// Calculate signs data using Signer and adds the signature to the set (see
+// Append).
+//
+// Signer MUST NOT be nil.
+//
+// See also CalculateContext, Verify.
+func (x *MultiSignature) Calculate(signer Signer, data []byte) error {
+	return x.CalculateContext(context.Background(), signer, data)
+}
+
+// CalculateContext is the same as Calculate but allows to abort signing
+// (see Signature.CalculateContext).
+func (x *MultiSignature) CalculateContext(ctx context.Context, signer Signer, data []byte) error {
+	var sig Signature
+
+	if err := sig.CalculateContext(ctx, signer, data); err != nil {
+		return err
+	}
+
+	return x.Append(sig)
+}
+
+// Len returns number of signatures in the set.
+func (x MultiSignature) Len() int {
+	return len(x.sigs)
+}
+
+// Signatures returns list of signatures in the set in order of addition.
+//
+// The value returned shares memory with the structure itself, so changing it
+// can lead to data corruption. Make a copy if you need to change it.
+func (x MultiSignature) Signatures() []Signature {
+	return x.sigs
+}
+
+// Verify checks whether all signatures in the set are valid signatures of the
+// data. Empty set is considered invalid.
+//
+// See also VerifyAll.
+func (x MultiSignature) Verify(data []byte) bool {
+	if len(x.sigs) == 0 {
+		return false
+	}
+
+	for i := range x.sigs {
+		if !x.sigs[i].Verify(data) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// VerifyAll checks each signature in the set and returns the list of
+// verification results in the same order as Signatures. Nil element means
+// valid signature, ErrInvalidSignature is returned for incorrect ones.
+//
+// See also Verify, VerifyBatch.
+func (x MultiSignature) VerifyAll(data []byte) []error {
+	items := make([]SignedItem, len(x.sigs))
+
+	for i := range x.sigs {
+		items[i] = SignedItem{Data: data, Signature: x.sigs[i]}
+	}
+
+	return VerifyBatch(items)
+}
@@ -0,0 +1,64 @@
+package neofscrypto_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiSignature(t *testing.T) {
+	data := make([]byte, 64)
+	_, _ = rand.Read(data)
+
+	var signers []neofscrypto.Signer
+	for i := 0; i < 3; i++ {
+		k, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+		signers = append(signers, neofsecdsa.SignerRFC6979(k.PrivateKey))
+	}
+
+	var ms neofscrypto.MultiSignature
+	require.False(t, ms.Verify(data))
+
+	for i := range signers {
+		require.NoError(t, ms.Calculate(signers[i], data))
+	}
+
+	require.Equal(t, len(signers), ms.Len())
+	require.True(t, ms.Verify(data))
+	require.Equal(t, []error{nil, nil, nil}, ms.VerifyAll(data))
+
+	require.ErrorIs(t, ms.Calculate(signers[1], data), neofscrypto.ErrDuplicateSignature)
+	require.Equal(t, len(signers), ms.Len())
+
+	var m []refs.Signature
+	ms.WriteToV2(&m)
+	require.Len(t, m, len(signers))
+
+	var ms2 neofscrypto.MultiSignature
+	require.NoError(t, ms2.ReadFromV2(m))
+	require.Equal(t, ms, ms2)
+
+	require.ErrorIs(t, ms2.ReadFromV2(append(m, m[0])), neofscrypto.ErrDuplicateSignature)
+	require.Equal(t, ms, ms2)
+
+	m[1].SetSign(nil)
+	require.Error(t, ms2.ReadFromV2(m))
+
+	var other neofscrypto.Signature
+	require.NoError(t, other.Calculate(signers[0], []byte("other data")))
+
+	var ms3 neofscrypto.MultiSignature
+	require.NoError(t, ms3.Calculate(signers[1], data))
+	require.NoError(t, ms3.Append(other))
+	require.False(t, ms3.Verify(data))
+
+	errs := ms3.VerifyAll(data)
+	require.NoError(t, errs[0])
+	require.ErrorIs(t, errs[1], neofscrypto.ErrInvalidSignature)
+}
@@ -0,0 +1,33 @@
+/*
+Package neofspkcs11 provides NeoFS signers backed by PKCS#11 tokens (HSM,
+smartcards, etc.) which never reveal the private key.
+
+Package does not bind to the particular PKCS#11 library: signers use Token
+interface which is implemented by the application on top of the opened
+PKCS#11 session (e.g. using github.com/miekg/pkcs11):
+
+	type token struct {
+		ctx *pkcs11.Ctx
+		ses pkcs11.SessionHandle
+		key pkcs11.ObjectHandle
+	}
+
+	func (x token) SignECDSA(digest []byte) ([]byte, error) {
+		err := x.ctx.SignInit(x.ses, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, x.key)
+		if err != nil {
+			return nil, err
+		}
+
+		return x.ctx.Sign(x.ses, digest)
+	}
+
+Key stored on the token MUST be secp256r1 (P-256) key. Its public part is
+required to construct the signer:
+
+	signer := neofspkcs11.NewSignerRFC6979(token{ctx, ses, key}, pub)
+
+Signer and SignerRFC6979 produce signatures of neofscrypto.ECDSA_SHA512 and
+neofscrypto.ECDSA_DETERMINISTIC_SHA256 schemes respectively, so they are
+verified using public keys from neofsecdsa package.
+*/
+package neofspkcs11
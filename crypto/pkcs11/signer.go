@@ -0,0 +1,66 @@
+package neofspkcs11
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+)
+
+// Token represents PKCS#11 token storing the P-256 private key.
+type Token interface {
+	// SignECDSA signs the given digest with the stored private key using
+	// CKM_ECDSA mechanism (C_SignInit and C_Sign functions). Returns
+	// concatenation of the fixed-size big-endian r and s values (64 bytes)
+	// as specified by PKCS#11.
+	SignECDSA(digest []byte) ([]byte, error)
+}
+
+// Signer represents signer based on ECDSA with SHA-512 hashing which
+// delegates signing to the PKCS#11 token. Provides neofscrypto.Signer
+// interface.
+//
+// Instances SHOULD be constructed using NewSigner.
+type Signer struct {
+	neofsecdsa.RemoteSigner
+}
+
+// NewSigner constructs Signer using the given token and public key
+// corresponding to the private key stored on it.
+func NewSigner(token Token, pub ecdsa.PublicKey) Signer {
+	return Signer{neofsecdsa.NewRemoteSigner(remoteKey{token}, neofscrypto.ECDSA_SHA512, false, pub)}
+}
+
+// SignerRFC6979 represents signer based on ECDSA with SHA-256 hashing which
+// delegates signing to the PKCS#11 token. Produces signatures of
+// neofscrypto.ECDSA_DETERMINISTIC_SHA256 scheme. Provides neofscrypto.Signer
+// interface.
+//
+// Note that the token may use random nonces, so signatures are valid but not
+// necessarily deterministic.
+//
+// Instances SHOULD be constructed using NewSignerRFC6979.
+type SignerRFC6979 struct {
+	neofsecdsa.RemoteSigner
+}
+
+// NewSignerRFC6979 constructs SignerRFC6979 using the given token and public
+// key corresponding to the private key stored on it.
+func NewSignerRFC6979(token Token, pub ecdsa.PublicKey) SignerRFC6979 {
+	return SignerRFC6979{neofsecdsa.NewRemoteSigner(remoteKey{token}, neofscrypto.ECDSA_DETERMINISTIC_SHA256, false, pub)}
+}
+
+// remoteKey is a neofsecdsa.RemoteKey stored on the PKCS#11 token.
+type remoteKey struct {
+	token Token
+}
+
+func (x remoteKey) SignDigest(digest []byte) ([]byte, error) {
+	sig, err := x.token.SignECDSA(digest)
+	if err != nil {
+		return nil, fmt.Errorf("sign on PKCS#11 token: %w", err)
+	}
+
+	return sig, nil
+}
@@ -0,0 +1,74 @@
+package neofspkcs11_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofspkcs11 "github.com/nspcc-dev/neofs-sdk-go/crypto/pkcs11"
+	"github.com/stretchr/testify/require"
+)
+
+// softToken emulates PKCS#11 token in memory.
+type softToken struct {
+	key *ecdsa.PrivateKey
+	err error
+}
+
+func (x softToken) SignECDSA(digest []byte) ([]byte, error) {
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, x.key, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	return sig, nil
+}
+
+func TestSigners(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	token := softToken{key: &k.PrivateKey}
+	data := []byte("Hello, world!")
+
+	for _, signer := range []neofscrypto.Signer{
+		neofspkcs11.NewSigner(token, k.PrivateKey.PublicKey),
+		neofspkcs11.NewSignerRFC6979(token, k.PrivateKey.PublicKey),
+	} {
+		var sig neofscrypto.Signature
+
+		require.NoError(t, sig.Calculate(signer, data))
+		require.True(t, sig.Verify(data), "type %T", signer)
+		require.False(t, sig.Verify(append(data, 1)), "type %T", signer)
+	}
+}
+
+func TestSigner_TokenFailure(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	errToken := errors.New("token removed")
+
+	_, err = neofspkcs11.NewSigner(softToken{err: errToken}, k.PrivateKey.PublicKey).Sign(nil)
+	require.ErrorIs(t, err, errToken)
+
+	_, err = neofspkcs11.NewSignerRFC6979(shortToken{}, k.PrivateKey.PublicKey).Sign(nil)
+	require.Error(t, err)
+}
+
+type shortToken struct{}
+
+func (shortToken) SignECDSA([]byte) ([]byte, error) {
+	return make([]byte, 63), nil
+}
@@ -0,0 +1,24 @@
+/*
+Package neofspolicy provides NeoFS signer refusing to sign operations outside
+the configured allow-list. This gives defense-in-depth when keys are shared
+with semi-trusted components.
+
+Signer relies on the operation descriptor attached to the context passed to
+neofscrypto.SignerContext.SignContext (see WithOperation). SDK client
+describes all requests it signs: the method of the NeoFS API and the
+container the request is addressed to (if any). Data signed without the
+context or the descriptor (e.g. by neofscrypto.Signer.Sign) is described by
+zero Operation which is denied unless MethodUnknown is explicitly allowed.
+
+	var p neofspolicy.Policy
+	p.Allow(neofspolicy.MethodObjectGet, cnr)
+	p.Allow(neofspolicy.MethodObjectHead, cnr)
+
+	signer := neofspolicy.NewSigner(userSigner, p)
+	// use signer with the SDK client
+
+Note that Signer protects from the mistakes and misconfigured components
+rather than from the malicious code having access to the signer: such code
+can attach any descriptor to the context.
+*/
+package neofspolicy
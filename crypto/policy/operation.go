@@ -0,0 +1,69 @@
+package neofspolicy
+
+import (
+	"context"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// Method is the full name of the NeoFS API method the data is signed for.
+type Method string
+
+// NeoFS API methods signed by the SDK client.
+const (
+	// MethodUnknown describes data signed outside the NeoFS API requests or
+	// without the operation descriptor.
+	MethodUnknown Method = ""
+
+	MethodAccountingBalance Method = "/neo.fs.v2.accounting.AccountingService/Balance"
+
+	MethodContainerPut               Method = "/neo.fs.v2.container.ContainerService/Put"
+	MethodContainerDelete            Method = "/neo.fs.v2.container.ContainerService/Delete"
+	MethodContainerGet               Method = "/neo.fs.v2.container.ContainerService/Get"
+	MethodContainerList              Method = "/neo.fs.v2.container.ContainerService/List"
+	MethodContainerSetExtendedACL    Method = "/neo.fs.v2.container.ContainerService/SetExtendedACL"
+	MethodContainerGetExtendedACL    Method = "/neo.fs.v2.container.ContainerService/GetExtendedACL"
+	MethodContainerAnnounceUsedSpace Method = "/neo.fs.v2.container.ContainerService/AnnounceUsedSpace"
+
+	MethodNetmapLocalNodeInfo  Method = "/neo.fs.v2.netmap.NetmapService/LocalNodeInfo"
+	MethodNetmapNetworkInfo    Method = "/neo.fs.v2.netmap.NetmapService/NetworkInfo"
+	MethodNetmapNetmapSnapshot Method = "/neo.fs.v2.netmap.NetmapService/NetmapSnapshot"
+
+	MethodObjectGet          Method = "/neo.fs.v2.object.ObjectService/Get"
+	MethodObjectPut          Method = "/neo.fs.v2.object.ObjectService/Put"
+	MethodObjectDelete       Method = "/neo.fs.v2.object.ObjectService/Delete"
+	MethodObjectHead         Method = "/neo.fs.v2.object.ObjectService/Head"
+	MethodObjectSearch       Method = "/neo.fs.v2.object.ObjectService/Search"
+	MethodObjectGetRange     Method = "/neo.fs.v2.object.ObjectService/GetRange"
+	MethodObjectGetRangeHash Method = "/neo.fs.v2.object.ObjectService/GetRangeHash"
+
+	MethodReputationAnnounceLocalTrust         Method = "/neo.fs.v2.reputation.ReputationService/AnnounceLocalTrust"
+	MethodReputationAnnounceIntermediateResult Method = "/neo.fs.v2.reputation.ReputationService/AnnounceIntermediateResult"
+
+	MethodSessionCreate Method = "/neo.fs.v2.session.SessionService/Create"
+)
+
+// Operation describes the operation the data is signed for.
+type Operation struct {
+	// Method of the NeoFS API.
+	Method Method
+	// Container the request is addressed to. Nil if the method is not
+	// container-specific or the container is not known yet (e.g. container
+	// creation).
+	Container *cid.ID
+}
+
+type operationContextKey struct{}
+
+// WithOperation returns a copy of the parent context carrying the operation
+// descriptor for the Signer.
+func WithOperation(parent context.Context, op Operation) context.Context {
+	return context.WithValue(parent, operationContextKey{}, op)
+}
+
+// OperationFromContext returns operation descriptor attached to the context
+// by WithOperation. Returns false if there is no descriptor.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	op, ok := ctx.Value(operationContextKey{}).(Operation)
+	return op, ok
+}
@@ -0,0 +1,134 @@
+package neofspolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// ErrDenied is returned by Signer when the operation is not allowed by the
+// Policy. This variable is intended to be used as documentation and for
+// [errors.Is] purposes and MUST NOT be changed.
+var ErrDenied = errors.New("operation denied by signing policy")
+
+type rule struct {
+	method     Method
+	containers []cid.ID // any if empty
+}
+
+// Policy is an allow-list of the operations. Zero Policy denies everything.
+//
+// Instances can be created using built-in var declaration.
+type Policy struct {
+	rules []rule
+}
+
+// Allow allows the method for the given containers. If no containers are
+// specified, method is allowed for any container. For container-specific
+// methods restricted by the containers, operations with unknown container
+// are denied.
+func (x *Policy) Allow(method Method, containers ...cid.ID) {
+	x.rules = append(x.rules, rule{method: method, containers: containers})
+}
+
+// Check checks whether the operation is allowed. Returns ErrDenied if not.
+func (x Policy) Check(op Operation) error {
+	for i := range x.rules {
+		if x.rules[i].method != op.Method {
+			continue
+		}
+
+		if len(x.rules[i].containers) == 0 {
+			return nil
+		}
+
+		if op.Container == nil {
+			continue
+		}
+
+		for j := range x.rules[i].containers {
+			if x.rules[i].containers[j].Equals(*op.Container) {
+				return nil
+			}
+		}
+	}
+
+	if op.Container != nil {
+		return fmt.Errorf("%w: method %q, container %s", ErrDenied, op.Method, op.Container)
+	}
+
+	return fmt.Errorf("%w: method %q", ErrDenied, op.Method)
+}
+
+// Signer wraps neofscrypto.Signer and signs only the operations allowed by
+// the Policy. Provides neofscrypto.SignerContext interface.
+//
+// Instances SHOULD be constructed using NewSigner.
+type Signer struct {
+	signer neofscrypto.Signer
+	policy Policy
+}
+
+// NewSigner constructs Signer signing operations allowed by the given Policy
+// using the underlying signer.
+func NewSigner(signer neofscrypto.Signer, policy Policy) Signer {
+	return Signer{signer: signer, policy: policy}
+}
+
+// Scheme returns scheme of the underlying signer.
+// Implements neofscrypto.Signer.
+func (x Signer) Scheme() neofscrypto.Scheme {
+	return x.signer.Scheme()
+}
+
+// Public returns public key of the underlying signer.
+// Implements neofscrypto.Signer.
+func (x Signer) Public() neofscrypto.PublicKey {
+	return x.signer.Public()
+}
+
+// Sign signs data if MethodUnknown is allowed by the Policy since there is no
+// operation descriptor.
+// Implements neofscrypto.Signer.
+func (x Signer) Sign(data []byte) ([]byte, error) {
+	return x.SignContext(context.Background(), data)
+}
+
+// SignContext signs data using the underlying signer if operation attached to
+// the context (see WithOperation) is allowed by the Policy. Returns ErrDenied
+// otherwise.
+// Implements neofscrypto.SignerContext.
+func (x Signer) SignContext(ctx context.Context, data []byte) ([]byte, error) {
+	op, _ := OperationFromContext(ctx)
+
+	if err := x.policy.Check(op); err != nil {
+		return nil, err
+	}
+
+	return neofscrypto.SignContext(ctx, x.signer, data)
+}
+
+// UserSigner is the same as Signer but wraps user.Signer. Provides
+// user.Signer and neofscrypto.SignerContext interfaces.
+//
+// Instances SHOULD be constructed using NewUserSigner.
+type UserSigner struct {
+	Signer
+	signer user.Signer
+}
+
+// NewUserSigner constructs UserSigner signing operations allowed by the given
+// Policy using the underlying user signer.
+func NewUserSigner(signer user.Signer, policy Policy) UserSigner {
+	return UserSigner{Signer: NewSigner(signer, policy), signer: signer}
+}
+
+// UserID returns user ID of the underlying signer.
+// Implements user.Signer.
+func (x UserSigner) UserID() user.ID {
+	return x.signer.UserID()
+}
@@ -0,0 +1,71 @@
+package neofspolicy_test
+
+import (
+	"context"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofspolicy "github.com/nspcc-dev/neofs-sdk-go/crypto/policy"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	cnr1 := cidtest.ID()
+	cnr2 := cidtest.ID()
+	data := []byte("Hello, world!")
+
+	base := test.RandomSignerRFC6979(t)
+
+	var p neofspolicy.Policy
+	p.Allow(neofspolicy.MethodObjectGet, cnr1)
+	p.Allow(neofspolicy.MethodNetmapNetworkInfo)
+
+	var signer user.Signer = neofspolicy.NewUserSigner(base, p)
+	require.Equal(t, base.Scheme(), signer.Scheme())
+	require.Equal(t, base.Public(), signer.Public())
+	require.Equal(t, base.UserID(), signer.UserID())
+
+	sign := func(op *neofspolicy.Operation) error {
+		ctx := context.Background()
+		if op != nil {
+			ctx = neofspolicy.WithOperation(ctx, *op)
+		}
+
+		sig, err := signer.(neofspolicy.UserSigner).SignContext(ctx, data)
+		if err == nil {
+			require.True(t, base.Public().Verify(data, sig))
+		}
+
+		return err
+	}
+
+	for _, op := range []neofspolicy.Operation{
+		{Method: neofspolicy.MethodObjectGet, Container: &cnr1},
+		{Method: neofspolicy.MethodNetmapNetworkInfo},
+		{Method: neofspolicy.MethodNetmapNetworkInfo, Container: &cnr2},
+	} {
+		require.NoError(t, sign(&op), op)
+	}
+
+	for _, op := range []neofspolicy.Operation{
+		{},
+		{Method: neofspolicy.MethodObjectGet},
+		{Method: neofspolicy.MethodObjectGet, Container: &cnr2},
+		{Method: neofspolicy.MethodObjectPut, Container: &cnr1},
+	} {
+		require.ErrorIs(t, sign(&op), neofspolicy.ErrDenied, op)
+	}
+
+	require.ErrorIs(t, sign(nil), neofspolicy.ErrDenied)
+	_, err := signer.Sign(data)
+	require.ErrorIs(t, err, neofspolicy.ErrDenied)
+
+	p.Allow(neofspolicy.MethodUnknown)
+	signer = neofspolicy.NewUserSigner(base, p)
+
+	require.NoError(t, sign(nil))
+	_, err = signer.Sign(data)
+	require.NoError(t, err)
+}
@@ -0,0 +1,23 @@
+/*
+Package neofsprotected provides NeoFS signer keeping the private key in the
+protected memory. This minimizes exposure of the key material of the
+long-running applications (e.g. gateways) in heap dumps, core files and swap.
+
+On Unix systems, key is stored in the separate memory pages allocated outside
+the Go heap which are:
+  - locked in RAM, so they are never swapped (mlock);
+  - excluded from core dumps on Linux (MADV_DONTDUMP);
+  - inaccessible between signing operations (mprotect with PROT_NONE).
+
+On other systems, key is stored in the Go heap. In all cases, the key is
+zeroed on Signer.Close.
+
+	signer, err := neofsprotected.NewSigner(neofscrypto.ECDSA_DETERMINISTIC_SHA256, key)
+	// ...
+	defer signer.Close()
+
+Note that the protection is the best effort: during signing, the key is
+processed by the standard cryptographic primitives which may leave its
+temporary copies in the Go heap.
+*/
+package neofsprotected
@@ -0,0 +1,9 @@
+package neofsprotected
+
+import "golang.org/x/sys/unix"
+
+// excludeFromDumps excludes memory region from core dumps. Errors are ignored
+// since the protection is optional and not supported by older kernels.
+func excludeFromDumps(region []byte) {
+	_ = unix.Madvise(region, unix.MADV_DONTDUMP)
+}
@@ -0,0 +1,6 @@
+//go:build !linux && (darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package neofsprotected
+
+// excludeFromDumps is no-op on systems without MADV_DONTDUMP.
+func excludeFromDumps([]byte) {}
@@ -0,0 +1,19 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly
+
+package neofsprotected
+
+// memory is a memory region in the Go heap used on systems without memory
+// locking support.
+type memory struct {
+	b []byte
+}
+
+func allocate(size int) (*memory, error) {
+	return &memory{b: make([]byte, size)}, nil
+}
+
+func (x *memory) protect() error { return nil }
+
+func (x *memory) unprotect() error { return nil }
+
+func (x *memory) free() error { return nil }
@@ -0,0 +1,47 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package neofsprotected
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// memory is a locked memory region allocated outside the Go heap.
+type memory struct {
+	region []byte // whole mapped pages
+	b      []byte // requested size
+}
+
+func allocate(size int) (*memory, error) {
+	pageSize := os.Getpagesize()
+
+	region, err := unix.Mmap(-1, 0, (size+pageSize-1)/pageSize*pageSize,
+		unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = unix.Mlock(region); err != nil {
+		_ = unix.Munmap(region)
+		return nil, err
+	}
+
+	excludeFromDumps(region)
+
+	return &memory{region: region, b: region[:size]}, nil
+}
+
+func (x *memory) protect() error {
+	return unix.Mprotect(x.region, unix.PROT_NONE)
+}
+
+func (x *memory) unprotect() error {
+	return unix.Mprotect(x.region, unix.PROT_READ|unix.PROT_WRITE)
+}
+
+func (x *memory) free() error {
+	_ = unix.Munlock(x.region)
+	return unix.Munmap(x.region)
+}
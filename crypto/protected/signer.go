@@ -0,0 +1,184 @@
+package neofsprotected
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// KeySize is the size of the binary P-256 private key.
+const KeySize = 32
+
+// ErrClosed is returned by Signer after Close. This variable is intended to
+// be used as documentation and for [errors.Is] purposes and MUST NOT be
+// changed.
+var ErrClosed = errors.New("signer is closed")
+
+// Signer represents signer of ECDSA-based schemes keeping the private key in
+// the protected memory. Provides user.Signer interface.
+//
+// Instances MUST be constructed using NewSigner and closed when no longer
+// needed.
+type Signer struct {
+	scheme neofscrypto.Scheme
+	pub    ecdsa.PublicKey
+	userID user.ID
+
+	mtx sync.Mutex
+	mem *memory // nil after Close
+}
+
+// NewSigner constructs Signer of the given scheme using binary P-256 private
+// key (big-endian scalar). Supported schemes are neofscrypto.ECDSA_SHA512,
+// neofscrypto.ECDSA_DETERMINISTIC_SHA256 and neofscrypto.ECDSA_WALLETCONNECT.
+//
+// The key is copied to the protected memory and zeroed in the given buffer.
+func NewSigner(scheme neofscrypto.Scheme, key []byte) (*Signer, error) {
+	defer wipe(key)
+
+	switch scheme {
+	default:
+		return nil, fmt.Errorf("%w: unsupported scheme %v", neofscrypto.ErrIncorrectSigner, scheme)
+	case neofscrypto.ECDSA_SHA512, neofscrypto.ECDSA_DETERMINISTIC_SHA256, neofscrypto.ECDSA_WALLETCONNECT:
+	}
+
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid key length %d, expected %d", len(key), KeySize)
+	}
+
+	curve := elliptic.P256()
+
+	var d big.Int
+	defer wipeInt(&d)
+
+	if d.SetBytes(key).Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, errors.New("invalid key: scalar out of range")
+	}
+
+	mem, err := allocate(KeySize)
+	if err != nil {
+		return nil, fmt.Errorf("allocate protected memory: %w", err)
+	}
+
+	copy(mem.b, key)
+
+	if err = mem.protect(); err != nil {
+		mem.free()
+		return nil, fmt.Errorf("protect memory: %w", err)
+	}
+
+	res := &Signer{
+		scheme: scheme,
+		mem:    mem,
+	}
+
+	res.pub.Curve = curve
+	res.pub.X, res.pub.Y = curve.ScalarBaseMult(key)
+	res.userID.SetScriptHash((*keys.PublicKey)(&res.pub).GetScriptHash())
+
+	return res, nil
+}
+
+// Scheme returns signature scheme specified in NewSigner.
+// Implements neofscrypto.Signer.
+func (x *Signer) Scheme() neofscrypto.Scheme {
+	return x.scheme
+}
+
+// Public returns public key corresponding to the scheme.
+// Implements neofscrypto.Signer.
+func (x *Signer) Public() neofscrypto.PublicKey {
+	switch x.scheme {
+	default:
+		return (*neofsecdsa.PublicKey)(&x.pub)
+	case neofscrypto.ECDSA_DETERMINISTIC_SHA256:
+		return (*neofsecdsa.PublicKeyRFC6979)(&x.pub)
+	case neofscrypto.ECDSA_WALLETCONNECT:
+		return (*neofsecdsa.PublicKeyWalletConnect)(&x.pub)
+	}
+}
+
+// UserID returns user ID corresponding to the public key.
+// Implements user.Signer.
+func (x *Signer) UserID() user.ID {
+	return x.userID
+}
+
+// Sign signs data according to the scheme. The key is accessible only during
+// signing, so concurrent calls are serialized. Returns ErrClosed after Close.
+// Implements neofscrypto.Signer.
+func (x *Signer) Sign(data []byte) ([]byte, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.mem == nil {
+		return nil, ErrClosed
+	}
+
+	if err := x.mem.unprotect(); err != nil {
+		return nil, fmt.Errorf("unprotect memory: %w", err)
+	}
+
+	var d big.Int
+	d.SetBytes(x.mem.b)
+
+	defer func() {
+		wipeInt(&d)
+		_ = x.mem.protect()
+	}()
+
+	pk := ecdsa.PrivateKey{PublicKey: x.pub, D: &d}
+
+	switch x.scheme {
+	default:
+		return neofsecdsa.Signer(pk).Sign(data)
+	case neofscrypto.ECDSA_DETERMINISTIC_SHA256:
+		return neofsecdsa.SignerRFC6979(pk).Sign(data)
+	case neofscrypto.ECDSA_WALLETCONNECT:
+		return neofsecdsa.SignerWalletConnect(pk).Sign(data)
+	}
+}
+
+// Close zeroes the key and releases the protected memory. Signer must not be
+// used after Close. Repeated calls are no-op.
+func (x *Signer) Close() error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.mem == nil {
+		return nil
+	}
+
+	err := x.mem.unprotect()
+	if err == nil {
+		wipe(x.mem.b)
+		err = x.mem.free()
+	}
+
+	x.mem = nil
+
+	return err
+}
+
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+func wipeInt(x *big.Int) {
+	w := x.Bits()
+	for i := range w {
+		w[i] = 0
+	}
+
+	x.SetInt64(0)
+}
@@ -0,0 +1,71 @@
+package neofsprotected_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/cryptotest"
+	neofsprotected "github.com/nspcc-dev/neofs-sdk-go/crypto/protected"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	for _, scheme := range []neofscrypto.Scheme{
+		neofscrypto.ECDSA_SHA512,
+		neofscrypto.ECDSA_DETERMINISTIC_SHA256,
+		neofscrypto.ECDSA_WALLETCONNECT,
+	} {
+		t.Run(scheme.String(), func(t *testing.T) {
+			cryptotest.Run(t, func(tb testing.TB) neofscrypto.Signer {
+				k, err := keys.NewPrivateKey()
+				require.NoError(tb, err)
+
+				s, err := neofsprotected.NewSigner(scheme, k.Bytes())
+				require.NoError(tb, err)
+				tb.Cleanup(func() { require.NoError(tb, s.Close()) })
+
+				return s
+			})
+		})
+	}
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	key := k.Bytes()
+
+	signer, err := neofsprotected.NewSigner(neofscrypto.ECDSA_DETERMINISTIC_SHA256, key)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, neofsprotected.KeySize), key, "key must be wiped")
+
+	var _ user.Signer = signer
+	require.Equal(t, user.NewSignerRFC6979(k.PrivateKey).UserID(), signer.UserID())
+
+	data := []byte("Hello, world!")
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+
+	exp, err := user.NewSignerRFC6979(k.PrivateKey).Sign(data)
+	require.NoError(t, err)
+	require.Equal(t, exp, sig)
+
+	require.NoError(t, signer.Close())
+	require.NoError(t, signer.Close())
+
+	_, err = signer.Sign(data)
+	require.ErrorIs(t, err, neofsprotected.ErrClosed)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := neofsprotected.NewSigner(neofscrypto.ECDSA_SHA512, make([]byte, neofsprotected.KeySize))
+		require.Error(t, err)
+
+		_, err = neofsprotected.NewSigner(neofscrypto.ECDSA_SHA512, k.Bytes()[1:])
+		require.Error(t, err)
+
+		_, err = neofsprotected.NewSigner(neofscrypto.ED25519, k.Bytes())
+		require.ErrorIs(t, err, neofscrypto.ErrIncorrectSigner)
+	})
+}
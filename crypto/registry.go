@@ -0,0 +1,101 @@
+package neofscrypto
+
+import (
+	"fmt"
+	"sync"
+)
+
+// maps Scheme to blank PublicKey constructor.
+var publicKeys = struct {
+	mtx sync.RWMutex
+	m   map[Scheme]func() PublicKey
+}{
+	m: make(map[Scheme]func() PublicKey),
+}
+
+// RegisterScheme registers a function that returns a new blank PublicKey
+// instance for the given Scheme. This is intended to be called from the init
+// function in packages that implement signature schemes.
+//
+// RegisterScheme panics if function for the given Scheme is already registered.
+//
+// RegisterScheme is safe for concurrent use, so schemes may be registered
+// lazily.
+//
+// See also UnregisterScheme, OverrideScheme.
+func RegisterScheme(scheme Scheme, f func() PublicKey) {
+	publicKeys.mtx.Lock()
+	defer publicKeys.mtx.Unlock()
+
+	if _, ok := publicKeys.m[scheme]; ok {
+		panic(fmt.Sprintf("scheme %v is already registered", scheme))
+	}
+
+	publicKeys.m[scheme] = f
+}
+
+// UnregisterScheme removes function registered for the given Scheme by
+// RegisterScheme. Signatures of the scheme are considered unsupported then
+// until the scheme is registered again. No-op if scheme is not registered.
+//
+// UnregisterScheme is intended for tests and dynamically loaded plugins.
+// Unregistering built-in schemes affects the whole process and is generally
+// dangerous.
+func UnregisterScheme(scheme Scheme) {
+	publicKeys.mtx.Lock()
+	delete(publicKeys.m, scheme)
+	publicKeys.mtx.Unlock()
+
+	resetPublicKeyCache()
+}
+
+// OverrideScheme registers function for the given Scheme like RegisterScheme
+// but replaces already registered one instead of panicking. The returned
+// function restores previous state of the registry for the Scheme and is
+// intended to be deferred or passed to testing.T.Cleanup.
+//
+// OverrideScheme is intended for tests and dynamically loaded plugins.
+func OverrideScheme(scheme Scheme, f func() PublicKey) (restore func()) {
+	publicKeys.mtx.Lock()
+	prev, had := publicKeys.m[scheme]
+	publicKeys.m[scheme] = f
+	publicKeys.mtx.Unlock()
+
+	resetPublicKeyCache()
+
+	return func() {
+		if had {
+			publicKeys.mtx.Lock()
+			publicKeys.m[scheme] = prev
+			publicKeys.mtx.Unlock()
+
+			resetPublicKeyCache()
+		} else {
+			UnregisterScheme(scheme)
+		}
+	}
+}
+
+// schemeRegistered checks whether function for the given Scheme is registered.
+func schemeRegistered(scheme Scheme) bool {
+	publicKeys.mtx.RLock()
+	_, ok := publicKeys.m[scheme]
+	publicKeys.mtx.RUnlock()
+
+	return ok
+}
+
+// NewPublicKey returns new blank PublicKey instance of the given Scheme which
+// can be decoded then. Returns an error if the scheme is not registered (see
+// RegisterScheme).
+func NewPublicKey(scheme Scheme) (PublicKey, error) {
+	publicKeys.mtx.RLock()
+	f, ok := publicKeys.m[scheme]
+	publicKeys.mtx.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unsupported scheme %v", scheme)
+	}
+
+	return f(), nil
+}
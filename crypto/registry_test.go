@@ -0,0 +1,83 @@
+package neofscrypto_test
+
+import (
+	"crypto/rand"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+// accepts signatures equal to the key.
+type testPublicKey []byte
+
+func (x testPublicKey) MaxEncodedSize() int       { return len(x) }
+func (x testPublicKey) Encode(buf []byte) int     { return copy(buf, x) }
+func (x *testPublicKey) Decode(b []byte) error    { *x = b; return nil }
+func (x testPublicKey) Verify(_, sig []byte) bool { return string(sig) == string(x) }
+func newTestPublicKey() neofscrypto.PublicKey     { return new(testPublicKey) }
+
+func testSignature(scheme neofscrypto.Scheme) neofscrypto.Signature {
+	key := testPublicKey("key")
+	var sig neofscrypto.Signature
+	_ = sig.Calculate(neofscrypto.NewStaticSigner(scheme, key, &key), nil)
+	return sig
+}
+
+func TestRegisterScheme(t *testing.T) {
+	const scheme = neofscrypto.Scheme(1000)
+
+	sig := testSignature(scheme)
+	require.False(t, sig.Verify(nil))
+
+	neofscrypto.RegisterScheme(scheme, newTestPublicKey)
+	require.True(t, sig.Verify(nil))
+	require.Panics(t, func() { neofscrypto.RegisterScheme(scheme, newTestPublicKey) })
+
+	neofscrypto.UnregisterScheme(scheme)
+	require.False(t, sig.Verify(nil))
+
+	t.Run("concurrent", func(t *testing.T) {
+		var wg sync.WaitGroup
+
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(scheme neofscrypto.Scheme) {
+				defer wg.Done()
+
+				neofscrypto.RegisterScheme(scheme, newTestPublicKey)
+				require.True(t, testSignature(scheme).Verify(nil))
+				neofscrypto.UnregisterScheme(scheme)
+			}(scheme + 1 + neofscrypto.Scheme(i))
+		}
+
+		wg.Wait()
+	})
+}
+
+func TestOverrideScheme(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	data := make([]byte, 32)
+	_, _ = rand.Read(data)
+
+	var sig neofscrypto.Signature
+	require.NoError(t, sig.Calculate(neofsecdsa.Signer(k.PrivateKey), data))
+	require.True(t, sig.Verify(data))
+
+	restore := neofscrypto.OverrideScheme(neofscrypto.ECDSA_SHA512, newTestPublicKey)
+	require.False(t, sig.Verify(data))
+	restore()
+	require.True(t, sig.Verify(data))
+
+	const scheme = neofscrypto.Scheme(2000)
+
+	restore = neofscrypto.OverrideScheme(scheme, newTestPublicKey)
+	require.True(t, testSignature(scheme).Verify(nil))
+	restore()
+	require.False(t, testSignature(scheme).Verify(nil))
+}
@@ -0,0 +1,30 @@
+/*
+Package neofsremote provides simple protocol for the remote signing and NeoFS
+signer client using it. This allows to keep private keys in one signing daemon
+which is used by many SDK-based services.
+
+The protocol works over HTTP. Client sends POST request to the SignPath of
+the daemon with JSON-encoded SignRequest body containing signature scheme,
+binary public key identifying the private key to sign with and the payload
+to be signed. On success, daemon responds with 200 OK status and
+JSON-encoded SignResponse containing the signature. Otherwise, it responds
+with the corresponding HTTP status and SignResponse containing the error
+message. Binary fields are encoded into standard base64 strings.
+
+Signers used by the daemon can be served using Handler:
+
+	http.Handle(neofsremote.SignPath, neofsremote.Handler(signer1, signer2))
+
+On client side:
+
+	signer := neofsremote.NewSigner("https://signer.example.com", neofscrypto.ECDSA_DETERMINISTIC_SHA256, pub)
+
+	var sig neofscrypto.Signature
+	err := sig.Calculate(signer, data)
+	// ...
+
+Note that the protocol does not provide authentication: it is expected to be
+provided by the transport (e.g. mutual TLS configured in http.Client, see
+Signer.SetHTTPClient) or the network isolation.
+*/
+package neofsremote
@@ -0,0 +1,23 @@
+package neofsremote
+
+// SignPath is the HTTP path of the signing endpoint.
+const SignPath = "/v1/sign"
+
+// SignRequest is the request to sign the payload.
+type SignRequest struct {
+	// Scheme is the neofscrypto.Scheme of the signature.
+	Scheme int32 `json:"scheme"`
+	// Key is the binary public key identifying the private key (see
+	// neofscrypto.PublicKey.Encode).
+	Key []byte `json:"key"`
+	// Payload is the data to be signed.
+	Payload []byte `json:"payload"`
+}
+
+// SignResponse is the response to SignRequest.
+type SignResponse struct {
+	// Signature of the payload. Set on success only.
+	Signature []byte `json:"signature,omitempty"`
+	// Error message. Set on failure only.
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,69 @@
+package neofsremote_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/cryptotest"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	neofsremote "github.com/nspcc-dev/neofs-sdk-go/crypto/remote"
+	"github.com/stretchr/testify/require"
+)
+
+func newDaemon(tb testing.TB, signers ...neofscrypto.Signer) string {
+	mux := http.NewServeMux()
+	mux.Handle(neofsremote.SignPath, neofsremote.Handler(signers...))
+
+	srv := httptest.NewServer(mux)
+	tb.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+func randomSigner(tb testing.TB) neofscrypto.Signer {
+	k, err := keys.NewPrivateKey()
+	require.NoError(tb, err)
+	return neofsecdsa.SignerRFC6979(k.PrivateKey)
+}
+
+func TestSigner(t *testing.T) {
+	cryptotest.Run(t, func(tb testing.TB) neofscrypto.Signer {
+		s := randomSigner(tb)
+		return neofsremote.NewSigner(newDaemon(tb, s), s.Scheme(), s.Public())
+	})
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	s1 := neofsecdsa.Signer(k.PrivateKey)
+	s2 := neofsecdsa.SignerRFC6979(k.PrivateKey)
+	url := newDaemon(t, s1, s2) + "/"
+
+	data := []byte("Hello, world!")
+
+	for _, s := range []neofscrypto.Signer{s1, s2} {
+		signer := neofsremote.NewSigner(url, s.Scheme(), s.Public())
+
+		var sig neofscrypto.Signature
+		require.NoError(t, sig.Calculate(signer, data))
+		require.True(t, sig.Verify(data))
+	}
+
+	t.Run("unknown key", func(t *testing.T) {
+		other := randomSigner(t)
+
+		_, err := neofsremote.NewSigner(url, other.Scheme(), other.Public()).Sign(data)
+		require.ErrorContains(t, err, "404")
+
+		_, err = neofsremote.NewSigner(url, neofscrypto.ECDSA_WALLETCONNECT, s1.Public()).Sign(data)
+		require.ErrorContains(t, err, "unknown key")
+	})
+
+	t.Run("wrong path", func(t *testing.T) {
+		_, err := neofsremote.NewSigner(url+"api", s1.Scheme(), s1.Public()).Sign(data)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,93 @@
+package neofsremote
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// maxRequestSize limits size of the SignRequest accepted by Handler.
+const maxRequestSize = 64 << 20
+
+type handler struct {
+	signers []servedSigner
+}
+
+type servedSigner struct {
+	key    []byte
+	signer neofscrypto.Signer
+}
+
+// Handler returns http.Handler serving signing requests using the given
+// signers. Request is served by the signer with the requested scheme and
+// public key. Handler should be registered at SignPath.
+func Handler(signers ...neofscrypto.Signer) http.Handler {
+	h := handler{signers: make([]servedSigner, len(signers))}
+
+	for i := range signers {
+		pub := signers[i].Public()
+		key := make([]byte, pub.MaxEncodedSize())
+
+		h.signers[i] = servedSigner{
+			key:    key[:pub.Encode(key)],
+			signer: signers[i],
+		}
+	}
+
+	return h
+}
+
+func (x handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeResponse(w, http.StatusMethodNotAllowed, SignResponse{Error: "only POST is allowed"})
+		return
+	}
+
+	var req SignRequest
+
+	err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&req)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, SignResponse{Error: fmt.Sprintf("decode request: %v", err)})
+		return
+	}
+
+	signer := x.signer(neofscrypto.Scheme(req.Scheme), req.Key)
+	if signer == nil {
+		writeResponse(w, http.StatusNotFound, SignResponse{Error: "unknown key"})
+		return
+	}
+
+	sig, err := neofscrypto.SignContext(r.Context(), signer, req.Payload)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, r.Context().Err()) {
+			status = http.StatusServiceUnavailable
+		}
+
+		writeResponse(w, status, SignResponse{Error: fmt.Sprintf("sign: %v", err)})
+		return
+	}
+
+	writeResponse(w, http.StatusOK, SignResponse{Signature: sig})
+}
+
+func (x handler) signer(scheme neofscrypto.Scheme, key []byte) neofscrypto.Signer {
+	for i := range x.signers {
+		if x.signers[i].signer.Scheme() == scheme && bytes.Equal(x.signers[i].key, key) {
+			return x.signers[i].signer
+		}
+	}
+
+	return nil
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp SignResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
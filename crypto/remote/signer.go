@@ -0,0 +1,120 @@
+package neofsremote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// maxResponseSize limits size of the daemon response.
+const maxResponseSize = 1 << 20
+
+// Signer represents neofscrypto.Signer sending signing requests to the remote
+// daemon.
+//
+// Instances SHOULD be constructed using NewSigner.
+type Signer struct {
+	cli    *http.Client
+	url    string
+	scheme neofscrypto.Scheme
+	pub    neofscrypto.PublicKey
+	key    []byte
+}
+
+// NewSigner constructs Signer sending requests to the daemon at the given
+// base URL. The daemon must have private key of the scheme corresponding to
+// the given public key. By default, http.DefaultClient is used.
+func NewSigner(baseURL string, scheme neofscrypto.Scheme, pub neofscrypto.PublicKey) *Signer {
+	key := make([]byte, pub.MaxEncodedSize())
+
+	return &Signer{
+		cli:    http.DefaultClient,
+		url:    strings.TrimSuffix(baseURL, "/") + SignPath,
+		scheme: scheme,
+		pub:    pub,
+		key:    key[:pub.Encode(key)],
+	}
+}
+
+// SetHTTPClient sets HTTP client used to send requests, e.g. with configured
+// TLS and timeouts. Must not be called concurrently with signing.
+func (x *Signer) SetHTTPClient(cli *http.Client) {
+	x.cli = cli
+}
+
+// Scheme returns signature scheme specified in NewSigner.
+// Implements neofscrypto.Signer.
+func (x *Signer) Scheme() neofscrypto.Scheme {
+	return x.scheme
+}
+
+// Public returns public key specified in NewSigner.
+// Implements neofscrypto.Signer.
+func (x *Signer) Public() neofscrypto.PublicKey {
+	return x.pub
+}
+
+// Sign sends data to the daemon and returns the signature.
+// Implements neofscrypto.Signer.
+func (x *Signer) Sign(data []byte) ([]byte, error) {
+	return x.SignContext(context.Background(), data)
+}
+
+// SignContext is the same as Sign but aborts the request when the context is
+// done.
+// Implements neofscrypto.SignerContext.
+func (x *Signer) SignContext(ctx context.Context, data []byte) ([]byte, error) {
+	body, err := json.Marshal(SignRequest{
+		Scheme:  int32(x.scheme),
+		Key:     x.key,
+		Payload: data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, x.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := x.cli.Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var res SignResponse
+
+	err = json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&res)
+	if err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if res.Error == "" {
+			res.Error = http.StatusText(resp.StatusCode)
+		}
+
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, res.Error)
+	}
+
+	if len(res.Signature) == 0 {
+		return nil, errors.New("empty signature in response")
+	}
+
+	return res.Signature, nil
+}
@@ -0,0 +1,20 @@
+/*
+Package neofsrotation provides NeoFS signer supporting rotation of the keys.
+
+Signer signs data with the current key while retaining previous keys: they are
+trusted during signature verification, so artifacts signed before rotation
+(e.g. objects or tokens) are still accepted by the application. Previous
+keys with private part can also sign during the transition period, so tokens
+can be dual-signed and accepted by the parties not yet aware of the new key:
+
+	signer := neofsrotation.NewSigner(newSigner, oldSigner)
+	signer.AddRetiredKeys(olderPublicKey)
+
+	tokens, err := signer.SignBearerTokens(token)
+	// tokens[0] is signed by newSigner, tokens[1] by oldSigner
+
+	if signer.VerifySignature(sig, data) {
+		// signed by one of the trusted keys
+	}
+*/
+package neofsrotation
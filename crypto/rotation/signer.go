@@ -0,0 +1,172 @@
+package neofsrotation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Signer is a composite signer signing data with the current key and trusting
+// the previous ones. Provides user.Signer interface which is implemented by
+// the current signer.
+//
+// Instances MUST be constructed using NewSigner. Signer is not safe for
+// concurrent modification.
+type Signer struct {
+	current  user.Signer
+	previous []user.Signer
+	retired  []neofscrypto.PublicKey
+}
+
+// NewSigner constructs Signer using the current signer and the previous ones
+// which are still able to sign during the transition period (see SignAll).
+// Previous signers are listed from the newest to the oldest.
+func NewSigner(current user.Signer, previous ...user.Signer) *Signer {
+	return &Signer{current: current, previous: previous}
+}
+
+// AddRetiredKeys adds previous public keys which are no longer able to sign
+// but are still trusted (see Trusted).
+func (x *Signer) AddRetiredKeys(keys ...neofscrypto.PublicKey) {
+	x.retired = append(x.retired, keys...)
+}
+
+// Scheme returns scheme of the current signer.
+// Implements neofscrypto.Signer.
+func (x *Signer) Scheme() neofscrypto.Scheme {
+	return x.current.Scheme()
+}
+
+// Sign signs data using the current signer.
+// Implements neofscrypto.Signer.
+func (x *Signer) Sign(data []byte) ([]byte, error) {
+	return x.current.Sign(data)
+}
+
+// Public returns public key of the current signer.
+// Implements neofscrypto.Signer.
+func (x *Signer) Public() neofscrypto.PublicKey {
+	return x.current.Public()
+}
+
+// UserID returns user ID of the current signer.
+// Implements user.Signer.
+func (x *Signer) UserID() user.ID {
+	return x.current.UserID()
+}
+
+// Signers returns current signer followed by the previous ones.
+func (x *Signer) Signers() []user.Signer {
+	return append([]user.Signer{x.current}, x.previous...)
+}
+
+// PublicKeys returns all trusted public keys: current, previous and retired
+// ones.
+func (x *Signer) PublicKeys() []neofscrypto.PublicKey {
+	res := make([]neofscrypto.PublicKey, 0, 1+len(x.previous)+len(x.retired))
+
+	res = append(res, x.current.Public())
+	for i := range x.previous {
+		res = append(res, x.previous[i].Public())
+	}
+
+	return append(res, x.retired...)
+}
+
+// Trusted checks whether the signature is made by one of the trusted keys
+// (see PublicKeys). Signature itself is not verified.
+//
+// See also VerifySignature.
+func (x *Signer) Trusted(sig neofscrypto.Signature) bool {
+	var m refs.Signature
+	sig.WriteToV2(&m)
+
+	key := m.GetKey()
+	keys := x.PublicKeys()
+
+	for i := range keys {
+		bKey := make([]byte, keys[i].MaxEncodedSize())
+		if bytes.Equal(bKey[:keys[i].Encode(bKey)], key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VerifySignature checks whether the signature is made by one of the trusted
+// keys and is a valid signature of the data.
+func (x *Signer) VerifySignature(sig neofscrypto.Signature, data []byte) bool {
+	return x.Trusted(sig) && sig.Verify(data)
+}
+
+// SignAll signs data by the current and previous signers.
+func (x *Signer) SignAll(data []byte) (neofscrypto.MultiSignature, error) {
+	var res neofscrypto.MultiSignature
+
+	for i, s := range x.Signers() {
+		if err := res.Calculate(s, data); err != nil {
+			return neofscrypto.MultiSignature{}, fmt.Errorf("signer #%d: %w", i, err)
+		}
+	}
+
+	return res, nil
+}
+
+// SignBearerTokens returns copies of the bearer token signed by the current
+// and previous signers in the same order as Signers. Issuer of each token is
+// resolved from its signing key (see bearer.Token.ResolveIssuer).
+func (x *Signer) SignBearerTokens(tok bearer.Token) ([]bearer.Token, error) {
+	signers := x.Signers()
+	res := make([]bearer.Token, len(signers))
+
+	for i := range signers {
+		res[i] = tok
+		if err := res[i].Sign(signers[i]); err != nil {
+			return nil, fmt.Errorf("signer #%d: %w", i, err)
+		}
+	}
+
+	return res, nil
+}
+
+// SignSessionObjects returns copies of the object session token signed by
+// the current and previous signers in the same order as Signers. Issuer of
+// the token should not be set (see session.Object.SetIssuer), so it is set to
+// the user ID of each signer.
+func (x *Signer) SignSessionObjects(tok session.Object) ([]session.Object, error) {
+	signers := x.Signers()
+	res := make([]session.Object, len(signers))
+
+	for i := range signers {
+		res[i] = tok
+		if err := res[i].Sign(signers[i]); err != nil {
+			return nil, fmt.Errorf("signer #%d: %w", i, err)
+		}
+	}
+
+	return res, nil
+}
+
+// SignSessionContainers returns copies of the container session token signed
+// by the current and previous signers in the same order as Signers. Issuer of
+// the token should not be set (see session.Container.SetIssuer), so it is set
+// to the user ID of each signer.
+func (x *Signer) SignSessionContainers(tok session.Container) ([]session.Container, error) {
+	signers := x.Signers()
+	res := make([]session.Container, len(signers))
+
+	for i := range signers {
+		res[i] = tok
+		if err := res[i].Sign(signers[i]); err != nil {
+			return nil, fmt.Errorf("signer #%d: %w", i, err)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,87 @@
+package neofsrotation_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsrotation "github.com/nspcc-dev/neofs-sdk-go/crypto/rotation"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	current := test.RandomSignerRFC6979(t)
+	previous := test.RandomSignerRFC6979(t)
+	retired := test.RandomSignerRFC6979(t)
+	unknown := test.RandomSignerRFC6979(t)
+
+	signer := neofsrotation.NewSigner(current, previous)
+	signer.AddRetiredKeys(retired.Public())
+
+	var _ user.Signer = signer
+	require.Equal(t, current.UserID(), signer.UserID())
+	require.Equal(t, current.Public(), signer.Public())
+	require.Equal(t, current.Scheme(), signer.Scheme())
+	require.Equal(t, []user.Signer{current, previous}, signer.Signers())
+	require.Equal(t, []neofscrypto.PublicKey{current.Public(), previous.Public(), retired.Public()}, signer.PublicKeys())
+
+	data := []byte("Hello, world!")
+
+	for _, s := range []user.Signer{signer, current, previous, retired} {
+		var sig neofscrypto.Signature
+		require.NoError(t, sig.Calculate(s, data))
+		require.True(t, signer.Trusted(sig))
+		require.True(t, signer.VerifySignature(sig, data))
+		require.False(t, signer.VerifySignature(sig, []byte("other data")))
+	}
+
+	var sig neofscrypto.Signature
+	require.NoError(t, sig.Calculate(unknown, data))
+	require.False(t, signer.Trusted(sig))
+	require.False(t, signer.VerifySignature(sig, data))
+
+	ms, err := signer.SignAll(data)
+	require.NoError(t, err)
+	require.Equal(t, 2, ms.Len())
+	require.True(t, ms.Verify(data))
+
+	t.Run("bearer", func(t *testing.T) {
+		var tok bearer.Token
+		tok.SetExp(100)
+
+		toks, err := signer.SignBearerTokens(tok)
+		require.NoError(t, err)
+		require.Len(t, toks, 2)
+
+		for i, s := range signer.Signers() {
+			require.True(t, toks[i].VerifySignature())
+			require.Equal(t, s.UserID(), toks[i].ResolveIssuer())
+		}
+	})
+
+	t.Run("session", func(t *testing.T) {
+		var obj session.Object
+		obj.SetExp(100)
+
+		objs, err := signer.SignSessionObjects(obj)
+		require.NoError(t, err)
+		require.Len(t, objs, 2)
+
+		var cnr session.Container
+		cnr.SetExp(100)
+
+		cnrs, err := signer.SignSessionContainers(cnr)
+		require.NoError(t, err)
+		require.Len(t, cnrs, 2)
+
+		for i, s := range signer.Signers() {
+			require.True(t, objs[i].VerifySignature())
+			require.Equal(t, s.UserID(), objs[i].Issuer())
+			require.True(t, cnrs[i].VerifySignature())
+			require.Equal(t, s.UserID(), cnrs[i].Issuer())
+		}
+	})
+}
@@ -0,0 +1,19 @@
+/*
+Package neofssecp256k1 collects secp256k1 primitives for NeoFS cryptography.
+
+Signer and PublicKey support deterministic ECDSA (RFC 6979) over secp256k1
+curve with SHA-256 hashing. This allows to reuse key material of the other
+blockchains (e.g. Bitcoin or Ethereum) in NeoFS. These types provide
+corresponding interfaces from neofscrypto package.
+
+Signatures are encoded as 64-byte concatenation of R and S (big-endian), S is
+always in the lower half of the curve order. Public keys are encoded in
+compressed 33-byte form, both compressed and uncompressed forms are decoded.
+
+Note that secp256k1 is not yet supported by the NeoFS API protocol, so the
+signers cannot be used for neofscrypto.Signature for now.
+
+Package import causes registration of next signature schemes via neofscrypto.RegisterScheme:
+  - neofscrypto.ECDSA_SECP256K1_SHA256
+*/
+package neofssecp256k1
@@ -0,0 +1,9 @@
+package neofssecp256k1
+
+import neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+
+func init() {
+	neofscrypto.RegisterScheme(neofscrypto.ECDSA_SECP256K1_SHA256, func() neofscrypto.PublicKey {
+		return new(PublicKey)
+	})
+}
@@ -0,0 +1,78 @@
+package neofssecp256k1
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// PublicKey is a wrapper over secp256k1.PublicKey used for NeoFS needs.
+// Provides neofscrypto.PublicKey interface.
+//
+// Instances MUST be initialized from secp256k1.PublicKey using type
+// conversion.
+type PublicKey secp256k1.PublicKey
+
+// MaxEncodedSize returns size of the compressed secp256k1 public key.
+// Implements neofscrypto.PublicKey.
+func (x PublicKey) MaxEncodedSize() int {
+	return secp256k1.PubKeyBytesLenCompressed
+}
+
+// Encode encodes public key into buf in compressed form. Returns number of
+// bytes written.
+//
+// Panics if buf length is less than MaxEncodedSize.
+//
+// See also Decode.
+func (x PublicKey) Encode(buf []byte) int {
+	if len(buf) < secp256k1.PubKeyBytesLenCompressed {
+		panic(fmt.Sprintf("too short buffer %d", len(buf)))
+	}
+
+	return copy(buf, (*secp256k1.PublicKey)(&x).SerializeCompressed())
+}
+
+// Decode decodes compressed or uncompressed binary representation of the
+// secp256k1 public key.
+//
+// See also Encode.
+func (x *PublicKey) Decode(data []byte) error {
+	pub, err := secp256k1.ParsePubKey(data)
+	if err != nil {
+		return err
+	}
+
+	*x = PublicKey(*pub)
+
+	return nil
+}
+
+// Verify verifies data signature calculated by deterministic ECDSA algorithm
+// with SHA-256 hashing.
+// Implements neofscrypto.PublicKey.
+func (x PublicKey) Verify(data, signature []byte) bool {
+	h := sha256.Sum256(data)
+	return x.VerifyDigest(h[:], signature)
+}
+
+// VerifyDigest verifies signature of the data calculated by deterministic
+// ECDSA algorithm by SHA-256 hash of the data. Signatures with S in the upper
+// half of the curve order are rejected.
+// Implements neofscrypto.DigestVerifier.
+func (x PublicKey) VerifyDigest(digest, signature []byte) bool {
+	if len(digest) != sha256.Size || len(signature) != SignatureSize {
+		return false
+	}
+
+	var r, s secp256k1.ModNScalar
+
+	if r.SetByteSlice(signature[:32]) || r.IsZero() ||
+		s.SetByteSlice(signature[32:]) || s.IsZero() || s.IsOverHalfOrder() {
+		return false
+	}
+
+	return ecdsa.NewSignature(&r, &s).Verify(digest, (*secp256k1.PublicKey)(&x))
+}
@@ -0,0 +1,47 @@
+package neofssecp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/cryptotest"
+	neofssecp256k1 "github.com/nspcc-dev/neofs-sdk-go/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	cryptotest.Run(t, func(tb testing.TB) neofscrypto.Signer {
+		k, err := secp256k1.GeneratePrivateKey()
+		require.NoError(tb, err)
+		return neofssecp256k1.Signer(*k)
+	})
+}
+
+func TestSigner_Vector(t *testing.T) {
+	// private key 1, see https://bitcointalk.org/index.php?topic=285142.40
+	var key [32]byte
+	key[31] = 1
+
+	signer := neofssecp256k1.Signer(*secp256k1.PrivKeyFromBytes(key[:]))
+	require.Equal(t, neofscrypto.ECDSA_SECP256K1_SHA256, signer.Scheme())
+
+	sig, err := signer.Sign([]byte("Satoshi Nakamoto"))
+	require.NoError(t, err)
+	require.Equal(t, "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8"+
+		"2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5", hex.EncodeToString(sig))
+
+	pub := signer.Public()
+	buf := make([]byte, pub.MaxEncodedSize())
+	require.Equal(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", hex.EncodeToString(buf[:pub.Encode(buf)]))
+
+	// uncompressed form
+	bPub, err := hex.DecodeString("0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	require.NoError(t, err)
+
+	var decoded neofssecp256k1.PublicKey
+	require.NoError(t, decoded.Decode(bPub))
+	require.True(t, decoded.Verify([]byte("Satoshi Nakamoto"), sig))
+}
@@ -0,0 +1,52 @@
+package neofssecp256k1
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// SignatureSize is the size of the secp256k1 signature in bytes.
+const SignatureSize = 64
+
+// Signer wraps secp256k1.PrivateKey and represents signer based on
+// deterministic ECDSA over secp256k1 curve with SHA-256 hashing. Provides
+// neofscrypto.Signer interface.
+//
+// Instances MUST be initialized from secp256k1.PrivateKey using type
+// conversion.
+type Signer secp256k1.PrivateKey
+
+// Scheme returns neofscrypto.ECDSA_SECP256K1_SHA256.
+// Implements neofscrypto.Signer.
+func (x Signer) Scheme() neofscrypto.Scheme {
+	return neofscrypto.ECDSA_SECP256K1_SHA256
+}
+
+// Sign signs data using deterministic ECDSA algorithm with SHA-256 hashing.
+// Implements neofscrypto.Signer.
+func (x Signer) Sign(data []byte) ([]byte, error) {
+	h := sha256.Sum256(data)
+	return x.SignDigest(h[:])
+}
+
+// SignDigest signs SHA-256 hash of the data using deterministic ECDSA
+// algorithm.
+// Implements neofscrypto.DigestSigner.
+func (x Signer) SignDigest(digest []byte) ([]byte, error) {
+	if len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid digest length %d, expected %d", len(digest), sha256.Size)
+	}
+
+	// compact signature is prefixed with the public key recovery code
+	return ecdsa.SignCompact((*secp256k1.PrivateKey)(&x), digest, true)[1:], nil
+}
+
+// Public initializes PublicKey and returns it as neofscrypto.PublicKey.
+// Implements neofscrypto.Signer.
+func (x Signer) Public() neofscrypto.PublicKey {
+	return (*PublicKey)((*secp256k1.PrivateKey)(&x).PubKey())
+}
@@ -1,6 +1,7 @@
 package neofscrypto
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -24,7 +25,9 @@ type StablyMarshallable interface {
 type Signature refs.Signature
 
 // ReadFromV2 reads Signature from the refs.Signature message. Checks if the
-// message conforms to NeoFS API V2 protocol.
+// message conforms to NeoFS API V2 protocol. Schemes registered via
+// RegisterScheme are also accepted except the ones reserved for the schemes
+// not supported by the protocol (e.g. ED25519).
 //
 // See also WriteToV2.
 func (x *Signature) ReadFromV2(m refs.Signature) error {
@@ -36,7 +39,13 @@ func (x *Signature) ReadFromV2(m refs.Signature) error {
 
 	switch m.GetScheme() {
 	default:
-		return fmt.Errorf("unsupported scheme %v", m.GetSign())
+		scheme := Scheme(m.GetScheme())
+		if !scheme.supportedByProtocol() {
+			return fmt.Errorf("scheme %v is not supported by the NeoFS API protocol", scheme)
+		}
+		if !schemeRegistered(scheme) {
+			return fmt.Errorf("unsupported scheme %v", m.GetScheme())
+		}
 	case
 		refs.ECDSA_SHA512,
 		refs.ECDSA_RFC6979_SHA256,
@@ -59,11 +68,24 @@ func (x Signature) WriteToV2(m *refs.Signature) {
 // Calculate signs data using Signer and encodes public key for subsequent
 // verification.
 //
-// Signer MUST NOT be nil.
+// Signer MUST NOT be nil. Signer's scheme MUST be supported by the NeoFS API
+// protocol, otherwise [ErrIncorrectSigner] is returned.
 //
-// See also Verify.
+// See also Verify, CalculateContext.
 func (x *Signature) Calculate(signer Signer, data []byte) error {
-	signature, err := signer.Sign(data)
+	return x.CalculateContext(context.Background(), signer, data)
+}
+
+// CalculateContext is the same as Calculate but allows to abort the signing
+// operation if signer implements SignerContext.
+//
+// See also SignContext.
+func (x *Signature) CalculateContext(ctx context.Context, signer Signer, data []byte) error {
+	if err := checkProtocolScheme(signer.Scheme()); err != nil {
+		return err
+	}
+
+	signature, err := SignContext(ctx, signer, data)
 	if err != nil {
 		return fmt.Errorf("signer %T failure: %w", signer, err)
 	}
@@ -78,9 +100,19 @@ func (x *Signature) Calculate(signer Signer, data []byte) error {
 //
 // Signer MUST NOT be nil.
 //
-// See also Verify.
+// See also Verify, CalculateMarshalledContext.
 func (x *Signature) CalculateMarshalled(signer Signer, obj StablyMarshallable) error {
+	return x.CalculateMarshalledContext(context.Background(), signer, obj)
+}
+
+// CalculateMarshalledContext is the same as CalculateMarshalled but allows to
+// abort the signing operation if signer implements SignerContext.
+func (x *Signature) CalculateMarshalledContext(ctx context.Context, signer Signer, obj StablyMarshallable) error {
 	if static, ok := signer.(*StaticSigner); ok {
+		if err := checkProtocolScheme(static.scheme); err != nil {
+			return err
+		}
+
 		x.fillSignature(signer, static.sig)
 		return nil
 	}
@@ -90,31 +122,33 @@ func (x *Signature) CalculateMarshalled(signer Signer, obj StablyMarshallable) e
 		data = obj.StableMarshal(nil)
 	}
 
-	return x.Calculate(signer, data)
+	return x.CalculateContext(ctx, signer, data)
 }
 
 // Verify verifies data signature using encoded public key. True means valid
 // signature.
 //
 // Verify fails if signature scheme is not supported (see RegisterScheme).
+// Decoded public keys are cached (see SetPublicKeyCacheSize).
 //
 // See also Calculate.
 func (x Signature) Verify(data []byte) bool {
 	m := (*refs.Signature)(&x)
 
-	f, ok := publicKeys[Scheme(m.GetScheme())]
-	if !ok {
+	key, err := decodePublicKey(Scheme(m.GetScheme()), m.GetKey())
+	if err != nil {
 		return false
 	}
 
-	key := f()
+	return key.Verify(data, m.GetSign())
+}
 
-	err := key.Decode(m.GetKey())
-	if err != nil {
-		return false
+func checkProtocolScheme(scheme Scheme) error {
+	if !scheme.supportedByProtocol() {
+		return fmt.Errorf("%w: scheme %v is not supported by the NeoFS API protocol", ErrIncorrectSigner, scheme)
 	}
 
-	return key.Verify(data, m.GetSign())
+	return nil
 }
 
 func (x *Signature) fillSignature(signer Signer, signature []byte) {
@@ -1,8 +1,8 @@
 package neofscrypto
 
 import (
+	"context"
 	"errors"
-	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 )
@@ -16,6 +16,10 @@ var ErrIncorrectSigner = errors.New("incorrect signer")
 // Scheme represents digital signature algorithm with fixed cryptographic hash function.
 //
 // Negative values are reserved and depend on context (e.g. unsupported scheme).
+// Values starting from 65536 are reserved for the schemes implemented by the
+// SDK but not yet supported by the NeoFS API protocol, so they never collide
+// with the protocol ones. Such schemes can be used for signing, but not for
+// the protocol Signature (see Signature.Calculate, Signature.ReadFromV2).
 type Scheme int32
 
 //nolint:revive
@@ -27,28 +31,37 @@ const (
 	ECDSA_WALLETCONNECT        // Wallet Connect signature scheme
 )
 
-// String implements fmt.Stringer.
-func (x Scheme) String() string {
-	return refs.SignatureScheme(x).String()
-}
+// Schemes not yet supported by the NeoFS API protocol.
+//
+//nolint:revive
+const (
+	ED25519                Scheme = 65536 // Ed25519 (RFC 8032)
+	BLS12_381              Scheme = 65537 // BLS signatures over BLS12-381 curve
+	ECDSA_SECP256K1_SHA256 Scheme = 65538 // Deterministic ECDSA over secp256k1 curve with SHA-256 hashing
+)
 
-// maps Scheme to blank PublicKey constructor.
-var publicKeys = make(map[Scheme]func() PublicKey)
+// minReservedScheme is the first Scheme value reserved for the schemes not
+// supported by the NeoFS API protocol.
+const minReservedScheme = ED25519
 
-// RegisterScheme registers a function that returns a new blank PublicKey
-// instance for the given Scheme. This is intended to be called from the init
-// function in packages that implement signature schemes.
-//
-// RegisterScheme panics if function for the given Scheme is already registered.
-//
-// Note that RegisterScheme isn't tread-safe.
-func RegisterScheme(scheme Scheme, f func() PublicKey) {
-	_, ok := publicKeys[scheme]
-	if ok {
-		panic(fmt.Sprintf("scheme %v is already registered", scheme))
+// supportedByProtocol checks whether the Scheme may be transmitted via the
+// NeoFS API protocol.
+func (x Scheme) supportedByProtocol() bool {
+	return x < minReservedScheme
+}
+
+// String implements fmt.Stringer.
+func (x Scheme) String() string {
+	switch x {
+	case ED25519:
+		return "ED25519"
+	case BLS12_381:
+		return "BLS12_381"
+	case ECDSA_SECP256K1_SHA256:
+		return "ECDSA_SECP256K1_SHA256"
 	}
 
-	publicKeys[scheme] = f
+	return refs.SignatureScheme(x).String()
 }
 
 // Signer is an interface of entities that can be used for signing operations
@@ -69,6 +82,36 @@ type Signer interface {
 	Public() PublicKey
 }
 
+// SignerContext is an optional interface of the Signer which is able to
+// abort signing operation, e.g. signers delegating signing to the external
+// devices or services. SDK functions accepting context prefer SignContext
+// over Sign when available.
+//
+// See also SignContext.
+type SignerContext interface {
+	Signer
+
+	// SignContext is the same as Sign but aborts signing when the context is
+	// done. In this case, context error MUST be returned.
+	SignContext(ctx context.Context, data []byte) ([]byte, error)
+}
+
+// SignContext signs data using SignerContext.SignContext if signer implements
+// SignerContext. Otherwise, it checks the context once and calls Signer.Sign.
+//
+// Signer MUST NOT be nil.
+func SignContext(ctx context.Context, signer Signer, data []byte) ([]byte, error) {
+	if sc, ok := signer.(SignerContext); ok {
+		return sc.SignContext(ctx, data)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return signer.Sign(data)
+}
+
 // PublicKey represents a public key using fixed signature scheme supported by
 // NeoFS.
 //
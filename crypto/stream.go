@@ -0,0 +1,109 @@
+package neofscrypto
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// SignatureWriter accumulates data to be signed.
+type SignatureWriter interface {
+	// Write appends data to be signed. Write never fails.
+	io.Writer
+
+	// Close finishes writing and returns signature of all written data.
+	// SignatureWriter MUST NOT be used after Close.
+	Close() ([]byte, error)
+}
+
+// StreamSigner is an optional interface of the Signer which is able to sign
+// data written incrementally without buffering it, e.g. by hashing the data
+// on the fly. SDK functions prefer StreamSigner for the large data when
+// available.
+//
+// See also NewSignatureWriter.
+type StreamSigner interface {
+	Signer
+
+	// NewSignatureWriter returns new SignatureWriter producing signatures
+	// equal to the Sign ones for the same data.
+	NewSignatureWriter() SignatureWriter
+}
+
+// NewSignatureWriter returns SignatureWriter of the signer. If signer does
+// not implement StreamSigner, resulting writer buffers the data and signs it
+// using Signer.Sign on Close.
+//
+// Signer MUST NOT be nil.
+func NewSignatureWriter(signer Signer) SignatureWriter {
+	if s, ok := signer.(StreamSigner); ok {
+		return s.NewSignatureWriter()
+	}
+
+	return &bufferedSignatureWriter{signer: signer}
+}
+
+type bufferedSignatureWriter struct {
+	signer Signer
+	buf    bytes.Buffer
+}
+
+func (x *bufferedSignatureWriter) Write(p []byte) (int, error) {
+	return x.buf.Write(p)
+}
+
+func (x *bufferedSignatureWriter) Close() ([]byte, error) {
+	return x.signer.Sign(x.buf.Bytes())
+}
+
+// CalculateStream is the same as Calculate but reads signed data from r until
+// EOF. StreamSigner allows to sign the data without buffering.
+//
+// See also NewSignatureWriter, CalculateStreamContext.
+func (x *Signature) CalculateStream(signer Signer, r io.Reader) error {
+	return x.CalculateStreamContext(context.Background(), signer, r)
+}
+
+// CalculateStreamContext is the same as CalculateStream but allows to abort
+// the signing operation. If signer implements SignerContext, the data is
+// buffered and signed by SignContext since such signers may depend on the
+// context (e.g. check the operation attached to it).
+func (x *Signature) CalculateStreamContext(ctx context.Context, signer Signer, r io.Reader) error {
+	if err := checkProtocolScheme(signer.Scheme()); err != nil {
+		return err
+	}
+
+	if _, ok := signer.(SignerContext); ok {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("read data: %w", err)
+		}
+
+		return x.CalculateContext(ctx, signer, data)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	w := NewSignatureWriter(signer)
+
+	_, err := io.Copy(w, r)
+	if err != nil {
+		return fmt.Errorf("read data: %w", err)
+	}
+
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	signature, err := w.Close()
+	if err != nil {
+		return fmt.Errorf("signer %T failure: %w", signer, err)
+	}
+
+	x.fillSignature(signer, signature)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package neofscrypto_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+type failReader struct{}
+
+func (failReader) Read([]byte) (int, error) {
+	return 0, errors.New("broken reader")
+}
+
+func TestSignatureWriter(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte("Hello, world!"), 1000)
+
+	for _, signer := range []neofscrypto.Signer{
+		neofsecdsa.Signer(k.PrivateKey),
+		neofsecdsa.SignerRFC6979(k.PrivateKey),
+		neofsecdsa.SignerWalletConnect(k.PrivateKey), // buffered
+	} {
+		w := neofscrypto.NewSignatureWriter(signer)
+
+		for i := 0; i < len(data); i += 100 {
+			end := i + 100
+			if end > len(data) {
+				end = len(data)
+			}
+
+			_, err := w.Write(data[i:end])
+			require.NoError(t, err)
+		}
+
+		sig, err := w.Close()
+		require.NoError(t, err)
+		require.True(t, signer.Public().Verify(data, sig), "type %T", signer)
+
+		var s neofscrypto.Signature
+		require.NoError(t, s.CalculateStream(signer, bytes.NewReader(data)))
+		require.True(t, s.Verify(data), "type %T", signer)
+
+		require.Error(t, s.CalculateStream(signer, failReader{}))
+	}
+
+	rfc6979 := neofsecdsa.SignerRFC6979(k.PrivateKey)
+	w := rfc6979.NewSignatureWriter()
+	_, _ = w.Write(data)
+	streamed, err := w.Close()
+	require.NoError(t, err)
+
+	sig, err := rfc6979.Sign(data)
+	require.NoError(t, err)
+	require.Equal(t, sig, streamed, "deterministic signatures must be equal")
+}
+
+func TestSignature_CalculateStreamContext(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	data := []byte("Hello, world!")
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var s neofscrypto.Signature
+
+	t.Run("stream signer", func(t *testing.T) {
+		signer := neofsecdsa.SignerRFC6979(k.PrivateKey)
+
+		require.ErrorIs(t, s.CalculateStreamContext(canceled, signer, bytes.NewReader(data)), context.Canceled)
+		require.NoError(t, s.CalculateStreamContext(context.Background(), signer, bytes.NewReader(data)))
+		require.True(t, s.Verify(data))
+	})
+
+	t.Run("context signer", func(t *testing.T) {
+		signer := &contextSigner{Signer: neofsecdsa.SignerRFC6979(k.PrivateKey)}
+
+		require.ErrorIs(t, s.CalculateStreamContext(canceled, signer, bytes.NewReader(data)), context.Canceled)
+		require.Equal(t, 1, signer.calls)
+		require.NoError(t, s.CalculateStreamContext(context.Background(), signer, bytes.NewReader(data)))
+		require.Equal(t, 2, signer.calls)
+		require.True(t, s.Verify(data))
+	})
+
+	t.Run("reserved scheme", func(t *testing.T) {
+		key := testPublicKey("key")
+		signer := neofscrypto.NewStaticSigner(neofscrypto.ED25519, key, &key)
+
+		require.ErrorIs(t, s.CalculateStream(signer, bytes.NewReader(data)), neofscrypto.ErrIncorrectSigner)
+	})
+}
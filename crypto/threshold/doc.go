@@ -0,0 +1,21 @@
+/*
+Package neofsthreshold provides composite NeoFS signer collecting partial
+signatures from multiple parties.
+
+Each party is represented by neofscrypto.Signer: local key, HSM, remote
+service, etc. Signer requests partial signatures from all parties
+concurrently and, once at least the threshold number of them succeeded,
+passes the results to the Combiner which builds the final signature of the
+configured scheme verifiable by the configured public key. Combination is
+scheme-specific (e.g. threshold ECDSA/Schnorr protocols or BLS threshold
+schemes), so it is provided by the application:
+
+	signer, err := neofsthreshold.NewSigner(scheme, pub, 2, combiner, party1, party2, party3)
+	// ...
+
+	var sig neofscrypto.Signature
+	err = sig.Calculate(signer, data)
+
+This allows M-of-N custody of the keys.
+*/
+package neofsthreshold
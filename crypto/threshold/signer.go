@@ -0,0 +1,125 @@
+package neofsthreshold
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+)
+
+// Combiner builds resulting signature from the partial ones.
+type Combiner interface {
+	// Combine combines partial signatures of the data into the final one.
+	// Partial signatures are ordered like parties passed into NewSigner,
+	// signatures of the failed parties are nil.
+	Combine(data []byte, partials [][]byte) ([]byte, error)
+}
+
+// CombinerFunc is an adapter to use ordinary functions as Combiner.
+type CombinerFunc func(data []byte, partials [][]byte) ([]byte, error)
+
+// Combine calls f(data, partials).
+func (f CombinerFunc) Combine(data []byte, partials [][]byte) ([]byte, error) {
+	return f(data, partials)
+}
+
+// Signer represents composite signer combining partial signatures of several
+// parties. Provides neofscrypto.Signer interface.
+//
+// Instances MUST be constructed using NewSigner.
+type Signer struct {
+	scheme    neofscrypto.Scheme
+	pub       neofscrypto.PublicKey
+	threshold int
+	combiner  Combiner
+	parties   []neofscrypto.Signer
+}
+
+// NewSigner constructs Signer producing signatures of the given scheme
+// verifiable by the given public key. Partial signatures are requested from
+// the parties, at least threshold of them are required to produce the final
+// signature using the combiner.
+//
+// Returns an error if threshold is out of [1, len(parties)] range.
+func NewSigner(scheme neofscrypto.Scheme, pub neofscrypto.PublicKey, threshold int, combiner Combiner, parties ...neofscrypto.Signer) (*Signer, error) {
+	if threshold < 1 || threshold > len(parties) {
+		return nil, fmt.Errorf("invalid threshold %d for %d parties", threshold, len(parties))
+	}
+
+	return &Signer{
+		scheme:    scheme,
+		pub:       pub,
+		threshold: threshold,
+		combiner:  combiner,
+		parties:   parties,
+	}, nil
+}
+
+// Scheme returns signature scheme specified in NewSigner.
+// Implements neofscrypto.Signer.
+func (x *Signer) Scheme() neofscrypto.Scheme {
+	return x.scheme
+}
+
+// Sign collects partial signatures of the data from all parties and combines
+// them. Returns an error if less than threshold parties succeeded.
+// Implements neofscrypto.Signer.
+func (x *Signer) Sign(data []byte) ([]byte, error) {
+	var (
+		wg       sync.WaitGroup
+		partials = make([][]byte, len(x.parties))
+		errs     = make([]error, len(x.parties))
+	)
+
+	for i := range x.parties {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			partials[i], errs[i] = x.parties[i].Sign(data)
+			if errs[i] != nil {
+				partials[i] = nil
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	var (
+		succeeded int
+		failures  []string
+	)
+
+	for i := range errs {
+		if errs[i] != nil {
+			failures = append(failures, fmt.Sprintf("party #%d: %v", i, errs[i]))
+		} else {
+			succeeded++
+		}
+	}
+
+	if succeeded < x.threshold {
+		return nil, fmt.Errorf("%w: %d of %d required partial signatures collected (%s)",
+			ErrNotEnoughSignatures, succeeded, x.threshold, strings.Join(failures, "; "))
+	}
+
+	sig, err := x.combiner.Combine(data, partials)
+	if err != nil {
+		return nil, fmt.Errorf("combine partial signatures: %w", err)
+	}
+
+	return sig, nil
+}
+
+// Public returns public key specified in NewSigner.
+// Implements neofscrypto.Signer.
+func (x *Signer) Public() neofscrypto.PublicKey {
+	return x.pub
+}
+
+// ErrNotEnoughSignatures is returned by Signer.Sign when less than threshold
+// parties produced partial signatures.
+var ErrNotEnoughSignatures = errors.New("not enough partial signatures")
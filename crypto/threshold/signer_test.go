@@ -0,0 +1,70 @@
+package neofsthreshold_test
+
+import (
+	"errors"
+	"testing"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	neofsthreshold "github.com/nspcc-dev/neofs-sdk-go/crypto/threshold"
+	"github.com/stretchr/testify/require"
+)
+
+type failSigner struct {
+	neofscrypto.Signer
+}
+
+func (failSigner) Sign([]byte) ([]byte, error) {
+	return nil, errors.New("party is offline")
+}
+
+func TestSigner(t *testing.T) {
+	parties := []neofscrypto.Signer{
+		test.RandomSignerRFC6979(t),
+		failSigner{test.RandomSignerRFC6979(t)},
+		test.RandomSignerRFC6979(t),
+	}
+
+	data := []byte("Hello, world!")
+
+	// emulates combination: concatenates signatures of the succeeded parties
+	// after checking them
+	combiner := neofsthreshold.CombinerFunc(func(d []byte, partials [][]byte) ([]byte, error) {
+		require.Equal(t, data, d)
+		require.Len(t, partials, len(parties))
+		require.Nil(t, partials[1])
+
+		var res []byte
+		for i := range partials {
+			if partials[i] != nil {
+				require.True(t, parties[i].Public().Verify(d, partials[i]))
+				res = append(res, partials[i]...)
+			}
+		}
+
+		return res, nil
+	})
+
+	pub := parties[0].Public()
+
+	_, err := neofsthreshold.NewSigner(neofscrypto.ECDSA_DETERMINISTIC_SHA256, pub, 0, combiner, parties...)
+	require.Error(t, err)
+	_, err = neofsthreshold.NewSigner(neofscrypto.ECDSA_DETERMINISTIC_SHA256, pub, 4, combiner, parties...)
+	require.Error(t, err)
+
+	signer, err := neofsthreshold.NewSigner(neofscrypto.ECDSA_DETERMINISTIC_SHA256, pub, 2, combiner, parties...)
+	require.NoError(t, err)
+	require.Equal(t, neofscrypto.ECDSA_DETERMINISTIC_SHA256, signer.Scheme())
+	require.Equal(t, pub, signer.Public())
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+	require.Len(t, sig, 128)
+
+	signer, err = neofsthreshold.NewSigner(neofscrypto.ECDSA_DETERMINISTIC_SHA256, pub, 3, combiner, parties...)
+	require.NoError(t, err)
+
+	_, err = signer.Sign(data)
+	require.ErrorIs(t, err, neofsthreshold.ErrNotEnoughSignatures)
+	require.ErrorContains(t, err, "party is offline")
+}
@@ -0,0 +1,218 @@
+package eacl
+
+import (
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// ConversionLoss enumerates access rules that can't be expressed when
+// converting between basic and extended ACL.
+type ConversionLoss uint8
+
+const (
+	_ ConversionLoss = iota
+
+	// ConversionLossSticky means that STICKY bit of the basic ACL can't be
+	// expressed in eACL.
+	ConversionLossSticky
+
+	// ConversionLossFinal means that FINAL bit of the basic ACL can't be
+	// expressed in eACL: the Table applies to extendable containers only.
+	ConversionLossFinal
+
+	// ConversionLossBearerRules means that restrictions of the bearer rules
+	// set in the basic ACL can't be expressed in eACL.
+	ConversionLossBearerRules
+
+	// ConversionLossFilters means that the Table has records with filters
+	// which can't be expressed in the basic ACL. Allowing records with filters
+	// are ignored while denying ones are applied unconditionally.
+	ConversionLossFilters
+
+	// ConversionLossPublicKeys means that the Table has records for the
+	// subjects with particular public keys which can't be expressed in the
+	// basic ACL. Such targets are ignored.
+	ConversionLossPublicKeys
+
+	// ConversionLossSystem means that the Table restricts operations for the
+	// system role that aren't controlled by the basic ACL (data replication and
+	// audit). Such restrictions are ignored.
+	ConversionLossSystem
+)
+
+// String implements fmt.Stringer.
+func (x ConversionLoss) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case ConversionLossSticky:
+		return "STICKY"
+	case ConversionLossFinal:
+		return "FINAL"
+	case ConversionLossBearerRules:
+		return "BEARER_RULES"
+	case ConversionLossFilters:
+		return "FILTERS"
+	case ConversionLossPublicKeys:
+		return "PUBLIC_KEYS"
+	case ConversionLossSystem:
+		return "SYSTEM"
+	}
+}
+
+// operations corresponding to each other in basic and extended ACL.
+var basicOps = []struct {
+	op    Operation
+	basic acl.Op
+}{
+	{OperationGet, acl.OpObjectGet},
+	{OperationHead, acl.OpObjectHead},
+	{OperationPut, acl.OpObjectPut},
+	{OperationDelete, acl.OpObjectDelete},
+	{OperationSearch, acl.OpObjectSearch},
+	{OperationRange, acl.OpObjectRange},
+	{OperationRangeHash, acl.OpObjectHash},
+}
+
+// roles corresponding to each other in basic and extended ACL. RoleSystem
+// also includes Inner Ring, but it is never allowed to the operations
+// controlled by the basic ACL for container nodes.
+var basicRoles = []struct {
+	role  Role
+	basic acl.Role
+}{
+	{RoleUser, acl.RoleOwner},
+	{RoleSystem, acl.RoleContainer},
+	{RoleOthers, acl.RoleOthers},
+}
+
+// isReplicationOp checks whether rights of the container nodes to the operation
+// can't be changed by basic ACL.
+func isReplicationOp(op acl.Op) bool {
+	var b acl.Basic
+	return b.IsOpAllowed(op, acl.RoleContainer)
+}
+
+// TableFromBasicACL returns Table for the given container denying everything
+// not allowed by the basic ACL. The Table applied to the extendable container
+// which basic ACL allows at least everything allowed by the given one (e.g.
+// acl.PublicRWExtended) results in the same access rules. Properties of the
+// basic ACL that can't be expressed are returned as the list of losses, nil
+// means that conversion is lossless.
+//
+// See also BasicACLFromTable.
+func TableFromBasicACL(cnr cid.ID, basic acl.Basic) (*Table, []ConversionLoss) {
+	var losses []ConversionLoss
+
+	if basic.Sticky() {
+		losses = append(losses, ConversionLossSticky)
+	}
+
+	if !basic.Extendable() {
+		losses = append(losses, ConversionLossFinal)
+	}
+
+	b := NewTableBuilder().ForContainer(cnr)
+	var bearerLost bool
+
+	for _, o := range basicOps {
+		var denied []Role
+
+		for _, r := range basicRoles {
+			if r.basic == acl.RoleContainer && isReplicationOp(o.basic) {
+				continue
+			}
+
+			if !basic.IsOpAllowed(o.basic, r.basic) {
+				denied = append(denied, r.role)
+			}
+		}
+
+		if len(denied) > 0 {
+			b.Deny(o.op).ForRole(denied...)
+		}
+
+		bearerLost = bearerLost || !basic.AllowedBearerRules(o.basic)
+	}
+
+	if bearerLost {
+		losses = append(losses, ConversionLossBearerRules)
+	}
+
+	t, _ := b.Build() // valid by construction
+
+	return t, losses
+}
+
+// BasicACLFromTable returns basic ACL resulting from the application of the
+// Table to the container with the given basic ACL, i.e. base with the rights
+// denied by the Table removed. STICKY, FINAL bits and bearer rules are
+// inherited from the base. Rules of the Table that can't be expressed are
+// returned as the list of losses, nil means that conversion is lossless.
+//
+// See also TableFromBasicACL.
+func BasicACLFromTable(t Table, base acl.Basic) (acl.Basic, []ConversionLoss) {
+	res := base
+	var lostFilters, lostKeys, lostSystem bool
+
+	for _, o := range basicOps {
+		for _, r := range basicRoles {
+			denied := false
+
+		loop:
+			for i := range t.records {
+				if t.records[i].operation != o.op {
+					continue
+				}
+
+				for _, tgt := range t.records[i].targets {
+					if len(tgt.BinaryKeys()) > 0 {
+						lostKeys = true
+						continue
+					}
+
+					if tgt.role != r.role {
+						continue
+					}
+
+					if len(t.records[i].filters) > 0 {
+						lostFilters = true
+
+						if t.records[i].action != ActionDeny {
+							continue loop
+						}
+					}
+
+					denied = t.records[i].action == ActionDeny
+
+					break loop
+				}
+			}
+
+			if r.basic == acl.RoleContainer && isReplicationOp(o.basic) {
+				lostSystem = lostSystem || denied
+				continue
+			}
+
+			if denied {
+				res.DisallowOp(o.basic, r.basic)
+			}
+		}
+	}
+
+	var losses []ConversionLoss
+
+	if lostFilters {
+		losses = append(losses, ConversionLossFilters)
+	}
+
+	if lostKeys {
+		losses = append(losses, ConversionLossPublicKeys)
+	}
+
+	if lostSystem {
+		losses = append(losses, ConversionLossSystem)
+	}
+
+	return res, losses
+}
@@ -0,0 +1,86 @@
+package eacl
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func requireSameBasicRights(t *testing.T, exp, act acl.Basic) {
+	for _, o := range basicOps {
+		for _, r := range []acl.Role{acl.RoleOwner, acl.RoleContainer, acl.RoleInnerRing, acl.RoleOthers} {
+			require.Equal(t, exp.IsOpAllowed(o.basic, r), act.IsOpAllowed(o.basic, r), "op %v, role %v", o.basic, r)
+		}
+	}
+}
+
+func TestTableFromBasicACL(t *testing.T) {
+	cnr := cidtest.ID()
+
+	table, losses := TableFromBasicACL(cnr, acl.PublicRWExtended)
+	require.Nil(t, losses)
+	require.Equal(t, "deny delete system\ndeny getrange system", table.EncodeToString())
+
+	table, losses = TableFromBasicACL(cnr, acl.PublicROExtended)
+	require.Equal(t, []ConversionLoss{ConversionLossBearerRules}, losses)
+	require.Equal(t, "deny put others\ndeny delete system others\ndeny getrange system", table.EncodeToString())
+
+	_, losses = TableFromBasicACL(cnr, acl.Private)
+	require.Equal(t, []ConversionLoss{ConversionLossFinal, ConversionLossBearerRules}, losses)
+
+	var sticky acl.Basic
+	sticky.MakeSticky()
+	_, losses = TableFromBasicACL(cnr, sticky)
+	require.Contains(t, losses, ConversionLossSticky)
+
+	for _, basic := range []acl.Basic{
+		acl.Private, acl.PrivateExtended,
+		acl.PublicRO, acl.PublicROExtended,
+		acl.PublicRW, acl.PublicRWExtended,
+		acl.PublicAppend, acl.PublicAppendExtended,
+	} {
+		table, _ := TableFromBasicACL(cnr, basic)
+		require.Nil(t, table.Validate())
+
+		res, losses := BasicACLFromTable(*table, acl.PublicRWExtended)
+		require.Nil(t, losses)
+		requireSameBasicRights(t, basic, res)
+
+		for _, o := range basicOps {
+			for _, r := range basicRoles {
+				action, _ := table.Evaluate(*NewRequest(o.op, r.role, nil))
+				require.Equal(t, basic.IsOpAllowed(o.basic, r.basic), action == ActionAllow, "basic %s, op %v, role %v", basic.EncodeToString(), o.op, r.role)
+			}
+		}
+	}
+}
+
+func TestBasicACLFromTable(t *testing.T) {
+	res, losses := BasicACLFromTable(Table{}, acl.PublicRWExtended)
+	require.Nil(t, losses)
+	require.Equal(t, acl.PublicRWExtended, res)
+
+	res, losses = BasicACLFromTable(*PublicReadTable(cidtest.ID()), acl.PublicRW)
+	require.Nil(t, losses)
+	require.Equal(t, acl.PublicRO.Sticky(), res.Sticky())
+	require.False(t, res.IsOpAllowed(acl.OpObjectPut, acl.RoleOthers))
+	require.False(t, res.IsOpAllowed(acl.OpObjectDelete, acl.RoleOthers))
+	require.True(t, res.IsOpAllowed(acl.OpObjectGet, acl.RoleOthers))
+	require.False(t, res.Extendable())
+
+	table := decodeTextTable(t, `allow get obj:a=b others
+deny head obj:a=b others
+deny search pubkey:0102
+deny get system
+deny delete system`)
+
+	res, losses = BasicACLFromTable(table, acl.PublicRWExtended)
+	require.Equal(t, []ConversionLoss{ConversionLossFilters, ConversionLossPublicKeys, ConversionLossSystem}, losses)
+	require.True(t, res.IsOpAllowed(acl.OpObjectGet, acl.RoleOthers))
+	require.False(t, res.IsOpAllowed(acl.OpObjectHead, acl.RoleOthers))
+	require.True(t, res.IsOpAllowed(acl.OpObjectSearch, acl.RoleOthers))
+	require.True(t, res.IsOpAllowed(acl.OpObjectGet, acl.RoleContainer))
+	require.False(t, res.IsOpAllowed(acl.OpObjectDelete, acl.RoleContainer))
+}
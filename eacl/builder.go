@@ -0,0 +1,224 @@
+package eacl
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// TableBuilder composes Table step by step. Each record is started by one of
+// the Allow* / Deny* methods, the following ForRole / ForKeys and With* calls
+// configure the last started record:
+//
+//	table, err := eacl.NewTableBuilder().
+//		ForContainer(cnr).
+//		AllowGet().ForRole(eacl.RoleOthers).WithObjectAttribute(eacl.MatchStringEqual, "Type", "public").
+//		DenyGet().ForRole(eacl.RoleOthers).
+//		Build()
+//
+// Errors are accumulated and returned by Build, so the chain must not be
+// interrupted to check them.
+//
+// Instances should be created using NewTableBuilder.
+type TableBuilder struct {
+	err error
+
+	table *Table
+	last  *Record
+}
+
+// NewTableBuilder returns TableBuilder producing Table of the current
+// version (see NewTable).
+func NewTableBuilder() *TableBuilder {
+	return &TableBuilder{
+		table: NewTable(),
+	}
+}
+
+// ForContainer binds produced Table to the given container.
+func (b *TableBuilder) ForContainer(cnr cid.ID) *TableBuilder {
+	b.table.SetCID(cnr)
+	return b
+}
+
+// Allow starts new record allowing given operation.
+func (b *TableBuilder) Allow(op Operation) *TableBuilder {
+	return b.addRecord(ActionAllow, op)
+}
+
+// Deny starts new record denying given operation.
+func (b *TableBuilder) Deny(op Operation) *TableBuilder {
+	return b.addRecord(ActionDeny, op)
+}
+
+// AllowGet starts new record allowing OperationGet.
+func (b *TableBuilder) AllowGet() *TableBuilder { return b.Allow(OperationGet) }
+
+// AllowHead starts new record allowing OperationHead.
+func (b *TableBuilder) AllowHead() *TableBuilder { return b.Allow(OperationHead) }
+
+// AllowPut starts new record allowing OperationPut.
+func (b *TableBuilder) AllowPut() *TableBuilder { return b.Allow(OperationPut) }
+
+// AllowDelete starts new record allowing OperationDelete.
+func (b *TableBuilder) AllowDelete() *TableBuilder { return b.Allow(OperationDelete) }
+
+// AllowSearch starts new record allowing OperationSearch.
+func (b *TableBuilder) AllowSearch() *TableBuilder { return b.Allow(OperationSearch) }
+
+// AllowRange starts new record allowing OperationRange.
+func (b *TableBuilder) AllowRange() *TableBuilder { return b.Allow(OperationRange) }
+
+// AllowRangeHash starts new record allowing OperationRangeHash.
+func (b *TableBuilder) AllowRangeHash() *TableBuilder { return b.Allow(OperationRangeHash) }
+
+// DenyGet starts new record denying OperationGet.
+func (b *TableBuilder) DenyGet() *TableBuilder { return b.Deny(OperationGet) }
+
+// DenyHead starts new record denying OperationHead.
+func (b *TableBuilder) DenyHead() *TableBuilder { return b.Deny(OperationHead) }
+
+// DenyPut starts new record denying OperationPut.
+func (b *TableBuilder) DenyPut() *TableBuilder { return b.Deny(OperationPut) }
+
+// DenyDelete starts new record denying OperationDelete.
+func (b *TableBuilder) DenyDelete() *TableBuilder { return b.Deny(OperationDelete) }
+
+// DenySearch starts new record denying OperationSearch.
+func (b *TableBuilder) DenySearch() *TableBuilder { return b.Deny(OperationSearch) }
+
+// DenyRange starts new record denying OperationRange.
+func (b *TableBuilder) DenyRange() *TableBuilder { return b.Deny(OperationRange) }
+
+// DenyRangeHash starts new record denying OperationRangeHash.
+func (b *TableBuilder) DenyRangeHash() *TableBuilder { return b.Deny(OperationRangeHash) }
+
+// ForRole adds targets of the given roles to the last started record.
+func (b *TableBuilder) ForRole(roles ...Role) *TableBuilder {
+	if b.lastRecord("ForRole") {
+		for i := range roles {
+			var t Target
+			t.SetRole(roles[i])
+
+			AddRecordTarget(b.last, &t)
+		}
+	}
+
+	return b
+}
+
+// ForKeys adds target of the subjects with the given binary public keys to
+// the last started record.
+func (b *TableBuilder) ForKeys(keys ...[]byte) *TableBuilder {
+	if b.lastRecord("ForKeys") {
+		var t Target
+		t.SetBinaryKeys(keys)
+
+		AddRecordTarget(b.last, &t)
+	}
+
+	return b
+}
+
+// ForECDSAKeys adds target of the subjects with the given ECDSA public keys to
+// the last started record.
+func (b *TableBuilder) ForECDSAKeys(keys ...*ecdsa.PublicKey) *TableBuilder {
+	if b.lastRecord("ForECDSAKeys") {
+		var t Target
+		SetTargetECDSAKeys(&t, keys...)
+
+		AddRecordTarget(b.last, &t)
+	}
+
+	return b
+}
+
+// ForTargets adds given targets to the last started record. Targets can be
+// created using NewTargetByRole, NewTargetByKeys and other constructors.
+func (b *TableBuilder) ForTargets(targets ...Target) *TableBuilder {
+	if b.lastRecord("ForTargets") {
+		b.last.targets = append(b.last.targets, targets...)
+	}
+
+	return b
+}
+
+// WithFilter adds generic filter to the last started record.
+func (b *TableBuilder) WithFilter(from FilterHeaderType, m Match, key, value string) *TableBuilder {
+	if b.lastRecord("WithFilter") {
+		f := Filter{
+			from:    from,
+			matcher: m,
+			value:   staticStringer(value),
+		}
+		f.key.fromString(key)
+
+		b.last.filters = append(b.last.filters, f)
+	}
+
+	return b
+}
+
+// WithObjectAttribute adds filter by object attribute (or reserved object
+// header if key is one of the well-known filter keys) to the last started
+// record.
+func (b *TableBuilder) WithObjectAttribute(m Match, key, value string) *TableBuilder {
+	return b.WithFilter(HeaderFromObject, m, key, value)
+}
+
+// WithRequestHeader adds filter by request X-header to the last started
+// record.
+func (b *TableBuilder) WithRequestHeader(m Match, key, value string) *TableBuilder {
+	return b.WithFilter(HeaderFromRequest, m, key, value)
+}
+
+// Build validates composed records and returns resulting Table. Build returns
+// the first error encountered during composition, if any. Each record MUST
+// have at least one target, every target MUST have either known role or
+// non-empty list of keys, every filter MUST have known header type, matcher
+// and non-empty key.
+//
+// TableBuilder MUST NOT be used after Build.
+func (b *TableBuilder) Build() (*Table, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	for i := range b.table.records {
+		if fs := recordFindings(b.table.records[i], i); len(fs) > 0 {
+			return nil, fmt.Errorf("invalid %s", fs[0])
+		}
+	}
+
+	return b.table, nil
+}
+
+func (b *TableBuilder) addRecord(a Action, op Operation) *TableBuilder {
+	if b.err == nil && op.ToV2() == v2acl.OperationUnknown {
+		b.err = fmt.Errorf("invalid operation %v in record #%d", op, len(b.table.records))
+	}
+
+	b.table.records = append(b.table.records, Record{
+		action:    a,
+		operation: op,
+	})
+	b.last = &b.table.records[len(b.table.records)-1]
+
+	return b
+}
+
+// lastRecord checks whether any record has been started. If not, the error
+// describing the calling method is saved.
+func (b *TableBuilder) lastRecord(method string) bool {
+	if b.last == nil {
+		if b.err == nil {
+			b.err = fmt.Errorf("%s called before any record is started", method)
+		}
+
+		return false
+	}
+
+	return true
+}
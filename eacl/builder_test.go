@@ -0,0 +1,80 @@
+package eacl
+
+import (
+	"testing"
+
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableBuilder(t *testing.T) {
+	cnr := cidtest.ID()
+	key := []byte{1, 2, 3}
+
+	table, err := NewTableBuilder().
+		ForContainer(cnr).
+		AllowGet().ForRole(RoleOthers).WithObjectAttribute(MatchStringEqual, "Type", "public").
+		DenyPut().ForKeys(key).WithRequestHeader(MatchStringNotEqual, "X", "Y").
+		Deny(OperationSearch).ForRole(RoleOthers, RoleSystem).
+		Build()
+	require.NoError(t, err)
+
+	cnrRes, ok := table.CID()
+	require.True(t, ok)
+	require.Equal(t, cnr, cnrRes)
+
+	expected := CreateTable(cnr)
+
+	r := CreateRecord(ActionAllow, OperationGet)
+	r.AddObjectAttributeFilter(MatchStringEqual, "Type", "public")
+	tgt := NewTarget()
+	tgt.SetRole(RoleOthers)
+	AddRecordTarget(r, tgt)
+	expected.AddRecord(r)
+
+	r = CreateRecord(ActionDeny, OperationPut)
+	r.AddFilter(HeaderFromRequest, MatchStringNotEqual, "X", "Y")
+	tgt = NewTarget()
+	tgt.SetBinaryKeys([][]byte{key})
+	AddRecordTarget(r, tgt)
+	expected.AddRecord(r)
+
+	r = CreateRecord(ActionDeny, OperationSearch)
+	AddFormedTarget(r, RoleOthers)
+	AddFormedTarget(r, RoleSystem)
+	expected.AddRecord(r)
+
+	require.True(t, EqualTables(*expected, *table))
+
+	t.Run("reserved filter key", func(t *testing.T) {
+		table, err := NewTableBuilder().
+			DenyHead().ForRole(RoleOthers).WithObjectAttribute(MatchStringEqual, v2acl.FilterObjectType, "TOMBSTONE").
+			Build()
+		require.NoError(t, err)
+
+		r := table.Records()[0]
+		require.Equal(t, fKeyObjType, r.Filters()[0].key.typ)
+		require.Equal(t, v2acl.FilterObjectType, r.Filters()[0].Key())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct {
+			name string
+			b    *TableBuilder
+		}{
+			{name: "no record", b: NewTableBuilder().ForRole(RoleOthers)},
+			{name: "filter without record", b: NewTableBuilder().WithObjectAttribute(MatchStringEqual, "k", "v")},
+			{name: "unknown operation", b: NewTableBuilder().Allow(OperationUnknown).ForRole(RoleOthers)},
+			{name: "no targets", b: NewTableBuilder().AllowGet()},
+			{name: "unknown role", b: NewTableBuilder().AllowGet().ForRole(RoleUnknown)},
+			{name: "empty key", b: NewTableBuilder().AllowGet().ForKeys([]byte{})},
+			{name: "unknown header type", b: NewTableBuilder().AllowGet().ForRole(RoleOthers).WithFilter(HeaderTypeUnknown, MatchStringEqual, "k", "v")},
+			{name: "unknown matcher", b: NewTableBuilder().AllowGet().ForRole(RoleOthers).WithObjectAttribute(MatchUnknown, "k", "v")},
+			{name: "empty key", b: NewTableBuilder().AllowGet().ForRole(RoleOthers).WithObjectAttribute(MatchStringEqual, "", "v")},
+		} {
+			_, err := tc.b.Build()
+			require.Error(t, err, tc.name)
+		}
+	})
+}
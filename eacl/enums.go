@@ -70,6 +70,12 @@ const (
 
 // Match is binary operation on filer name and value to check if request is matched.
 // Match is compatible with v2 acl.MatchType enum.
+//
+// Note that NeoFS API protocol doesn't define any wildcard or regular
+// expression matchers: header values are matched by storage nodes using
+// listed operations only, so pattern-based rules (e.g. by attribute prefix)
+// can't be expressed in eACL. Such rules should be replaced with the explicit
+// enumeration of the values or a dedicated attribute.
 type Match uint32
 
 const (
@@ -81,6 +87,44 @@ const (
 
 	// MatchStringNotEqual is a Match of string inequality.
 	MatchStringNotEqual
+
+	// MatchNotPresent is a Match of header absence: filter matches if there is
+	// no header with the filter key while the filter value is ignored.
+	//
+	// Note that neofs-api-go v2 message conversions (gRPC, JSON and binary
+	// decoding) don't support this matcher yet: it is kept by ToV2 methods
+	// and binary encoding only.
+	MatchNotPresent
+)
+
+// Numeric matchers. Both header and filter values are interpreted as base-10
+// integers, non-numeric values never match. Values go in the NeoFS API order.
+//
+// Note that neofs-api-go v2 message conversions (gRPC, JSON and binary
+// decoding) don't support these matchers yet: they are kept by ToV2 methods
+// and binary encoding only.
+const (
+	// MatchNumGT is a Match of "greater than" numeric relation.
+	MatchNumGT Match = iota + 4
+
+	// MatchNumGE is a Match of "greater or equal" numeric relation.
+	MatchNumGE
+
+	// MatchNumLT is a Match of "less than" numeric relation.
+	MatchNumLT
+
+	// MatchNumLE is a Match of "less or equal" numeric relation.
+	MatchNumLE
+)
+
+// v2 acl.MatchType values of the matchers defined by NeoFS API but not
+// declared in neofs-api-go.
+const (
+	v2MatchTypeNotPresent v2acl.MatchType = iota + 3
+	v2MatchTypeNumGT
+	v2MatchTypeNumGE
+	v2MatchTypeNumLT
+	v2MatchTypeNumLE
 )
 
 // FilterHeaderType indicates source of headers to make matches.
@@ -321,6 +365,16 @@ func (m Match) ToV2() v2acl.MatchType {
 		return v2acl.MatchTypeStringEqual
 	case MatchStringNotEqual:
 		return v2acl.MatchTypeStringNotEqual
+	case MatchNotPresent:
+		return v2MatchTypeNotPresent
+	case MatchNumGT:
+		return v2MatchTypeNumGT
+	case MatchNumGE:
+		return v2MatchTypeNumGE
+	case MatchNumLT:
+		return v2MatchTypeNumLT
+	case MatchNumLE:
+		return v2MatchTypeNumLE
 	default:
 		return v2acl.MatchTypeUnknown
 	}
@@ -333,6 +387,16 @@ func MatchFromV2(match v2acl.MatchType) (m Match) {
 		m = MatchStringEqual
 	case v2acl.MatchTypeStringNotEqual:
 		m = MatchStringNotEqual
+	case v2MatchTypeNotPresent:
+		m = MatchNotPresent
+	case v2MatchTypeNumGT:
+		m = MatchNumGT
+	case v2MatchTypeNumGE:
+		m = MatchNumGE
+	case v2MatchTypeNumLT:
+		m = MatchNumLT
+	case v2MatchTypeNumLE:
+		m = MatchNumLE
 	default:
 		m = MatchUnknown
 	}
@@ -345,9 +409,27 @@ func MatchFromV2(match v2acl.MatchType) (m Match) {
 // String mapping:
 //   - MatchStringEqual: STRING_EQUAL;
 //   - MatchStringNotEqual: STRING_NOT_EQUAL;
+//   - MatchNotPresent: NOT_PRESENT;
+//   - MatchNumGT: NUM_GT;
+//   - MatchNumGE: NUM_GE;
+//   - MatchNumLT: NUM_LT;
+//   - MatchNumLE: NUM_LE;
 //   - MatchUnknown, default: MATCH_TYPE_UNSPECIFIED.
 func (m Match) EncodeToString() string {
-	return m.ToV2().String()
+	switch m {
+	case MatchNotPresent:
+		return "NOT_PRESENT"
+	case MatchNumGT:
+		return "NUM_GT"
+	case MatchNumGE:
+		return "NUM_GE"
+	case MatchNumLT:
+		return "NUM_LT"
+	case MatchNumLE:
+		return "NUM_LE"
+	default:
+		return m.ToV2().String()
+	}
 }
 
 // String implements fmt.Stringer.
@@ -364,6 +446,24 @@ func (m Match) String() string {
 //
 // Returns true if s was parsed successfully.
 func (m *Match) DecodeString(s string) bool {
+	switch s {
+	case "NOT_PRESENT":
+		*m = MatchNotPresent
+		return true
+	case "NUM_GT":
+		*m = MatchNumGT
+		return true
+	case "NUM_GE":
+		*m = MatchNumGE
+		return true
+	case "NUM_LT":
+		*m = MatchNumLT
+		return true
+	case "NUM_LE":
+		*m = MatchNumLE
+		return true
+	}
+
 	var g v2acl.MatchType
 
 	ok := g.FromString(s)
@@ -37,6 +37,11 @@ var (
 		eacl.MatchUnknown:        v2acl.MatchTypeUnknown,
 		eacl.MatchStringEqual:    v2acl.MatchTypeStringEqual,
 		eacl.MatchStringNotEqual: v2acl.MatchTypeStringNotEqual,
+		eacl.MatchNotPresent:     3,
+		eacl.MatchNumGT:          4,
+		eacl.MatchNumGE:          5,
+		eacl.MatchNumLT:          6,
+		eacl.MatchNumLE:          7,
 	}
 
 	eqV2HeaderTypes = map[eacl.FilterHeaderType]v2acl.HeaderType{
@@ -91,15 +96,20 @@ func TestRole(t *testing.T) {
 
 func TestMatch(t *testing.T) {
 	t.Run("known matches", func(t *testing.T) {
-		for i := eacl.MatchUnknown; i <= eacl.MatchStringNotEqual; i++ {
+		for i := eacl.MatchUnknown; i <= eacl.MatchNotPresent; i++ {
+			require.Equal(t, eqV2Matches[i], i.ToV2())
+			require.Equal(t, eacl.MatchFromV2(i.ToV2()), i)
+		}
+
+		for i := eacl.MatchNumGT; i <= eacl.MatchNumLE; i++ {
 			require.Equal(t, eqV2Matches[i], i.ToV2())
 			require.Equal(t, eacl.MatchFromV2(i.ToV2()), i)
 		}
 	})
 
 	t.Run("unknown matches", func(t *testing.T) {
-		require.Equal(t, (eacl.MatchStringNotEqual + 1).ToV2(), v2acl.MatchTypeUnknown)
-		require.Equal(t, eacl.MatchFromV2(v2acl.MatchTypeStringNotEqual+1), eacl.MatchUnknown)
+		require.Equal(t, (eacl.MatchNumLE + 1).ToV2(), v2acl.MatchTypeUnknown)
+		require.Equal(t, eacl.MatchFromV2(8), eacl.MatchUnknown)
 	})
 }
 
@@ -197,6 +207,11 @@ func TestMatch_String(t *testing.T) {
 	testEnumStrings(t, new(eacl.Match), []enumStringItem{
 		{val: toPtr(eacl.MatchStringEqual), str: "STRING_EQUAL"},
 		{val: toPtr(eacl.MatchStringNotEqual), str: "STRING_NOT_EQUAL"},
+		{val: toPtr(eacl.MatchNotPresent), str: "NOT_PRESENT"},
+		{val: toPtr(eacl.MatchNumGT), str: "NUM_GT"},
+		{val: toPtr(eacl.MatchNumGE), str: "NUM_GE"},
+		{val: toPtr(eacl.MatchNumLT), str: "NUM_LT"},
+		{val: toPtr(eacl.MatchNumLE), str: "NUM_LE"},
 		{val: toPtr(eacl.MatchUnknown), str: "MATCH_TYPE_UNSPECIFIED"},
 	})
 }
@@ -0,0 +1,37 @@
+package eacl
+
+import (
+	"fmt"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+)
+
+// Deny objects reading to anyone except the container owner unless the
+// request carries the particular X-header.
+func ExampleRecord_AddFilterRequestHeader() {
+	allow := CreateRecord(ActionAllow, OperationGet)
+	allow.AddFilterRequestHeader("X-Access-Level", MatchStringEqual, "public")
+	AddFormedTarget(allow, RoleOthers)
+
+	deny := CreateRecord(ActionDeny, OperationGet)
+	AddFormedTarget(deny, RoleOthers)
+
+	table := CreateTable(cidtest.ID())
+	table.AddRecord(allow)
+	table.AddRecord(deny)
+
+	f := table.Records()[0].Filters()[0]
+	fmt.Println(f.From(), f.Key(), f.Matcher(), f.Value())
+	// Output: REQUEST X-Access-Level STRING_EQUAL public
+}
+
+// Filters can be constructed separately and then passed to the record.
+func ExampleNewFilterRequestHeader() {
+	f := NewFilterRequestHeader("X-Access-Level", MatchStringNotEqual, "private")
+
+	var r Record
+	r.AddFilter(f.From(), f.Matcher(), f.Key(), f.Value())
+
+	fmt.Println(r.Filters()[0].From(), r.Filters()[0].Key())
+	// Output: REQUEST X-Access-Level
+}
@@ -57,11 +57,21 @@ func (f Filter) Value() string {
 	return f.value.EncodeToString()
 }
 
+// SetValue sets filtered string value.
+func (f *Filter) SetValue(value string) {
+	f.value = staticStringer(value)
+}
+
 // Matcher returns filter Match type.
 func (f Filter) Matcher() Match {
 	return f.matcher
 }
 
+// SetMatcher sets filter Match type.
+func (f *Filter) SetMatcher(m Match) {
+	f.matcher = m
+}
+
 // Key returns key to the filtered header.
 func (f Filter) Key() string {
 	return f.key.String()
@@ -150,6 +160,21 @@ func NewFilter() *Filter {
 	return NewFilterFromV2(new(v2acl.HeaderFilter))
 }
 
+// NewFilterRequestHeader creates and returns Filter by request X-header with
+// the given key, matcher and value.
+//
+// See also Record.AddFilterRequestHeader.
+func NewFilterRequestHeader(key string, m Match, value string) Filter {
+	f := Filter{
+		from:    HeaderFromRequest,
+		matcher: m,
+		value:   staticStringer(value),
+	}
+	f.key.str = key
+
+	return f
+}
+
 // NewFilterFromV2 converts v2 acl.EACLRecord.Filter message to Filter.
 func NewFilterFromV2(filter *v2acl.HeaderFilter) *Filter {
 	f := new(Filter)
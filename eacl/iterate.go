@@ -0,0 +1,116 @@
+package eacl
+
+// NumRecords returns number of the Table records.
+func (t Table) NumRecords() int {
+	return len(t.records)
+}
+
+// IterateRecords passes all records of the Table into f in the order of their
+// evaluation. Records are passed by pointer to the Table storage without
+// copying, so f can modify them in place. Breaks on f's false return, f MUST
+// NOT be nil. f MUST NOT add or remove the Table records.
+//
+// See also Records, RemoveRecords.
+func (t *Table) IterateRecords(f func(*Record) bool) {
+	for i := range t.records {
+		if !f(&t.records[i]) {
+			return
+		}
+	}
+}
+
+// RemoveRecords removes all records of the Table for which f returns true
+// preserving order of the remaining ones. Removal is done in place without
+// reallocation. f MUST NOT be nil.
+func (t *Table) RemoveRecords(f func(Record) bool) {
+	n := 0
+
+	for i := range t.records {
+		if !f(t.records[i]) {
+			t.records[n] = t.records[i]
+			n++
+		}
+	}
+
+	for i := n; i < len(t.records); i++ {
+		t.records[i] = Record{} // release references
+	}
+
+	t.records = t.records[:n]
+}
+
+// NumFilters returns number of the Record filters.
+func (r Record) NumFilters() int {
+	return len(r.filters)
+}
+
+// IterateFilters passes all filters of the Record into f. Filters are passed
+// by pointer to the Record storage without copying, so f can modify them in
+// place. Breaks on f's false return, f MUST NOT be nil. f MUST NOT add or
+// remove the Record filters.
+//
+// See also Filters, RemoveFilters.
+func (r *Record) IterateFilters(f func(*Filter) bool) {
+	for i := range r.filters {
+		if !f(&r.filters[i]) {
+			return
+		}
+	}
+}
+
+// RemoveFilters removes all filters of the Record for which f returns true
+// preserving order of the remaining ones. f MUST NOT be nil.
+func (r *Record) RemoveFilters(f func(Filter) bool) {
+	n := 0
+
+	for i := range r.filters {
+		if !f(r.filters[i]) {
+			r.filters[n] = r.filters[i]
+			n++
+		}
+	}
+
+	for i := n; i < len(r.filters); i++ {
+		r.filters[i] = Filter{} // release references
+	}
+
+	r.filters = r.filters[:n]
+}
+
+// NumTargets returns number of the Record targets.
+func (r Record) NumTargets() int {
+	return len(r.targets)
+}
+
+// IterateTargets passes all targets of the Record into f. Targets are passed
+// by pointer to the Record storage without copying, so f can modify them in
+// place. Breaks on f's false return, f MUST NOT be nil. f MUST NOT add or
+// remove the Record targets.
+//
+// See also Targets, RemoveTargets.
+func (r *Record) IterateTargets(f func(*Target) bool) {
+	for i := range r.targets {
+		if !f(&r.targets[i]) {
+			return
+		}
+	}
+}
+
+// RemoveTargets removes all targets of the Record for which f returns true
+// preserving order of the remaining ones. f MUST NOT be nil.
+func (r *Record) RemoveTargets(f func(Target) bool) {
+	n := 0
+
+	for i := range r.targets {
+		if !f(r.targets[i]) {
+			r.targets[n] = r.targets[i]
+			n++
+		}
+	}
+
+	for i := n; i < len(r.targets); i++ {
+		r.targets[i] = Target{} // release references
+	}
+
+	r.targets = r.targets[:n]
+}
@@ -0,0 +1,53 @@
+package eacl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_IterateRecords(t *testing.T) {
+	table := decodeTextTable(t, `allow get obj:a=1 obj:b=2 others
+deny put others user
+deny delete others`)
+	require.Equal(t, 3, table.NumRecords())
+
+	var ops []Operation
+	table.IterateRecords(func(r *Record) bool {
+		ops = append(ops, r.Operation())
+		return r.Operation() != OperationPut
+	})
+	require.Equal(t, []Operation{OperationGet, OperationPut}, ops)
+
+	table.IterateRecords(func(r *Record) bool {
+		r.SetAction(ActionDeny)
+
+		r.IterateFilters(func(f *Filter) bool {
+			f.SetMatcher(MatchStringNotEqual)
+			f.SetValue(f.Value() + "0")
+			return true
+		})
+
+		r.IterateTargets(func(t *Target) bool {
+			t.SetRole(RoleSystem)
+			return false
+		})
+
+		return true
+	})
+	require.Equal(t, `deny get obj:a!=10 obj:b!=20 system
+deny put system user
+deny delete system`, table.EncodeToString())
+
+	table.Records()[0].RemoveFilters(func(f Filter) bool { return f.Key() == "a" })
+	table.Records()[1].RemoveTargets(func(t Target) bool { return t.Role() == RoleSystem })
+	require.Equal(t, 1, table.Records()[0].NumFilters())
+	require.Equal(t, 1, table.Records()[1].NumTargets())
+
+	table.RemoveRecords(func(r Record) bool { return r.Operation() == OperationDelete })
+	require.Equal(t, `deny get obj:b!=20 system
+deny put user`, table.EncodeToString())
+
+	table.RemoveRecords(func(Record) bool { return true })
+	require.Zero(t, table.NumRecords())
+}
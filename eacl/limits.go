@@ -0,0 +1,91 @@
+package eacl
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+)
+
+// MaxTableSize is the maximum size of the Table binary form in bytes checked
+// by Table.CheckLimits. The Table is stored in the NeoFS Container contract,
+// so it is submitted within single Neo transaction which size is limited by
+// the Neo N3 protocol (see [transaction.MaxTransactionSize]). Actual limit is
+// lower since the transaction also carries signature, session token and
+// its own fields.
+const MaxTableSize = transaction.MaxTransactionSize
+
+// LimitType is an enumeration of the limits checked by Table.CheckLimits.
+type LimitType uint8
+
+const (
+	_ LimitType = iota
+
+	// LimitTableSize is a LimitType of MaxTableSize.
+	LimitTableSize
+)
+
+// String implements fmt.Stringer.
+func (x LimitType) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case LimitTableSize:
+		return "TABLE_SIZE"
+	}
+}
+
+// ErrLimitExceeded is returned by Table.CheckLimits when the Table exceeds
+// some limit. Use [errors.As] to get the details.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrLimitExceeded LimitExceededError
+
+// LimitExceededError describes exceeded limit of the Table.
+type LimitExceededError struct {
+	typ LimitType
+
+	limit, actual int
+}
+
+// Type returns type of the exceeded limit.
+func (e LimitExceededError) Type() LimitType {
+	return e.typ
+}
+
+// Limit returns value of the exceeded limit.
+func (e LimitExceededError) Limit() int {
+	return e.limit
+}
+
+// Actual returns actual value exceeding the limit.
+func (e LimitExceededError) Actual() int {
+	return e.actual
+}
+
+// Error implements the error interface.
+func (e LimitExceededError) Error() string {
+	return fmt.Sprintf("limit %s exceeded: %d > %d", e.typ, e.actual, e.limit)
+}
+
+// Is implements interface for correct checking current error type with [errors.Is].
+func (e LimitExceededError) Is(target error) bool {
+	switch target.(type) {
+	default:
+		return false
+	case LimitExceededError, *LimitExceededError:
+		return true
+	}
+}
+
+// CheckLimits checks whether the Table conforms to the limits (see
+// MaxTableSize). CheckLimits returns LimitExceededError describing the first
+// exceeded limit, if any. Tables passing the check may still be rejected by
+// the NeoFS network.
+func (t Table) CheckLimits() error {
+	if n := t.ToV2().StableSize(); n > MaxTableSize {
+		return LimitExceededError{typ: LimitTableSize, limit: MaxTableSize, actual: n}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package eacl
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_CheckLimits(t *testing.T) {
+	r := CreateRecord(ActionDeny, OperationGet)
+	AddFormedTarget(r, RoleOthers)
+	r.AddObjectAttributeFilter(MatchStringEqual, "k", strings.Repeat("v", 1000))
+
+	n := MaxTableSize / len(r.ToV2().StableMarshal(nil))
+
+	tb := CreateTable(cidtest.ID())
+	for i := 0; i < n-1; i++ {
+		tb.AddRecord(r)
+	}
+
+	require.NoError(t, tb.CheckLimits())
+
+	tb.AddRecord(r)
+	tb.AddRecord(r)
+
+	err := tb.CheckLimits()
+	require.ErrorIs(t, err, ErrLimitExceeded)
+
+	var e LimitExceededError
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, LimitTableSize, e.Type())
+	require.Equal(t, MaxTableSize, e.Limit())
+	require.Greater(t, e.Actual(), e.Limit())
+	require.Equal(t, "UNKNOWN", LimitType(0).String())
+}
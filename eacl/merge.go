@@ -0,0 +1,260 @@
+package eacl
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MergeStrategy defines how MergeTables resolves conflicting records, i.e.
+// records of both tables with the same operation, targets and filters
+// (matching the same requests) but different actions.
+type MergeStrategy uint8
+
+const (
+	// MergeFail makes MergeTables return ErrMergeConflict on conflict.
+	MergeFail MergeStrategy = iota
+
+	// MergePreferFirst keeps the record of the first table and drops the
+	// conflicting record of the second one.
+	MergePreferFirst
+
+	// MergePreferSecond replaces the record of the first table with the
+	// conflicting record of the second one in place.
+	MergePreferSecond
+)
+
+// ErrMergeConflict is returned by MergeTables with MergeFail strategy when
+// tables have conflicting records.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrMergeConflict = errors.New("conflicting records")
+
+// sameSubject checks whether records match the same requests regardless of
+// their actions.
+func sameSubject(r1, r2 Record) bool {
+	r1.action = r2.action
+	return equalRecords(r1, r2)
+}
+
+// MergeTables merges records of two tables. Resulting table contains all
+// records of the first table followed by the records of the second one
+// missing in the first table, so the first table has priority at the
+// evaluation. Conflicting records are resolved according to the strategy.
+// Merge is deterministic: the result depends only on the tables and the
+// strategy.
+//
+// Tables must be bound to the same container if both are bound. The result
+// has container and version of the first table (container of the second one
+// if first is unbound).
+func MergeTables(t1, t2 Table, s MergeStrategy) (*Table, error) {
+	cnr1, ok1 := t1.CID()
+	cnr2, ok2 := t2.CID()
+
+	if ok1 && ok2 && cnr1 != cnr2 {
+		return nil, fmt.Errorf("tables are bound to different containers %s and %s", cnr1, cnr2)
+	}
+
+	res := NewTable()
+	res.SetVersion(t1.Version())
+
+	if ok1 {
+		res.SetCID(cnr1)
+	} else if ok2 {
+		res.SetCID(cnr2)
+	}
+
+	res.records = make([]Record, len(t1.records), len(t1.records)+len(t2.records))
+	copy(res.records, t1.records)
+
+nextRecord:
+	for i := range t2.records {
+		for j := 0; j < len(t1.records); j++ {
+			if !sameSubject(t1.records[j], t2.records[i]) {
+				continue
+			}
+
+			if t1.records[j].action == t2.records[i].action {
+				continue nextRecord
+			}
+
+			switch s {
+			case MergePreferFirst:
+			case MergePreferSecond:
+				res.records[j] = t2.records[i]
+			default:
+				return nil, fmt.Errorf("%w: #%d of the first table and #%d of the second one", ErrMergeConflict, j, i)
+			}
+
+			continue nextRecord
+		}
+
+		res.records = append(res.records, t2.records[i])
+	}
+
+	return res, nil
+}
+
+// RecordDiffType is an enumeration of the record changes.
+type RecordDiffType uint8
+
+const (
+	_ RecordDiffType = iota
+
+	// RecordAdded is a RecordDiffType of the record added to the table.
+	RecordAdded
+
+	// RecordRemoved is a RecordDiffType of the record removed from the table.
+	RecordRemoved
+
+	// RecordChanged is a RecordDiffType of the record replaced with another
+	// one at the same position.
+	RecordChanged
+)
+
+// String implements fmt.Stringer.
+func (x RecordDiffType) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case RecordAdded:
+		return "ADDED"
+	case RecordRemoved:
+		return "REMOVED"
+	case RecordChanged:
+		return "CHANGED"
+	}
+}
+
+// RecordDiff describes single change of the Table records.
+//
+// See also DiffTables.
+type RecordDiff struct {
+	typ RecordDiffType
+
+	oldIndex, newIndex int
+
+	oldRecord, newRecord Record
+}
+
+// Type returns type of the change.
+func (x RecordDiff) Type() RecordDiffType {
+	return x.typ
+}
+
+// OldIndex returns index of the removed or changed record in the original
+// table. Returns -1 for RecordAdded.
+func (x RecordDiff) OldIndex() int {
+	return x.oldIndex
+}
+
+// NewIndex returns index of the added or changed record in the resulting
+// table. Returns -1 for RecordRemoved.
+func (x RecordDiff) NewIndex() int {
+	return x.newIndex
+}
+
+// OldRecord returns removed or changed record of the original table. Makes
+// sense for RecordRemoved and RecordChanged only.
+func (x RecordDiff) OldRecord() Record {
+	return x.oldRecord
+}
+
+// NewRecord returns added record or the replacement of the changed one. Makes
+// sense for RecordAdded and RecordChanged only.
+func (x RecordDiff) NewRecord() Record {
+	return x.newRecord
+}
+
+// String returns unified-diff-like text representation of the change using
+// text form of the records (see Record.EncodeToString).
+func (x RecordDiff) String() string {
+	switch x.typ {
+	default:
+		return ""
+	case RecordAdded:
+		return fmt.Sprintf("+ #%d %s", x.newIndex, x.newRecord.EncodeToString())
+	case RecordRemoved:
+		return fmt.Sprintf("- #%d %s", x.oldIndex, x.oldRecord.EncodeToString())
+	case RecordChanged:
+		return fmt.Sprintf("~ #%d %s -> #%d %s",
+			x.oldIndex, x.oldRecord.EncodeToString(), x.newIndex, x.newRecord.EncodeToString())
+	}
+}
+
+// DiffTables computes changes of the records turning the first table into
+// the second one. Records are compared by value, and the minimal list of
+// changes is built based on the longest common subsequence of the records.
+// Removed and added records between the same common ones are paired into
+// RecordChanged in order. Changes are returned in the order of the record
+// positions. Container and version are not compared.
+//
+// Returns nil if tables have equal records.
+func DiffTables(from, to Table) []RecordDiff {
+	rs1, rs2 := from.records, to.records
+	n, m := len(rs1), len(rs2)
+
+	// lcs[i][j] is the LCS length of rs1[i:] and rs2[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if equalRecords(rs1[i], rs2[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var res []RecordDiff
+	var removed, added []int
+
+	flush := func() {
+		k := 0
+		for ; k < len(removed) && k < len(added); k++ {
+			res = append(res, RecordDiff{
+				typ:       RecordChanged,
+				oldIndex:  removed[k],
+				newIndex:  added[k],
+				oldRecord: rs1[removed[k]],
+				newRecord: rs2[added[k]],
+			})
+		}
+
+		for _, i := range removed[k:] {
+			res = append(res, RecordDiff{typ: RecordRemoved, oldIndex: i, newIndex: -1, oldRecord: rs1[i]})
+		}
+
+		for _, j := range added[k:] {
+			res = append(res, RecordDiff{typ: RecordAdded, oldIndex: -1, newIndex: j, newRecord: rs2[j]})
+		}
+
+		removed, added = removed[:0], added[:0]
+	}
+
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && equalRecords(rs1[i], rs2[j]):
+			flush()
+			i++
+			j++
+		case j == m || (i < n && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, i)
+			i++
+		default:
+			added = append(added, j)
+			j++
+		}
+	}
+
+	flush()
+
+	return res
+}
@@ -0,0 +1,87 @@
+package eacl
+
+import (
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeTextTable(t testing.TB, s string) Table {
+	var table Table
+	require.NoError(t, table.DecodeString(s))
+	return table
+}
+
+func TestMergeTables(t *testing.T) {
+	t1 := decodeTextTable(t, `allow get obj:a=1 others
+deny put others`)
+	t2 := decodeTextTable(t, `deny get obj:a=1 others
+deny put others
+deny delete others`)
+
+	_, err := MergeTables(t1, t2, MergeFail)
+	require.ErrorIs(t, err, ErrMergeConflict)
+
+	res, err := MergeTables(t1, t2, MergePreferFirst)
+	require.NoError(t, err)
+	require.Equal(t, "allow get obj:a=1 others\ndeny put others\ndeny delete others", res.EncodeToString())
+
+	res, err = MergeTables(t1, t2, MergePreferSecond)
+	require.NoError(t, err)
+	require.Equal(t, "deny get obj:a=1 others\ndeny put others\ndeny delete others", res.EncodeToString())
+
+	res, err = MergeTables(t1, decodeTextTable(t, "deny put others"), MergeFail)
+	require.NoError(t, err)
+	require.Equal(t, t1.EncodeToString(), res.EncodeToString())
+
+	t.Run("containers", func(t *testing.T) {
+		cnr := cidtest.ID()
+
+		t1.SetCID(cnr)
+		res, err := MergeTables(Table{}, t1, MergeFail)
+		require.NoError(t, err)
+		cnrRes, ok := res.CID()
+		require.True(t, ok)
+		require.Equal(t, cnr, cnrRes)
+
+		t2.SetCID(cidtest.ID())
+		_, err = MergeTables(t1, t2, MergePreferFirst)
+		require.Error(t, err)
+	})
+}
+
+func TestDiffTables(t *testing.T) {
+	from := decodeTextTable(t, `allow get others
+deny put others
+deny delete others
+allow head others`)
+	to := decodeTextTable(t, `allow search others
+allow get others
+allow put others
+allow head others
+deny head others`)
+
+	require.Nil(t, DiffTables(from, from))
+
+	var lines []string
+	for _, d := range DiffTables(from, to) {
+		lines = append(lines, d.String())
+	}
+
+	require.Equal(t, []string{
+		"+ #0 allow search others",
+		"~ #1 deny put others -> #2 allow put others",
+		"- #2 deny delete others",
+		"+ #4 deny head others",
+	}, lines)
+
+	diff := DiffTables(to, Table{})
+	require.Len(t, diff, 5)
+	for i := range diff {
+		require.Equal(t, RecordRemoved, diff[i].Type())
+		require.Equal(t, i, diff[i].OldIndex())
+		require.Equal(t, -1, diff[i].NewIndex())
+		require.True(t, equalRecords(to.Records()[i], diff[i].OldRecord()))
+	}
+}
@@ -0,0 +1,168 @@
+package eacl
+
+import (
+	"bytes"
+	"fmt"
+
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// MigrationFixType is an enumeration of the legacy Table properties fixed by
+// Table.Migrate.
+type MigrationFixType uint8
+
+const (
+	_ MigrationFixType = iota
+
+	// MigrationFixMissingVersion is a MigrationFixType of the Table without
+	// format version which is set to version.Current().
+	MigrationFixMissingVersion
+
+	// MigrationFixOutdatedVersion is a MigrationFixType of the Table with
+	// format version older than version.Current(). The format is backward
+	// compatible, so the version is raised without other changes.
+	MigrationFixOutdatedVersion
+
+	// MigrationFixKeyForm is a MigrationFixType of the target public key in
+	// uncompressed or hybrid form which is converted to the compressed one used
+	// by NeoFS to identify request senders.
+	MigrationFixKeyForm
+
+	// MigrationFixKeyTargetRole is a MigrationFixType of the target having
+	// both public keys and role. Role of such targets is ignored by the
+	// evaluation, so it is reset to RoleUnknown.
+	MigrationFixKeyTargetRole
+)
+
+// String implements fmt.Stringer.
+func (x MigrationFixType) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case MigrationFixMissingVersion:
+		return "MISSING_VERSION"
+	case MigrationFixOutdatedVersion:
+		return "OUTDATED_VERSION"
+	case MigrationFixKeyForm:
+		return "KEY_FORM"
+	case MigrationFixKeyTargetRole:
+		return "KEY_TARGET_ROLE"
+	}
+}
+
+// MigrationFix describes single change made by Table.Migrate.
+type MigrationFix struct {
+	typ MigrationFixType
+
+	record, target int
+
+	desc string
+}
+
+// Type returns type of the fix.
+func (x MigrationFix) Type() MigrationFixType {
+	return x.typ
+}
+
+// Record returns index of the fixed record in the Table. Returns -1 for the
+// fixes of the whole Table.
+func (x MigrationFix) Record() int {
+	return x.record
+}
+
+// Target returns index of the fixed target in the record. Returns -1 for the
+// fixes of the whole Table.
+func (x MigrationFix) Target() int {
+	return x.target
+}
+
+// String returns human-readable description of the fix suitable for logging.
+func (x MigrationFix) String() string {
+	if x.record < 0 {
+		return x.desc
+	}
+
+	return fmt.Sprintf("record #%d: target #%d: %s", x.record, x.target, x.desc)
+}
+
+// Migrate upgrades the Table created by older SDK or NeoFS API versions to
+// the current form without changing its access rules. Migrate returns list of
+// the changes made in the order they were applied, nil means that the Table
+// is already up-to-date. The result is intended for logging. Invalid public
+// keys are left as is, see also Validate.
+//
+// Note that the changes modify the Table binary form, so the Table MUST be
+// signed again before submission to the NeoFS network.
+func (t *Table) Migrate() []MigrationFix {
+	var res []MigrationFix
+
+	cur := version.Current()
+
+	switch ver := t.version; {
+	case ver.Major() == 0 && ver.Minor() == 0:
+		res = append(res, MigrationFix{
+			typ:    MigrationFixMissingVersion,
+			record: -1,
+			target: -1,
+			desc:   fmt.Sprintf("missing version set to %s", cur),
+		})
+	case ver.Major() < cur.Major() || ver.Major() == cur.Major() && ver.Minor() < cur.Minor():
+		res = append(res, MigrationFix{
+			typ:    MigrationFixOutdatedVersion,
+			record: -1,
+			target: -1,
+			desc:   fmt.Sprintf("version %s raised to %s", ver, cur),
+		})
+	default:
+		cur = ver
+	}
+
+	t.version = cur
+
+	for i := range t.records {
+		for j := range t.records[i].targets {
+			tgt := &t.records[i].targets[j]
+			if len(tgt.keys) == 0 {
+				continue
+			}
+
+			if tgt.role != RoleUnknown {
+				res = append(res, MigrationFix{
+					typ:    MigrationFixKeyTargetRole,
+					record: i,
+					target: j,
+					desc:   fmt.Sprintf("role %s of the target by keys reset", tgt.role),
+				})
+
+				tgt.role = RoleUnknown
+			}
+
+			copied := false
+
+			for k := range tgt.keys {
+				norm, err := neofsecdsa.NormalizePublicKey(tgt.keys[k])
+				if err != nil || bytes.Equal(norm, tgt.keys[k]) {
+					continue
+				}
+
+				if !copied {
+					// keys may be shared with the other instances
+					tgt.keys = append([][]byte(nil), tgt.keys...)
+					copied = true
+				}
+
+				res = append(res, MigrationFix{
+					typ:    MigrationFixKeyForm,
+					record: i,
+					target: j,
+					desc:   fmt.Sprintf("key #%d converted to the compressed form", k),
+				})
+
+				tgt.keys[k] = norm
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,97 @@
+package eacl
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_Migrate(t *testing.T) {
+	t.Run("up-to-date", func(t *testing.T) {
+		tb := PublicReadTable(cidtest.ID())
+		require.Nil(t, tb.Migrate())
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		k1, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+		k2, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		uncompressed := k1.PublicKey().UncompressedBytes()
+		compressed := k2.PublicKey().Bytes()
+
+		var tgtByKeys v2acl.Target
+		tgtByKeys.SetRole(v2acl.RoleOthers)
+		tgtByKeys.SetKeys([][]byte{compressed, uncompressed, {1, 2, 3}})
+
+		var tgtByRole v2acl.Target
+		tgtByRole.SetRole(v2acl.RoleUser)
+
+		var r v2acl.Record
+		r.SetAction(v2acl.ActionDeny)
+		r.SetOperation(v2acl.OperationGet)
+		r.SetTargets([]v2acl.Target{tgtByRole, tgtByKeys})
+
+		var m v2acl.Table
+		m.SetRecords([]v2acl.Record{r})
+
+		tb := NewTableFromV2(&m)
+		before := tb.Records()[0].Targets()[1].BinaryKeys()
+
+		fixes := tb.Migrate()
+		require.Len(t, fixes, 3)
+		require.Equal(t, MigrationFixMissingVersion, fixes[0].Type())
+		require.Equal(t, -1, fixes[0].Record())
+		require.Equal(t, MigrationFixKeyTargetRole, fixes[1].Type())
+		require.Equal(t, 0, fixes[1].Record())
+		require.Equal(t, 1, fixes[1].Target())
+		require.Equal(t, MigrationFixKeyForm, fixes[2].Type())
+		require.Equal(t, "record #0: target #1: key #1 converted to the compressed form", fixes[2].String())
+
+		require.Equal(t, version.Current(), tb.Version())
+
+		tgt := tb.Records()[0].Targets()[1]
+		require.Equal(t, RoleUnknown, tgt.Role())
+		require.Equal(t, [][]byte{compressed, k1.PublicKey().Bytes(), {1, 2, 3}}, tgt.BinaryKeys())
+		require.Equal(t, uncompressed, before[1], "source keys must not be modified")
+
+		require.Equal(t, RoleUser, tb.Records()[0].Targets()[0].Role())
+
+		require.Nil(t, tb.Migrate())
+	})
+
+	t.Run("outdated version", func(t *testing.T) {
+		cur := version.Current()
+
+		var ver refs.Version
+		ver.SetMajor(cur.Major())
+		ver.SetMinor(cur.Minor() - 1)
+
+		var m v2acl.Table
+		m.SetVersion(&ver)
+
+		tb := NewTableFromV2(&m)
+
+		fixes := tb.Migrate()
+		require.Len(t, fixes, 1)
+		require.Equal(t, MigrationFixOutdatedVersion, fixes[0].Type())
+		require.Equal(t, version.Current(), tb.Version())
+	})
+
+	t.Run("newer version", func(t *testing.T) {
+		ver := version.Current()
+		ver.SetMinor(ver.Minor() + 1)
+
+		tb := NewTable()
+		tb.SetVersion(ver)
+
+		require.Nil(t, tb.Migrate())
+		require.Equal(t, ver, tb.Version())
+	})
+}
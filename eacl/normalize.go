@@ -0,0 +1,151 @@
+package eacl
+
+import (
+	"bytes"
+	"sort"
+)
+
+// Normalize brings the Table to the canonical form without changing its
+// access rules. Records are evaluated in order, so their sequence is kept
+// as is, but inside each record:
+//   - filters are sorted by header type, key, matcher and value, duplicates
+//     are removed;
+//   - targets by role are sorted by role, duplicates are removed;
+//   - targets by public keys are merged into single target with RoleUnknown
+//     placed after the role ones, keys are sorted, duplicates are removed.
+//
+// Role of the targets by keys is ignored by the evaluation, so merging doesn't
+// change the rules. Normalized tables with the same rules are equal in terms
+// of EqualTables.
+//
+// See also EquivalentTables.
+func (t *Table) Normalize() {
+	for i := range t.records {
+		t.records[i] = normalizeRecord(t.records[i])
+	}
+}
+
+// EquivalentTables checks whether both tables are bound to the same container
+// and have the same access rules, i.e. ignoring order of the filters and
+// targets in the records, their duplicates and format version. Tables are not
+// modified.
+//
+// See also Table.Normalize, EqualTables.
+func EquivalentTables(t1, t2 Table) bool {
+	cnr1, ok1 := t1.CID()
+	cnr2, ok2 := t2.CID()
+
+	if ok1 != ok2 || cnr1 != cnr2 || len(t1.records) != len(t2.records) {
+		return false
+	}
+
+	for i := range t1.records {
+		if !equalRecords(normalizeRecord(t1.records[i]), normalizeRecord(t2.records[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeRecord returns canonical form of the Record. The Record itself is
+// not modified.
+func normalizeRecord(r Record) Record {
+	res := Record{
+		action:    r.action,
+		operation: r.operation,
+	}
+
+	if len(r.filters) > 0 {
+		res.filters = make([]Filter, len(r.filters))
+		copy(res.filters, r.filters)
+
+		sort.Slice(res.filters, func(i, j int) bool {
+			return compareFilters(res.filters[i], res.filters[j]) < 0
+		})
+
+		n := 1
+		for i := 1; i < len(res.filters); i++ {
+			if !equalFilters(res.filters[i], res.filters[n-1]) {
+				res.filters[n] = res.filters[i]
+				n++
+			}
+		}
+
+		res.filters = res.filters[:n]
+	}
+
+	var keys [][]byte
+
+	for i := range r.targets {
+		if ks := r.targets[i].BinaryKeys(); len(ks) > 0 {
+			keys = append(keys, ks...)
+			continue
+		}
+
+		var found bool
+		for j := range res.targets {
+			if found = res.targets[j].role == r.targets[i].role; found {
+				break
+			}
+		}
+
+		if !found {
+			res.targets = append(res.targets, Target{role: r.targets[i].role})
+		}
+	}
+
+	sort.Slice(res.targets, func(i, j int) bool {
+		return res.targets[i].role < res.targets[j].role
+	})
+
+	if len(keys) > 0 {
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i], keys[j]) < 0
+		})
+
+		n := 1
+		for i := 1; i < len(keys); i++ {
+			if !bytes.Equal(keys[i], keys[n-1]) {
+				keys[n] = keys[i]
+				n++
+			}
+		}
+
+		var t Target
+		t.SetBinaryKeys(keys[:n])
+
+		res.targets = append(res.targets, t)
+	}
+
+	return res
+}
+
+// compareFilters returns an integer comparing two filters in the canonical
+// order.
+func compareFilters(f1, f2 Filter) int {
+	switch {
+	case f1.from != f2.from:
+		if f1.from < f2.from {
+			return -1
+		}
+		return 1
+	case f1.Key() != f2.Key():
+		if f1.Key() < f2.Key() {
+			return -1
+		}
+		return 1
+	case f1.matcher != f2.matcher:
+		if f1.matcher < f2.matcher {
+			return -1
+		}
+		return 1
+	case f1.Value() != f2.Value():
+		if f1.Value() < f2.Value() {
+			return -1
+		}
+		return 1
+	}
+
+	return 0
+}
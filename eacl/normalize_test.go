@@ -0,0 +1,74 @@
+package eacl
+
+import (
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_Normalize(t *testing.T) {
+	cnr := cidtest.ID()
+	k1, k2, k3 := []byte{1}, []byte{2}, []byte{3}
+
+	var t1, t2 Table
+	t1.SetCID(cnr)
+	t2.SetCID(cnr)
+
+	r := CreateRecord(ActionDeny, OperationGet)
+	r.AddFilterRequestHeader("b", MatchStringEqual, "1")
+	r.AddObjectAttributeFilter(MatchStringEqual, "a", "1")
+	r.AddObjectAttributeFilter(MatchStringNotEqual, "a", "1")
+	r.AddObjectAttributeFilter(MatchStringEqual, "a", "1")
+	r.SetTargets(NewTargetByRole(RoleOthers), Target{keys: [][]byte{k3, k1}}, NewTargetByRole(RoleUser), Target{role: RoleOthers, keys: [][]byte{k2, k1}})
+	t1.AddRecord(r)
+	t1.AddRecord(CreateRecord(ActionAllow, OperationPut))
+
+	r = CreateRecord(ActionDeny, OperationGet)
+	r.AddObjectAttributeFilter(MatchStringNotEqual, "a", "1")
+	r.AddObjectAttributeFilter(MatchStringEqual, "a", "1")
+	r.AddFilterRequestHeader("b", MatchStringEqual, "1")
+	r.SetTargets(NewTargetByRole(RoleUser), Target{keys: [][]byte{k1, k2, k3}}, NewTargetByRole(RoleOthers), NewTargetByRole(RoleUser))
+	t2.AddRecord(r)
+	t2.AddRecord(CreateRecord(ActionAllow, OperationPut))
+
+	require.False(t, EqualTables(t1, t2))
+	require.True(t, EquivalentTables(t1, t2))
+	require.False(t, EqualTables(t1, t2), "tables must not be modified")
+
+	t1.Normalize()
+	t2.Normalize()
+	require.True(t, EqualTables(t1, t2))
+
+	rec := t1.Records()[0]
+	require.Equal(t, []Filter{
+		NewFilterRequestHeader("b", MatchStringEqual, "1"),
+		*newObjectFilter(MatchStringEqual, "a", "1"),
+		*newObjectFilter(MatchStringNotEqual, "a", "1"),
+	}, rec.Filters())
+	require.Equal(t, []Target{
+		NewTargetByRole(RoleUser),
+		NewTargetByRole(RoleOthers),
+		{keys: [][]byte{k1, k2, k3}},
+	}, rec.Targets())
+
+	t.Run("differences", func(t *testing.T) {
+		var t3 Table
+		t3.SetCID(cnr)
+		t3.AddRecord(CreateRecord(ActionAllow, OperationPut))
+		t3.AddRecord(&rec)
+		require.False(t, EquivalentTables(t1, t3), "records order matters")
+
+		var t4 Table
+		t4.SetCID(cidtest.ID())
+		t4.AddRecord(&t1.Records()[0])
+		t4.AddRecord(&t1.Records()[1])
+		require.False(t, EquivalentTables(t1, t4))
+
+		t4.SetCID(cnr)
+		require.True(t, EquivalentTables(t1, t4))
+
+		t4.Records()[0].SetTargets(NewTargetByRole(RoleUser))
+		require.False(t, EquivalentTables(t1, t4))
+	})
+}
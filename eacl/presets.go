@@ -0,0 +1,107 @@
+package eacl
+
+import (
+	"errors"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// Preset tables below implement common access policies. Note that eACL only
+// overrides basic ACL of the container, and the requests not matching any
+// record are checked by basic ACL rules. System role (container and Inner
+// Ring nodes) is never restricted by presets to keep the container
+// serviceable.
+
+// readOperations lists operations which don't modify container objects.
+var readOperations = []Operation{
+	OperationGet,
+	OperationHead,
+	OperationSearch,
+	OperationRange,
+	OperationRangeHash,
+}
+
+// writeOperations lists operations which modify container objects.
+var writeOperations = []Operation{
+	OperationPut,
+	OperationDelete,
+}
+
+// PublicReadTable returns Table for the given container allowing anyone to
+// read objects while denying their modification by anyone except the
+// container owner.
+func PublicReadTable(cnr cid.ID) *Table {
+	b := NewTableBuilder().ForContainer(cnr)
+
+	for _, op := range readOperations {
+		b.Allow(op).ForRole(RoleOthers)
+	}
+
+	for _, op := range writeOperations {
+		b.Deny(op).ForRole(RoleOthers)
+	}
+
+	t, _ := b.Build() // valid by construction
+	return t
+}
+
+// OwnerOnlyTable returns Table for the given container denying any access to
+// the objects for anyone except the container owner.
+func OwnerOnlyTable(cnr cid.ID) *Table {
+	b := NewTableBuilder().ForContainer(cnr)
+
+	for _, op := range readOperations {
+		b.Deny(op).ForRole(RoleOthers)
+	}
+
+	for _, op := range writeOperations {
+		b.Deny(op).ForRole(RoleOthers)
+	}
+
+	t, _ := b.Build() // valid by construction
+	return t
+}
+
+// ReadOnlyForKeysTable returns Table for the given container allowing the
+// subjects with given binary public keys to read objects while denying any
+// other access for anyone except the container owner. At least one key MUST
+// be specified.
+func ReadOnlyForKeysTable(cnr cid.ID, keys ...[]byte) (*Table, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("missing public keys")
+	}
+
+	b := NewTableBuilder().ForContainer(cnr)
+
+	for _, op := range readOperations {
+		b.Allow(op).ForKeys(keys...)
+	}
+
+	for _, op := range readOperations {
+		b.Deny(op).ForRole(RoleOthers)
+	}
+
+	for _, op := range writeOperations {
+		b.Deny(op).ForRole(RoleOthers)
+	}
+
+	return b.Build()
+}
+
+// DenyDeleteTable returns Table for the given container denying removal of
+// the objects by the subjects of the given roles. If no roles are specified,
+// both RoleUser and RoleOthers are used, so the objects can't be deleted by
+// anyone (except for the expiration). RoleSystem MUST NOT be specified.
+func DenyDeleteTable(cnr cid.ID, roles ...Role) (*Table, error) {
+	if len(roles) == 0 {
+		roles = []Role{RoleUser, RoleOthers}
+	}
+
+	for i := range roles {
+		if roles[i] == RoleSystem {
+			return nil, errors.New("system role must not be restricted")
+		}
+	}
+
+	return NewTableBuilder().ForContainer(cnr).DenyDelete().ForRole(roles...).Build()
+}
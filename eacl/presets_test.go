@@ -0,0 +1,82 @@
+package eacl
+
+import (
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+// checkPreset evaluates the table for all operations of the subject and
+// checks whether it matches expected allowed operations. Operations which are
+// not restricted by the table are considered allowed.
+func checkPreset(t *testing.T, table *Table, role Role, key []byte, allowed ...Operation) {
+	for op := OperationGet; op <= OperationRangeHash; op++ {
+		exp := ActionDeny
+		for i := range allowed {
+			if allowed[i] == op {
+				exp = ActionAllow
+			}
+		}
+
+		action, _ := table.Evaluate(*NewRequest(op, role, key))
+		require.Equal(t, exp, action, "role %v, key %v, op %v", role, key, op)
+	}
+}
+
+func TestPresets(t *testing.T) {
+	cnr := cidtest.ID()
+	key := makeKeys(t, 1)[0]
+
+	allOps := append(append([]Operation{}, readOperations...), writeOperations...)
+
+	t.Run("public read", func(t *testing.T) {
+		table := PublicReadTable(cnr)
+		cnrRes, _ := table.CID()
+		require.Equal(t, cnr, cnrRes)
+
+		checkPreset(t, table, RoleOthers, nil, readOperations...)
+		checkPreset(t, table, RoleUser, nil, allOps...)
+		checkPreset(t, table, RoleSystem, nil, allOps...)
+	})
+
+	t.Run("owner only", func(t *testing.T) {
+		table := OwnerOnlyTable(cnr)
+
+		checkPreset(t, table, RoleOthers, nil)
+		checkPreset(t, table, RoleOthers, key)
+		checkPreset(t, table, RoleUser, nil, allOps...)
+	})
+
+	t.Run("read only for keys", func(t *testing.T) {
+		_, err := ReadOnlyForKeysTable(cnr)
+		require.Error(t, err)
+
+		table, err := ReadOnlyForKeysTable(cnr, key)
+		require.NoError(t, err)
+
+		checkPreset(t, table, RoleOthers, key, readOperations...)
+		checkPreset(t, table, RoleOthers, makeKeys(t, 1)[0])
+		checkPreset(t, table, RoleUser, nil, allOps...)
+	})
+
+	t.Run("deny delete", func(t *testing.T) {
+		_, err := DenyDeleteTable(cnr, RoleOthers, RoleSystem)
+		require.Error(t, err)
+
+		table, err := DenyDeleteTable(cnr)
+		require.NoError(t, err)
+
+		notDelete := []Operation{OperationGet, OperationHead, OperationPut, OperationSearch, OperationRange, OperationRangeHash}
+
+		checkPreset(t, table, RoleOthers, nil, notDelete...)
+		checkPreset(t, table, RoleUser, nil, notDelete...)
+		checkPreset(t, table, RoleSystem, nil, allOps...)
+
+		table, err = DenyDeleteTable(cnr, RoleOthers)
+		require.NoError(t, err)
+
+		checkPreset(t, table, RoleOthers, nil, notDelete...)
+		checkPreset(t, table, RoleUser, nil, allOps...)
+	})
+}
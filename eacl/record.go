@@ -109,6 +109,33 @@ func (r *Record) AddObjectAttributeFilter(m Match, key, value string) {
 	r.addObjectFilter(m, 0, key, staticStringer(value))
 }
 
+// AddFilterRequestHeader adds filter by request X-header with the given key:
+// the header value is matched against the given one using the specified
+// matcher. X-headers are attached by the request sender, so such filters
+// control access based on request metadata rather than object properties.
+//
+// See also NewFilterRequestHeader.
+func (r *Record) AddFilterRequestHeader(key string, m Match, value string) {
+	r.addFilter(HeaderFromRequest, m, 0, key, staticStringer(value))
+}
+
+// AddObjectAttributeAbsentFilter adds filter matching objects without the
+// attribute (or reserved object header if key is one of the well-known filter
+// keys) with the given key, e.g. objects without expiration epoch.
+//
+// See also MatchNotPresent.
+func (r *Record) AddObjectAttributeAbsentFilter(key string) {
+	r.AddFilter(HeaderFromObject, MatchNotPresent, key, "")
+}
+
+// AddRequestHeaderAbsentFilter adds filter matching requests without the
+// X-header with the given key.
+//
+// See also MatchNotPresent.
+func (r *Record) AddRequestHeaderAbsentFilter(key string) {
+	r.AddFilterRequestHeader(key, MatchNotPresent, "")
+}
+
 // AddObjectVersionFilter adds filter by object version.
 func (r *Record) AddObjectVersionFilter(m Match, v *version.Version) {
 	r.addObjectReservedFilter(m, fKeyObjVersion, staticStringer(version.EncodeToString(*v)))
@@ -251,3 +251,22 @@ func randomPublicKey(t *testing.T) *ecdsa.PublicKey {
 	require.NoError(t, err)
 	return &p.PrivateKey.PublicKey
 }
+
+func TestRecord_AddFilterRequestHeader(t *testing.T) {
+	var r Record
+	r.AddFilterRequestHeader("X-Custom", MatchStringNotEqual, "value")
+
+	fs := r.Filters()
+	require.Len(t, fs, 1)
+	require.Equal(t, HeaderFromRequest, fs[0].From())
+	require.Equal(t, MatchStringNotEqual, fs[0].Matcher())
+	require.Equal(t, "X-Custom", fs[0].Key())
+	require.Equal(t, "value", fs[0].Value())
+
+	require.Equal(t, NewFilterRequestHeader("X-Custom", MatchStringNotEqual, "value"), fs[0])
+
+	// reserved object keys are not interpreted for request headers
+	r.AddFilterRequestHeader(v2acl.FilterObjectID, MatchStringEqual, "value")
+	require.Equal(t, v2acl.FilterObjectID, r.Filters()[1].Key())
+	require.Equal(t, v2acl.FilterObjectID, r.ToV2().GetFilters()[1].GetKey())
+}
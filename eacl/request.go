@@ -0,0 +1,163 @@
+package eacl
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// Request describes NeoFS object request to be checked against the eACL
+// Table locally, e.g. by gateways before sending the request to the network
+// or in the offline tests of the tables.
+//
+// Request implements TypedHeaderSource. Headers of the particular type are
+// considered available only if they have been set (even if empty), so the
+// filters by the missing header types make the Table inapplicable like on the
+// storage node side.
+//
+// Instances should be created using NewRequest.
+type Request struct {
+	op   Operation
+	role Role
+	key  []byte
+
+	hdrs map[FilterHeaderType][]Header
+}
+
+// NewRequest returns description of the request executing given operation by
+// the sender with the given role and binary public key. The key may be nil
+// if the sender is identified by role only.
+func NewRequest(op Operation, role Role, senderKey []byte) *Request {
+	return &Request{
+		op:   op,
+		role: role,
+		key:  senderKey,
+	}
+}
+
+// Operation returns requested operation.
+func (r Request) Operation() Operation {
+	return r.op
+}
+
+// Role returns sender's role.
+func (r Request) Role() Role {
+	return r.role
+}
+
+// SenderKey returns sender's binary public key.
+func (r Request) SenderKey() []byte {
+	return r.key
+}
+
+type stringHeader struct {
+	key, value string
+}
+
+func (h stringHeader) Key() string { return h.key }
+
+func (h stringHeader) Value() string { return h.value }
+
+// AddHeader adds key-value header of the given type and makes headers of
+// this type available.
+func (r *Request) AddHeader(typ FilterHeaderType, key, value string) {
+	r.SetHeaders(typ, append(r.hdrs[typ], stringHeader{key: key, value: value})...)
+}
+
+// SetHeaders sets list of headers of the given type. Calling SetHeaders
+// without headers makes headers of this type available but empty.
+func (r *Request) SetHeaders(typ FilterHeaderType, hs ...Header) {
+	if r.hdrs == nil {
+		r.hdrs = make(map[FilterHeaderType][]Header)
+	}
+
+	if hs == nil {
+		hs = []Header{}
+	}
+
+	r.hdrs[typ] = hs
+}
+
+// SetXHeaders sets request X-headers given in key-value pairs, i.e. the
+// length of kv MUST be even.
+func (r *Request) SetXHeaders(kv ...string) {
+	hs := make([]Header, len(kv)/2)
+	for i := range hs {
+		hs[i] = stringHeader{key: kv[2*i], value: kv[2*i+1]}
+	}
+
+	r.SetHeaders(HeaderFromRequest, hs...)
+}
+
+// SetObject sets headers of the requested object. Reserved headers (see
+// FilterObject* constants of the v2 acl package) are converted in the same
+// format as storage nodes do. Object attributes are also included.
+func (r *Request) SetObject(obj object.Object) {
+	r.SetHeaders(HeaderFromObject, ObjectHeaders(obj)...)
+}
+
+// HeadersOfType implements TypedHeaderSource.
+func (r Request) HeadersOfType(typ FilterHeaderType) ([]Header, bool) {
+	hs, ok := r.hdrs[typ]
+	return hs, ok
+}
+
+// ObjectHeaders returns list of the object headers to be matched against
+// HeaderFromObject filters. Format of the reserved headers corresponds to the
+// storage node one.
+func ObjectHeaders(obj object.Object) []Header {
+	attrs := obj.Attributes()
+	res := make([]Header, 0, 9+len(attrs))
+
+	if ver := obj.Version(); ver != nil {
+		res = append(res, stringHeader{v2acl.FilterObjectVersion, version.EncodeToString(*ver)})
+	}
+
+	if id, ok := obj.ID(); ok {
+		res = append(res, stringHeader{v2acl.FilterObjectID, id.EncodeToString()})
+	}
+
+	if cnr, ok := obj.ContainerID(); ok {
+		res = append(res, stringHeader{v2acl.FilterObjectContainerID, cnr.EncodeToString()})
+	}
+
+	if owner := obj.OwnerID(); owner != nil {
+		res = append(res, stringHeader{v2acl.FilterObjectOwnerID, owner.EncodeToString()})
+	}
+
+	res = append(res,
+		stringHeader{v2acl.FilterObjectCreationEpoch, strconv.FormatUint(obj.CreationEpoch(), 10)},
+		stringHeader{v2acl.FilterObjectPayloadLength, strconv.FormatUint(obj.PayloadSize(), 10)},
+		stringHeader{v2acl.FilterObjectType, obj.Type().EncodeToString()},
+	)
+
+	if cs, ok := obj.PayloadChecksum(); ok {
+		res = append(res, stringHeader{v2acl.FilterObjectPayloadHash, hex.EncodeToString(cs.Value())})
+	}
+
+	if cs, ok := obj.PayloadHomomorphicHash(); ok {
+		res = append(res, stringHeader{v2acl.FilterObjectHomomorphicHash, hex.EncodeToString(cs.Value())})
+	}
+
+	for i := range attrs {
+		res = append(res, stringHeader{attrs[i].Key(), attrs[i].Value()})
+	}
+
+	return res
+}
+
+// Evaluate calculates the action on the request according to the Table
+// (see Validator.CalculateAction for details). Second return value is true
+// iff the action was produced by a matching record, otherwise ActionAllow is
+// returned and the request is subject to the basic ACL check only.
+func (t *Table) Evaluate(req Request) (Action, bool) {
+	return NewValidator().CalculateAction(new(ValidationUnit).
+		WithOperation(req.op).
+		WithRole(req.role).
+		WithSenderKey(req.key).
+		WithHeaderSource(req).
+		WithEACLTable(t))
+}
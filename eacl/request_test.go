@@ -0,0 +1,130 @@
+package eacl
+
+import (
+	"strconv"
+	"testing"
+
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_Evaluate(t *testing.T) {
+	owner := usertest.ID(t)
+	key := makeKeys(t, 1)[0]
+
+	var attr object.Attribute
+	attr.SetKey("Type")
+	attr.SetValue("public")
+
+	var obj object.Object
+	obj.SetID(oidtest.ID())
+	obj.SetContainerID(cidtest.ID())
+	obj.SetOwnerID(owner)
+	obj.SetPayloadSize(42)
+	obj.SetAttributes(attr)
+
+	table, err := NewTableBuilder().
+		AllowGet().ForKeys(key).
+		AllowGet().ForRole(RoleOthers).WithObjectAttribute(MatchStringEqual, "Type", "public").
+		DenyGet().ForRole(RoleOthers).WithObjectAttribute(MatchStringEqual, v2acl.FilterObjectOwnerID, owner.EncodeToString()).
+		DenyPut().ForRole(RoleOthers).WithRequestHeader(MatchStringEqual, "X", "Y").
+		DenyHead().ForRole(RoleOthers).WithObjectAttribute(MatchStringEqual, v2acl.FilterObjectPayloadLength, "42").
+		Build()
+	require.NoError(t, err)
+
+	req := NewRequest(OperationGet, RoleOthers, nil)
+	req.SetObject(obj)
+
+	action, ok := table.Evaluate(*req)
+	require.True(t, ok)
+	require.Equal(t, ActionAllow, action)
+
+	attr.SetValue("private")
+	obj.SetAttributes(attr)
+	req.SetObject(obj)
+
+	action, ok = table.Evaluate(*req)
+	require.True(t, ok)
+	require.Equal(t, ActionDeny, action)
+
+	t.Run("sender key", func(t *testing.T) {
+		req := NewRequest(OperationGet, RoleOthers, key)
+		req.SetObject(obj)
+
+		action, ok := table.Evaluate(*req)
+		require.True(t, ok)
+		require.Equal(t, ActionAllow, action)
+	})
+
+	t.Run("role mismatch", func(t *testing.T) {
+		req := NewRequest(OperationGet, RoleUser, nil)
+		req.SetObject(obj)
+
+		action, ok := table.Evaluate(*req)
+		require.False(t, ok)
+		require.Equal(t, ActionAllow, action)
+	})
+
+	t.Run("missing headers", func(t *testing.T) {
+		req := NewRequest(OperationPut, RoleOthers, nil)
+
+		_, ok := table.Evaluate(*req)
+		require.False(t, ok)
+
+		req.SetXHeaders("X", "Y")
+
+		action, ok := table.Evaluate(*req)
+		require.True(t, ok)
+		require.Equal(t, ActionDeny, action)
+
+		req.SetXHeaders()
+
+		_, ok = table.Evaluate(*req)
+		require.False(t, ok)
+	})
+
+	t.Run("reserved headers", func(t *testing.T) {
+		req := NewRequest(OperationHead, RoleOthers, nil)
+		req.SetObject(obj)
+
+		action, ok := table.Evaluate(*req)
+		require.True(t, ok)
+		require.Equal(t, ActionDeny, action)
+	})
+}
+
+func TestObjectHeaders(t *testing.T) {
+	id := oidtest.ID()
+	cnr := cidtest.ID()
+	owner := usertest.ID(t)
+	ver := version.Current()
+
+	var obj object.Object
+	obj.SetVersion(&ver)
+	obj.SetID(id)
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(owner)
+	obj.SetCreationEpoch(13)
+	obj.SetPayloadSize(42)
+	obj.SetType(object.TypeTombstone)
+
+	m := make(map[string]string)
+	for _, h := range ObjectHeaders(obj) {
+		m[h.Key()] = h.Value()
+	}
+
+	require.Equal(t, map[string]string{
+		v2acl.FilterObjectVersion:       version.EncodeToString(ver),
+		v2acl.FilterObjectID:            id.EncodeToString(),
+		v2acl.FilterObjectContainerID:   cnr.EncodeToString(),
+		v2acl.FilterObjectOwnerID:       owner.EncodeToString(),
+		v2acl.FilterObjectCreationEpoch: strconv.Itoa(13),
+		v2acl.FilterObjectPayloadLength: strconv.Itoa(42),
+		v2acl.FilterObjectType:          "TOMBSTONE",
+	}, m)
+}
@@ -0,0 +1,103 @@
+package eacl
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// SignedData returns binary representation of the Table signed by the
+// container owner to submit the Table in the NeoFS network.
+//
+// See also Sign.
+func (t Table) SignedData() []byte {
+	return t.ToV2().StableMarshal(nil)
+}
+
+// Sign calculates signature of the Table. Signer SHOULD be the one of the
+// container owner or the one authorized by the container session with
+// session.VerbContainerSetEACL verb. Note that NeoFS API transmits eACL
+// signatures of neofscrypto.ECDSA_DETERMINISTIC_SHA256 scheme only.
+//
+// See also VerifySignature.
+func (t Table) Sign(signer neofscrypto.Signer) (neofscrypto.Signature, error) {
+	var sig neofscrypto.Signature
+
+	if err := sig.Calculate(signer, t.SignedData()); err != nil {
+		return sig, err
+	}
+
+	return sig, nil
+}
+
+// VerifySignature checks whether the Table is authentically set by the
+// container owner like storage nodes do. If session token is nil, the
+// signature MUST be made by the owner's key. Otherwise, the session MUST be
+// signed by the owner, be applied to the Table container for
+// session.VerbContainerSetEACL verb and be bound to the signature key. Token
+// lifetime is not checked since it relates to the Table submission only.
+//
+// The Table MUST be bound to the container (see SetCID).
+//
+// See also Sign.
+func (t Table) VerifySignature(sig neofscrypto.Signature, owner user.ID, sess *session.Container) error {
+	cnr, ok := t.CID()
+	if !ok {
+		return errors.New("table is not bound to any container")
+	}
+
+	var sigV2 refs.Signature
+	sig.WriteToV2(&sigV2)
+
+	if !sig.Verify(t.SignedData()) {
+		return errors.New("invalid signature")
+	}
+
+	if sess == nil {
+		pub, err := keys.NewPublicKeyFromBytes(sigV2.GetKey(), elliptic.P256())
+		if err != nil {
+			return fmt.Errorf("decode public key of the signature: %w", err)
+		}
+
+		var signer user.ID
+		signer.SetScriptHash(pub.GetScriptHash())
+
+		if !signer.Equals(owner) {
+			return fmt.Errorf("table is signed by %s instead of container owner %s", signer, owner)
+		}
+
+		return nil
+	}
+
+	switch {
+	case !sess.VerifySignature():
+		return errors.New("invalid session token signature")
+	case !session.IssuedBy(*sess, owner):
+		return fmt.Errorf("session token is issued by %s instead of container owner %s", sess.Issuer(), owner)
+	case !sess.AssertVerb(session.VerbContainerSetEACL):
+		return errors.New("session token is not for eACL setting")
+	case !sess.AppliedTo(cnr):
+		return fmt.Errorf("session token is not applied to the container %s", cnr)
+	}
+
+	pub, err := neofscrypto.NewPublicKey(neofscrypto.Scheme(sigV2.GetScheme()))
+	if err == nil {
+		err = pub.Decode(sigV2.GetKey())
+	}
+
+	if err != nil {
+		return fmt.Errorf("decode public key of the signature: %w", err)
+	}
+
+	if !sess.AssertAuthKey(pub) {
+		return errors.New("table is signed by the key not bound to the session token")
+	}
+
+	return nil
+}
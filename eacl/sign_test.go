@@ -0,0 +1,92 @@
+package eacl
+
+import (
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_VerifySignature(t *testing.T) {
+	cnr := cidtest.ID()
+	owner := test.RandomSignerRFC6979(t)
+	ownerID := owner.UserID()
+
+	table := PublicReadTable(cnr)
+
+	t.Run("unbound table", func(t *testing.T) {
+		unbound := NewTableBuilder().DenyGet().ForRole(RoleOthers)
+		tb, err := unbound.Build()
+		require.NoError(t, err)
+
+		sig, err := tb.Sign(owner)
+		require.NoError(t, err)
+		require.Error(t, tb.VerifySignature(sig, ownerID, nil))
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		sig, err := table.Sign(owner)
+		require.NoError(t, err)
+		require.NoError(t, table.VerifySignature(sig, ownerID, nil))
+
+		require.Error(t, table.VerifySignature(sig, *usertest.ID(t), nil))
+
+		modified := PublicReadTable(cnr)
+		modified.AddRecord(CreateRecord(ActionDeny, OperationGet))
+		require.Error(t, modified.VerifySignature(sig, ownerID, nil))
+	})
+
+	t.Run("not owner", func(t *testing.T) {
+		sig, err := table.Sign(test.RandomSignerRFC6979(t))
+		require.NoError(t, err)
+		require.Error(t, table.VerifySignature(sig, ownerID, nil))
+	})
+
+	t.Run("session", func(t *testing.T) {
+		delegate := test.RandomSignerRFC6979(t)
+
+		newSession := func(t *testing.T, issuer user.Signer) *session.Container {
+			var tok session.Container
+			tok.ForVerb(session.VerbContainerSetEACL)
+			tok.ApplyOnlyTo(cnr)
+			tok.SetAuthKey(delegate.Public())
+			require.NoError(t, tok.Sign(issuer))
+			return &tok
+		}
+
+		sig, err := table.Sign(delegate)
+		require.NoError(t, err)
+
+		require.NoError(t, table.VerifySignature(sig, ownerID, newSession(t, owner)))
+
+		// signer is not the owner, so no session means failure
+		require.Error(t, table.VerifySignature(sig, ownerID, nil))
+
+		// issued by someone else
+		require.Error(t, table.VerifySignature(sig, ownerID, newSession(t, test.RandomSignerRFC6979(t))))
+
+		tok := newSession(t, owner)
+		tok.ForVerb(session.VerbContainerDelete)
+		require.NoError(t, tok.Sign(owner))
+		require.Error(t, table.VerifySignature(sig, ownerID, tok))
+
+		tok = newSession(t, owner)
+		tok.ApplyOnlyTo(cidtest.ID())
+		require.NoError(t, tok.Sign(owner))
+		require.Error(t, table.VerifySignature(sig, ownerID, tok))
+
+		tok = newSession(t, owner)
+		tok.SetAuthKey(test.RandomSignerRFC6979(t).Public())
+		require.NoError(t, tok.Sign(owner))
+		require.Error(t, table.VerifySignature(sig, ownerID, tok))
+
+		// corrupted session signature
+		tok = newSession(t, owner)
+		tok.ForVerb(session.VerbContainerDelete)
+		require.Error(t, table.VerifySignature(sig, ownerID, tok))
+	})
+}
@@ -56,6 +56,58 @@ func (t *Table) AddRecord(r *Record) {
 	}
 }
 
+// InsertRecord inserts eACL rule at the given position shifting the following
+// records forward. Since records are evaluated in order, the inserted record
+// takes precedence over all subsequent ones. Index MUST be in range [0,
+// NumRecords()], NumRecords() is equivalent to AddRecord.
+//
+// See also ReplaceRecord, MoveRecord.
+func (t *Table) InsertRecord(i int, r Record) {
+	if i < 0 || i > len(t.records) {
+		panic(fmt.Sprintf("record index %d out of range [0:%d]", i, len(t.records)))
+	}
+
+	t.records = append(t.records, Record{})
+	copy(t.records[i+1:], t.records[i:])
+	t.records[i] = r
+}
+
+// ReplaceRecord replaces eACL rule at the given position keeping positions of
+// the other records. Index MUST be in range [0, NumRecords()).
+//
+// See also InsertRecord, MoveRecord.
+func (t *Table) ReplaceRecord(i int, r Record) {
+	if i < 0 || i >= len(t.records) {
+		panic(fmt.Sprintf("record index %d out of range [0:%d)", i, len(t.records)))
+	}
+
+	t.records[i] = r
+}
+
+// MoveRecord moves eACL rule from one position to another preserving relative
+// order of the other records, i.e. records between the positions are shifted by
+// one towards the source position. Both indices MUST be in range [0,
+// NumRecords()).
+//
+// See also InsertRecord, ReplaceRecord.
+func (t *Table) MoveRecord(from, to int) {
+	for _, i := range [2]int{from, to} {
+		if i < 0 || i >= len(t.records) {
+			panic(fmt.Sprintf("record index %d out of range [0:%d)", i, len(t.records)))
+		}
+	}
+
+	r := t.records[from]
+
+	if from < to {
+		copy(t.records[from:to], t.records[from+1:to+1])
+	} else {
+		copy(t.records[to+1:from+1], t.records[to:from])
+	}
+
+	t.records[to] = r
+}
+
 // ToV2 converts Table to v2 acl.EACLTable message.
 //
 // Nil Table converts to nil.
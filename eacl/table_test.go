@@ -65,6 +65,76 @@ func TestTable_AddRecord(t *testing.T) {
 	require.Equal(t, records, table.Records())
 }
 
+func TestTable_RecordOrder(t *testing.T) {
+	newTable := func(ops ...eacl.Operation) *eacl.Table {
+		var tb eacl.Table
+		for i := range ops {
+			tb.AddRecord(eacl.CreateRecord(eacl.ActionDeny, ops[i]))
+		}
+
+		return &tb
+	}
+
+	ops := func(t *eacl.Table) []eacl.Operation {
+		res := make([]eacl.Operation, len(t.Records()))
+		for i, r := range t.Records() {
+			res[i] = r.Operation()
+		}
+
+		return res
+	}
+
+	t.Run("insert", func(t *testing.T) {
+		table := newTable(eacl.OperationGet, eacl.OperationPut)
+
+		table.InsertRecord(0, *eacl.CreateRecord(eacl.ActionDeny, eacl.OperationHead))
+		require.Equal(t, []eacl.Operation{eacl.OperationHead, eacl.OperationGet, eacl.OperationPut}, ops(table))
+
+		table.InsertRecord(1, *eacl.CreateRecord(eacl.ActionDeny, eacl.OperationDelete))
+		require.Equal(t, []eacl.Operation{eacl.OperationHead, eacl.OperationDelete, eacl.OperationGet, eacl.OperationPut}, ops(table))
+
+		table.InsertRecord(4, *eacl.CreateRecord(eacl.ActionDeny, eacl.OperationSearch))
+		require.Equal(t, []eacl.Operation{eacl.OperationHead, eacl.OperationDelete, eacl.OperationGet, eacl.OperationPut, eacl.OperationSearch}, ops(table))
+
+		require.Panics(t, func() { table.InsertRecord(-1, eacl.Record{}) })
+		require.Panics(t, func() { table.InsertRecord(6, eacl.Record{}) })
+
+		var empty eacl.Table
+		empty.InsertRecord(0, *eacl.CreateRecord(eacl.ActionDeny, eacl.OperationGet))
+		require.Equal(t, []eacl.Operation{eacl.OperationGet}, ops(&empty))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		table := newTable(eacl.OperationGet, eacl.OperationPut)
+
+		table.ReplaceRecord(1, *eacl.CreateRecord(eacl.ActionAllow, eacl.OperationHead))
+		require.Equal(t, []eacl.Operation{eacl.OperationGet, eacl.OperationHead}, ops(table))
+		require.Equal(t, eacl.ActionAllow, table.Records()[1].Action())
+
+		require.Panics(t, func() { table.ReplaceRecord(-1, eacl.Record{}) })
+		require.Panics(t, func() { table.ReplaceRecord(2, eacl.Record{}) })
+	})
+
+	t.Run("move", func(t *testing.T) {
+		table := newTable(eacl.OperationGet, eacl.OperationHead, eacl.OperationPut, eacl.OperationDelete)
+
+		table.MoveRecord(3, 0)
+		require.Equal(t, []eacl.Operation{eacl.OperationDelete, eacl.OperationGet, eacl.OperationHead, eacl.OperationPut}, ops(table))
+
+		table.MoveRecord(0, 3)
+		require.Equal(t, []eacl.Operation{eacl.OperationGet, eacl.OperationHead, eacl.OperationPut, eacl.OperationDelete}, ops(table))
+
+		table.MoveRecord(1, 2)
+		require.Equal(t, []eacl.Operation{eacl.OperationGet, eacl.OperationPut, eacl.OperationHead, eacl.OperationDelete}, ops(table))
+
+		table.MoveRecord(2, 2)
+		require.Equal(t, []eacl.Operation{eacl.OperationGet, eacl.OperationPut, eacl.OperationHead, eacl.OperationDelete}, ops(table))
+
+		require.Panics(t, func() { table.MoveRecord(-1, 0) })
+		require.Panics(t, func() { table.MoveRecord(0, 4) })
+	})
+}
+
 func TestTableEncoding(t *testing.T) {
 	tab := eacltest.Table(t)
 
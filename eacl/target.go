@@ -3,9 +3,15 @@ package eacl
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
 // Target is a group of request senders to match ContainerEACL. Defined by role enum
@@ -177,3 +183,83 @@ func equalTargets(t1, t2 Target) bool {
 
 	return true
 }
+
+// NewTargetByRole returns Target of all subjects with the given role.
+func NewTargetByRole(role Role) Target {
+	return Target{role: role}
+}
+
+// NewTargetByKeys returns Target of the subjects with the given binary P-256
+// public keys. Keys are accepted in any of compressed, uncompressed or hybrid
+// forms and stored in the compressed one used by NeoFS to identify request
+// senders. Returns an error if any key is invalid or no keys are specified.
+func NewTargetByKeys(bKeys ...[]byte) (Target, error) {
+	if len(bKeys) == 0 {
+		return Target{}, errors.New("missing public keys")
+	}
+
+	res := make([][]byte, len(bKeys))
+
+	for i := range bKeys {
+		var err error
+
+		res[i], err = neofsecdsa.NormalizePublicKey(bKeys[i])
+		if err != nil {
+			return Target{}, fmt.Errorf("invalid public key #%d: %w", i, err)
+		}
+	}
+
+	return Target{keys: res}, nil
+}
+
+// NewTargetByPublicKeys is the same as NewTargetByKeys but accepts keys as
+// neofscrypto.PublicKey. Only ECDSA keys are supported.
+func NewTargetByPublicKeys(pubs ...neofscrypto.PublicKey) (Target, error) {
+	bKeys := make([][]byte, len(pubs))
+
+	for i := range pubs {
+		bKeys[i] = make([]byte, pubs[i].MaxEncodedSize())
+		bKeys[i] = bKeys[i][:pubs[i].Encode(bKeys[i])]
+	}
+
+	return NewTargetByKeys(bKeys...)
+}
+
+// NewTargetByUsers returns Target of all public keys of the given users. Since
+// eACL identifies subjects by public keys, keys of each user are requested
+// from resolver (e.g. from the NeoFS ID contract). Returns an error if
+// resolver fails, returns no keys or returns invalid or foreign key.
+func NewTargetByUsers(resolver func(user.ID) ([][]byte, error), users ...user.ID) (Target, error) {
+	if len(users) == 0 {
+		return Target{}, errors.New("missing users")
+	}
+
+	var res [][]byte
+
+	for i := range users {
+		bKeys, err := resolver(users[i])
+		if err != nil {
+			return Target{}, fmt.Errorf("resolve public keys of user %s: %w", users[i], err)
+		} else if len(bKeys) == 0 {
+			return Target{}, fmt.Errorf("no public keys of user %s", users[i])
+		}
+
+		for j := range bKeys {
+			pub, err := keys.NewPublicKeyFromBytes(bKeys[j], elliptic.P256())
+			if err != nil {
+				return Target{}, fmt.Errorf("invalid public key #%d of user %s: %w", j, users[i], err)
+			}
+
+			var owner user.ID
+			owner.SetScriptHash(pub.GetScriptHash())
+
+			if !owner.Equals(users[i]) {
+				return Target{}, fmt.Errorf("public key #%d doesn't belong to user %s", j, users[i])
+			}
+
+			res = append(res, pub.Bytes())
+		}
+	}
+
+	return Target{keys: res}, nil
+}
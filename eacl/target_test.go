@@ -2,11 +2,16 @@ package eacl
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"errors"
 	"testing"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-api-go/v2/acl"
 	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -83,3 +88,67 @@ func TestTarget_ToV2(t *testing.T) {
 		require.Nil(t, targetV2.GetKeys())
 	})
 }
+
+func TestTargetConstructors(t *testing.T) {
+	tgt := NewTargetByRole(RoleOthers)
+	require.Equal(t, RoleOthers, tgt.Role())
+	require.Empty(t, tgt.BinaryKeys())
+
+	signer := test.RandomSignerRFC6979(t)
+	pub := (*keys.PublicKey)(randomPublicKey(t))
+
+	_, err := NewTargetByKeys()
+	require.Error(t, err)
+	_, err = NewTargetByKeys([]byte{1, 2, 3})
+	require.Error(t, err)
+	_, err = NewTargetByKeys(make([]byte, 33))
+	require.Error(t, err)
+
+	tgt, err = NewTargetByKeys(pub.UncompressedBytes(), pub.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, RoleUnknown, tgt.Role())
+	require.Equal(t, [][]byte{pub.Bytes(), pub.Bytes()}, tgt.BinaryKeys())
+
+	tgt, err = NewTargetByPublicKeys(signer.Public())
+	require.NoError(t, err)
+	require.Len(t, tgt.BinaryKeys(), 1)
+
+	ed := make([]byte, ed25519.PublicKeySize)
+	_, err = NewTargetByKeys(ed)
+	require.Error(t, err)
+
+	t.Run("users", func(t *testing.T) {
+		usr := signer.UserID()
+		bPub := tgt.BinaryKeys()[0]
+
+		resolver := func(id user.ID) ([][]byte, error) {
+			switch {
+			case id.Equals(usr):
+				return [][]byte{bPub}, nil
+			case id.Equals(*usertest.ID(t)):
+				return nil, nil
+			default:
+				return nil, errors.New("unknown user")
+			}
+		}
+
+		_, err := NewTargetByUsers(resolver)
+		require.Error(t, err)
+
+		res, err := NewTargetByUsers(resolver, usr)
+		require.NoError(t, err)
+		require.Equal(t, tgt, res)
+
+		_, err = NewTargetByUsers(resolver, usr, *usertest.ID(t))
+		require.Error(t, err)
+
+		_, err = NewTargetByUsers(func(user.ID) ([][]byte, error) {
+			return [][]byte{pub.Bytes()}, nil
+		}, usr)
+		require.ErrorContains(t, err, "doesn't belong")
+	})
+
+	table, err := NewTableBuilder().DenyGet().ForTargets(NewTargetByRole(RoleOthers), tgt).Build()
+	require.NoError(t, err)
+	require.Len(t, table.Records()[0].Targets(), 2)
+}
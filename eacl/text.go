@@ -0,0 +1,273 @@
+package eacl
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Text form of the eACL rules corresponds to the one accepted by neofs-cli:
+//
+//	<action> <operation> [<filter1> ...] [<target1> ...]
+//
+// where
+//   - action is 'allow' or 'deny';
+//   - operation is one of 'get', 'head', 'put', 'delete', 'search', 'getrange'
+//     or 'getrangehash';
+//   - filter is '{obj|req}:<key><operator><value>', operator is one of '=',
+//     '!=', '>', '>=', '<', '<=' or '!' for the header absence (value is
+//     ignored and usually omitted);
+//   - target is 'user', 'system', 'others' or 'pubkey:<hex1>[,<hex2>...]'.
+//
+// Keys and values containing whitespaces cannot be expressed in text form.
+
+// text prefixes of the header types.
+const (
+	textHeaderFromObject  = "obj"
+	textHeaderFromRequest = "req"
+)
+
+// text target of the subjects identified by public keys.
+const textTargetPubKey = "pubkey"
+
+// text operators of the matchers. If one operator is a prefix of another, it
+// must go after the longer one.
+var textMatchers = []struct {
+	op string
+	m  Match
+}{
+	{"!=", MatchStringNotEqual},
+	{"!", MatchNotPresent},
+	{"=", MatchStringEqual},
+	{">=", MatchNumGE},
+	{">", MatchNumGT},
+	{"<=", MatchNumLE},
+	{"<", MatchNumLT},
+}
+
+// EncodeToString returns text representation of the Record in neofs-cli
+// format:
+//
+//	allow get obj:Key=Value req:X!=Y others pubkey:03...
+//
+// Records having properties that cannot be expressed in this format (e.g.
+// unknown enum values) are encoded with the corresponding String values, so
+// DecodeString will fail on the result.
+//
+// See also DecodeString.
+func (r Record) EncodeToString() string {
+	var sb strings.Builder
+
+	sb.WriteString(strings.ToLower(r.action.EncodeToString()))
+	sb.WriteByte(' ')
+	sb.WriteString(strings.ToLower(r.operation.EncodeToString()))
+
+	for i := range r.filters {
+		sb.WriteByte(' ')
+
+		switch r.filters[i].from {
+		case HeaderFromObject:
+			sb.WriteString(textHeaderFromObject)
+		case HeaderFromRequest:
+			sb.WriteString(textHeaderFromRequest)
+		default:
+			sb.WriteString(r.filters[i].from.String())
+		}
+
+		sb.WriteByte(':')
+		sb.WriteString(r.filters[i].Key())
+
+		op := r.filters[i].matcher.String()
+		for j := range textMatchers {
+			if textMatchers[j].m == r.filters[i].matcher {
+				op = textMatchers[j].op
+				break
+			}
+		}
+
+		sb.WriteString(op)
+		sb.WriteString(r.filters[i].Value())
+	}
+
+	for i := range r.targets {
+		sb.WriteByte(' ')
+
+		if keys := r.targets[i].BinaryKeys(); len(keys) > 0 {
+			sb.WriteString(textTargetPubKey)
+			sb.WriteByte(':')
+
+			for j := range keys {
+				if j > 0 {
+					sb.WriteByte(',')
+				}
+
+				sb.WriteString(hex.EncodeToString(keys[j]))
+			}
+
+			continue
+		}
+
+		sb.WriteString(strings.ToLower(r.targets[i].role.EncodeToString()))
+	}
+
+	return sb.String()
+}
+
+// DecodeString parses Record from the text representation in neofs-cli format.
+// It is a reverse action to EncodeToString.
+//
+// Returns an error if s is not a valid text representation of the Record.
+func (r *Record) DecodeString(s string) error {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return errors.New("missing action or operation")
+	}
+
+	var res Record
+
+	switch strings.ToLower(fields[0]) {
+	case "allow":
+		res.action = ActionAllow
+	case "deny":
+		res.action = ActionDeny
+	default:
+		return fmt.Errorf("invalid action '%s' (expected 'allow' or 'deny')", fields[0])
+	}
+
+	if !res.operation.DecodeString(strings.ToUpper(fields[1])) || res.operation == OperationUnknown {
+		return fmt.Errorf("invalid operation '%s'", fields[1])
+	}
+
+	for _, f := range fields[2:] {
+		var err error
+
+		switch typ, val, _ := strings.Cut(f, ":"); typ {
+		case textHeaderFromObject:
+			err = res.decodeTextFilter(HeaderFromObject, val)
+		case textHeaderFromRequest:
+			err = res.decodeTextFilter(HeaderFromRequest, val)
+		case textTargetPubKey:
+			err = res.decodeTextKeys(val)
+		default:
+			var t Target
+			if !t.role.DecodeString(strings.ToUpper(f)) || t.role == RoleUnknown {
+				err = errors.New("neither filter nor target")
+			} else {
+				res.targets = append(res.targets, t)
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid argument '%s': %w", f, err)
+		}
+	}
+
+	if len(res.targets) == 0 {
+		return errors.New("missing targets")
+	}
+
+	*r = res
+
+	return nil
+}
+
+func (r *Record) decodeTextFilter(from FilterHeaderType, s string) error {
+	// value may contain operator symbols, so the leftmost operator is searched
+	ind, m := -1, Match(0)
+	var op string
+
+	for i := range textMatchers {
+		j := strings.Index(s, textMatchers[i].op)
+		if j >= 0 && (ind < 0 || j < ind) {
+			ind, m, op = j, textMatchers[i].m, textMatchers[i].op
+		}
+	}
+
+	if ind < 0 {
+		return errors.New("missing filter operator")
+	} else if ind == 0 {
+		return errors.New("missing filter key")
+	}
+
+	f := Filter{
+		from:    from,
+		matcher: m,
+		value:   staticStringer(s[ind+len(op):]),
+	}
+	f.key.fromString(s[:ind])
+
+	r.filters = append(r.filters, f)
+
+	return nil
+}
+
+func (r *Record) decodeTextKeys(s string) error {
+	if s == "" {
+		return errors.New("missing public keys")
+	}
+
+	hexKeys := strings.Split(s, ",")
+	keys := make([][]byte, len(hexKeys))
+
+	for i := range hexKeys {
+		var err error
+
+		keys[i], err = hex.DecodeString(hexKeys[i])
+		if err != nil {
+			return fmt.Errorf("decode public key #%d from hex: %w", i, err)
+		} else if len(keys[i]) == 0 {
+			return fmt.Errorf("empty public key #%d", i)
+		}
+	}
+
+	var t Target
+	t.SetBinaryKeys(keys)
+
+	r.targets = append(r.targets, t)
+
+	return nil
+}
+
+// EncodeToString returns text representation of the Table records: each
+// record is encoded on a separate line using Record.EncodeToString. Container
+// and version are not encoded.
+//
+// See also DecodeString.
+func (t Table) EncodeToString() string {
+	lines := make([]string, len(t.records))
+	for i := range t.records {
+		lines[i] = t.records[i].EncodeToString()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// DecodeString parses Table records from the text representation: each
+// non-empty line is decoded using Record.DecodeString. Lines starting with '#'
+// are treated as comments and skipped. Container and version of the Table are
+// not changed.
+//
+// Returns an error if any line is not a valid text representation of the
+// Record.
+func (t *Table) DecodeString(s string) error {
+	var records []Record
+
+	for i, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var r Record
+		if err := r.DecodeString(line); err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		records = append(records, r)
+	}
+
+	t.records = records
+
+	return nil
+}
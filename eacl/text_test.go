@@ -0,0 +1,105 @@
+package eacl
+
+import (
+	"encoding/hex"
+	"testing"
+
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord_EncodeToString(t *testing.T) {
+	key := makeKeys(t, 1)[0]
+
+	// rules from neofs-cli 'acl extended create' help
+	for _, s := range []string{
+		"allow get obj:Key=Value others",
+		"deny put others",
+		"deny head obj:$Object:ownerID=NNxVrKjLsRkWsmGgmuNXLcMswtxTGaNQLk system",
+		"allow getrange req:X-Header!=some-value user others",
+		"deny delete obj:A=B=C pubkey:" + hex.EncodeToString(key),
+		"allow getrangehash pubkey:" + hex.EncodeToString(key) + "," + hex.EncodeToString(key),
+		"deny search obj:a=1 obj:b!=2 req:c= others",
+		"deny put obj:$Object:payloadLength>1048576 obj:$Object:creationEpoch<=100 others",
+		"allow head obj:a>=1 obj:b<2 obj:c=>3 system",
+		"deny get obj:a! req:b!=c! obj:c!d others",
+	} {
+		var r Record
+		require.NoError(t, r.DecodeString(s), s)
+		require.Equal(t, s, r.EncodeToString())
+	}
+
+	var r Record
+	require.NoError(t, r.DecodeString("  ALLOW   Get  obj:$Object:objectType=REGULAR  Others "))
+
+	exp := CreateRecord(ActionAllow, OperationGet)
+	exp.AddObjectTypeFilter(MatchStringEqual, 0)
+	AddFormedTarget(exp, RoleOthers)
+	require.True(t, equalRecords(*exp, r))
+	require.Equal(t, fKeyObjType, r.Filters()[0].key.typ)
+	require.Equal(t, v2acl.FilterObjectType, r.Filters()[0].Key())
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{
+			"",
+			"allow",
+			"permit get others",
+			"allow fetch others",
+			"allow operation_unspecified others",
+			"allow get",
+			"allow get obj:Key=Value",
+			"allow get everyone",
+			"allow get role_unknown",
+			"allow get obj:KeyValue others",
+			"allow get obj:=Value others",
+			"allow get pubkey:",
+			"allow get pubkey:zz",
+			"allow get pubkey:0102,",
+		} {
+			var r Record
+			require.Error(t, r.DecodeString(s), s)
+		}
+	})
+}
+
+func TestRecord_EncodeToString_Matchers(t *testing.T) {
+	for m := MatchStringEqual; m <= MatchNumLE; m++ {
+		r := CreateRecord(ActionDeny, OperationGet)
+		r.AddObjectAttributeFilter(m, "key", "value")
+		AddFormedTarget(r, RoleOthers)
+
+		var r2 Record
+		require.NoError(t, r2.DecodeString(r.EncodeToString()), m)
+		require.True(t, equalRecords(*r, r2), m)
+	}
+
+	r := CreateRecord(ActionDeny, OperationGet)
+	r.AddObjectAttributeFilter(MatchUnknown, "key", "value")
+	AddFormedTarget(r, RoleOthers)
+
+	var r2 Record
+	require.Error(t, r2.DecodeString(r.EncodeToString()))
+}
+
+func TestTable_EncodeToString(t *testing.T) {
+	const s = `# public objects
+allow get obj:Type=public others
+deny get others
+
+deny put others`
+
+	var table Table
+	require.NoError(t, table.DecodeString(s))
+	require.Len(t, table.Records(), 3)
+
+	exp, err := NewTableBuilder().
+		AllowGet().ForRole(RoleOthers).WithObjectAttribute(MatchStringEqual, "Type", "public").
+		DenyGet().ForRole(RoleOthers).
+		DenyPut().ForRole(RoleOthers).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, exp.EncodeToString(), table.EncodeToString())
+	require.Equal(t, "allow get obj:Type=public others\ndeny get others\ndeny put others", table.EncodeToString())
+
+	require.ErrorContains(t, table.DecodeString("deny get others\nallow get"), "line 2")
+}
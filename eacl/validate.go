@@ -0,0 +1,271 @@
+package eacl
+
+import (
+	"bytes"
+	"fmt"
+
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+)
+
+// FindingType is an enumeration of the Table problems detected by
+// Table.Validate.
+type FindingType uint8
+
+const (
+	_ FindingType = iota
+
+	// FindingUnknownAction is a FindingType of the record with unknown action.
+	FindingUnknownAction
+
+	// FindingUnknownOperation is a FindingType of the record with unknown
+	// operation.
+	FindingUnknownOperation
+
+	// FindingEmptyTargets is a FindingType of the record without targets.
+	// Such record never matches any request.
+	FindingEmptyTargets
+
+	// FindingInvalidTarget is a FindingType of the record with target having
+	// neither known role nor valid keys.
+	FindingInvalidTarget
+
+	// FindingInvalidFilter is a FindingType of the record with filter having
+	// unknown header type or matcher, or empty key.
+	FindingInvalidFilter
+
+	// FindingUnreachable is a FindingType of the record shadowed by the
+	// previous record with the same action: any request matching the second
+	// one is processed by the first one, so the second record is redundant.
+	FindingUnreachable
+
+	// FindingContradiction is a FindingType of the record shadowed by the
+	// previous record with a different action: the second record never takes
+	// effect, which usually means misordered records.
+	FindingContradiction
+)
+
+// String implements fmt.Stringer.
+func (x FindingType) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case FindingUnknownAction:
+		return "UNKNOWN_ACTION"
+	case FindingUnknownOperation:
+		return "UNKNOWN_OPERATION"
+	case FindingEmptyTargets:
+		return "EMPTY_TARGETS"
+	case FindingInvalidTarget:
+		return "INVALID_TARGET"
+	case FindingInvalidFilter:
+		return "INVALID_FILTER"
+	case FindingUnreachable:
+		return "UNREACHABLE"
+	case FindingContradiction:
+		return "CONTRADICTION"
+	}
+}
+
+// Finding describes single problem of the Table detected by Table.Validate.
+type Finding struct {
+	typ FindingType
+
+	record int
+
+	shadowedBy int
+
+	desc string
+}
+
+// Type returns type of the problem.
+func (x Finding) Type() FindingType {
+	return x.typ
+}
+
+// Record returns index of the problem record in the Table.
+func (x Finding) Record() int {
+	return x.record
+}
+
+// ShadowedBy returns index of the record shadowing the problem one. Makes
+// sense for FindingUnreachable and FindingContradiction only, otherwise -1 is
+// returned.
+func (x Finding) ShadowedBy() int {
+	return x.shadowedBy
+}
+
+// String returns human-readable description of the problem.
+func (x Finding) String() string {
+	return fmt.Sprintf("record #%d: %s", x.record, x.desc)
+}
+
+// Validate checks the Table for the problems that make records ineffective or
+// unexpected: unknown enum values, records without targets, invalid targets
+// and filters, records shadowed by earlier ones (e.g. catch-all rules). A
+// record is shadowed if some previous record has the same operation, matches
+// all its targets and has a subset of its filters. Validate returns all
+// detected problems in the order of records, nil means no problems.
+//
+// Note that Validate doesn't fail on the problems, the Table remains
+// encodable and usable as is.
+func (t Table) Validate() []Finding {
+	var res []Finding
+
+	for i := range t.records {
+		res = append(res, recordFindings(t.records[i], i)...)
+
+		for j := 0; j < i; j++ {
+			if !shadows(t.records[j], t.records[i]) {
+				continue
+			}
+
+			f := Finding{
+				typ:        FindingUnreachable,
+				record:     i,
+				shadowedBy: j,
+				desc:       fmt.Sprintf("unreachable: shadowed by record #%d", j),
+			}
+
+			if t.records[j].action != t.records[i].action {
+				f.typ = FindingContradiction
+				f.desc = fmt.Sprintf("%s is never applied: shadowed by %s record #%d",
+					t.records[i].action, t.records[j].action, j)
+			}
+
+			res = append(res, f)
+
+			break
+		}
+	}
+
+	return res
+}
+
+// recordFindings returns problems of the record contents.
+func recordFindings(r Record, index int) []Finding {
+	var res []Finding
+
+	add := func(typ FindingType, format string, args ...any) {
+		res = append(res, Finding{
+			typ:        typ,
+			record:     index,
+			shadowedBy: -1,
+			desc:       fmt.Sprintf(format, args...),
+		})
+	}
+
+	if r.action != ActionAllow && r.action != ActionDeny {
+		add(FindingUnknownAction, "unknown action %v", r.action)
+	}
+
+	if r.operation.ToV2() == v2acl.OperationUnknown {
+		add(FindingUnknownOperation, "unknown operation %v", r.operation)
+	}
+
+	if len(r.targets) == 0 {
+		add(FindingEmptyTargets, "missing targets")
+	}
+
+	for i := range r.targets {
+		if keys := r.targets[i].BinaryKeys(); len(keys) != 0 {
+			for j := range keys {
+				if len(keys[j]) == 0 {
+					add(FindingInvalidTarget, "target #%d: empty key #%d", i, j)
+				}
+			}
+		} else if r.targets[i].role.ToV2() == v2acl.RoleUnknown {
+			add(FindingInvalidTarget, "target #%d: neither role nor keys are set", i)
+		}
+	}
+
+	for i := range r.filters {
+		switch f := r.filters[i]; {
+		case f.from.ToV2() == v2acl.HeaderTypeUnknown:
+			add(FindingInvalidFilter, "filter #%d: unknown header type %v", i, f.from)
+		case f.matcher.ToV2() == v2acl.MatchTypeUnknown:
+			add(FindingInvalidFilter, "filter #%d: unknown matcher %v", i, f.matcher)
+		case f.Key() == "":
+			add(FindingInvalidFilter, "filter #%d: missing key", i)
+		}
+	}
+
+	return res
+}
+
+// shadows checks whether any request matching r2 is also matched by r1.
+func shadows(r1, r2 Record) bool {
+	if r1.operation != r2.operation || len(r2.targets) == 0 {
+		return false
+	}
+
+	for i := range r1.filters {
+		var found bool
+		for j := range r2.filters {
+			if found = equalFilters(r1.filters[i], r2.filters[j]); found {
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	for i := range r2.targets {
+		if !targetCovered(r1.targets, r2.targets[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// targetCovered checks whether any subject matching t is matched by some of
+// the targets.
+func targetCovered(targets []Target, t Target) bool {
+	keys := t.BinaryKeys()
+	if len(keys) == 0 {
+		for i := range targets {
+			if len(targets[i].BinaryKeys()) == 0 && targets[i].role == t.role {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// subject with any key has some role, so targets of all roles cover keys
+	var user, system, others bool
+
+	for i := range targets {
+		if len(targets[i].BinaryKeys()) == 0 {
+			switch targets[i].role {
+			case RoleUser:
+				user = true
+			case RoleSystem:
+				system = true
+			case RoleOthers:
+				others = true
+			}
+		}
+	}
+
+	if user && system && others {
+		return true
+	}
+
+nextKey:
+	for i := range keys {
+		for j := range targets {
+			for _, k := range targets[j].BinaryKeys() {
+				if bytes.Equal(k, keys[i]) {
+					continue nextKey
+				}
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,65 @@
+package eacl
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_Validate(t *testing.T) {
+	keys := makeKeys(t, 2)
+	hexKey0, hexKey1 := hex.EncodeToString(keys[0]), hex.EncodeToString(keys[1])
+
+	require.Nil(t, decodeTextTable(t, `allow get obj:a=1 others
+deny get others
+deny put others
+allow put pubkey:`+hexKey0+`
+allow head pubkey:`+hexKey0+`
+deny head pubkey:`+hexKey1).Validate())
+
+	table := decodeTextTable(t, `deny get others
+allow get obj:a=1 others
+deny put obj:a=1 others user
+deny put obj:a=1 obj:b=2 others
+allow delete user system others
+deny delete pubkey:`+hexKey0+`,`+hexKey1+`
+allow head pubkey:`+hexKey0+`,`+hexKey1+`
+allow head pubkey:`+hexKey1+` obj:c=3`)
+
+	var r Record
+	table.AddRecord(&r)
+
+	r = *CreateRecord(ActionAllow, OperationSearch)
+	AddFormedTarget(&r, RoleUnknown)
+	r.AddFilter(HeaderTypeUnknown, MatchStringEqual, "a", "b")
+	r.AddFilter(HeaderFromObject, MatchUnknown, "a", "b")
+	r.AddFilter(HeaderFromObject, MatchStringEqual, "", "b")
+	table.AddRecord(&r)
+
+	type finding struct {
+		typ        FindingType
+		record     int
+		shadowedBy int
+	}
+
+	var res []finding
+	for _, f := range table.Validate() {
+		require.NotEmpty(t, f.String())
+		res = append(res, finding{f.Type(), f.Record(), f.ShadowedBy()})
+	}
+
+	require.Equal(t, []finding{
+		{FindingContradiction, 1, 0},
+		{FindingUnreachable, 3, 2},
+		{FindingContradiction, 5, 4},
+		{FindingUnreachable, 7, 6},
+		{FindingUnknownAction, 8, -1},
+		{FindingUnknownOperation, 8, -1},
+		{FindingEmptyTargets, 8, -1},
+		{FindingInvalidTarget, 9, -1},
+		{FindingInvalidFilter, 9, -1},
+		{FindingInvalidFilter, 9, -1},
+		{FindingInvalidFilter, 9, -1},
+	}, res)
+}
@@ -2,12 +2,27 @@ package eacl
 
 import (
 	"bytes"
+	"math/big"
 )
 
 // Validator is a tool that calculates
 // the action on a request according
 // to the extended ACL rule table.
 type Validator struct {
+	customFilters map[customFilterKey]FilterEvaluator
+}
+
+// FilterEvaluator is a function checking whether filter matches given headers
+// of the filter type. Headers are passed as is without preliminary selection
+// by the filter key, so the function can implement arbitrary logic (e.g.
+// computed or tenant-specific headers). FilterEvaluator MUST NOT modify
+// headers.
+type FilterEvaluator func(f Filter, headers []Header) bool
+
+// customFilterKey is a key of the custom FilterEvaluator.
+type customFilterKey struct {
+	from FilterHeaderType
+	key  string
 }
 
 // NewValidator creates and initializes a new Validator using options.
@@ -15,6 +30,33 @@ func NewValidator() *Validator {
 	return &Validator{}
 }
 
+// WithFilterEvaluator configures Validator to evaluate filters with given
+// header type and key using f instead of the built-in matchers. Evaluators
+// affect local evaluation only and have nothing to do with the eACL encoding,
+// so tables with such filters remain transmittable and are checked by the
+// built-in matchers in the NeoFS network. Repeated calls for the same filter
+// overwrite previous evaluator, nil f removes it.
+//
+// WithFilterEvaluator MUST NOT be called concurrently with CalculateAction.
+func (v *Validator) WithFilterEvaluator(from FilterHeaderType, key string, f FilterEvaluator) *Validator {
+	if v != nil {
+		k := customFilterKey{from: from, key: key}
+
+		if f == nil {
+			delete(v.customFilters, k)
+			return v
+		}
+
+		if v.customFilters == nil {
+			v.customFilters = make(map[customFilterKey]FilterEvaluator)
+		}
+
+		v.customFilters[k] = f
+	}
+
+	return v
+}
+
 // CalculateAction calculates action on the request according
 // to its information represented in ValidationUnit.
 //
@@ -38,7 +80,7 @@ func (v *Validator) CalculateAction(unit *ValidationUnit) (Action, bool) {
 		}
 
 		// check headers
-		switch val := matchFilters(unit.hdrSrc, record.Filters()); {
+		switch val := v.matchFilters(unit.hdrSrc, record.Filters()); {
 		case val < 0:
 			// headers of some type could not be composed => allow
 			return ActionAllow, false
@@ -54,7 +96,7 @@ func (v *Validator) CalculateAction(unit *ValidationUnit) (Action, bool) {
 //   - positive value if no matching header is found for at least one filter;
 //   - zero if at least one suitable header is found for all filters;
 //   - negative value if the headers of at least one filter cannot be obtained.
-func matchFilters(hdrSrc TypedHeaderSource, filters []Filter) int {
+func (v *Validator) matchFilters(hdrSrc TypedHeaderSource, filters []Filter) int {
 	matched := 0
 
 	for _, filter := range filters {
@@ -63,6 +105,22 @@ func matchFilters(hdrSrc TypedHeaderSource, filters []Filter) int {
 			return -1
 		}
 
+		if f, ok := v.customFilters[customFilterKey{from: filter.From(), key: filter.Key()}]; ok {
+			if f(filter, headers) {
+				matched++
+			}
+
+			continue
+		}
+
+		if filter.Matcher() == MatchNotPresent {
+			if !headerPresent(headers, filter.Key()) {
+				matched++
+			}
+
+			continue
+		}
+
 		// get headers of filtering type
 		for _, header := range headers {
 			// prevent NPE
@@ -119,6 +177,17 @@ func targetMatches(unit *ValidationUnit, record *Record) bool {
 	return false
 }
 
+// headerPresent checks whether there is a header with the given key.
+func headerPresent(headers []Header, key string) bool {
+	for _, header := range headers {
+		if header != nil && header.Key() == key {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Maps match type to corresponding function.
 var mMatchFns = map[Match]func(Header, *Filter) bool{
 	MatchStringEqual: func(header Header, filter *Filter) bool {
@@ -128,4 +197,40 @@ var mMatchFns = map[Match]func(Header, *Filter) bool{
 	MatchStringNotEqual: func(header Header, filter *Filter) bool {
 		return header.Value() != filter.Value()
 	},
+
+	MatchNumGT: func(header Header, filter *Filter) bool {
+		c, ok := compareNumeric(header, filter)
+		return ok && c > 0
+	},
+
+	MatchNumGE: func(header Header, filter *Filter) bool {
+		c, ok := compareNumeric(header, filter)
+		return ok && c >= 0
+	},
+
+	MatchNumLT: func(header Header, filter *Filter) bool {
+		c, ok := compareNumeric(header, filter)
+		return ok && c < 0
+	},
+
+	MatchNumLE: func(header Header, filter *Filter) bool {
+		c, ok := compareNumeric(header, filter)
+		return ok && c <= 0
+	},
+}
+
+// compareNumeric compares header and filter values as base-10 integers.
+// Second return value is false if any of the values is not an integer.
+func compareNumeric(header Header, filter *Filter) (int, bool) {
+	var h, f big.Int
+
+	if _, ok := h.SetString(header.Value(), 10); !ok {
+		return 0, false
+	}
+
+	if _, ok := f.SetString(filter.Value(), 10); !ok {
+		return 0, false
+	}
+
+	return h.Cmp(&f), true
 }
@@ -2,6 +2,7 @@ package eacl
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -124,6 +125,75 @@ func TestFilterMatch(t *testing.T) {
 		hs.obj = makeHeaders("a", "xxx")
 		checkAction(t, ActionDeny, v, vu)
 	})
+
+	t.Run("numeric", func(t *testing.T) {
+		for _, tc := range []struct {
+			m     Match
+			value string
+			hdr   string
+			match bool
+		}{
+			{m: MatchNumGT, value: "10", hdr: "11", match: true},
+			{m: MatchNumGT, value: "10", hdr: "10"},
+			{m: MatchNumGE, value: "10", hdr: "10", match: true},
+			{m: MatchNumGE, value: "10", hdr: "9"},
+			{m: MatchNumLT, value: "10", hdr: "9", match: true},
+			{m: MatchNumLT, value: "10", hdr: "10"},
+			{m: MatchNumLE, value: "10", hdr: "10", match: true},
+			{m: MatchNumLE, value: "10", hdr: "11"},
+			{m: MatchNumGT, value: "18446744073709551615", hdr: "18446744073709551616", match: true},
+			{m: MatchNumLT, value: "0", hdr: "-1", match: true},
+			{m: MatchNumGE, value: "10", hdr: "abc"},
+			{m: MatchNumLE, value: "abc", hdr: "10"},
+		} {
+			tb := NewTable()
+			r := newRecord(ActionDeny, OperationUnknown, tgt)
+			r.AddFilter(HeaderFromObject, tc.m, "a", tc.value)
+			tb.AddRecord(r)
+			tb.AddRecord(newRecord(ActionAllow, OperationUnknown, tgt))
+
+			vu := newValidationUnit(RoleOthers, nil, tb)
+			vu.hdrSrc = headers{obj: makeHeaders("a", tc.hdr)}
+
+			exp := ActionAllow
+			if tc.match {
+				exp = ActionDeny
+			}
+
+			action, ok := NewValidator().CalculateAction(vu)
+			require.True(t, ok)
+			require.Equal(t, exp, action, "%v %s %s", tc.m, tc.value, tc.hdr)
+		}
+	})
+
+	t.Run("not present", func(t *testing.T) {
+		tb := NewTable()
+		r := newRecord(ActionDeny, OperationPut, tgt)
+		r.AddObjectAttributeAbsentFilter("__NEOFS__EXPIRATION_EPOCH")
+		tb.AddRecord(r)
+		r = newRecord(ActionDeny, OperationGet, tgt)
+		r.AddRequestHeaderAbsentFilter("X-Token")
+		tb.AddRecord(r)
+
+		for _, tc := range []struct {
+			op   Operation
+			hdrs headers
+			exp  Action
+		}{
+			{op: OperationPut, hdrs: headers{obj: makeHeaders("a", "b")}, exp: ActionDeny},
+			{op: OperationPut, hdrs: headers{obj: makeHeaders("__NEOFS__EXPIRATION_EPOCH", "10")}, exp: ActionAllow},
+			{op: OperationPut, hdrs: headers{obj: makeHeaders("__NEOFS__EXPIRATION_EPOCH", "")}, exp: ActionAllow},
+			{op: OperationGet, hdrs: headers{req: makeHeaders("X-Other", "1")}, exp: ActionDeny},
+			{op: OperationGet, hdrs: headers{req: makeHeaders("X-Token", "1")}, exp: ActionAllow},
+		} {
+			vu := newValidationUnit(RoleOthers, nil, tb)
+			vu.op = tc.op
+			vu.hdrSrc = tc.hdrs
+
+			action, _ := NewValidator().CalculateAction(vu)
+			require.Equal(t, tc.exp, action, "%v %v", tc.op, tc.hdrs)
+		}
+	})
 }
 
 func TestOperationMatch(t *testing.T) {
@@ -250,3 +320,52 @@ func newValidationUnit(role Role, key []byte, table *Table) *ValidationUnit {
 		WithSenderKey(key).
 		WithEACLTable(table)
 }
+
+func TestValidator_WithFilterEvaluator(t *testing.T) {
+	tgt := *NewTarget()
+	tgt.SetRole(RoleOthers)
+
+	tb := NewTable()
+	r := newRecord(ActionDeny, OperationUnknown, tgt)
+	r.AddFilterRequestHeader("X-Tenant", MatchStringEqual, "blocked")
+	tb.AddRecord(r)
+
+	vu := newValidationUnit(RoleOthers, nil, tb)
+	vu.hdrSrc = headers{req: makeHeaders("X-Tenant-ID", "blocked-42")}
+
+	v := NewValidator()
+
+	action, ok := v.CalculateAction(vu)
+	require.False(t, ok)
+	require.Equal(t, ActionAllow, action)
+
+	var called int
+
+	v.WithFilterEvaluator(HeaderFromRequest, "X-Tenant", func(f Filter, hs []Header) bool {
+		called++
+		require.Equal(t, "X-Tenant", f.Key())
+
+		for i := range hs {
+			if hs[i].Key() == "X-Tenant-ID" && strings.HasPrefix(hs[i].Value(), f.Value()) {
+				return true
+			}
+		}
+
+		return false
+	})
+
+	action, ok = v.CalculateAction(vu)
+	require.True(t, ok)
+	require.Equal(t, ActionDeny, action)
+	require.Equal(t, 1, called)
+
+	// other header types are not affected
+	v.WithFilterEvaluator(HeaderFromObject, "X-Tenant", func(Filter, []Header) bool { return false })
+	action, _ = v.CalculateAction(vu)
+	require.Equal(t, ActionDeny, action)
+
+	v.WithFilterEvaluator(HeaderFromRequest, "X-Tenant", nil)
+	action, ok = v.CalculateAction(vu)
+	require.False(t, ok)
+	require.Equal(t, ActionAllow, action)
+}
@@ -0,0 +1,268 @@
+package eacl
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// YAML form of the eACL is intended for human-edited configuration files:
+//
+//	container: <base58 container ID>
+//	records:
+//	  - action: ALLOW
+//	    operation: GET
+//	    filters:
+//	      - header: OBJECT
+//	        key: Type
+//	        match: STRING_EQUAL
+//	        value: public
+//	    targets:
+//	      - role: OTHERS
+//	      - keys:
+//	          - <hex public key>
+//
+// Enumerations are written in the EncodeToString form of the corresponding
+// types. Version of the eACL format is not encoded: decoded tables always
+// have version.Current(). Container is optional.
+//
+// Table, Record and Filter can be embedded into the configuration structures
+// of the application: their YAML methods are called by the gopkg.in/yaml
+// decoders.
+
+// yamlTable is a YAML representation of the Table.
+type yamlTable struct {
+	Container string       `yaml:"container,omitempty"`
+	Records   []yamlRecord `yaml:"records"`
+}
+
+// yamlRecord is a YAML representation of the Record.
+type yamlRecord struct {
+	Action    string       `yaml:"action"`
+	Operation string       `yaml:"operation"`
+	Filters   []yamlFilter `yaml:"filters,omitempty"`
+	Targets   []yamlTarget `yaml:"targets"`
+}
+
+// yamlFilter is a YAML representation of the Filter.
+type yamlFilter struct {
+	Header string `yaml:"header"`
+	Key    string `yaml:"key"`
+	Match  string `yaml:"match"`
+	Value  string `yaml:"value"`
+}
+
+// yamlTarget is a YAML representation of the Target. Role is omitted for the
+// targets by keys.
+type yamlTarget struct {
+	Role string   `yaml:"role,omitempty"`
+	Keys []string `yaml:"keys,omitempty"`
+}
+
+// MarshalYAML returns YAML representation of the Table.
+//
+// See also UnmarshalYAML.
+func (t Table) MarshalYAML() (any, error) {
+	var res yamlTable
+
+	if cnr, ok := t.CID(); ok {
+		res.Container = cnr.EncodeToString()
+	}
+
+	res.Records = make([]yamlRecord, len(t.records))
+	for i := range t.records {
+		res.Records[i] = t.records[i].toYAML()
+	}
+
+	return res, nil
+}
+
+// UnmarshalYAML decodes Table from its YAML representation. Enumerations
+// MUST have known values.
+//
+// See also MarshalYAML.
+func (t *Table) UnmarshalYAML(unmarshal func(any) error) error {
+	var src yamlTable
+	if err := unmarshal(&src); err != nil {
+		return err
+	}
+
+	var res Table
+	res.version = version.Current()
+
+	if src.Container != "" {
+		var cnr cid.ID
+		if err := cnr.DecodeString(src.Container); err != nil {
+			return fmt.Errorf("invalid container: %w", err)
+		}
+
+		res.cid = &cnr
+	}
+
+	if len(src.Records) > 0 {
+		res.records = make([]Record, len(src.Records))
+		for i := range src.Records {
+			if err := res.records[i].fromYAML(src.Records[i]); err != nil {
+				return fmt.Errorf("invalid record #%d: %w", i, err)
+			}
+		}
+	}
+
+	*t = res
+
+	return nil
+}
+
+// MarshalYAML returns YAML representation of the Record.
+//
+// See also UnmarshalYAML.
+func (r Record) MarshalYAML() (any, error) {
+	return r.toYAML(), nil
+}
+
+// UnmarshalYAML decodes Record from its YAML representation. Enumerations
+// MUST have known values.
+//
+// See also MarshalYAML.
+func (r *Record) UnmarshalYAML(unmarshal func(any) error) error {
+	var src yamlRecord
+	if err := unmarshal(&src); err != nil {
+		return err
+	}
+
+	var res Record
+	if err := res.fromYAML(src); err != nil {
+		return err
+	}
+
+	*r = res
+
+	return nil
+}
+
+// MarshalYAML returns YAML representation of the Filter.
+//
+// See also UnmarshalYAML.
+func (f Filter) MarshalYAML() (any, error) {
+	return f.toYAML(), nil
+}
+
+// UnmarshalYAML decodes Filter from its YAML representation. Enumerations
+// MUST have known values.
+//
+// See also MarshalYAML.
+func (f *Filter) UnmarshalYAML(unmarshal func(any) error) error {
+	var src yamlFilter
+	if err := unmarshal(&src); err != nil {
+		return err
+	}
+
+	var res Filter
+	if err := res.fromYAML(src); err != nil {
+		return err
+	}
+
+	*f = res
+
+	return nil
+}
+
+func (r Record) toYAML() yamlRecord {
+	res := yamlRecord{
+		Action:    r.action.EncodeToString(),
+		Operation: r.operation.EncodeToString(),
+		Targets:   make([]yamlTarget, len(r.targets)),
+	}
+
+	if len(r.filters) > 0 {
+		res.Filters = make([]yamlFilter, len(r.filters))
+		for i := range r.filters {
+			res.Filters[i] = r.filters[i].toYAML()
+		}
+	}
+
+	for i := range r.targets {
+		keys := r.targets[i].BinaryKeys()
+		if len(keys) == 0 {
+			res.Targets[i].Role = r.targets[i].role.EncodeToString()
+			continue
+		}
+
+		res.Targets[i].Keys = make([]string, len(keys))
+		for j := range keys {
+			res.Targets[i].Keys[j] = hex.EncodeToString(keys[j])
+		}
+	}
+
+	return res
+}
+
+func (r *Record) fromYAML(src yamlRecord) error {
+	if !r.action.DecodeString(src.Action) {
+		return fmt.Errorf("invalid action %q", src.Action)
+	}
+
+	if !r.operation.DecodeString(src.Operation) {
+		return fmt.Errorf("invalid operation %q", src.Operation)
+	}
+
+	if len(src.Filters) > 0 {
+		r.filters = make([]Filter, len(src.Filters))
+		for i := range src.Filters {
+			if err := r.filters[i].fromYAML(src.Filters[i]); err != nil {
+				return fmt.Errorf("invalid filter #%d: %w", i, err)
+			}
+		}
+	}
+
+	if len(src.Targets) > 0 {
+		r.targets = make([]Target, len(src.Targets))
+	}
+
+	for i, t := range src.Targets {
+		switch {
+		case t.Role != "" && len(t.Keys) > 0:
+			return fmt.Errorf("invalid target #%d: both role and keys are set", i)
+		case len(t.Keys) > 0:
+			keys := make([][]byte, len(t.Keys))
+			for j := range t.Keys {
+				var err error
+				if keys[j], err = hex.DecodeString(t.Keys[j]); err != nil {
+					return fmt.Errorf("invalid target #%d: invalid key #%d: %w", i, j, err)
+				}
+			}
+
+			r.targets[i].SetBinaryKeys(keys)
+		case !r.targets[i].role.DecodeString(t.Role):
+			return fmt.Errorf("invalid target #%d: invalid role %q", i, t.Role)
+		}
+	}
+
+	return nil
+}
+
+func (f Filter) toYAML() yamlFilter {
+	return yamlFilter{
+		Header: f.from.EncodeToString(),
+		Key:    f.Key(),
+		Match:  f.matcher.EncodeToString(),
+		Value:  f.Value(),
+	}
+}
+
+func (f *Filter) fromYAML(src yamlFilter) error {
+	if !f.from.DecodeString(src.Header) {
+		return fmt.Errorf("invalid header type %q", src.Header)
+	}
+
+	if !f.matcher.DecodeString(src.Match) {
+		return fmt.Errorf("invalid matcher %q", src.Match)
+	}
+
+	f.key.fromString(src.Key)
+	f.value = staticStringer(src.Value)
+
+	return nil
+}
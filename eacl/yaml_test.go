@@ -0,0 +1,121 @@
+package eacl
+
+import (
+	"fmt"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestTable_YAML(t *testing.T) {
+	cnr := cidtest.ID()
+	key := randomPublicKey(t)
+
+	table, err := NewTableBuilder().ForContainer(cnr).
+		AllowGet().ForRole(RoleOthers).WithObjectAttribute(MatchStringEqual, "Type", "public").
+		AllowHead().ForECDSAKeys(key).WithRequestHeader(MatchStringNotEqual, "X-Header", "value").
+		DenyPut().ForRole(RoleUser, RoleOthers).WithObjectAttribute(MatchNumGT, "$Object:payloadLength", "1024").
+		Build()
+	require.NoError(t, err)
+
+	data, err := yaml.Marshal(table)
+	require.NoError(t, err)
+
+	var res Table
+	require.NoError(t, yaml.Unmarshal(data, &res))
+	require.True(t, EqualTables(*table, res))
+	require.Equal(t, version.Current(), res.Version())
+
+	t.Run("human-written", func(t *testing.T) {
+		const src = `
+container: %s
+records:
+  - action: DENY
+    operation: DELETE
+    targets:
+      - role: OTHERS
+  - action: ALLOW
+    operation: GET
+    filters:
+      - header: REQUEST
+        key: X-Access
+        match: STRING_EQUAL
+        value: granted
+    targets:
+      - role: OTHERS
+`
+		var res Table
+		require.NoError(t, yaml.Unmarshal([]byte(fmt.Sprintf(src, cnr.EncodeToString())), &res))
+
+		resCnr, ok := res.CID()
+		require.True(t, ok)
+		require.Equal(t, cnr, resCnr)
+
+		exp := CreateTable(cnr)
+		r := CreateRecord(ActionDeny, OperationDelete)
+		AddFormedTarget(r, RoleOthers)
+		exp.AddRecord(r)
+		r = CreateRecord(ActionAllow, OperationGet)
+		r.AddFilterRequestHeader("X-Access", MatchStringEqual, "granted")
+		AddFormedTarget(r, RoleOthers)
+		exp.AddRecord(r)
+
+		require.True(t, EqualTables(*exp, res))
+	})
+
+	t.Run("without container", func(t *testing.T) {
+		var res Table
+		require.NoError(t, yaml.Unmarshal([]byte("records: []"), &res))
+		_, ok := res.CID()
+		require.False(t, ok)
+		require.Zero(t, res.NumRecords())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct{ name, src string }{
+			{"container", "container: abc"},
+			{"action", "records: [{action: MAYBE, operation: GET, targets: [{role: OTHERS}]}]"},
+			{"operation", "records: [{action: ALLOW, operation: FETCH, targets: [{role: OTHERS}]}]"},
+			{"role", "records: [{action: ALLOW, operation: GET, targets: [{role: ANYONE}]}]"},
+			{"empty target", "records: [{action: ALLOW, operation: GET, targets: [{}]}]"},
+			{"role with keys", "records: [{action: ALLOW, operation: GET, targets: [{role: OTHERS, keys: [ab]}]}]"},
+			{"key", "records: [{action: ALLOW, operation: GET, targets: [{keys: [xyz]}]}]"},
+			{"header", "records: [{action: ALLOW, operation: GET, targets: [{role: OTHERS}], filters: [{header: BODY, key: k, match: STRING_EQUAL, value: v}]}]"},
+			{"match", "records: [{action: ALLOW, operation: GET, targets: [{role: OTHERS}], filters: [{header: OBJECT, key: k, match: LIKE, value: v}]}]"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				var res Table
+				require.Error(t, yaml.Unmarshal([]byte(tc.src), &res))
+			})
+		}
+	})
+}
+
+func TestRecord_YAML(t *testing.T) {
+	r := CreateRecord(ActionDeny, OperationRange)
+	r.AddObjectAttributeFilter(MatchStringEqual, "key", "value")
+	AddFormedTarget(r, RoleSystem)
+	AddFormedTarget(r, 0, *randomPublicKey(t), *randomPublicKey(t))
+
+	data, err := yaml.Marshal(r)
+	require.NoError(t, err)
+
+	var res Record
+	require.NoError(t, yaml.Unmarshal(data, &res))
+	require.True(t, equalRecords(*r, res))
+}
+
+func TestFilter_YAML(t *testing.T) {
+	f := NewFilterRequestHeader("X-Header", MatchStringNotEqual, "value")
+
+	data, err := yaml.Marshal(f)
+	require.NoError(t, err)
+	require.Equal(t, "header: REQUEST\nkey: X-Header\nmatch: STRING_NOT_EQUAL\nvalue: value\n", string(data))
+
+	var res Filter
+	require.NoError(t, yaml.Unmarshal(data, &res))
+	require.Equal(t, f, res)
+}
@@ -68,9 +68,12 @@ func (c *context) setPivot(pivot []byte) {
 	}
 }
 
+// defaultCBF is a container backup factor used when the policy does not set it.
+const defaultCBF = 3
+
 func (c *context) setCBF(cbf uint32) {
 	if cbf == 0 {
-		c.cbf = 3
+		c.cbf = defaultCBF
 	} else {
 		c.cbf = cbf
 	}
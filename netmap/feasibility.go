@@ -0,0 +1,203 @@
+package netmap
+
+import (
+	"crypto/sha256"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// FilterReport describes the result of applying a top-level filter of the
+// PlacementPolicy to the NetMap.
+type FilterReport struct {
+	name string
+
+	matched int
+}
+
+// Name returns name of the filter.
+func (x FilterReport) Name() string {
+	return x.name
+}
+
+// Matched returns number of the network map nodes matching the filter.
+func (x FilterReport) Matched() int {
+	return x.matched
+}
+
+// SelectorReport describes the result of applying a selector of the
+// PlacementPolicy to the NetMap.
+type SelectorReport struct {
+	name, filter string
+
+	matched int
+
+	buckets, required, nodesInBucket int
+}
+
+// Name returns name of the selector. Empty for the implicit selectors of
+// the policies without selectors.
+func (x SelectorReport) Name() string {
+	return x.name
+}
+
+// Filter returns name of the filter applied to the nodes before selection.
+func (x SelectorReport) Filter() string {
+	return x.filter
+}
+
+// Matched returns number of the network map nodes passed the selector filter.
+func (x SelectorReport) Matched() int {
+	return x.matched
+}
+
+// Buckets returns number of the node buckets having enough nodes to be
+// selected. Without bucket attribute, each node forms a separate bucket.
+func (x SelectorReport) Buckets() int {
+	return x.buckets
+}
+
+// RequiredBuckets returns number of the node buckets required by the
+// selector.
+func (x SelectorReport) RequiredBuckets() int {
+	return x.required
+}
+
+// NodesInBucket returns minimum number of the nodes in each selected bucket.
+func (x SelectorReport) NodesInBucket() int {
+	return x.nodesInBucket
+}
+
+// Feasible checks whether there are enough buckets to satisfy the selector.
+func (x SelectorReport) Feasible() bool {
+	return x.buckets >= x.required
+}
+
+// PolicyReport describes the result of applying the PlacementPolicy to the
+// NetMap. Its main purpose is to explain why the policy cannot be satisfied.
+//
+// Instances are returned by NetMap.CheckPolicy.
+type PolicyReport struct {
+	err error
+
+	filters []FilterReport
+
+	selectors []SelectorReport
+
+	vectors [][]NodeInfo
+}
+
+// Feasible checks whether the policy can be satisfied by the network map.
+func (x PolicyReport) Feasible() bool {
+	return x.err == nil
+}
+
+// Err returns the error of the container nodes selection, nil if the policy
+// is feasible.
+func (x PolicyReport) Err() error {
+	return x.err
+}
+
+// Filters returns reports of the top-level filters in order of the policy.
+// Filters matching fewer nodes than the network map has eliminated the rest
+// ones.
+func (x PolicyReport) Filters() []FilterReport {
+	return x.filters
+}
+
+// Selectors returns reports of the selectors in order of the policy. If the
+// policy has no selectors, each replica descriptor is reported as an
+// implicit unnamed selector of the whole network map.
+func (x PolicyReport) Selectors() []SelectorReport {
+	return x.selectors
+}
+
+// Vectors returns container nodes as ContainerNodes does. Nil if the policy
+// is infeasible.
+func (x PolicyReport) Vectors() [][]NodeInfo {
+	return x.vectors
+}
+
+// CheckPolicy applies the PlacementPolicy to the NetMap for the referenced
+// container like ContainerNodes does and reports the number of nodes matched
+// by each filter and selector along with the resulting vectors. Filters and
+// selectors are not reported if the policy is invalid.
+func (m NetMap) CheckPolicy(p PlacementPolicy, containerID cid.ID) PolicyReport {
+	var res PolicyReport
+
+	c := newContext(m)
+	c.setCBF(p.backupFactor)
+
+	pivot := make([]byte, sha256.Size)
+	containerID.Encode(pivot)
+	c.setPivot(pivot)
+
+	if res.err = c.processFilters(p); res.err != nil {
+		return res
+	}
+
+	res.filters = make([]FilterReport, len(p.filters))
+
+	for i := range p.filters {
+		res.filters[i].name = p.filters[i].GetName()
+		f := c.processedFilters[res.filters[i].name]
+
+		for j := range m.nodes {
+			if c.match(f, m.nodes[j]) {
+				res.filters[i].matched++
+			}
+		}
+	}
+
+	if len(p.selectors) == 0 {
+		res.selectors = make([]SelectorReport, len(p.replicas))
+
+		for i := range p.replicas {
+			var s netmap.Selector
+			s.SetCount(p.replicas[i].GetCount())
+			s.SetFilter(mainFilterName)
+
+			res.selectors[i] = c.reportSelector(s)
+		}
+	} else {
+		res.selectors = make([]SelectorReport, len(p.selectors))
+
+		for i := range p.selectors {
+			res.selectors[i] = c.reportSelector(p.selectors[i])
+		}
+	}
+
+	res.vectors, res.err = m.ContainerNodes(p, containerID)
+	if res.err != nil {
+		res.vectors = nil
+	}
+
+	return res
+}
+
+// reportSelector returns SelectorReport of the given selector. Filters MUST be
+// processed.
+func (c *context) reportSelector(s netmap.Selector) SelectorReport {
+	res := SelectorReport{
+		name:   s.GetName(),
+		filter: s.GetFilter(),
+	}
+
+	res.required, res.nodesInBucket = calcNodesCount(s)
+
+	if res.filter != mainFilterName && c.processedFilters[res.filter] == nil {
+		return res
+	}
+
+	buckets := c.getSelectionBase(s)
+
+	for i := range buckets {
+		res.matched += len(buckets[i].nodes)
+
+		if len(buckets[i].nodes) >= res.nodesInBucket {
+			res.buckets++
+		}
+	}
+
+	return res
+}
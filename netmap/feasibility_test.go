@@ -0,0 +1,126 @@
+package netmap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetMap_CheckPolicy(t *testing.T) {
+	var nm NetMap
+	nm.SetNodes([]NodeInfo{
+		nodeInfoFromAttributes("Country", "Russia", "City", "SPB"),
+		nodeInfoFromAttributes("Country", "Russia", "City", "Moscow"),
+		nodeInfoFromAttributes("Country", "Germany", "City", "Berlin"),
+		nodeInfoFromAttributes("Country", "France", "City", "Paris"),
+	})
+
+	cnr := cidtest.ID()
+
+	t.Run("feasible", func(t *testing.T) {
+		p := newPlacementPolicy(1,
+			[]ReplicaDescriptor{newReplica(2, "RU")},
+			[]Selector{newSelector("RU", "City", 2, "FromRU", (*Selector).SelectDistinct)},
+			[]Filter{newFilter("FromRU", "Country", "Russia", netmap.EQ)})
+
+		r := nm.CheckPolicy(p, cnr)
+		require.True(t, r.Feasible())
+		require.NoError(t, r.Err())
+
+		require.Len(t, r.Filters(), 1)
+		require.Equal(t, "FromRU", r.Filters()[0].Name())
+		require.Equal(t, 2, r.Filters()[0].Matched())
+
+		require.Len(t, r.Selectors(), 1)
+		s := r.Selectors()[0]
+		require.Equal(t, "RU", s.Name())
+		require.Equal(t, "FromRU", s.Filter())
+		require.Equal(t, 2, s.Matched())
+		require.Equal(t, 2, s.Buckets())
+		require.Equal(t, 2, s.RequiredBuckets())
+		require.Equal(t, 1, s.NodesInBucket())
+		require.True(t, s.Feasible())
+
+		exp, err := nm.ContainerNodes(p, cnr)
+		require.NoError(t, err)
+		require.Equal(t, exp, r.Vectors())
+	})
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		p := newPlacementPolicy(1,
+			[]ReplicaDescriptor{newReplica(1, "EU")},
+			[]Selector{
+				newSelector("EU", "Country", 3, "EU", (*Selector).SelectDistinct),
+				newSelector("Any", "", 4, "*", (*Selector).SelectDistinct),
+			},
+			[]Filter{newFilter("EU", "", "", netmap.OR,
+				newFilter("", "Country", "Germany", netmap.EQ),
+				newFilter("", "Country", "France", netmap.EQ),
+			)})
+
+		r := nm.CheckPolicy(p, cnr)
+		require.False(t, r.Feasible())
+		require.True(t, errors.Is(r.Err(), errNotEnoughNodes))
+		require.Nil(t, r.Vectors())
+
+		require.Equal(t, 2, r.Filters()[0].Matched())
+
+		require.Len(t, r.Selectors(), 2)
+		s := r.Selectors()[0]
+		require.Equal(t, 2, s.Matched())
+		require.Equal(t, 2, s.Buckets())
+		require.Equal(t, 3, s.RequiredBuckets())
+		require.False(t, s.Feasible())
+
+		s = r.Selectors()[1]
+		require.Equal(t, mainFilterName, s.Filter())
+		require.Equal(t, 4, s.Matched())
+		require.True(t, s.Feasible())
+	})
+
+	t.Run("same bucket", func(t *testing.T) {
+		p := newPlacementPolicy(1,
+			[]ReplicaDescriptor{newReplica(3, "")},
+			[]Selector{newSelector("S", "Country", 3, "*", (*Selector).SelectSame)},
+			nil)
+
+		r := nm.CheckPolicy(p, cnr)
+		require.False(t, r.Feasible())
+
+		s := r.Selectors()[0]
+		require.Equal(t, 4, s.Matched())
+		require.Equal(t, 0, s.Buckets())
+		require.Equal(t, 1, s.RequiredBuckets())
+		require.Equal(t, 3, s.NodesInBucket())
+	})
+
+	t.Run("no selectors", func(t *testing.T) {
+		p := newPlacementPolicy(1, []ReplicaDescriptor{newReplica(2, ""), newReplica(5, "")}, nil, nil)
+
+		r := nm.CheckPolicy(p, cnr)
+		require.False(t, r.Feasible())
+
+		require.Len(t, r.Selectors(), 2)
+		require.Empty(t, r.Selectors()[0].Name())
+		require.True(t, r.Selectors()[0].Feasible())
+		require.False(t, r.Selectors()[1].Feasible())
+		require.Equal(t, 4, r.Selectors()[1].Buckets())
+		require.Equal(t, 5, r.Selectors()[1].RequiredBuckets())
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		p := newPlacementPolicy(1,
+			[]ReplicaDescriptor{newReplica(1, "")},
+			nil,
+			[]Filter{newFilter("", "Country", "Russia", netmap.EQ)})
+
+		r := nm.CheckPolicy(p, cnr)
+		require.False(t, r.Feasible())
+		require.True(t, errors.Is(r.Err(), errUnnamedTopFilter))
+		require.Empty(t, r.Filters())
+		require.Empty(t, r.Selectors())
+	})
+}
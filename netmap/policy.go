@@ -359,7 +359,10 @@ func (p *PlacementPolicy) AddFilters(fs ...Filter) {
 }
 
 // WriteStringTo encodes PlacementPolicy into human-readably query and writes
-// the result into w. Returns w's errors directly.
+// the result into w. Returns w's errors directly. Filter keys and values which
+// are not identifiers or numbers are double-quoted, nested filters are
+// parenthesized when needed, so the result is decoded by DecodeString into
+// the same policy.
 //
 // See also DecodeString.
 func (p PlacementPolicy) WriteStringTo(w io.StringWriter) (err error) {
@@ -481,7 +484,7 @@ func writeFilterStringTo(w io.StringWriter, f netmap.Filter) error {
 	unspecified := op == 0
 
 	if s = f.GetKey(); s != "" {
-		_, err = w.WriteString(fmt.Sprintf("%s %s %s", s, op, f.GetValue()))
+		_, err = w.WriteString(fmt.Sprintf("%s %s %s", quoteFilterKey(s), op, quoteFilterValue(f.GetValue())))
 		if err != nil {
 			return err
 		}
@@ -501,10 +504,26 @@ func writeFilterStringTo(w io.StringWriter, f netmap.Filter) error {
 			}
 		}
 
+		// AND takes precedence over OR
+		parens := op == netmap.AND && inner[i].GetOp() == netmap.OR
+		if parens {
+			_, err = w.WriteString("(")
+			if err != nil {
+				return err
+			}
+		}
+
 		err = writeFilterStringTo(w, inner[i])
 		if err != nil {
 			return err
 		}
+
+		if parens {
+			_, err = w.WriteString(")")
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	if s = f.GetName(); s != "" && !unspecified {
@@ -517,6 +536,17 @@ func writeFilterStringTo(w io.StringWriter, f netmap.Filter) error {
 	return nil
 }
 
+// EncodeToString encodes PlacementPolicy into human-readably query, e.g.
+// to display it or to store in the configuration.
+//
+// See also WriteStringTo, DecodeString.
+func (p PlacementPolicy) EncodeToString() string {
+	var sb strings.Builder
+	_ = p.WriteStringTo(&sb) // strings.Builder never fails
+
+	return sb.String()
+}
+
 // DecodeString decodes PlacementPolicy from the string composed using
 // WriteStringTo. Returns error if s is malformed.
 func (p *PlacementPolicy) DecodeString(s string) error {
@@ -546,7 +576,7 @@ func (p *PlacementPolicy) DecodeString(s string) error {
 		return errors.New("parsed nil value")
 	}
 
-	if err := validatePolicy(*p); err != nil {
+	if err := validatePolicy(*parsed); err != nil {
 		return fmt.Errorf("invalid policy: %w", err)
 	}
 
@@ -721,8 +751,7 @@ func (p *policyVisitor) VisitFilterKey(ctx *parser.FilterKeyContext) any {
 		return id.GetText()
 	}
 
-	str := ctx.STRING().GetText()
-	return str[1 : len(str)-1]
+	return p.unquote(ctx.STRING().GetText())
 }
 
 func (p *policyVisitor) VisitFilterValue(ctx *parser.FilterValueContext) any {
@@ -734,8 +763,19 @@ func (p *policyVisitor) VisitFilterValue(ctx *parser.FilterValueContext) any {
 		return num.GetText()
 	}
 
-	str := ctx.STRING().GetText()
-	return str[1 : len(str)-1]
+	return p.unquote(ctx.STRING().GetText())
+}
+
+// unquote returns content of the quoted string with resolved escape
+// sequences.
+func (p *policyVisitor) unquote(str string) string {
+	res, err := unquoteFilterString(str)
+	if err != nil {
+		p.reportError(fmt.Errorf("invalid string %s: %w", str, err))
+		return str[1 : len(str)-1]
+	}
+
+	return res
 }
 
 // VisitExpr implements parser.QueryVisitor interface.
@@ -802,3 +842,155 @@ func operationFromString(s string) (op netmap.Operation) {
 
 	return
 }
+
+// tokens of the policy language which can not be used as identifiers.
+var reservedPolicyWords = map[string]struct{}{
+	"AND": {}, "OR": {}, "EQ": {}, "NE": {}, "GE": {}, "GT": {}, "LT": {}, "LE": {},
+	"CBF": {}, "SAME": {}, "DISTINCT": {},
+}
+
+// isPolicyIdent checks whether s may be written into the policy query as an
+// identifier.
+func isPolicyIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || i > 0 && c >= '0' && c <= '9' {
+			continue
+		}
+
+		return false
+	}
+
+	_, reserved := reservedPolicyWords[s]
+
+	return !reserved
+}
+
+// isPolicyNumber checks whether s may be written into the policy query as a
+// number.
+func isPolicyNumber(s string) bool {
+	if s == "0" {
+		return true
+	}
+
+	if s == "" || s[0] == '0' {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func quoteFilterKey(s string) string {
+	if isPolicyIdent(s) {
+		return s
+	}
+
+	return quoteFilterString(s)
+}
+
+func quoteFilterValue(s string) string {
+	if isPolicyIdent(s) || isPolicyNumber(s) {
+		return s
+	}
+
+	return quoteFilterString(s)
+}
+
+// quoteFilterString returns double-quoted s with escaped special characters
+// according to the policy language.
+func quoteFilterString(s string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				sb.WriteString(fmt.Sprintf(`\u%04x`, r))
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+
+	sb.WriteByte('"')
+
+	return sb.String()
+}
+
+// unquoteFilterString returns content of the quoted string of the policy
+// language with resolved escape sequences.
+func unquoteFilterString(s string) (string, error) {
+	s = s[1 : len(s)-1]
+	if !strings.ContainsRune(s, '\\') {
+		return s, nil
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			sb.WriteByte(s[i])
+			continue
+		}
+
+		if i++; i == len(s) {
+			return "", errors.New("unterminated escape sequence")
+		}
+
+		switch c := s[i]; c {
+		case '"', '\'', '\\', '/':
+			sb.WriteByte(c)
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'u':
+			if i+5 > len(s) {
+				return "", errors.New("incomplete unicode escape sequence")
+			}
+
+			r, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape sequence: %w", err)
+			}
+
+			sb.WriteRune(rune(r))
+			i += 4
+		default:
+			return "", fmt.Errorf("invalid escape sequence \\%c", c)
+		}
+	}
+
+	return sb.String(), nil
+}
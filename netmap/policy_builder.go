@@ -0,0 +1,219 @@
+package netmap
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+)
+
+// PolicyBuilder composes PlacementPolicy step by step. Each replica is started
+// by Replicate, each selector - by Select, the following calls configure the
+// last started element:
+//
+//	policy, err := netmap.NewPolicyBuilder().
+//		Replicate(2).In("SPB").
+//		BackupFactor(3).
+//		Select(2).InSame("City").From("Russia").As("SPB").
+//		Filter(russia).
+//		Build()
+//
+// is equivalent to the policy
+//
+//	REP 2 IN SPB
+//	CBF 3
+//	SELECT 2 IN SAME City FROM Russia AS SPB
+//	FILTER Country EQ RU AS Russia
+//
+// Errors are accumulated and returned by Build, so the chain must not be
+// interrupted to check them.
+//
+// Instances should be created using NewPolicyBuilder.
+type PolicyBuilder struct {
+	err error
+
+	policy PlacementPolicy
+
+	// last started element
+	replica  *netmap.Replica
+	selector *netmap.Selector
+}
+
+// NewPolicyBuilder returns new PolicyBuilder of the empty PlacementPolicy.
+func NewPolicyBuilder() *PolicyBuilder {
+	return new(PolicyBuilder)
+}
+
+// Replicate starts new replica descriptor requiring n object replicas. By
+// default, replicas are stored on nodes selected by all selectors, see In.
+func (b *PolicyBuilder) Replicate(n uint32) *PolicyBuilder {
+	var r netmap.Replica
+	r.SetCount(n)
+
+	b.policy.replicas = append(b.policy.replicas, r)
+	b.replica = &b.policy.replicas[len(b.policy.replicas)-1]
+	b.selector = nil
+
+	return b
+}
+
+// In sets name of the selector to store replicas from the last started
+// replica descriptor on.
+func (b *PolicyBuilder) In(selector string) *PolicyBuilder {
+	if b.replica == nil {
+		b.fail("In called before any replica is started")
+		return b
+	}
+
+	b.replica.SetSelector(selector)
+
+	return b
+}
+
+// BackupFactor sets container backup factor, see
+// PlacementPolicy.SetContainerBackupFactor.
+func (b *PolicyBuilder) BackupFactor(f uint32) *PolicyBuilder {
+	b.policy.SetContainerBackupFactor(f)
+	return b
+}
+
+// Select starts new selector of n nodes (buckets of nodes if bucket attribute
+// is set). By default, selector takes nodes from the whole network map, see
+// From.
+func (b *PolicyBuilder) Select(n uint32) *PolicyBuilder {
+	var s netmap.Selector
+	s.SetCount(n)
+	s.SetFilter(mainFilterName)
+
+	b.policy.selectors = append(b.policy.selectors, s)
+	b.selector = &b.policy.selectors[len(b.policy.selectors)-1]
+	b.replica = nil
+
+	return b
+}
+
+// InBucket makes the last started selector to select nodes from the buckets
+// formed by the given attribute.
+func (b *PolicyBuilder) InBucket(attr string) *PolicyBuilder {
+	return b.setBucket("InBucket", netmap.UnspecifiedClause, attr)
+}
+
+// InSame makes the last started selector to select nodes having the same
+// value of the given attribute.
+func (b *PolicyBuilder) InSame(attr string) *PolicyBuilder {
+	return b.setBucket("InSame", netmap.Same, attr)
+}
+
+// InDistinct makes the last started selector to select nodes having distinct
+// values of the given attribute.
+func (b *PolicyBuilder) InDistinct(attr string) *PolicyBuilder {
+	return b.setBucket("InDistinct", netmap.Distinct, attr)
+}
+
+// From sets name of the filter applied to the nodes before selection by the
+// last started selector.
+func (b *PolicyBuilder) From(filter string) *PolicyBuilder {
+	if b.lastSelector("From") {
+		b.selector.SetFilter(filter)
+	}
+
+	return b
+}
+
+// As sets name of the last started selector to reference it from the replica
+// descriptors.
+func (b *PolicyBuilder) As(name string) *PolicyBuilder {
+	if b.lastSelector("As") {
+		b.selector.SetName(name)
+	}
+
+	return b
+}
+
+// Filter adds named top-level filters referenced by the selectors.
+func (b *PolicyBuilder) Filter(fs ...Filter) *PolicyBuilder {
+	b.policy.AddFilters(fs...)
+	return b
+}
+
+// Build validates composed PlacementPolicy and returns it. Build returns the
+// first error encountered during composition, if any. The policy MUST have at
+// least one replica descriptor, all replicas and selectors MUST be non-zero,
+// selector names MUST be unique, bucket attributes MUST be set along with
+// SAME and DISTINCT clauses, filters MUST be correct and all references MUST
+// point to the declared elements.
+//
+// PolicyBuilder MUST NOT be used after Build.
+func (b *PolicyBuilder) Build() (PlacementPolicy, error) {
+	if b.err != nil {
+		return PlacementPolicy{}, b.err
+	}
+
+	p := b.policy
+
+	if len(p.replicas) == 0 {
+		return PlacementPolicy{}, errors.New("missing replicas")
+	}
+
+	for i := range p.replicas {
+		if p.replicas[i].GetCount() == 0 {
+			return PlacementPolicy{}, fmt.Errorf("invalid replica #%d: zero number of objects", i)
+		}
+	}
+
+	names := make(map[string]struct{}, len(p.selectors))
+
+	for i := range p.selectors {
+		s := p.selectors[i]
+
+		if s.GetCount() == 0 {
+			return PlacementPolicy{}, fmt.Errorf("invalid selector #%d: zero number of nodes", i)
+		}
+
+		if s.GetClause() != netmap.UnspecifiedClause && s.GetAttribute() == "" {
+			return PlacementPolicy{}, fmt.Errorf("invalid selector #%d: missing bucket attribute for %s clause", i, s.GetClause())
+		}
+
+		if _, ok := names[s.GetName()]; ok {
+			return PlacementPolicy{}, fmt.Errorf("invalid selector #%d: duplicated name '%s'", i, s.GetName())
+		}
+
+		names[s.GetName()] = struct{}{}
+	}
+
+	if err := newContext(NetMap{}).processFilters(p); err != nil {
+		return PlacementPolicy{}, err
+	}
+
+	if err := validatePolicy(p); err != nil {
+		return PlacementPolicy{}, err
+	}
+
+	return p, nil
+}
+
+func (b *PolicyBuilder) setBucket(method string, clause netmap.Clause, attr string) *PolicyBuilder {
+	if b.lastSelector(method) {
+		b.selector.SetClause(clause)
+		b.selector.SetAttribute(attr)
+	}
+
+	return b
+}
+
+// lastSelector checks whether the last started element is a selector. If not,
+// the error describing the calling method is saved.
+func (b *PolicyBuilder) lastSelector(method string) bool {
+	if b.selector == nil {
+		b.fail(method + " called before any selector is started")
+		return false
+	}
+
+	return true
+}
+
+func (b *PolicyBuilder) fail(msg string) {
+	if b.err == nil {
+		b.err = errors.New(msg)
+	}
+}
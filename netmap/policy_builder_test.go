@@ -0,0 +1,98 @@
+package netmap_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyBuilder(t *testing.T) {
+	var ru, spb, msk Filter
+	ru.Equal("Country", "RU")
+	ru.SetName("Russia")
+	spb.Equal("City", "SPB")
+	msk.Equal("City", "MSK")
+
+	var cities Filter
+	cities.SetName("Cities")
+	cities.LogicalOR(spb, msk)
+
+	var good Filter
+	good.SetName("Good")
+	good.NumericGE("Rating", 4)
+
+	p, err := NewPolicyBuilder().
+		Replicate(2).In("SPB").
+		Replicate(1).In("Any").
+		BackupFactor(3).
+		Select(2).InSame("City").From("Russia").As("SPB").
+		Select(3).InDistinct("Location").From("Cities").As("Any").
+		Select(1).InBucket("Country").From("Good").As("Other").
+		Filter(ru, cities, good).
+		Build()
+	require.NoError(t, err)
+
+	var sb strings.Builder
+	require.NoError(t, p.WriteStringTo(&sb))
+	require.Equal(t, `REP 2 IN SPB
+REP 1 IN Any
+CBF 3
+SELECT 2 IN SAME City FROM Russia AS SPB
+SELECT 3 IN DISTINCT Location FROM Cities AS Any
+SELECT 1 IN Country FROM Good AS Other
+FILTER Country EQ RU AS Russia
+FILTER City EQ SPB OR City EQ MSK AS Cities
+FILTER Rating GE 4 AS Good`, sb.String())
+
+	var decoded PlacementPolicy
+	require.NoError(t, decoded.DecodeString(sb.String()))
+	require.Equal(t, decoded, p)
+
+	p, err = NewPolicyBuilder().Replicate(3).Build()
+	require.NoError(t, err)
+
+	sb.Reset()
+	require.NoError(t, p.WriteStringTo(&sb))
+	require.Equal(t, "REP 3", sb.String())
+
+	p, err = NewPolicyBuilder().Replicate(1).Select(2).Build()
+	require.NoError(t, err)
+
+	sb.Reset()
+	require.NoError(t, p.WriteStringTo(&sb))
+	require.Equal(t, "REP 1\nSELECT 2 FROM *", sb.String())
+
+	var unnamed, unknownRef, badNumber Filter
+	unnamed.Equal("k", "v")
+	unknownRef.SetName("F")
+	unknownRef.LogicalAND(Filter{})
+	badNumber.SetName("F")
+	badNumber.Equal("k", "v")
+	badNumber.NumericGT("k", -1)
+
+	for _, tc := range []struct {
+		name string
+		b    *PolicyBuilder
+	}{
+		{name: "no replicas", b: NewPolicyBuilder().Select(1)},
+		{name: "zero replica", b: NewPolicyBuilder().Replicate(0)},
+		{name: "In without replica", b: NewPolicyBuilder().In("X").Replicate(1)},
+		{name: "In after selector", b: NewPolicyBuilder().Replicate(1).Select(1).As("X").In("X")},
+		{name: "As without selector", b: NewPolicyBuilder().Replicate(1).As("X")},
+		{name: "From without selector", b: NewPolicyBuilder().Replicate(1).From("X")},
+		{name: "InSame without selector", b: NewPolicyBuilder().Replicate(1).InSame("X")},
+		{name: "zero selector", b: NewPolicyBuilder().Replicate(1).Select(0)},
+		{name: "clause without attribute", b: NewPolicyBuilder().Replicate(1).Select(1).InDistinct("")},
+		{name: "duplicated selector", b: NewPolicyBuilder().Replicate(1).Select(1).As("X").Select(2).As("X")},
+		{name: "unknown selector", b: NewPolicyBuilder().Replicate(1).In("X").Select(1).As("Y")},
+		{name: "unknown filter", b: NewPolicyBuilder().Replicate(1).Select(1).From("X")},
+		{name: "unnamed filter", b: NewPolicyBuilder().Replicate(1).Filter(unnamed)},
+		{name: "invalid inner filter", b: NewPolicyBuilder().Replicate(1).Filter(unknownRef)},
+		{name: "invalid number", b: NewPolicyBuilder().Replicate(1).Filter(badNumber)},
+	} {
+		_, err := tc.b.Build()
+		require.Error(t, err, tc.name)
+	}
+}
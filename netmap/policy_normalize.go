@@ -0,0 +1,189 @@
+package netmap
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+)
+
+// Normalize returns canonical form of the PlacementPolicy which selects the
+// same nodes. Normalization:
+//   - replaces zero container backup factor with the default one (3);
+//   - replaces unspecified selector clauses with the default DISTINCT one;
+//   - orders the filters by names with the referenced filters preceding the
+//     referencing ones;
+//   - orders the operands of AND and OR filters;
+//   - orders the selectors by names if all replicas reference them by names.
+//
+// Replicas are never reordered since their order defines order of the
+// placement vectors. Normalize does not check validity of the policy, invalid
+// policies remain invalid: in particular, filters referencing the filters
+// defined after them are not reordered. The original PlacementPolicy is not
+// changed.
+//
+// See also Equivalent.
+func (p PlacementPolicy) Normalize() PlacementPolicy {
+	var res PlacementPolicy
+
+	res.backupFactor = p.backupFactor
+	if res.backupFactor == 0 {
+		res.backupFactor = defaultCBF
+	}
+
+	res.replicas = append([]netmap.Replica(nil), p.replicas...)
+
+	res.selectors = append([]netmap.Selector(nil), p.selectors...)
+	for i := range res.selectors {
+		if res.selectors[i].GetClause() == netmap.UnspecifiedClause {
+			res.selectors[i].SetClause(netmap.Distinct)
+		}
+	}
+
+	sortSelectors := true
+	for i := range res.replicas {
+		if res.replicas[i].GetSelector() == "" {
+			// such replicas are bound to the selectors by indices
+			sortSelectors = false
+			break
+		}
+	}
+
+	if sortSelectors {
+		sort.SliceStable(res.selectors, func(i, j int) bool {
+			return res.selectors[i].GetName() < res.selectors[j].GetName()
+		})
+	}
+
+	fs := make([]netmap.Filter, len(p.filters))
+	for i := range p.filters {
+		fs[i] = normalizeFilter(p.filters[i])
+	}
+
+	res.filters = orderFilters(fs)
+
+	return res
+}
+
+// Equivalent checks whether the PlacementPolicy selects the same nodes as the
+// given one, i.e. they are equal in the normalized form. Equivalent is intended
+// to compare policies written differently, e.g. desired and applied ones.
+//
+// See also Normalize.
+func (p PlacementPolicy) Equivalent(p2 PlacementPolicy) bool {
+	return bytes.Equal(p.Normalize().Marshal(), p2.Normalize().Marshal())
+}
+
+// normalizeFilter returns deep copy of the filter with ordered operands of
+// AND and OR operations.
+func normalizeFilter(f netmap.Filter) netmap.Filter {
+	inner := f.GetFilters()
+	if len(inner) == 0 {
+		return f
+	}
+
+	type operand struct {
+		f   netmap.Filter
+		bin []byte
+	}
+
+	ops := make([]operand, len(inner))
+	for i := range inner {
+		ops[i].f = normalizeFilter(inner[i])
+		ops[i].bin = ops[i].f.StableMarshal(nil)
+	}
+
+	if op := f.GetOp(); op == netmap.AND || op == netmap.OR {
+		sort.SliceStable(ops, func(i, j int) bool {
+			return bytes.Compare(ops[i].bin, ops[j].bin) < 0
+		})
+	}
+
+	res := make([]netmap.Filter, len(ops))
+	for i := range ops {
+		res[i] = ops[i].f
+	}
+
+	f.SetFilters(res)
+
+	return f
+}
+
+// orderFilters orders top-level filters by names so that each filter is
+// preceded by the filters it references. If any filter references the one not
+// defined before it, filters are returned in the original order: such policy
+// is invalid, and reordering would make it valid.
+func orderFilters(fs []netmap.Filter) []netmap.Filter {
+	refs := make([][]string, len(fs))
+	defined := make(map[string]struct{}, len(fs))
+
+	for i := range fs {
+		refs[i] = filterReferences(fs[i], nil)
+
+		for _, ref := range refs[i] {
+			if _, ok := defined[ref]; !ok {
+				return fs
+			}
+		}
+
+		defined[fs[i].GetName()] = struct{}{}
+	}
+
+	idx := make([]int, len(fs))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool {
+		return fs[idx[i]].GetName() < fs[idx[j]].GetName()
+	})
+
+	res := make([]netmap.Filter, 0, len(fs))
+	done := make([]bool, len(fs))
+	placed := make(map[string]struct{}, len(fs))
+
+	// original order is the valid one, so each pass places a filter
+	for len(res) < len(fs) {
+		for _, i := range idx {
+			if done[i] {
+				continue
+			}
+
+			ready := true
+			for _, ref := range refs[i] {
+				if _, ok := placed[ref]; !ok {
+					ready = false
+					break
+				}
+			}
+
+			if !ready {
+				continue
+			}
+
+			res = append(res, fs[i])
+			done[i] = true
+			placed[fs[i].GetName()] = struct{}{}
+
+			break // restart to keep the smallest ready name first
+		}
+	}
+
+	return res
+}
+
+// filterReferences appends names of the filters referenced by the inner
+// filters of f.
+func filterReferences(f netmap.Filter, res []string) []string {
+	inner := f.GetFilters()
+
+	for i := range inner {
+		if name := inner[i].GetName(); name != "" {
+			res = append(res, name)
+		}
+
+		res = filterReferences(inner[i], res)
+	}
+
+	return res
+}
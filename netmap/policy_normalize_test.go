@@ -0,0 +1,130 @@
+package netmap_test
+
+import (
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	. "github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func decodePolicy(t *testing.T, s string) PlacementPolicy {
+	var p PlacementPolicy
+	require.NoError(t, p.DecodeString(s))
+	return p
+}
+
+func TestPlacementPolicy_Normalize(t *testing.T) {
+	p := decodePolicy(t, `REP 1 IN Y
+REP 2 IN X
+SELECT 2 IN City FROM B AS Y
+SELECT 1 IN SAME Country FROM * AS X
+FILTER Country EQ Germany AS C
+FILTER City EQ SPB AND Country EQ Russia AS A
+FILTER @A OR @C AS B`)
+
+	require.Equal(t, `REP 1 IN Y
+REP 2 IN X
+CBF 3
+SELECT 1 IN SAME Country FROM * AS X
+SELECT 2 IN DISTINCT City FROM B AS Y
+FILTER City EQ SPB AND Country EQ Russia AS A
+FILTER Country EQ Germany AS C
+FILTER @A OR @C AS B`, p.Normalize().EncodeToString())
+
+	// original policy is not changed
+	require.Equal(t, `REP 1 IN Y
+REP 2 IN X
+SELECT 2 IN City FROM B AS Y
+SELECT 1 IN SAME Country FROM * AS X
+FILTER Country EQ Germany AS C
+FILTER City EQ SPB AND Country EQ Russia AS A
+FILTER @A OR @C AS B`, p.EncodeToString())
+
+	t.Run("referenced filters", func(t *testing.T) {
+		// Z is referenced by A, so it precedes A
+		p := decodePolicy(t, `REP 1
+FILTER Country EQ Russia AS Z
+FILTER @Z AND City EQ SPB AS A
+FILTER Country EQ Germany AS B`)
+
+		require.Equal(t, `REP 1
+CBF 3
+FILTER Country EQ Germany AS B
+FILTER Country EQ Russia AS Z
+FILTER @Z AND City EQ SPB AS A`, p.Normalize().EncodeToString())
+	})
+
+	t.Run("forward reference", func(t *testing.T) {
+		// A references Z defined after it, so the policy is invalid and
+		// the filters are not reordered
+		const invalid = `REP 1
+CBF 3
+FILTER @Z AND City EQ SPB AS A
+FILTER Country EQ Russia AS Z`
+
+		p := decodePolicy(t, invalid)
+		require.Equal(t, invalid, p.Normalize().EncodeToString())
+
+		_, err := NetMap{}.ContainerNodes(p.Normalize(), cidtest.ID())
+		require.ErrorContains(t, err, "filter not found")
+	})
+
+	t.Run("unnamed replicas", func(t *testing.T) {
+		// selectors are bound to such replicas by indices, so they are not reordered
+		p := decodePolicy(t, `REP 1
+CBF 2
+SELECT 1 FROM * AS Y
+SELECT 1 FROM * AS X`)
+
+		require.Equal(t, `REP 1
+CBF 2
+SELECT 1 FROM * AS Y
+SELECT 1 FROM * AS X`, p.Normalize().EncodeToString())
+	})
+}
+
+func TestPlacementPolicy_Equivalent(t *testing.T) {
+	for _, tc := range [][2]string{
+		{"REP 3", "REP 3 CBF 3"},
+		{
+			"REP 1 IN X SELECT 1 IN City FROM * AS X",
+			"REP 1 IN X SELECT 1 IN DISTINCT City FROM * AS X",
+		},
+		{
+			"REP 1 IN X REP 1 IN Y SELECT 1 IN City FROM F AS X SELECT 2 FROM * AS Y FILTER A EQ 1 AND B EQ 2 AS F",
+			"REP 1 IN X REP 1 IN Y SELECT 2 FROM * AS Y SELECT 1 IN City FROM F AS X FILTER B EQ 2 AND A EQ 1 AS F",
+		},
+		{
+			"REP 1 FILTER A EQ 1 AS F FILTER @F OR C EQ 3 AS G FILTER B EQ 2 AS H",
+			"REP 1 FILTER B EQ 2 AS H FILTER A EQ 1 AS F FILTER C EQ 3 OR @F AS G",
+		},
+	} {
+		a, b := decodePolicy(t, tc[0]), decodePolicy(t, tc[1])
+		require.True(t, a.Equivalent(b), tc)
+		require.True(t, b.Equivalent(a), tc)
+	}
+
+	for _, tc := range [][2]string{
+		{"REP 3", "REP 2"},
+		{"REP 3", "REP 3 CBF 2"},
+		{"REP 1 REP 2", "REP 2 REP 1"},
+		{
+			"REP 1 IN X SELECT 1 IN City FROM * AS X",
+			"REP 1 IN X SELECT 1 IN SAME City FROM * AS X",
+		},
+		{
+			"REP 1 FILTER A EQ 1 AND B EQ 2 AS F",
+			"REP 1 FILTER A EQ 1 OR B EQ 2 AS F",
+		},
+		{
+			// forward reference makes the first policy invalid
+			"REP 1 FILTER @F OR C EQ 3 AS G FILTER A EQ 1 AS F",
+			"REP 1 FILTER A EQ 1 AS F FILTER @F OR C EQ 3 AS G",
+		},
+	} {
+		a, b := decodePolicy(t, tc[0]), decodePolicy(t, tc[1])
+		require.False(t, a.Equivalent(b), tc)
+		require.False(t, b.Equivalent(a), tc)
+	}
+}
@@ -65,3 +65,106 @@ func TestPlacementPolicyEncoding(t *testing.T) {
 		require.Equal(t, v, v2)
 	})
 }
+
+func TestPlacementPolicy_EncodeToString(t *testing.T) {
+	// policies as displayed by neofs-cli
+	for _, s := range []string{
+		`REP 3`,
+		`REP 1
+REP 2
+CBF 2`,
+		`REP 2 IN X
+CBF 3
+SELECT 2 FROM * AS X`,
+		`REP 1 IN SPB
+REP 2 IN MSK
+SELECT 1 IN DISTINCT Location FROM SPBLoc AS SPB
+SELECT 2 IN SAME Location FROM MSKLoc AS MSK
+FILTER Location EQ SPB AS SPBLoc
+FILTER Location EQ MSK AS MSKLoc`,
+		`REP 1
+SELECT 1 FROM F
+FILTER (Country EQ RU OR Country EQ DE) AND Rating GE 5 AS F`,
+		`REP 1
+SELECT 1 FROM F
+FILTER Country EQ RU AND (City EQ SPB OR City EQ MSK) AND Rating LT 10 AS F`,
+		`REP 1
+SELECT 1 FROM F
+FILTER Country EQ RU OR City EQ SPB AND Rating GT 0 AS F`,
+		`REP 1
+SELECT 1 FROM F
+FILTER "Node Name" EQ "my node" AND Version NE "1.2.3" AND Code EQ "007" AND Word EQ "AND" AS F`,
+		`REP 1
+SELECT 1 FROM F
+FILTER Quote EQ "a\"b\\c\td" AS F`,
+		`REP 1
+SELECT 1 FROM F
+FILTER Empty EQ "" AND REP EQ SELECT AS F`,
+	} {
+		var p PlacementPolicy
+		require.NoError(t, p.DecodeString(s), s)
+		require.Equal(t, s, p.EncodeToString())
+
+		var p2 PlacementPolicy
+		require.NoError(t, p2.DecodeString(p.EncodeToString()))
+		require.Equal(t, p, p2)
+	}
+
+	t.Run("precedence", func(t *testing.T) {
+		var or, and, f Filter
+		or.LogicalOR(filterEqual("Country", "RU"), filterEqual("Country", "DE"))
+		and.LogicalAND(filterEqual("Country", "RU"), filterEqual("City", "SPB"))
+		f.SetName("F")
+		f.LogicalAND(or, filterEqual("Rating", "5"))
+
+		var f2 Filter
+		f2.SetName("G")
+		f2.LogicalOR(and, filterEqual("Rating", "5"))
+
+		var p PlacementPolicy
+		p.AddReplicas(replica(1))
+		p.AddFilters(f, f2)
+
+		s := p.EncodeToString()
+		require.Equal(t, `REP 1
+FILTER (Country EQ RU OR Country EQ DE) AND Rating EQ 5 AS F
+FILTER Country EQ RU AND City EQ SPB OR Rating EQ 5 AS G`, s)
+
+		var p2 PlacementPolicy
+		require.NoError(t, p2.DecodeString(s))
+		require.Equal(t, p.Marshal(), p2.Marshal())
+	})
+
+	t.Run("escapes", func(t *testing.T) {
+		var p PlacementPolicy
+		require.NoError(t, p.DecodeString(`REP 1
+SELECT 1 FROM F
+FILTER 'single \' quote' EQ "A\/\n" AS F`))
+		require.Equal(t, `REP 1
+SELECT 1 FROM F
+FILTER "single ' quote" EQ "A/\n" AS F`, p.EncodeToString())
+
+		require.Error(t, p.DecodeString(`REP 1
+SELECT 1 FROM F
+FILTER A EQ "\x" AS F`))
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		var p PlacementPolicy
+		require.Error(t, p.DecodeString(`REP 1 IN X`))
+		require.Error(t, p.DecodeString(`REP 1
+SELECT 1 FROM F AS X`))
+	})
+}
+
+func filterEqual(k, v string) Filter {
+	var f Filter
+	f.Equal(k, v)
+	return f
+}
+
+func replica(n uint32) ReplicaDescriptor {
+	var r ReplicaDescriptor
+	r.SetNumberOfObjects(n)
+	return r
+}
@@ -0,0 +1,21 @@
+/*
+Package ns provides functionality of NeoFS name system.
+
+NNS type binds NeoFS containers to the domain names registered in the Neo Name
+Service (NNS) contract deployed in the NeoFS network. Container domain is
+bound by the TXT record holding container ID:
+
+	var n ns.NNS
+	// ...
+
+	var d container.Domain
+	d.SetName("my-container")
+
+	id, err := n.ResolveContainerDomain(d)
+	// ...
+
+RegisterContainerDomain writes the domain into the container, optionally
+registers the domain using neo-go actor (see RegisterOptions) and checks the
+resolution result.
+*/
+package ns
@@ -0,0 +1,217 @@
+package ns
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/nns"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/unwrap"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// Default parameters of the domains registered by RegisterContainerDomain,
+// see RegisterOptions. They match the ones used by the NeoFS Container
+// contract.
+const (
+	DefaultEmail   = "ops@nspcc.ru"
+	DefaultRefresh = 3600
+	DefaultRetry   = 600
+	DefaultExpire  = 3600 * 24 * 365 * 10
+	DefaultTTL     = 3600
+)
+
+// ErrNotFound is returned when the domain is not bound to any container.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrNotFound = errors.New("container not found")
+
+// Invoker describes requirements to the invoker of the read-only NNS contract
+// methods, e.g. [invoker.Invoker] of the neo-go RPC client.
+type Invoker interface {
+	Call(contract util.Uint160, operation string, params ...any) (*result.Invoke, error)
+}
+
+// Actor describes requirements to the actor sending NNS contract
+// transactions, e.g. [actor.Actor] of the neo-go RPC client. Actor's sender
+// becomes the owner of the registered domains.
+type Actor interface {
+	Invoker
+	SendCall(contract util.Uint160, method string, params ...any) (util.Uint256, uint32, error)
+	Wait(h util.Uint256, vub uint32, err error) (*state.AppExecResult, error)
+	Sender() util.Uint160
+}
+
+// RegisterOptions groups parameters of the SOA record of the domains registered
+// by RegisterContainerDomain. Zero RegisterOptions correspond to the Default*
+// constants.
+type RegisterOptions struct {
+	email string
+
+	refresh, retry, expire, ttl uint32
+}
+
+// SetEmail sets email of the domain administrator. Defaults to DefaultEmail.
+func (x *RegisterOptions) SetEmail(email string) {
+	x.email = email
+}
+
+// SetRefresh sets interval in seconds after which the domain records should be
+// refreshed. Defaults to DefaultRefresh.
+func (x *RegisterOptions) SetRefresh(sec uint32) {
+	x.refresh = sec
+}
+
+// SetRetry sets interval in seconds after which the failed refresh should be
+// retried. Defaults to DefaultRetry.
+func (x *RegisterOptions) SetRetry(sec uint32) {
+	x.retry = sec
+}
+
+// SetExpire sets time in seconds after which the domain records are no longer
+// authoritative. Defaults to DefaultExpire.
+func (x *RegisterOptions) SetExpire(sec uint32) {
+	x.expire = sec
+}
+
+// SetTTL sets time in seconds for which the domain records may be cached.
+// Defaults to DefaultTTL.
+func (x *RegisterOptions) SetTTL(sec uint32) {
+	x.ttl = sec
+}
+
+// registerParams returns parameters of the NNS contract 'register' method
+// following the domain name and owner.
+func (x RegisterOptions) registerParams() []any {
+	email := x.email
+	if email == "" {
+		email = DefaultEmail
+	}
+
+	orDefault := func(v uint32, def int64) int64 {
+		if v == 0 {
+			return def
+		}
+		return int64(v)
+	}
+
+	return []any{
+		email,
+		orDefault(x.refresh, DefaultRefresh),
+		orDefault(x.retry, DefaultRetry),
+		orDefault(x.expire, DefaultExpire),
+		orDefault(x.ttl, DefaultTTL),
+	}
+}
+
+// NNS looks up and registers container domains in the NNS contract.
+//
+// Instances should be created using NewNNS.
+type NNS struct {
+	inv Invoker
+
+	contract util.Uint160
+}
+
+// NewNNS constructs NNS working with the NNS contract by the given address
+// using the given Invoker.
+func NewNNS(inv Invoker, contract util.Uint160) NNS {
+	return NNS{inv: inv, contract: contract}
+}
+
+// ResolveContainerDomain looks up container ID by the container domain. Returns
+// [ErrNotFound] if the domain has no TXT record holding container ID.
+func (n NNS) ResolveContainerDomain(d container.Domain) (cid.ID, error) {
+	name := domainName(d)
+
+	records, err := unwrap.ArrayOfUTF8Strings(n.inv.Call(n.contract, "resolve", name, int64(nns.TXT)))
+	if err != nil {
+		return cid.ID{}, fmt.Errorf("resolve %s: %w", name, err)
+	}
+
+	var id cid.ID
+
+	for i := range records {
+		if id.DecodeString(records[i]) == nil {
+			return id, nil
+		}
+	}
+
+	return cid.ID{}, fmt.Errorf("%w by domain %s", ErrNotFound, name)
+}
+
+// RegisterContainerDomain writes the Domain into the container (see
+// [container.Container.WriteDomain]) and binds the resulting container ID to
+// the domain. If act is not nil, the domain is registered in the NNS contract
+// (if it is available) and the TXT record with the container ID is added to it
+// through act. Otherwise, the domain is expected to be registered by the
+// Container contract when the container is saved. Finally, the domain is
+// resolved to check the binding, so without act the container MUST be saved
+// beforehand. Parameters of the registered domain are set by opts.
+//
+// Returns the container ID.
+func (n NNS) RegisterContainerDomain(cnr *container.Container, d container.Domain, act Actor, opts RegisterOptions) (cid.ID, error) {
+	if d.Name() == "" {
+		return cid.ID{}, errors.New("missing domain name")
+	}
+
+	cnr.WriteDomain(d)
+
+	var id cid.ID
+	cnr.CalculateID(&id)
+
+	if act != nil {
+		name := domainName(d)
+
+		available, err := unwrap.Bool(act.Call(n.contract, "isAvailable", name))
+		if err != nil {
+			return id, fmt.Errorf("check availability of %s: %w", name, err)
+		}
+
+		if available {
+			err = send(act, n.contract, "register", append([]any{name, act.Sender()}, opts.registerParams()...)...)
+			if err != nil {
+				return id, fmt.Errorf("register %s: %w", name, err)
+			}
+		}
+
+		if err = send(act, n.contract, "addRecord", name, int64(nns.TXT), id.EncodeToString()); err != nil {
+			return id, fmt.Errorf("add TXT record to %s: %w", name, err)
+		}
+	}
+
+	res, err := n.ResolveContainerDomain(d)
+	if err != nil {
+		return id, err
+	}
+
+	if res != id {
+		return id, fmt.Errorf("domain %s is bound to another container %s", domainName(d), res)
+	}
+
+	return id, nil
+}
+
+// send sends transaction calling the contract method and waits for its
+// successful execution.
+func send(act Actor, contract util.Uint160, method string, params ...any) error {
+	res, err := act.Wait(act.SendCall(contract, method, params...))
+	if err != nil {
+		return err
+	}
+
+	if res.VMState != vmstate.Halt {
+		return fmt.Errorf("transaction failed with %s: %s", res.VMState, res.FaultException)
+	}
+
+	return nil
+}
+
+func domainName(d container.Domain) string {
+	return d.Name() + "." + d.Zone()
+}
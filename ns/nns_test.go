@@ -0,0 +1,251 @@
+package ns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/nns"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ Invoker = (*invoker.Invoker)(nil)
+	_ Actor   = (*actor.Actor)(nil)
+)
+
+// testNNS is an in-memory NNS contract.
+type testNNS struct {
+	contract util.Uint160
+
+	domains map[string][]string
+
+	// fail makes method calls to fail
+	fail map[string]bool
+
+	calls []string
+
+	// parameters of the last 'register' call
+	registerParams []any
+}
+
+func newTestNNS() *testNNS {
+	return &testNNS{
+		contract: util.Uint160{1, 2, 3},
+		domains:  make(map[string][]string),
+		fail:     make(map[string]bool),
+	}
+}
+
+func (x *testNNS) Call(contract util.Uint160, operation string, params ...any) (*result.Invoke, error) {
+	if contract != x.contract {
+		return nil, errors.New("wrong contract")
+	}
+
+	x.calls = append(x.calls, operation)
+
+	if x.fail[operation] {
+		return &result.Invoke{State: vmstate.Fault.String(), FaultException: "some failure"}, nil
+	}
+
+	name := params[0].(string)
+
+	var item stackitem.Item
+
+	switch operation {
+	default:
+		return nil, errors.New("unsupported operation")
+	case "isAvailable":
+		_, ok := x.domains[name]
+		item = stackitem.NewBool(!ok)
+	case "resolve":
+		if params[1] != int64(nns.TXT) {
+			return nil, errors.New("wrong record type")
+		}
+
+		records := x.domains[name]
+		items := make([]stackitem.Item, len(records))
+
+		for i := range records {
+			items[i] = stackitem.NewByteArray([]byte(records[i]))
+		}
+
+		item = stackitem.NewArray(items)
+	}
+
+	return &result.Invoke{State: vmstate.Halt.String(), Stack: []stackitem.Item{item}}, nil
+}
+
+func (x *testNNS) SendCall(contract util.Uint160, method string, params ...any) (util.Uint256, uint32, error) {
+	if contract != x.contract {
+		return util.Uint256{}, 0, errors.New("wrong contract")
+	}
+
+	x.calls = append(x.calls, method)
+
+	if x.fail[method] {
+		return util.Uint256{0xff}, 0, nil
+	}
+
+	name := params[0].(string)
+
+	switch method {
+	default:
+		return util.Uint256{}, 0, errors.New("unsupported method")
+	case "register":
+		x.domains[name] = nil
+		x.registerParams = params
+	case "addRecord":
+		x.domains[name] = append(x.domains[name], params[2].(string))
+	}
+
+	return util.Uint256{}, 0, nil
+}
+
+func (x *testNNS) Wait(h util.Uint256, _ uint32, err error) (*state.AppExecResult, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	res := &state.AppExecResult{Execution: state.Execution{VMState: vmstate.Halt}}
+	if h == (util.Uint256{0xff}) {
+		res.VMState = vmstate.Fault
+	}
+
+	return res, nil
+}
+
+func (x *testNNS) Sender() util.Uint160 {
+	return util.Uint160{4, 5, 6}
+}
+
+func TestNNS_ResolveContainerDomain(t *testing.T) {
+	contract := newTestNNS()
+	n := NewNNS(contract, contract.contract)
+	id := cidtest.ID()
+
+	var d container.Domain
+	d.SetName("name")
+
+	_, err := n.ResolveContainerDomain(d)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	contract.domains["name.container"] = []string{"not a container", id.EncodeToString()}
+
+	res, err := n.ResolveContainerDomain(d)
+	require.NoError(t, err)
+	require.Equal(t, id, res)
+
+	d.SetZone("zone")
+
+	_, err = n.ResolveContainerDomain(d)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	contract.fail["resolve"] = true
+
+	_, err = n.ResolveContainerDomain(d)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNotFound)
+}
+
+func TestNNS_RegisterContainerDomain(t *testing.T) {
+	var d container.Domain
+	d.SetName("name")
+	d.SetZone("zone")
+
+	t.Run("actor", func(t *testing.T) {
+		contract := newTestNNS()
+		n := NewNNS(contract, contract.contract)
+		cnr := containertest.Container(t)
+
+		id, err := n.RegisterContainerDomain(&cnr, d, contract, RegisterOptions{})
+		require.NoError(t, err)
+		require.Equal(t, d, cnr.ReadDomain())
+		require.True(t, cnr.AssertID(id))
+		require.Equal(t, []string{"isAvailable", "register", "addRecord", "resolve"}, contract.calls)
+		require.Equal(t, []any{"name.zone", contract.Sender(), DefaultEmail,
+			int64(DefaultRefresh), int64(DefaultRetry), int64(DefaultExpire), int64(DefaultTTL)}, contract.registerParams)
+
+		// custom options
+		contract = newTestNNS()
+		n = NewNNS(contract, contract.contract)
+		cnr = containertest.Container(t)
+
+		var opts RegisterOptions
+		opts.SetEmail("admin@example.com")
+		opts.SetRefresh(1)
+		opts.SetRetry(2)
+		opts.SetExpire(3)
+		opts.SetTTL(4)
+
+		_, err = n.RegisterContainerDomain(&cnr, d, contract, opts)
+		require.NoError(t, err)
+		require.Equal(t, []any{"name.zone", contract.Sender(), "admin@example.com",
+			int64(1), int64(2), int64(3), int64(4)}, contract.registerParams)
+
+		// already registered domain
+		contract = newTestNNS()
+		contract.domains["name.zone"] = nil
+		n = NewNNS(contract, contract.contract)
+		cnr = containertest.Container(t)
+
+		_, err = n.RegisterContainerDomain(&cnr, d, contract, RegisterOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"isAvailable", "addRecord", "resolve"}, contract.calls)
+	})
+
+	t.Run("without actor", func(t *testing.T) {
+		contract := newTestNNS()
+		n := NewNNS(contract, contract.contract)
+		cnr := containertest.Container(t)
+
+		_, err := n.RegisterContainerDomain(&cnr, d, nil, RegisterOptions{})
+		require.ErrorIs(t, err, ErrNotFound)
+
+		cnr.WriteDomain(d)
+
+		var id cid.ID
+		cnr.CalculateID(&id)
+		contract.domains["name.zone"] = []string{id.EncodeToString()}
+
+		res, err := n.RegisterContainerDomain(&cnr, d, nil, RegisterOptions{})
+		require.NoError(t, err)
+		require.Equal(t, id, res)
+
+		other := containertest.Container(t)
+
+		_, err = n.RegisterContainerDomain(&other, d, nil, RegisterOptions{})
+		require.ErrorContains(t, err, "another container")
+	})
+
+	t.Run("failures", func(t *testing.T) {
+		for _, method := range []string{"isAvailable", "register", "addRecord"} {
+			t.Run(method, func(t *testing.T) {
+				contract := newTestNNS()
+				contract.fail[method] = true
+				n := NewNNS(contract, contract.contract)
+				cnr := containertest.Container(t)
+
+				_, err := n.RegisterContainerDomain(&cnr, d, contract, RegisterOptions{})
+				require.ErrorContains(t, err, "name.zone")
+			})
+		}
+
+		var empty container.Domain
+		cnr := containertest.Container(t)
+		n := NewNNS(newTestNNS(), util.Uint160{})
+
+		_, err := n.RegisterContainerDomain(&cnr, empty, nil, RegisterOptions{})
+		require.Error(t, err)
+	})
+}
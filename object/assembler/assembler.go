@@ -0,0 +1,465 @@
+package assembler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Mode is an enumeration of the Assembler reactions to the integrity
+// violations.
+type Mode uint8
+
+const (
+	// ModeStrict fails the assembly on any integrity violation.
+	ModeStrict Mode = iota
+
+	// ModeBestEffort reports integrity violations to the handler (see
+	// Assembler.SetViolationHandler) and continues the assembly. Unavailable
+	// objects still fail the assembly.
+	ModeBestEffort
+)
+
+// String implements fmt.Stringer.
+func (x Mode) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case ModeStrict:
+		return "STRICT"
+	case ModeBestEffort:
+		return "BEST_EFFORT"
+	}
+}
+
+// ErrIntegrityViolation is returned by Assembler when some object of the
+// split-chain is invalid or does not correspond to the others.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrIntegrityViolation = errors.New("integrity violation")
+
+var errClosed = errors.New("payload reader is closed")
+
+// Assembler assembles objects sliced into split-chains. Assembler must be
+// constructed via New.
+type Assembler struct {
+	src Source
+
+	mode Mode
+
+	violationHandler func(error)
+}
+
+// New constructs Assembler reading objects from the given Source in
+// ModeStrict.
+func New(src Source) *Assembler {
+	return &Assembler{src: src}
+}
+
+// SetMode sets reaction to the integrity violations. Defaults to ModeStrict.
+func (x *Assembler) SetMode(m Mode) {
+	x.mode = m
+}
+
+// SetViolationHandler sets handler of the integrity violations tolerated in
+// ModeBestEffort. Errors passed to the handler match ErrIntegrityViolation.
+func (x *Assembler) SetViolationHandler(f func(error)) {
+	x.violationHandler = f
+}
+
+// violation returns error of the integrity violation in ModeStrict, otherwise
+// passes it to the handler and returns nil.
+func (x *Assembler) violation(format string, args ...any) error {
+	err := fmt.Errorf("%w: %s", ErrIntegrityViolation, fmt.Sprintf(format, args...))
+	if x.mode == ModeStrict {
+		return err
+	}
+
+	if x.violationHandler != nil {
+		x.violationHandler(err)
+	}
+
+	return nil
+}
+
+// checkHeader checks ID and signature of the object header.
+func (x *Assembler) checkHeader(hdr object.Object, expected oid.ID) error {
+	id, ok := hdr.ID()
+	if !ok || id != expected {
+		return x.violation("object ID %s instead of %s", id, expected)
+	}
+
+	if err := hdr.CheckHeaderVerificationFields(); err != nil {
+		return x.violation("object %s: %v", expected, err)
+	}
+
+	return nil
+}
+
+// Assemble returns header of the referenced root object and stream of its
+// payload. The object may be either sliced into split-chain or stored as is.
+// Payload stream MUST be closed after use.
+//
+// Objects of the split-chain are read sequentially while reading the payload,
+// so the integrity violations are detected on the payload reading too.
+// Payload stream returns io.EOF only after all checks, payload read before
+// MUST NOT be trusted.
+//
+// Errors of the integrity violations match ErrIntegrityViolation.
+func (x *Assembler) Assemble(ctx context.Context, addr oid.Address) (object.Object, io.ReadCloser, error) {
+	hdr, r, err := x.src.Get(ctx, addr)
+	if err == nil {
+		if err = x.checkHeader(hdr, addr.Object()); err != nil {
+			_ = r.Close()
+			return object.Object{}, nil, err
+		}
+
+		res := x.newChainReader(ctx, addr, []oid.ID{addr.Object()}, nil, nil)
+		res.start(hdr, r)
+
+		return hdr, res, nil
+	}
+
+	var errSplit *object.SplitInfoError
+	if !errors.As(err, &errSplit) {
+		return object.Object{}, nil, fmt.Errorf("get object: %w", err)
+	}
+
+	si := errSplit.SplitInfo()
+
+	children, parent, err := x.collectChildren(ctx, addr, *si)
+	if err != nil {
+		return object.Object{}, nil, err
+	}
+
+	var root object.Object
+
+	if parent != nil {
+		if err = x.checkHeader(*parent, addr.Object()); err != nil {
+			return object.Object{}, nil, err
+		}
+
+		root = *parent
+	} else {
+		if err = x.violation("missing root header in the split-chain"); err != nil {
+			return object.Object{}, nil, err
+		}
+
+		cnr := addr.Container()
+		root.SetContainerID(cnr)
+		root.SetID(addr.Object())
+	}
+
+	res := x.newChainReader(ctx, addr, children, parent, si.SplitID())
+	_, res.linked = si.Link()
+
+	return root, res, nil
+}
+
+// collectChildren returns split-chain of the root object in order along with
+// the root header.
+func (x *Assembler) collectChildren(ctx context.Context, addr oid.Address, si object.SplitInfo) ([]oid.ID, *object.Object, error) {
+	var childAddr oid.Address
+	childAddr.SetContainer(addr.Container())
+
+	if link, ok := si.Link(); ok {
+		childAddr.SetObject(link)
+
+		hdr, err := x.src.Head(ctx, childAddr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get linking object %s header: %w", link, err)
+		}
+
+		if err = x.checkHeader(hdr, link); err != nil {
+			return nil, nil, err
+		}
+
+		children := hdr.Children()
+		if len(children) == 0 {
+			return nil, nil, fmt.Errorf("linking object %s has no children", link)
+		}
+
+		return children, hdr.Parent(), nil
+	}
+
+	last, ok := si.LastPart()
+	if !ok {
+		return nil, nil, errors.New("missing split-chain references in split info")
+	}
+
+	var parent *object.Object
+
+	children := []oid.ID{last}
+	mChildren := map[oid.ID]struct{}{last: {}}
+
+	for id := last; ; {
+		childAddr.SetObject(id)
+
+		hdr, err := x.src.Head(ctx, childAddr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get split-chain element %s header: %w", id, err)
+		}
+
+		if err = x.checkHeader(hdr, id); err != nil {
+			return nil, nil, err
+		}
+
+		if id == last {
+			parent = hdr.Parent()
+		}
+
+		prev, ok := hdr.PreviousID()
+		if !ok {
+			break
+		}
+
+		if _, ok = mChildren[prev]; ok {
+			return nil, nil, fmt.Errorf("split-chain is cycled on %s", prev)
+		}
+
+		mChildren[prev] = struct{}{}
+		children = append([]oid.ID{prev}, children...)
+		id = prev
+	}
+
+	return children, parent, nil
+}
+
+// chainReader reads payload of the split-chain elements sequentially checking
+// them.
+type chainReader struct {
+	ctx context.Context
+
+	a *Assembler
+
+	addr oid.Address
+
+	children []oid.ID
+
+	// nil in the best-effort mode if unavailable
+	parent *object.Object
+
+	splitID *object.SplitID
+
+	// true if children are listed by the linking object. In this case,
+	// elements may omit references to the previous ones (e.g. parts of the
+	// multipart upload written independently).
+	linked bool
+
+	// index of the next element
+	next int
+
+	cur io.ReadCloser
+
+	curHdr object.Object
+
+	curHash hash.Hash
+
+	curLen uint64
+
+	rootHash hash.Hash
+
+	rootLen uint64
+
+	// nil if any element has no homomorphic hash
+	homo []checksum.Checksum
+
+	err error
+}
+
+func (x *Assembler) newChainReader(ctx context.Context, addr oid.Address, children []oid.ID, parent *object.Object, splitID *object.SplitID) *chainReader {
+	return &chainReader{
+		ctx:      ctx,
+		a:        x,
+		addr:     addr,
+		children: children,
+		parent:   parent,
+		splitID:  splitID,
+		rootHash: sha256.New(),
+		homo:     make([]checksum.Checksum, 0, len(children)),
+	}
+}
+
+func (x *chainReader) Read(p []byte) (int, error) {
+	for {
+		if x.err != nil {
+			return 0, x.err
+		}
+
+		if x.cur == nil {
+			if x.next == len(x.children) {
+				if x.err = x.finish(); x.err == nil {
+					x.err = io.EOF
+				}
+			} else {
+				x.err = x.open()
+			}
+
+			continue
+		}
+
+		n, err := x.cur.Read(p)
+		x.curHash.Write(p[:n])
+		x.rootHash.Write(p[:n])
+		x.curLen += uint64(n)
+		x.rootLen += uint64(n)
+
+		if errors.Is(err, io.EOF) {
+			x.err = x.closeCurrent()
+		} else if err != nil {
+			x.err = fmt.Errorf("read split-chain element %s payload: %w", x.children[x.next-1], err)
+		}
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// open opens the next split-chain element.
+func (x *chainReader) open() error {
+	id := x.children[x.next]
+
+	var addr oid.Address
+	addr.SetContainer(x.addr.Container())
+	addr.SetObject(id)
+
+	hdr, r, err := x.a.src.Get(x.ctx, addr)
+	if err != nil {
+		return fmt.Errorf("get split-chain element %s: %w", id, err)
+	}
+
+	err = x.checkRelations(hdr, id)
+	if err != nil {
+		_ = r.Close()
+		return err
+	}
+
+	x.start(hdr, r)
+
+	return nil
+}
+
+// checkRelations checks split-chain element relations to the others and the
+// root object.
+func (x *chainReader) checkRelations(hdr object.Object, id oid.ID) error {
+	if err := x.a.checkHeader(hdr, id); err != nil {
+		return err
+	}
+
+	if x.next > 0 {
+		if prev, ok := hdr.PreviousID(); ok && prev != x.children[x.next-1] || !ok && !x.linked {
+			if err := x.a.violation("split-chain element %s does not reference previous %s", id, x.children[x.next-1]); err != nil {
+				return err
+			}
+		}
+
+		if splitID := hdr.SplitID(); x.splitID != nil && splitID != nil && !bytes.Equal(splitID.ToV2(), x.splitID.ToV2()) {
+			if err := x.a.violation("split-chain element %s has different split ID", id); err != nil {
+				return err
+			}
+		}
+	}
+
+	if x.parent != nil && x.next == len(x.children)-1 {
+		if parID, ok := hdr.ParentID(); !ok || parID != x.addr.Object() {
+			if err := x.a.violation("last split-chain element %s does not reference root object", id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// start starts reading of the split-chain element.
+func (x *chainReader) start(hdr object.Object, r io.ReadCloser) {
+	x.next++
+	x.cur = r
+	x.curHdr = hdr
+	x.curHash = sha256.New()
+	x.curLen = 0
+
+	if cs, ok := hdr.PayloadHomomorphicHash(); ok && x.homo != nil {
+		x.homo = append(x.homo, cs)
+	} else {
+		x.homo = nil
+	}
+}
+
+// closeCurrent finishes reading of the current split-chain element and checks
+// its payload.
+func (x *chainReader) closeCurrent() error {
+	id := x.children[x.next-1]
+
+	err := x.cur.Close()
+	x.cur = nil
+	if err != nil {
+		return fmt.Errorf("finish reading split-chain element %s: %w", id, err)
+	}
+
+	if sz := x.curHdr.PayloadSize(); x.curLen != sz {
+		if err = x.a.violation("object %s payload size %d instead of %d", id, x.curLen, sz); err != nil {
+			return err
+		}
+	}
+
+	if cs, ok := x.curHdr.PayloadChecksum(); !ok || !bytes.Equal(cs.Value(), x.curHash.Sum(nil)) {
+		if err = x.a.violation("object %s payload checksum mismatch", id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// finish checks the payload of the root object.
+func (x *chainReader) finish() error {
+	if x.parent == nil {
+		return nil
+	}
+
+	if sz := x.parent.PayloadSize(); x.rootLen != sz {
+		if err := x.a.violation("root object payload size %d instead of %d", x.rootLen, sz); err != nil {
+			return err
+		}
+	}
+
+	if cs, ok := x.parent.PayloadChecksum(); !ok || !bytes.Equal(cs.Value(), x.rootHash.Sum(nil)) {
+		if err := x.a.violation("root object payload checksum mismatch"); err != nil {
+			return err
+		}
+	}
+
+	if cs, ok := x.parent.PayloadHomomorphicHash(); ok && x.homo != nil {
+		if err := checksum.VerifyTZ(cs, x.homo...); err != nil {
+			if err = x.a.violation("root object homomorphic hash: %v", err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Close implements io.Closer.
+func (x *chainReader) Close() error {
+	var err error
+	if x.cur != nil {
+		err = x.cur.Close()
+		x.cur = nil
+	}
+
+	x.err = errClosed
+
+	return err
+}
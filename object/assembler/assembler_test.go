@@ -0,0 +1,277 @@
+package assembler_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/assembler"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+var _ assembler.Client = (*client.Client)(nil)
+
+// storage is an in-memory assembler.Source filled by slicer.
+type storage struct {
+	objects map[oid.ID]object.Object
+	// latest split info by root ID
+	splitInfo map[oid.ID]*object.SplitInfo
+	hideLink  bool
+}
+
+func newStorage() *storage {
+	return &storage{
+		objects:   make(map[oid.ID]object.Object),
+		splitInfo: make(map[oid.ID]*object.SplitInfo),
+	}
+}
+
+type objectWriter struct {
+	s   *storage
+	hdr object.Object
+	buf bytes.Buffer
+}
+
+func (x *objectWriter) Write(p []byte) (int, error) { return x.buf.Write(p) }
+
+func (x *objectWriter) Close() error {
+	id, _ := x.hdr.ID()
+	x.hdr.SetPayload(x.buf.Bytes())
+	x.s.objects[id] = x.hdr
+
+	if par := x.hdr.Parent(); par != nil {
+		parID, _ := par.ID()
+		si, ok := x.s.splitInfo[parID]
+		if !ok {
+			si = object.NewSplitInfo()
+			x.s.splitInfo[parID] = si
+		}
+
+		if len(x.hdr.Children()) > 0 {
+			si.SetLink(id)
+		} else {
+			si.SetLastPart(id)
+		}
+	}
+
+	return nil
+}
+
+func (x *objectWriter) GetResult() client.ResObjectPut { return client.ResObjectPut{} }
+
+func (x *storage) ObjectPutInit(_ context.Context, hdr object.Object, _ user.Signer, _ client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	// slicer reuses headers, so they are copied
+	b, err := hdr.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var cp object.Object
+	if err = cp.Unmarshal(b); err != nil {
+		return nil, err
+	}
+
+	return &objectWriter{s: x, hdr: cp}, nil
+}
+
+func (x *storage) get(addr oid.Address) (object.Object, error) {
+	if si, ok := x.splitInfo[addr.Object()]; ok {
+		if x.hideLink {
+			last, _ := si.LastPart()
+			si = object.NewSplitInfo()
+			si.SetLastPart(last)
+		}
+		return object.Object{}, object.NewSplitInfoError(si)
+	}
+
+	obj, ok := x.objects[addr.Object()]
+	if !ok {
+		return object.Object{}, apistatus.ErrObjectNotFound
+	}
+
+	return obj, nil
+}
+
+func (x *storage) Head(_ context.Context, addr oid.Address) (object.Object, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return obj, err
+	}
+
+	return *obj.CutPayload(), nil
+}
+
+func (x *storage) Get(_ context.Context, addr oid.Address) (object.Object, io.ReadCloser, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return obj, nil, err
+	}
+
+	return *obj.CutPayload(), io.NopCloser(bytes.NewReader(obj.Payload())), nil
+}
+
+func put(t testing.TB, s *storage, cnr cid.ID, payload []byte, withHomo bool) oid.ID {
+	signer := test.RandomSignerRFC6979(t)
+	owner := signer.UserID()
+
+	var hdr object.Object
+	hdr.SetContainerID(cnr)
+	hdr.SetOwnerID(&owner)
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(100)
+	if withHomo {
+		opts.CalculateHomomorphicChecksum()
+	}
+
+	id, err := slicer.Put(context.Background(), s, hdr, signer, bytes.NewReader(payload), opts)
+	require.NoError(t, err)
+
+	return id
+}
+
+func assemble(a *assembler.Assembler, addr oid.Address) (object.Object, []byte, error) {
+	hdr, r, err := a.Assemble(context.Background(), addr)
+	if err != nil {
+		return hdr, nil, err
+	}
+	defer r.Close()
+
+	b, err := io.ReadAll(r)
+	return hdr, b, err
+}
+
+func randomPayload(t testing.TB, size int) []byte {
+	b := make([]byte, size)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func TestAssembler_Assemble(t *testing.T) {
+	cnr := cidtest.ID()
+
+	for _, tc := range []struct {
+		name     string
+		size     int
+		withHomo bool
+		hideLink bool
+	}{
+		{name: "single", size: 50},
+		{name: "linking object", size: 1050},
+		{name: "linking object with homomorphic hash", size: 1050, withHomo: true},
+		{name: "last part", size: 1050, hideLink: true},
+		{name: "last part with homomorphic hash", size: 1000, withHomo: true, hideLink: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newStorage()
+			s.hideLink = tc.hideLink
+			payload := randomPayload(t, tc.size)
+
+			var addr oid.Address
+			addr.SetContainer(cnr)
+			addr.SetObject(put(t, s, cnr, payload, tc.withHomo))
+
+			hdr, res, err := assemble(assembler.New(s), addr)
+			require.NoError(t, err)
+			require.Equal(t, payload, res)
+
+			id, ok := hdr.ID()
+			require.True(t, ok)
+			require.Equal(t, addr.Object(), id)
+			require.EqualValues(t, tc.size, hdr.PayloadSize())
+		})
+	}
+}
+
+func TestAssembler_Violations(t *testing.T) {
+	cnr := cidtest.ID()
+
+	prepare := func(t *testing.T) (*storage, oid.Address, []byte, []oid.ID) {
+		s := newStorage()
+		payload := randomPayload(t, 1050)
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(put(t, s, cnr, payload, true))
+
+		link, _ := s.splitInfo[addr.Object()].Link()
+		linkObj := s.objects[link]
+
+		return s, addr, payload, linkObj.Children()
+	}
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		s, addr, payload, children := prepare(t)
+
+		obj := s.objects[children[3]]
+		corrupted := append([]byte{}, obj.Payload()...)
+		corrupted[0]++
+		obj.SetPayload(corrupted)
+		s.objects[children[3]] = obj
+
+		_, _, err := assemble(assembler.New(s), addr)
+		require.ErrorIs(t, err, assembler.ErrIntegrityViolation)
+
+		var violations []error
+		a := assembler.New(s)
+		a.SetMode(assembler.ModeBestEffort)
+		a.SetViolationHandler(func(err error) {
+			require.ErrorIs(t, err, assembler.ErrIntegrityViolation)
+			violations = append(violations, err)
+		})
+
+		_, res, err := assemble(a, addr)
+		require.NoError(t, err)
+		require.Len(t, res, len(payload))
+		require.NotEqual(t, payload, res)
+		// element checksum, root checksum
+		require.Len(t, violations, 2)
+	})
+
+	t.Run("substituted element", func(t *testing.T) {
+		s, addr, _, children := prepare(t)
+
+		s.objects[children[3]] = s.objects[children[4]]
+
+		_, _, err := assemble(assembler.New(s), addr)
+		require.ErrorIs(t, err, assembler.ErrIntegrityViolation)
+	})
+
+	t.Run("missing element", func(t *testing.T) {
+		s, addr, _, children := prepare(t)
+
+		delete(s.objects, children[3])
+
+		for _, m := range []assembler.Mode{assembler.ModeStrict, assembler.ModeBestEffort} {
+			a := assembler.New(s)
+			a.SetMode(m)
+
+			_, _, err := assemble(a, addr)
+			require.ErrorIs(t, err, apistatus.ErrObjectNotFound, m)
+		}
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		s, _, _, _ := prepare(t)
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(oid.ID{1})
+
+		_, _, err := assemble(assembler.New(s), addr)
+		require.True(t, errors.Is(err, apistatus.ErrObjectNotFound))
+	})
+}
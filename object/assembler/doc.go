@@ -0,0 +1,19 @@
+/*
+Package assembler provides download of the objects sliced into split-chains
+with the integrity verification of all the parts.
+
+Assembler walks physically stored objects of the split-chain, checks their
+identifiers, signatures, payload checksums and relations to each other and to
+the root object, and exposes the payload of the root object as a stream:
+
+	a := assembler.New(assembler.NewClientSource(c, signer, relations.Tokens{}))
+	hdr, payload, err := a.Assemble(ctx, addr)
+	// ...
+	defer payload.Close()
+	_, err = io.Copy(dst, payload)
+
+In the default strict mode, any violation fails the assembly. In best-effort
+mode, violations are reported to the handler and the assembly is continued
+while the data is available.
+*/
+package assembler
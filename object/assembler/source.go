@@ -0,0 +1,92 @@
+package assembler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+)
+
+// Source provides physically stored objects.
+type Source interface {
+	// Head returns header of the physically stored object. Returns
+	// *object.SplitInfoError for objects sliced into split-chains.
+	Head(ctx context.Context, addr oid.Address) (object.Object, error)
+
+	// Get returns header and payload stream of the physically stored object.
+	// Returns *object.SplitInfoError for objects sliced into split-chains.
+	Get(ctx context.Context, addr oid.Address) (object.Object, io.ReadCloser, error)
+}
+
+// Client describes methods of the NeoFS client required by NewClientSource.
+// Implemented by *client.Client.
+type Client interface {
+	ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer neofscrypto.Signer, prm client.PrmObjectHead) (*client.ResObjectHead, error)
+	ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer neofscrypto.Signer, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error)
+}
+
+type clientSource struct {
+	c Client
+
+	signer neofscrypto.Signer
+
+	tokens relations.Tokens
+}
+
+// NewClientSource returns Source reading objects via NeoFS client on behalf
+// of the given signer with optional tokens.
+func NewClientSource(c Client, signer neofscrypto.Signer, tokens relations.Tokens) Source {
+	return &clientSource{
+		c:      c,
+		signer: signer,
+		tokens: tokens,
+	}
+}
+
+func (x *clientSource) Head(ctx context.Context, addr oid.Address) (object.Object, error) {
+	var prm client.PrmObjectHead
+	prm.MarkRaw()
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	var hdr object.Object
+
+	res, err := x.c.ObjectHead(ctx, addr.Container(), addr.Object(), x.signer, prm)
+	if err != nil {
+		return hdr, err
+	}
+
+	if !res.ReadHeader(&hdr) {
+		return hdr, errors.New("missing header in response")
+	}
+
+	return hdr, nil
+}
+
+func (x *clientSource) Get(ctx context.Context, addr oid.Address) (object.Object, io.ReadCloser, error) {
+	var prm client.PrmObjectGet
+	prm.MarkRaw()
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	hdr, r, err := x.c.ObjectGetInit(ctx, addr.Container(), addr.Object(), x.signer, prm)
+	if err != nil {
+		return hdr, nil, err
+	}
+
+	return hdr, r, nil
+}
@@ -79,3 +79,36 @@ func (a *Attribute) MarshalJSON() ([]byte, error) {
 func (a *Attribute) UnmarshalJSON(data []byte) error {
 	return (*object.Attribute)(a).UnmarshalJSON(data)
 }
+
+// setAttribute sets value of the object attribute with the given key
+// replacing the existing one.
+func (o *Object) setAttribute(key, value string) {
+	attrs := o.Attributes()
+
+	for i := range attrs {
+		if attrs[i].Key() == key {
+			attrs[i].SetValue(value)
+			o.SetAttributes(attrs...)
+			return
+		}
+	}
+
+	var a Attribute
+	a.SetKey(key)
+	a.SetValue(value)
+
+	o.SetAttributes(append(attrs, a)...)
+}
+
+// attribute returns value of the object attribute with the given key.
+func (o *Object) attribute(key string) (string, bool) {
+	attrs := (*object.Object)(o).GetHeader().GetAttributes()
+
+	for i := range attrs {
+		if attrs[i].GetKey() == key {
+			return attrs[i].GetValue(), true
+		}
+	}
+
+	return "", false
+}
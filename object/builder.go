@@ -0,0 +1,297 @@
+package object
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// HeaderField is an enumeration of the object header fields required by
+// Builder.Build.
+type HeaderField uint8
+
+const (
+	_ HeaderField = iota
+
+	// HeaderFieldVersion is a HeaderField of the object format version.
+	HeaderFieldVersion
+
+	// HeaderFieldContainer is a HeaderField of the container ID.
+	HeaderFieldContainer
+
+	// HeaderFieldOwner is a HeaderField of the owner ID.
+	HeaderFieldOwner
+)
+
+// String implements fmt.Stringer.
+func (x HeaderField) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case HeaderFieldVersion:
+		return "VERSION"
+	case HeaderFieldContainer:
+		return "CONTAINER"
+	case HeaderFieldOwner:
+		return "OWNER"
+	}
+}
+
+// ErrIncompleteHeader is returned by Builder.Build when some required header
+// fields are missing. Use [errors.As] to get the details.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrIncompleteHeader IncompleteHeaderError
+
+// IncompleteHeaderError describes missing required fields of the object
+// header.
+type IncompleteHeaderError struct {
+	missing []HeaderField
+}
+
+// Missing returns list of the missing fields.
+func (e IncompleteHeaderError) Missing() []HeaderField {
+	return e.missing
+}
+
+// Error implements the error interface.
+func (e IncompleteHeaderError) Error() string {
+	fields := make([]string, len(e.missing))
+	for i := range e.missing {
+		fields[i] = e.missing[i].String()
+	}
+
+	return fmt.Sprintf("incomplete object header: missing %s", strings.Join(fields, ", "))
+}
+
+// Is implements interface for correct checking current error type with [errors.Is].
+func (e IncompleteHeaderError) Is(target error) bool {
+	switch target.(type) {
+	default:
+		return false
+	case IncompleteHeaderError, *IncompleteHeaderError:
+		return true
+	}
+}
+
+// Builder accumulates fields of the object and produces the object ready to
+// be put into NeoFS: Builder.Build checks required fields and consistency of
+// the header, calculates payload checksums, ID and signature in the right
+// order. Builder methods can be chained:
+//
+//	obj, err := object.NewBuilder().
+//		Container(cnr).
+//		Owner(signer.UserID()).
+//		Attribute(object.AttributeFileName, "cat.jpg").
+//		Payload(data).
+//		Build(signer)
+//
+// Builder must be constructed via NewBuilder.
+type Builder struct {
+	ver version.Version
+
+	cnr    cid.ID
+	cnrSet bool
+
+	owner    user.ID
+	ownerSet bool
+
+	epoch uint64
+
+	typ Type
+
+	attrs []Attribute
+
+	sess *session.Object
+
+	payload []byte
+
+	// nil if not declared
+	expectedChecksum *checksum.Checksum
+
+	homomorphic bool
+}
+
+// NewBuilder returns new Builder of the regular object of the current format
+// version (see version.Current) with empty payload.
+func NewBuilder() *Builder {
+	return &Builder{
+		ver: version.Current(),
+	}
+}
+
+// Version sets object format version.
+func (b *Builder) Version(v version.Version) *Builder {
+	b.ver = v
+	return b
+}
+
+// Container sets container of the object. Required.
+func (b *Builder) Container(cnr cid.ID) *Builder {
+	b.cnr, b.cnrSet = cnr, true
+	return b
+}
+
+// Owner sets owner of the object. Required.
+func (b *Builder) Owner(owner user.ID) *Builder {
+	b.owner, b.ownerSet = owner, true
+	return b
+}
+
+// CreationEpoch sets epoch when the object is created.
+func (b *Builder) CreationEpoch(epoch uint64) *Builder {
+	b.epoch = epoch
+	return b
+}
+
+// Type sets type of the object.
+func (b *Builder) Type(typ Type) *Builder {
+	b.typ = typ
+	return b
+}
+
+// Attribute appends the object attribute. Keys MUST be unique, keys and
+// values MUST NOT be empty.
+func (b *Builder) Attribute(key, value string) *Builder {
+	var a Attribute
+	a.SetKey(key)
+	a.SetValue(value)
+
+	b.attrs = append(b.attrs, a)
+
+	return b
+}
+
+// Session sets session token within which the object is created. The token
+// MUST be issued by the owner for [session.VerbObjectPut] in the object
+// container.
+func (b *Builder) Session(sess *session.Object) *Builder {
+	b.sess = sess
+	return b
+}
+
+// Payload sets payload of the object.
+func (b *Builder) Payload(payload []byte) *Builder {
+	b.payload = payload
+	return b
+}
+
+// PayloadChecksum declares expected payload checksum, e.g. received along with
+// the data. Build fails with [ErrPayloadChecksumMismatch] if the payload does
+// not match.
+func (b *Builder) PayloadChecksum(cs checksum.Checksum) *Builder {
+	b.expectedChecksum = &cs
+	return b
+}
+
+// HomomorphicHashing makes Build to calculate homomorphic payload checksum.
+// It is required if the object container does not disable homomorphic
+// hashing.
+func (b *Builder) HomomorphicHashing() *Builder {
+	b.homomorphic = true
+	return b
+}
+
+// Build checks accumulated fields and returns the object with calculated
+// payload checksums, ID and signature of the given signer. Returns
+// [IncompleteHeaderError] listing all missing required fields, if any, and
+// [LimitExceededError] if the resulting object exceeds protocol limits.
+func (b *Builder) Build(signer neofscrypto.Signer) (*Object, error) {
+	var missing []HeaderField
+
+	if b.ver.Major() == 0 && b.ver.Minor() == 0 {
+		missing = append(missing, HeaderFieldVersion)
+	}
+
+	if !b.cnrSet {
+		missing = append(missing, HeaderFieldContainer)
+	}
+
+	if !b.ownerSet {
+		missing = append(missing, HeaderFieldOwner)
+	}
+
+	if len(missing) > 0 {
+		return nil, IncompleteHeaderError{missing: missing}
+	}
+
+	if signer == nil {
+		return nil, errors.New("missing signer")
+	}
+
+	mKeys := make(map[string]struct{}, len(b.attrs))
+	for i := range b.attrs {
+		key := b.attrs[i].Key()
+		if key == "" {
+			return nil, fmt.Errorf("attribute #%d: empty key", i)
+		} else if b.attrs[i].Value() == "" {
+			return nil, fmt.Errorf("attribute %q: empty value", key)
+		} else if _, ok := mKeys[key]; ok {
+			return nil, fmt.Errorf("duplicated attribute %q", key)
+		}
+
+		mKeys[key] = struct{}{}
+	}
+
+	if b.sess != nil {
+		if !b.sess.Issuer().Equals(b.owner) {
+			return nil, errors.New("session token is not issued by the owner")
+		}
+
+		if !b.sess.AssertVerb(session.VerbObjectPut) {
+			return nil, errors.New("session token is not for the object creation")
+		}
+
+		if !b.sess.AssertContainer(b.cnr) {
+			return nil, errors.New("session token is not for the object container")
+		}
+	}
+
+	cs := CalculatePayloadChecksum(b.payload)
+
+	if b.expectedChecksum != nil {
+		if b.expectedChecksum.Type() != cs.Type() || !bytes.Equal(b.expectedChecksum.Value(), cs.Value()) {
+			return nil, ErrPayloadChecksumMismatch
+		}
+	}
+
+	ver := b.ver
+	owner := b.owner
+
+	obj := New()
+	obj.SetVersion(&ver)
+	obj.SetContainerID(b.cnr)
+	obj.SetOwnerID(&owner)
+	obj.SetCreationEpoch(b.epoch)
+	obj.SetType(b.typ)
+	obj.SetAttributes(b.attrs...)
+	obj.SetSessionToken(b.sess)
+	obj.SetPayload(b.payload)
+	obj.SetPayloadSize(uint64(len(b.payload)))
+	obj.SetPayloadChecksum(cs)
+
+	if b.homomorphic {
+		var tzCS checksum.Checksum
+		checksum.Calculate(&tzCS, checksum.TZ, b.payload)
+		obj.SetPayloadHomomorphicHash(tzCS)
+	}
+
+	if err := obj.SetIDWithSignature(signer); err != nil {
+		return nil, err
+	}
+
+	if err := obj.CheckLimits(); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
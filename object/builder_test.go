@@ -0,0 +1,142 @@
+package object_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderField_String(t *testing.T) {
+	for _, tc := range []struct {
+		f   object.HeaderField
+		str string
+	}{
+		{0, "UNKNOWN"},
+		{object.HeaderFieldVersion, "VERSION"},
+		{object.HeaderFieldContainer, "CONTAINER"},
+		{object.HeaderFieldOwner, "OWNER"},
+		{object.HeaderFieldOwner + 1, "UNKNOWN"},
+	} {
+		require.Equal(t, tc.str, tc.f.String())
+	}
+}
+
+func TestBuilder_Build(t *testing.T) {
+	signer := test.RandomSignerRFC6979(t)
+	owner := signer.UserID()
+	cnr := cidtest.ID()
+	payload := []byte("Hello, world!")
+
+	obj, err := object.NewBuilder().
+		Container(cnr).
+		Owner(owner).
+		CreationEpoch(10).
+		Type(object.TypeLock).
+		Attribute("k1", "v1").
+		Attribute("k2", "v2").
+		Payload(payload).
+		PayloadChecksum(object.CalculatePayloadChecksum(payload)).
+		HomomorphicHashing().
+		Build(signer)
+	require.NoError(t, err)
+	require.NoError(t, obj.CheckVerificationFields())
+
+	require.Equal(t, version.Current(), *obj.Version())
+	resCnr, _ := obj.ContainerID()
+	require.Equal(t, cnr, resCnr)
+	require.Equal(t, owner, *obj.OwnerID())
+	require.EqualValues(t, 10, obj.CreationEpoch())
+	require.Equal(t, object.TypeLock, obj.Type())
+	require.Len(t, obj.Attributes(), 2)
+	require.Equal(t, payload, obj.Payload())
+	require.EqualValues(t, len(payload), obj.PayloadSize())
+
+	var tzCS checksum.Checksum
+	checksum.Calculate(&tzCS, checksum.TZ, payload)
+	cs, ok := obj.PayloadHomomorphicHash()
+	require.True(t, ok)
+	require.Equal(t, tzCS, cs)
+
+	t.Run("missing fields", func(t *testing.T) {
+		_, err := object.NewBuilder().Version(version.Version{}).Build(signer)
+		require.ErrorIs(t, err, object.ErrIncompleteHeader)
+
+		var e object.IncompleteHeaderError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, []object.HeaderField{
+			object.HeaderFieldVersion,
+			object.HeaderFieldContainer,
+			object.HeaderFieldOwner,
+		}, e.Missing())
+		require.EqualError(t, err, "incomplete object header: missing VERSION, CONTAINER, OWNER")
+
+		_, err = object.NewBuilder().Container(cnr).Build(signer)
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, []object.HeaderField{object.HeaderFieldOwner}, e.Missing())
+
+		_, err = object.NewBuilder().Container(cnr).Owner(owner).Build(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		_, err := object.NewBuilder().
+			Container(cnr).
+			Owner(owner).
+			Payload(payload).
+			PayloadChecksum(object.CalculatePayloadChecksum(payload[1:])).
+			Build(signer)
+		require.ErrorIs(t, err, object.ErrPayloadChecksumMismatch)
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		for _, attrs := range [][2]string{{"", "v"}, {"k", ""}, {"k1", "v"}} {
+			_, err := object.NewBuilder().
+				Container(cnr).
+				Owner(owner).
+				Attribute("k1", "v1").
+				Attribute(attrs[0], attrs[1]).
+				Build(signer)
+			require.Error(t, err)
+		}
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		_, err := object.NewBuilder().
+			Container(cnr).
+			Owner(owner).
+			Attribute("k", strings.Repeat("v", object.MaxAttributeValueLength+1)).
+			Build(signer)
+		require.ErrorIs(t, err, object.ErrLimitExceeded)
+	})
+
+	t.Run("session", func(t *testing.T) {
+		tok := *sessiontest.Object()
+		tok.ForVerb(session.VerbObjectPut)
+		tok.BindContainer(cnr)
+		require.NoError(t, tok.Sign(signer))
+
+		obj, err := object.NewBuilder().Container(cnr).Owner(owner).Session(&tok).Build(signer)
+		require.NoError(t, err)
+		require.Equal(t, &tok, obj.SessionToken())
+
+		_, err = object.NewBuilder().Container(cnr).Owner(*usertest.ID(t)).Session(&tok).Build(signer)
+		require.Error(t, err)
+
+		_, err = object.NewBuilder().Container(cidtest.ID()).Owner(owner).Session(&tok).Build(signer)
+		require.Error(t, err)
+
+		tok.ForVerb(session.VerbObjectDelete)
+		_, err = object.NewBuilder().Container(cnr).Owner(owner).Session(&tok).Build(signer)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,268 @@
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// AttributeCodec is an attribute key of the codec the object payload is
+// compressed with.
+const AttributeCodec = "Compression-Codec"
+
+// CodecGzip is a name of the gzip (RFC 1952) codec. Registered by default.
+const CodecGzip = "gzip"
+
+// readChunkSize is a size of the buffer read from the uncompressed stream by
+// Compressor.Reader at once.
+const readChunkSize = 32 << 10
+
+// ErrNotCompressed is returned by NewReader when object attributes do not
+// describe compression.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrNotCompressed = errors.New("object is not compressed")
+
+// Codec compresses and decompresses data streams.
+type Codec interface {
+	// NewWriter returns io.WriteCloser compressing the written data into w.
+	// Close MUST flush all the data, but MUST NOT close w.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+
+	// NewReader returns io.ReadCloser of the decompressed data read from r.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+type gzipCodec struct{}
+
+func (gzipCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriter(w), nil
+}
+
+func (gzipCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+// maps codec names to implementations.
+var codecs = struct {
+	mtx sync.RWMutex
+	m   map[string]Codec
+}{
+	m: map[string]Codec{
+		CodecGzip: gzipCodec{},
+	},
+}
+
+// RegisterCodec registers Codec under the given name. The name is stored in
+// the object attributes, so it MUST be the same for all applications working
+// with the objects. This is intended to be called from the init function in
+// packages that implement codecs.
+//
+// RegisterCodec panics if codec with the given name is already registered.
+//
+// RegisterCodec is safe for concurrent use, so codecs may be registered
+// lazily.
+func RegisterCodec(name string, c Codec) {
+	codecs.mtx.Lock()
+	defer codecs.mtx.Unlock()
+
+	if _, ok := codecs.m[name]; ok {
+		panic(fmt.Sprintf("codec %s is already registered", name))
+	}
+
+	codecs.m[name] = c
+}
+
+func getCodec(name string) (Codec, error) {
+	codecs.mtx.RLock()
+	c, ok := codecs.m[name]
+	codecs.mtx.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unsupported codec %q", name)
+	}
+
+	return c, nil
+}
+
+// Compressor compresses object payload. Compressor must be constructed via
+// NewCompressor.
+type Compressor struct {
+	name string
+
+	codec Codec
+}
+
+// NewCompressor constructs Compressor of the payload using codec registered
+// under the given name (see CodecGzip and RegisterCodec).
+func NewCompressor(codec string) (*Compressor, error) {
+	c, err := getCodec(codec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Compressor{
+		name:  codec,
+		codec: c,
+	}, nil
+}
+
+// Attributes returns object attributes describing the compression. They MUST
+// be added to the object header for decompression.
+func (x *Compressor) Attributes() []object.Attribute {
+	attrs := make([]object.Attribute, 1)
+
+	attrs[0].SetKey(AttributeCodec)
+	attrs[0].SetValue(x.name)
+
+	return attrs
+}
+
+// Writer returns io.WriteCloser compressing the written data into w. Close
+// MUST be called to flush the compressed data, it also closes w if it
+// implements io.Closer (e.g. slicer.PayloadWriter or encrypting writer).
+func (x *Compressor) Writer(w io.Writer) (io.WriteCloser, error) {
+	cw, err := x.codec.NewWriter(w)
+	if err != nil {
+		return nil, fmt.Errorf("init %s writer: %w", x.name, err)
+	}
+
+	return &compressingWriter{
+		WriteCloser: cw,
+		w:           w,
+	}, nil
+}
+
+// Reader returns io.Reader of the compressed data read from r. Reader is
+// suitable for slicer.Slicer.Put and encryption.Encryptor.Reader.
+func (x *Compressor) Reader(r io.Reader) (io.Reader, error) {
+	res := &compressingReader{
+		r:     r,
+		chunk: make([]byte, readChunkSize),
+	}
+
+	var err error
+	if res.w, err = x.codec.NewWriter(&res.buf); err != nil {
+		return nil, fmt.Errorf("init %s writer: %w", x.name, err)
+	}
+
+	return res, nil
+}
+
+// TransformWriter implements [object.PayloadTransformer] via Writer.
+func (x *Compressor) TransformWriter(w io.Writer) (io.WriteCloser, error) {
+	return x.Writer(w)
+}
+
+// RestoreReader implements [object.PayloadRestorer] via NewReader.
+func (x *Compressor) RestoreReader(r io.Reader, attrs []object.Attribute) (io.Reader, error) {
+	return Decompressor{}.RestoreReader(r, attrs)
+}
+
+// Decompressor is an [object.PayloadRestorer] decompressing the payload by any
+// registered codec specified in the object attributes. It allows to
+// decompress the payload without the Compressor used on upload. Zero value is
+// ready to use.
+type Decompressor struct{}
+
+// RestoreReader implements [object.PayloadRestorer] via NewReader.
+func (Decompressor) RestoreReader(r io.Reader, attrs []object.Attribute) (io.Reader, error) {
+	return NewReader(r, attrs)
+}
+
+type compressingWriter struct {
+	io.WriteCloser
+
+	// underlying writer
+	w io.Writer
+}
+
+func (x *compressingWriter) Close() error {
+	if err := x.WriteCloser.Close(); err != nil {
+		return fmt.Errorf("flush compressed data: %w", err)
+	}
+
+	if c, ok := x.w.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+type compressingReader struct {
+	r io.Reader
+
+	chunk []byte
+
+	// compressing writer into buf
+	w io.WriteCloser
+
+	// compressed data not read yet
+	buf bytes.Buffer
+
+	eof bool
+}
+
+func (x *compressingReader) Read(p []byte) (int, error) {
+	for x.buf.Len() == 0 {
+		if x.eof {
+			return 0, io.EOF
+		}
+
+		n, err := x.r.Read(x.chunk)
+		if n > 0 {
+			if _, wErr := x.w.Write(x.chunk[:n]); wErr != nil {
+				return 0, fmt.Errorf("compress data: %w", wErr)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			if err = x.w.Close(); err != nil {
+				return 0, fmt.Errorf("flush compressed data: %w", err)
+			}
+
+			x.eof = true
+		} else if err != nil {
+			return 0, err
+		}
+	}
+
+	return x.buf.Read(p)
+}
+
+// NewReader returns io.ReadCloser of the decompressed payload of the object
+// with given attributes read from r. Returns ErrNotCompressed if attributes do
+// not describe compression. The result MUST be closed after use, r is not
+// closed.
+func NewReader(r io.Reader, attrs []object.Attribute) (io.ReadCloser, error) {
+	var name string
+
+	for i := range attrs {
+		if attrs[i].Key() == AttributeCodec {
+			name = attrs[i].Value()
+			break
+		}
+	}
+
+	if name == "" {
+		return nil, ErrNotCompressed
+	}
+
+	c, err := getCodec(name)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("init %s reader: %w", name, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,249 @@
+package compression_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/compression"
+	"github.com/nspcc-dev/neofs-sdk-go/object/encryption"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func testData(t testing.TB, n int) []byte {
+	// half-random data to be compressible
+	b := make([]byte, n)
+	_, err := rand.Read(b[:n/2])
+	require.NoError(t, err)
+	return b
+}
+
+func decompress(t testing.TB, c *compression.Compressor, data []byte) []byte {
+	r, err := compression.NewReader(bytes.NewReader(data), c.Attributes())
+	require.NoError(t, err)
+
+	res, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	return res
+}
+
+func TestCompressor(t *testing.T) {
+	c, err := compression.NewCompressor(compression.CodecGzip)
+	require.NoError(t, err)
+
+	attrs := c.Attributes()
+	require.Len(t, attrs, 1)
+	require.Equal(t, compression.AttributeCodec, attrs[0].Key())
+	require.Equal(t, compression.CodecGzip, attrs[0].Value())
+
+	for _, size := range []int{0, 1, 1000, 100 << 10} {
+		data := testData(t, size)
+
+		var buf bytes.Buffer
+		w, err := c.Writer(&buf)
+		require.NoError(t, err)
+		_, err = io.Copy(w, bytes.NewReader(data))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		if size > 1000 {
+			require.Less(t, buf.Len(), size)
+		}
+
+		require.Equal(t, data, decompress(t, c, buf.Bytes()), size)
+
+		r, err := c.Reader(bytes.NewReader(data))
+		require.NoError(t, err)
+		compressed, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, decompress(t, c, compressed), size)
+	}
+
+	_, err = compression.NewCompressor("zstd")
+	require.Error(t, err)
+}
+
+type nopCodec struct{}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+func (nopCodec) NewWriter(w io.Writer) (io.WriteCloser, error) { return nopWriteCloser{w}, nil }
+
+func (nopCodec) NewReader(r io.Reader) (io.ReadCloser, error) { return io.NopCloser(r), nil }
+
+func TestRegisterCodec(t *testing.T) {
+	compression.RegisterCodec("test-nop", nopCodec{})
+	require.Panics(t, func() { compression.RegisterCodec("test-nop", nopCodec{}) })
+	require.Panics(t, func() { compression.RegisterCodec(compression.CodecGzip, nopCodec{}) })
+
+	c, err := compression.NewCompressor("test-nop")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w, err := c.Writer(&buf)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("Hello, world!"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, "Hello, world!", buf.String())
+	require.Equal(t, []byte("Hello, world!"), decompress(t, c, buf.Bytes()))
+}
+
+func TestNewReader(t *testing.T) {
+	_, err := compression.NewReader(bytes.NewReader(nil), nil)
+	require.ErrorIs(t, err, compression.ErrNotCompressed)
+
+	var attr object.Attribute
+	attr.SetKey(compression.AttributeCodec)
+
+	attr.SetValue("lz4")
+	_, err = compression.NewReader(bytes.NewReader(nil), []object.Attribute{attr})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, compression.ErrNotCompressed)
+
+	attr.SetValue(compression.CodecGzip)
+	_, err = compression.NewReader(bytes.NewReader([]byte("not gzip")), []object.Attribute{attr})
+	require.Error(t, err)
+}
+
+type payloadCollector struct {
+	payload bytes.Buffer
+}
+
+func (x *payloadCollector) ObjectPutInit(context.Context, object.Object, user.Signer, client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	return x, nil
+}
+
+func (x *payloadCollector) Write(p []byte) (int, error) { return x.payload.Write(p) }
+
+func (x *payloadCollector) Close() error { return nil }
+
+func (x *payloadCollector) GetResult() client.ResObjectPut { return client.ResObjectPut{} }
+
+func TestCompressor_SlicerEncryption(t *testing.T) {
+	data := testData(t, 10<<10)
+	signer := test.RandomSignerRFC6979(t)
+	key := make([]byte, 32)
+
+	c, err := compression.NewCompressor(compression.CodecGzip)
+	require.NoError(t, err)
+
+	enc, err := encryption.NewEncryptor("key", key, 100)
+	require.NoError(t, err)
+
+	owner := signer.UserID()
+
+	var hdr object.Object
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(&owner)
+	hdr.SetAttributes(append(c.Attributes(), enc.Attributes()...)...)
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(1 << 20)
+
+	var pc payloadCollector
+
+	w, err := slicer.InitPut(context.Background(), &pc, hdr, signer, opts)
+	require.NoError(t, err)
+
+	ew, err := enc.Writer(w)
+	require.NoError(t, err)
+	cw, err := c.Writer(ew)
+	require.NoError(t, err)
+	_, err = io.Copy(cw, bytes.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, cw.Close())
+	require.Less(t, pc.payload.Len(), len(data))
+
+	dr, err := encryption.NewReader(&pc.payload, hdr.Attributes(), func(string) ([]byte, error) {
+		return key, nil
+	})
+	require.NoError(t, err)
+
+	r, err := compression.NewReader(dr, hdr.Attributes())
+	require.NoError(t, err)
+
+	res, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+
+	_, err = compression.NewReader(&pc.payload, nil)
+	require.True(t, errors.Is(err, compression.ErrNotCompressed))
+}
+
+func TestPayloadPipeline(t *testing.T) {
+	data := testData(t, 10<<10)
+	signer := test.RandomSignerRFC6979(t)
+	key := make([]byte, 32)
+
+	c, err := compression.NewCompressor(compression.CodecGzip)
+	require.NoError(t, err)
+
+	enc, err := encryption.NewEncryptor("key", key, 100)
+	require.NoError(t, err)
+
+	hasher := object.NewPayloadHasher()
+	p := object.NewPayloadPipeline(c, enc, hasher)
+
+	owner := signer.UserID()
+
+	var hdr object.Object
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(&owner)
+	hdr.SetAttributes(p.Attributes()...)
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(1 << 20)
+
+	var pc payloadCollector
+
+	w, err := slicer.InitPut(context.Background(), &pc, hdr, signer, opts)
+	require.NoError(t, err)
+
+	pw, err := p.TransformWriter(w)
+	require.NoError(t, err)
+	_, err = io.Copy(pw, bytes.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, pw.Close())
+	require.EqualValues(t, pc.payload.Len(), hasher.Size())
+
+	var cs checksum.Checksum
+	checksum.Calculate(&cs, checksum.SHA256, pc.payload.Bytes())
+	require.Equal(t, cs, hasher.Checksum())
+
+	stored := pc.payload.Bytes()
+
+	r, err := p.RestoreReader(bytes.NewReader(stored), hdr.Attributes())
+	require.NoError(t, err)
+	res, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+
+	r, err = object.RestorePayload(bytes.NewReader(stored), hdr.Attributes(), compression.Decompressor{}, encryption.KeyProvider(func(string) ([]byte, error) {
+		return key, nil
+	}))
+	require.NoError(t, err)
+	res, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+
+	_, err = p.RestoreReader(bytes.NewReader(stored), enc.Attributes())
+	require.ErrorIs(t, err, compression.ErrNotCompressed)
+
+	_, err = p.RestoreReader(bytes.NewReader(stored), c.Attributes())
+	require.ErrorIs(t, err, encryption.ErrNotEncrypted)
+}
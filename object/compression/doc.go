@@ -0,0 +1,35 @@
+/*
+Package compression provides client-side compression of the NeoFS object
+payload.
+
+Payload is compressed as a stream by the codec recorded in the object
+attribute, so it is transparently decompressed on reading. Only gzip codec is
+supported out of the box, other codecs can be plugged in via RegisterCodec:
+
+	func init() {
+		compression.RegisterCodec("zstd", zstdCodec{})
+	}
+
+Compression is composable with the slicer and encryption. Payload MUST be
+compressed before encryption since encrypted data is incompressible:
+
+	c, err := compression.NewCompressor(compression.CodecGzip)
+	// ...
+	w, err := s.InitPut(ctx, append(attrs, c.Attributes()...))
+	// ...
+	cw, err := c.Writer(w) // or c.Writer(ew) with encryption.Encryptor.Writer
+	// ...
+	_, err = io.Copy(cw, data)
+	// ...
+	err = cw.Close() // also closes w
+	// ...
+	id := w.ID()
+
+Decompression is done on reading after decryption:
+
+	data, err := compression.NewReader(payload, hdr.Attributes())
+	// ...
+	defer data.Close()
+	_, err = io.Copy(dst, data)
+*/
+package compression
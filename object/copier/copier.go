@@ -0,0 +1,210 @@
+package copier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/encryption"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Client describes methods of the NeoFS client required by CopyObject.
+// Implemented by *client.Client.
+type Client interface {
+	slicer.NetworkedClient
+
+	ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer neofscrypto.Signer, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error)
+}
+
+// Options groups CopyObject parameters. Zero Options copy the object as is.
+type Options struct {
+	srcTokens relations.Tokens
+
+	session *session.Object
+
+	rewrite func([]object.Attribute) []object.Attribute
+
+	keys encryption.KeyProvider
+
+	encryptor *encryption.Encryptor
+
+	progress func(copied, total uint64)
+}
+
+// SetSourceTokens sets tokens to read the source object with.
+func (x *Options) SetSourceTokens(tokens relations.Tokens) {
+	x.srcTokens = tokens
+}
+
+// SetSession sets session token to write the copy with.
+func (x *Options) SetSession(sess *session.Object) {
+	x.session = sess
+}
+
+// SetAttributeRewriter sets function returning attributes of the copy by the
+// attributes of the source object. The argument may be modified. By default,
+// attributes are copied as is.
+func (x *Options) SetAttributeRewriter(f func([]object.Attribute) []object.Attribute) {
+	x.rewrite = f
+}
+
+// SetDecryptionKeys sets provider of the keys to decrypt the source payload
+// encrypted by package encryption. Not encrypted payload is copied as is.
+func (x *Options) SetDecryptionKeys(keys encryption.KeyProvider) {
+	x.keys = keys
+}
+
+// SetEncryptor sets Encryptor of the copy payload. If the source payload is
+// encrypted, decryption keys MUST be set (see SetDecryptionKeys). The
+// Encryptor MUST NOT be used for other objects.
+func (x *Options) SetEncryptor(enc *encryption.Encryptor) {
+	x.encryptor = enc
+}
+
+// SetProgressHandler sets function called each time a portion of the source
+// payload is read with total number of bytes read so far and the full payload
+// size.
+func (x *Options) SetProgressHandler(f func(copied, total uint64)) {
+	x.progress = f
+}
+
+// CopyObject copies regular object by the src address into the dst container
+// on behalf of the given signer who becomes the owner of the copy. Returns ID
+// of the copy.
+//
+// Payload is verified against the SHA-256 checksum of the source header while
+// streaming: CopyObject fails with [object.ErrPayloadChecksumMismatch] or
+// [object.ErrPayloadSizeMismatch] before completing the copy of the corrupted
+// payload. Creation epoch of the copy is the current one, the object may be
+// sliced according to the destination network settings.
+func CopyObject(ctx context.Context, c Client, src oid.Address, dst cid.ID, signer user.Signer, opts Options) (oid.ID, error) {
+	return copyObject(ctx, func(ctx context.Context) (object.Object, io.ReadCloser, error) {
+		var prm client.PrmObjectGet
+		if opts.srcTokens.Bearer != nil {
+			prm.WithBearerToken(*opts.srcTokens.Bearer)
+		}
+		if opts.srcTokens.Session != nil {
+			prm.WithinSession(*opts.srcTokens.Session)
+		}
+
+		hdr, r, err := c.ObjectGetInit(ctx, src.Container(), src.Object(), signer, prm)
+		if err != nil {
+			return hdr, nil, err
+		}
+
+		return hdr, r, nil
+	}, c, dst, signer, opts)
+}
+
+type getFunc func(ctx context.Context) (object.Object, io.ReadCloser, error)
+
+func copyObject(ctx context.Context, get getFunc, w slicer.NetworkedClient, dst cid.ID, signer user.Signer, opts Options) (oid.ID, error) {
+	var res oid.ID
+
+	hdr, r, err := get(ctx)
+	if err != nil {
+		return res, fmt.Errorf("get source object: %w", err)
+	}
+
+	defer r.Close()
+
+	if typ := hdr.Type(); typ != object.TypeRegular {
+		return res, fmt.Errorf("unsupported object type %s", typ)
+	}
+
+	payload, err := object.NewVerifyingPayloadReader(hdr, r, false)
+	if err != nil {
+		return res, fmt.Errorf("verify source payload: %w", err)
+	}
+
+	if opts.progress != nil {
+		payload = &progressReader{r: payload, total: hdr.PayloadSize(), f: opts.progress}
+	}
+
+	attrs := append([]object.Attribute(nil), hdr.Attributes()...)
+
+	if opts.keys != nil {
+		dr, err := encryption.NewReader(payload, attrs, opts.keys)
+		if err == nil {
+			payload = dr
+			attrs = withoutEncryption(attrs)
+		} else if !errors.Is(err, encryption.ErrNotEncrypted) {
+			return res, fmt.Errorf("decrypt source payload: %w", err)
+		}
+	}
+
+	if opts.encryptor != nil {
+		if len(withoutEncryption(attrs)) != len(attrs) {
+			return res, errors.New("source payload is encrypted, decryption keys are required for re-encryption")
+		}
+
+		if payload, err = opts.encryptor.Reader(payload); err != nil {
+			return res, fmt.Errorf("encrypt payload: %w", err)
+		}
+
+		attrs = append(attrs, opts.encryptor.Attributes()...)
+	}
+
+	if opts.rewrite != nil {
+		attrs = opts.rewrite(attrs)
+	}
+
+	s, err := slicer.New(ctx, w, signer, dst, signer.UserID(), opts.session)
+	if err != nil {
+		return res, fmt.Errorf("init slicer: %w", err)
+	}
+
+	res, err = s.Put(ctx, payload, attrs)
+	if err != nil {
+		return res, fmt.Errorf("put copy: %w", err)
+	}
+
+	return res, nil
+}
+
+// withoutEncryption returns attributes except ones describing encryption.
+func withoutEncryption(attrs []object.Attribute) []object.Attribute {
+	res := make([]object.Attribute, 0, len(attrs))
+
+	for i := range attrs {
+		switch attrs[i].Key() {
+		case encryption.AttributeAlgorithm,
+			encryption.AttributeKeyID,
+			encryption.AttributeNonceStrategy,
+			encryption.AttributeNonce,
+			encryption.AttributeChunkSize:
+		default:
+			res = append(res, attrs[i])
+		}
+	}
+
+	return res
+}
+
+type progressReader struct {
+	r io.Reader
+
+	copied, total uint64
+
+	f func(copied, total uint64)
+}
+
+func (x *progressReader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	if n > 0 {
+		x.copied += uint64(n)
+		x.f(x.copied, x.total)
+	}
+
+	return n, err
+}
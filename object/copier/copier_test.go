@@ -0,0 +1,192 @@
+package copier
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/encryption"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+var _ Client = (*client.Client)(nil)
+
+type storage struct {
+	last object.Object
+}
+
+type objectWriter struct {
+	s   *storage
+	hdr object.Object
+	buf bytes.Buffer
+}
+
+func (x *objectWriter) Write(p []byte) (int, error) { return x.buf.Write(p) }
+
+func (x *objectWriter) Close() error {
+	x.hdr.SetPayload(x.buf.Bytes())
+	x.s.last = x.hdr
+	return nil
+}
+
+func (x *objectWriter) GetResult() client.ResObjectPut { return client.ResObjectPut{} }
+
+func (x *storage) ObjectPutInit(_ context.Context, hdr object.Object, _ user.Signer, _ client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	return &objectWriter{s: x, hdr: hdr}, nil
+}
+
+func (x *storage) NetworkInfo(context.Context, client.PrmNetworkInfo) (netmap.NetworkInfo, error) {
+	var ni netmap.NetworkInfo
+	ni.SetCurrentEpoch(10)
+	ni.DisableHomomorphicHashing()
+	return ni, nil
+}
+
+func source(hdr object.Object, payload []byte) getFunc {
+	return func(context.Context) (object.Object, io.ReadCloser, error) {
+		return hdr, io.NopCloser(bytes.NewReader(payload)), nil
+	}
+}
+
+func sourceObject(payload []byte, attrs ...object.Attribute) object.Object {
+	var hdr object.Object
+	hdr.SetType(object.TypeRegular)
+	hdr.SetPayloadSize(uint64(len(payload)))
+	hdr.SetPayloadChecksum(object.CalculatePayloadChecksum(payload))
+	hdr.SetAttributes(attrs...)
+	return hdr
+}
+
+func attribute(k, v string) object.Attribute {
+	var a object.Attribute
+	a.SetKey(k)
+	a.SetValue(v)
+	return a
+}
+
+func randomBytes(t testing.TB, n int) []byte {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func TestCopyObject(t *testing.T) {
+	ctx := context.Background()
+	signer := test.RandomSignerRFC6979(t)
+	dst := cidtest.ID()
+	payload := randomBytes(t, 4<<10)
+	attr := attribute("k", "v")
+
+	t.Run("as is", func(t *testing.T) {
+		var s storage
+		var opts Options
+		var copied, total uint64
+
+		opts.SetProgressHandler(func(c, t uint64) { copied, total = c, t })
+		opts.SetAttributeRewriter(func(attrs []object.Attribute) []object.Attribute {
+			return append(attrs, attribute("copied", "true"))
+		})
+
+		id, err := copyObject(ctx, source(sourceObject(payload, attr), payload), &s, dst, signer, opts)
+		require.NoError(t, err)
+
+		resID, _ := s.last.ID()
+		require.Equal(t, id, resID)
+		require.Equal(t, payload, s.last.Payload())
+		cnr, _ := s.last.ContainerID()
+		require.Equal(t, dst, cnr)
+		require.Equal(t, signer.UserID(), *s.last.OwnerID())
+		require.EqualValues(t, 10, s.last.CreationEpoch())
+		require.Equal(t, []object.Attribute{attr, attribute("copied", "true")}, s.last.Attributes())
+		require.EqualValues(t, len(payload), copied)
+		require.EqualValues(t, len(payload), total)
+	})
+
+	t.Run("re-encryption", func(t *testing.T) {
+		srcKey, dstKey := randomBytes(t, 32), randomBytes(t, 32)
+
+		srcEnc, err := encryption.NewEncryptor("src", srcKey, 0)
+		require.NoError(t, err)
+		dstEnc, err := encryption.NewEncryptor("dst", dstKey, 0)
+		require.NoError(t, err)
+
+		er, err := srcEnc.Reader(bytes.NewReader(payload))
+		require.NoError(t, err)
+		encrypted, err := io.ReadAll(er)
+		require.NoError(t, err)
+
+		get := source(sourceObject(encrypted, append([]object.Attribute{attr}, srcEnc.Attributes()...)...), encrypted)
+
+		var s storage
+		var opts Options
+		opts.SetEncryptor(dstEnc)
+
+		_, err = copyObject(ctx, get, &s, dst, signer, opts)
+		require.Error(t, err)
+
+		opts.SetDecryptionKeys(func(keyID string) ([]byte, error) {
+			if keyID != "src" {
+				return nil, errors.New("unknown key")
+			}
+			return srcKey, nil
+		})
+
+		_, err = copyObject(ctx, get, &s, dst, signer, opts)
+		require.NoError(t, err)
+		require.Equal(t, append([]object.Attribute{attr}, dstEnc.Attributes()...), s.last.Attributes())
+
+		r, err := encryption.NewReader(bytes.NewReader(s.last.Payload()), s.last.Attributes(), func(string) ([]byte, error) {
+			return dstKey, nil
+		})
+		require.NoError(t, err)
+
+		res, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, payload, res)
+
+		opts.SetEncryptor(nil)
+
+		_, err = copyObject(ctx, get, &s, dst, signer, opts)
+		require.NoError(t, err)
+		require.Equal(t, []object.Attribute{attr}, s.last.Attributes())
+		require.Equal(t, payload, s.last.Payload())
+	})
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		corrupted := append([]byte{}, payload...)
+		corrupted[0]++
+
+		var s storage
+		_, err := copyObject(ctx, source(sourceObject(payload), corrupted), &s, dst, signer, Options{})
+		require.ErrorIs(t, err, object.ErrPayloadChecksumMismatch)
+	})
+
+	t.Run("non-regular", func(t *testing.T) {
+		hdr := sourceObject(payload)
+		hdr.SetType(object.TypeLock)
+
+		var s storage
+		_, err := copyObject(ctx, source(hdr, payload), &s, dst, signer, Options{})
+		require.Error(t, err)
+	})
+
+	t.Run("get failure", func(t *testing.T) {
+		errGet := errors.New("any error")
+
+		var s storage
+		_, err := copyObject(ctx, func(context.Context) (object.Object, io.ReadCloser, error) {
+			return object.Object{}, nil, errGet
+		}, &s, dst, signer, Options{})
+		require.ErrorIs(t, err, errGet)
+	})
+}
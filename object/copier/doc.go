@@ -0,0 +1,23 @@
+/*
+Package copier provides copying of the NeoFS objects between containers.
+
+Object is streamed from the source container into the destination one without
+buffering: payload is read by GET and written by PUT through the slicer, so
+objects of any size are supported. Payload is verified against the source
+header on the fly.
+
+	var opts copier.Options
+	opts.SetAttributeRewriter(func(attrs []object.Attribute) []object.Attribute {
+		// ...
+	})
+	opts.SetProgressHandler(func(copied, total uint64) {
+		// ...
+	})
+
+	id, err := copier.CopyObject(ctx, c, src, dst, signer, opts)
+
+Encrypted payload (see package encryption) is copied as is by default. With
+decryption keys, copy is stored decrypted unless an Encryptor is also set: in
+this case payload is re-encrypted.
+*/
+package copier
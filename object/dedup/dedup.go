@@ -0,0 +1,139 @@
+package dedup
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Searcher searches objects by payload checksum.
+type Searcher interface {
+	// SearchByPayloadChecksum returns IDs of the root objects in the container
+	// with the given SHA-256 payload checksum.
+	SearchByPayloadChecksum(ctx context.Context, cnr cid.ID, cs [sha256.Size]byte) ([]oid.ID, error)
+}
+
+type clientSearcher struct {
+	c relations.SearchExecutor
+
+	signer user.Signer
+
+	tokens relations.Tokens
+}
+
+// NewClientSearcher returns Searcher of the objects via NeoFS client on behalf
+// of the given signer with optional tokens. Implemented by *client.Client.
+func NewClientSearcher(c relations.SearchExecutor, signer user.Signer, tokens relations.Tokens) Searcher {
+	return &clientSearcher{
+		c:      c,
+		signer: signer,
+		tokens: tokens,
+	}
+}
+
+func (x *clientSearcher) SearchByPayloadChecksum(ctx context.Context, cnr cid.ID, cs [sha256.Size]byte) ([]oid.ID, error) {
+	var fs object.SearchFilters
+	fs.AddRootFilter()
+	fs.AddPayloadHashFilter(object.MatchStringEqual, cs)
+
+	var prm client.PrmObjectSearch
+	prm.SetFilters(fs)
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	r, err := x.c.ObjectSearchInit(ctx, cnr, x.signer, prm)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	var res []oid.ID
+	err = r.Iterate(func(id oid.ID) bool {
+		res = append(res, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate: %w", err)
+	}
+
+	return res, nil
+}
+
+// PayloadChecksum reads the payload from r until EOF and returns its SHA-256
+// checksum matching the checksum of the object with such payload.
+func PayloadChecksum(r io.Reader) ([sha256.Size]byte, error) {
+	var res [sha256.Size]byte
+
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return res, err
+	}
+
+	copy(res[:], h.Sum(nil))
+
+	return res, nil
+}
+
+// Find looks for the object with the given SHA-256 payload checksum in the
+// container. Second value is false if there are no such objects. If several
+// objects are found, any of them is returned.
+func Find(ctx context.Context, s Searcher, cnr cid.ID, cs [sha256.Size]byte) (oid.Address, bool, error) {
+	var res oid.Address
+
+	ids, err := s.SearchByPayloadChecksum(ctx, cnr, cs)
+	if err != nil {
+		return res, false, fmt.Errorf("search objects by payload checksum: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return res, false, nil
+	}
+
+	res.SetContainer(cnr)
+	res.SetObject(ids[0])
+
+	return res, true, nil
+}
+
+// PutIfAbsent calculates checksum of the payload, looks for the object with
+// the same payload in the container and puts new object via the Slicer
+// configured for this container only if there is no such object. Second value
+// is true if the returned object already existed. The data is read twice, it
+// is rewound to the start before the upload.
+func PutIfAbsent(ctx context.Context, s Searcher, slc *slicer.Slicer, cnr cid.ID, data io.ReadSeeker, attrs []object.Attribute) (oid.Address, bool, error) {
+	cs, err := PayloadChecksum(data)
+	if err != nil {
+		return oid.Address{}, false, fmt.Errorf("calculate payload checksum: %w", err)
+	}
+
+	res, found, err := Find(ctx, s, cnr, cs)
+	if err != nil || found {
+		return res, found, err
+	}
+
+	if _, err = data.Seek(0, io.SeekStart); err != nil {
+		return res, false, fmt.Errorf("rewind payload: %w", err)
+	}
+
+	id, err := slc.Put(ctx, data, attrs)
+	if err != nil {
+		return res, false, fmt.Errorf("put object: %w", err)
+	}
+
+	res.SetContainer(cnr)
+	res.SetObject(id)
+
+	return res, false, nil
+}
@@ -0,0 +1,117 @@
+package dedup_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/dedup"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+// storage is an in-memory container of the root objects.
+type storage struct {
+	cnr     cid.ID
+	objects map[oid.ID]object.Object
+	err     error
+}
+
+func (x *storage) SearchByPayloadChecksum(_ context.Context, cnr cid.ID, cs [sha256.Size]byte) ([]oid.ID, error) {
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	var res []oid.ID
+	if cnr != x.cnr {
+		return res, nil
+	}
+
+	for id, obj := range x.objects {
+		if objCS, _ := obj.PayloadChecksum(); bytes.Equal(objCS.Value(), cs[:]) {
+			res = append(res, id)
+		}
+	}
+
+	return res, nil
+}
+
+type objectWriter struct {
+	s   *storage
+	hdr object.Object
+}
+
+func (x *objectWriter) Write(p []byte) (int, error) { return len(p), nil }
+
+func (x *objectWriter) Close() error {
+	id, _ := x.hdr.ID()
+	x.s.objects[id] = x.hdr
+	return nil
+}
+
+func (x *objectWriter) GetResult() client.ResObjectPut { return client.ResObjectPut{} }
+
+func (x *storage) ObjectPutInit(_ context.Context, hdr object.Object, _ user.Signer, _ client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	return &objectWriter{s: x, hdr: hdr}, nil
+}
+
+func (x *storage) NetworkInfo(context.Context, client.PrmNetworkInfo) (netmap.NetworkInfo, error) {
+	return netmap.NetworkInfo{}, nil
+}
+
+func TestPayloadChecksum(t *testing.T) {
+	payload := []byte("Hello, world!")
+
+	cs, err := dedup.PayloadChecksum(bytes.NewReader(payload))
+	require.NoError(t, err)
+	require.Equal(t, sha256.Sum256(payload), cs)
+}
+
+func TestPutIfAbsent(t *testing.T) {
+	ctx := context.Background()
+	signer := test.RandomSignerRFC6979(t)
+	s := &storage{cnr: cidtest.ID(), objects: make(map[oid.ID]object.Object)}
+
+	slc, err := slicer.New(ctx, s, signer, s.cnr, signer.UserID(), nil)
+	require.NoError(t, err)
+
+	payload := []byte("Hello, world!")
+
+	addr, existed, err := dedup.PutIfAbsent(ctx, s, slc, s.cnr, bytes.NewReader(payload), nil)
+	require.NoError(t, err)
+	require.False(t, existed)
+	require.Equal(t, s.cnr, addr.Container())
+	require.Contains(t, s.objects, addr.Object())
+
+	addr2, existed, err := dedup.PutIfAbsent(ctx, s, slc, s.cnr, bytes.NewReader(payload), nil)
+	require.NoError(t, err)
+	require.True(t, existed)
+	require.Equal(t, addr, addr2)
+	require.Len(t, s.objects, 1)
+
+	addr3, existed, err := dedup.PutIfAbsent(ctx, s, slc, s.cnr, bytes.NewReader(payload[1:]), nil)
+	require.NoError(t, err)
+	require.False(t, existed)
+	require.NotEqual(t, addr, addr3)
+	require.Len(t, s.objects, 2)
+
+	_, found, err := dedup.Find(ctx, s, cidtest.ID(), sha256.Sum256(payload))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	t.Run("search failure", func(t *testing.T) {
+		s.err = errors.New("any error")
+		_, _, err := dedup.PutIfAbsent(ctx, s, slc, s.cnr, bytes.NewReader(payload), nil)
+		require.ErrorIs(t, err, s.err)
+	})
+}
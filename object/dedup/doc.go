@@ -0,0 +1,13 @@
+/*
+Package dedup provides payload-based deduplication of the NeoFS objects.
+
+Objects with the same payload have the same SHA-256 payload checksum, so the
+checksum calculated locally allows to find existing object in the container
+before uploading the data again:
+
+	s := dedup.NewClientSearcher(c, signer, relations.Tokens{})
+	addr, existed, err := dedup.PutIfAbsent(ctx, s, slc, cnr, file, attrs)
+
+Note that the existing object is returned as is, its attributes may differ.
+*/
+package dedup
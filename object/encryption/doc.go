@@ -0,0 +1,29 @@
+/*
+Package encryption provides client-side encryption of the NeoFS object payload.
+
+Payload is encrypted with AES-GCM in chunks of fixed size, so it can be
+processed as a stream of unlimited size. Parameters required for decryption
+except the key itself are stored in the object attributes, the key is
+referenced by the user-defined identifier.
+
+Encryption is composable with the slicer:
+
+	enc, err := encryption.NewEncryptor(keyID, key, 0)
+	// ...
+	w, err := s.InitPut(ctx, append(attrs, enc.Attributes()...))
+	// ...
+	ew, err := enc.Writer(w)
+	// ...
+	_, err = io.Copy(ew, data)
+	// ...
+	err = ew.Close() // also closes w
+	// ...
+	id := w.ID()
+
+Decryption is done on reading:
+
+	data, err := encryption.NewReader(payload, hdr.Attributes(), keys)
+	// ...
+	_, err = io.Copy(dst, data)
+*/
+package encryption
@@ -0,0 +1,537 @@
+package encryption
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// Object attributes describing encryption of the payload.
+const (
+	// AttributeAlgorithm is an attribute key of the encryption algorithm.
+	// The only supported value is AlgorithmAESGCM.
+	AttributeAlgorithm = "Encryption-Algorithm"
+
+	// AttributeKeyID is an attribute key of the user-defined identifier of the
+	// encryption key.
+	AttributeKeyID = "Encryption-Key-ID"
+
+	// AttributeNonceStrategy is an attribute key of the nonce derivation
+	// strategy. The only supported value is NonceStrategySTREAM.
+	AttributeNonceStrategy = "Encryption-Nonce-Strategy"
+
+	// AttributeNonce is an attribute key of the Base64-encoded random nonce
+	// prefix.
+	AttributeNonce = "Encryption-Nonce"
+
+	// AttributeChunkSize is an attribute key of the plaintext chunk size in
+	// bytes.
+	AttributeChunkSize = "Encryption-Chunk-Size"
+)
+
+const (
+	// AlgorithmAESGCM is an AES in Galois/Counter Mode. Key size determines
+	// AES-128, AES-192 or AES-256.
+	AlgorithmAESGCM = "AES-GCM"
+
+	// NonceStrategySTREAM is a nonce derivation strategy of the STREAM
+	// construction: nonce of the chunk is a concatenation of the random 7-byte
+	// prefix, 4-byte big-endian chunk index and 1-byte flag of the last chunk.
+	// The flag protects the payload from truncation.
+	NonceStrategySTREAM = "STREAM"
+)
+
+// DefaultChunkSize is a default size of the plaintext chunk.
+const DefaultChunkSize = 64 << 10
+
+// MaxChunkSize is a maximum size of the plaintext chunk. It matches the
+// default maximum object size in the NeoFS network, so larger chunks do not
+// make sense. NewReader rejects larger chunk sizes from the object attributes.
+const MaxChunkSize = 64 << 20
+
+const (
+	noncePrefixSize = 7
+	nonceSize       = noncePrefixSize + 4 + 1
+	tagSize         = 16
+)
+
+// ErrNotEncrypted is returned by NewReader when object attributes do not
+// describe encryption.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrNotEncrypted = errors.New("object is not encrypted")
+
+// KeyProvider returns encryption key by its identifier.
+type KeyProvider func(keyID string) ([]byte, error)
+
+// RestoreReader implements [object.PayloadRestorer] via NewReader. It allows
+// to decrypt the payload without the Encryptor used on upload.
+func (x KeyProvider) RestoreReader(r io.Reader, attrs []object.Attribute) (io.Reader, error) {
+	return NewReader(r, attrs, x)
+}
+
+// Encryptor encrypts object payload. Encryptor must be constructed via
+// NewEncryptor. Single Encryptor encrypts exactly one payload since its nonce
+// prefix is generated once: Writer, Reader and TransformWriter fail if any of
+// them has already been called.
+type Encryptor struct {
+	// set when the stream is created, nonce prefix must not be reused
+	used uint32
+
+	keyID string
+
+	key []byte
+
+	aead cipher.AEAD
+
+	prefix [noncePrefixSize]byte
+
+	chunkSize int
+}
+
+// NewEncryptor constructs Encryptor of the payload using AES-GCM with the
+// given key of 16, 24 or 32 bytes. Key ID is stored in the object attributes
+// to select the key on decryption, it MUST NOT be empty. Non-positive chunk
+// size means DefaultChunkSize, chunk size MUST NOT exceed MaxChunkSize.
+func NewEncryptor(keyID string, key []byte, chunkSize int) (*Encryptor, error) {
+	if keyID == "" {
+		return nil, errors.New("empty key ID")
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	} else if chunkSize > MaxChunkSize {
+		return nil, fmt.Errorf("chunk size %d exceeds the limit %d", chunkSize, MaxChunkSize)
+	}
+
+	res := &Encryptor{
+		keyID:     keyID,
+		key:       append([]byte{}, key...),
+		aead:      aead,
+		chunkSize: chunkSize,
+	}
+
+	if _, err = rand.Read(res.prefix[:]); err != nil {
+		return nil, fmt.Errorf("generate nonce prefix: %w", err)
+	}
+
+	return res, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("init AES cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("init GCM: %w", err)
+	}
+
+	return aead, nil
+}
+
+// Attributes returns object attributes describing the encryption. They MUST
+// be added to the object header for decryption.
+func (x *Encryptor) Attributes() []object.Attribute {
+	attrs := make([]object.Attribute, 5)
+
+	attrs[0].SetKey(AttributeAlgorithm)
+	attrs[0].SetValue(AlgorithmAESGCM)
+	attrs[1].SetKey(AttributeKeyID)
+	attrs[1].SetValue(x.keyID)
+	attrs[2].SetKey(AttributeNonceStrategy)
+	attrs[2].SetValue(NonceStrategySTREAM)
+	attrs[3].SetKey(AttributeNonce)
+	attrs[3].SetValue(base64.StdEncoding.EncodeToString(x.prefix[:]))
+	attrs[4].SetKey(AttributeChunkSize)
+	attrs[4].SetValue(strconv.Itoa(x.chunkSize))
+
+	return attrs
+}
+
+// EncryptedSize returns size of the encrypted payload of the given plaintext
+// size.
+func (x *Encryptor) EncryptedSize(size uint64) uint64 {
+	chunks := (size + uint64(x.chunkSize) - 1) / uint64(x.chunkSize)
+	if chunks == 0 {
+		chunks = 1 // empty payload is still authenticated
+	}
+
+	return size + chunks*tagSize
+}
+
+// Writer returns io.WriteCloser encrypting the written data into w. Close
+// MUST be called to write the final chunk, it also closes w if it implements
+// io.Closer (e.g. slicer.PayloadWriter). Returns an error if the Encryptor
+// has already been used.
+func (x *Encryptor) Writer(w io.Writer) (io.WriteCloser, error) {
+	s, err := x.newStream()
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptingWriter{
+		stream: s,
+		w:      w,
+		buf:    make([]byte, 0, x.chunkSize+tagSize),
+		size:   x.chunkSize,
+	}, nil
+}
+
+// Reader returns io.Reader of the encrypted data read from r. Reader is
+// suitable for slicer.Slicer.Put. Returns an error if the Encryptor has
+// already been used.
+func (x *Encryptor) Reader(r io.Reader) (io.Reader, error) {
+	s, err := x.newStream()
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptingReader{
+		stream: s,
+		r:      bufio.NewReader(r),
+		chunk:  make([]byte, x.chunkSize, x.chunkSize+tagSize),
+	}, nil
+}
+
+// TransformWriter implements [object.PayloadTransformer] via Writer.
+func (x *Encryptor) TransformWriter(w io.Writer) (io.WriteCloser, error) {
+	return x.Writer(w)
+}
+
+// newStream returns the only stream of the Encryptor.
+func (x *Encryptor) newStream() (*stream, error) {
+	if !atomic.CompareAndSwapUint32(&x.used, 0, 1) {
+		return nil, errors.New("encryptor has already been used, nonce prefix cannot be reused")
+	}
+
+	return newStream(x.aead, x.prefix[:]), nil
+}
+
+// RestoreReader implements [object.PayloadRestorer] decrypting the payload
+// with the Encryptor key. Returns ErrNotEncrypted if attributes do not
+// describe encryption.
+//
+// See also NewReader.
+func (x *Encryptor) RestoreReader(r io.Reader, attrs []object.Attribute) (io.Reader, error) {
+	return NewReader(r, attrs, func(keyID string) ([]byte, error) {
+		if keyID != x.keyID {
+			return nil, fmt.Errorf("unknown key %q", keyID)
+		}
+
+		return x.key, nil
+	})
+}
+
+// stream seals and opens sequential chunks according to NonceStrategySTREAM.
+type stream struct {
+	aead cipher.AEAD
+
+	nonce [nonceSize]byte
+
+	counter uint64
+}
+
+func newStream(aead cipher.AEAD, prefix []byte) *stream {
+	res := &stream{aead: aead}
+	copy(res.nonce[:], prefix)
+	return res
+}
+
+func (x *stream) nextNonce(last bool) ([]byte, error) {
+	if x.counter > math.MaxUint32 {
+		return nil, errors.New("too many chunks")
+	}
+
+	binary.BigEndian.PutUint32(x.nonce[noncePrefixSize:], uint32(x.counter))
+	if last {
+		x.nonce[nonceSize-1] = 1
+	} else {
+		x.nonce[nonceSize-1] = 0
+	}
+
+	x.counter++
+
+	return x.nonce[:], nil
+}
+
+func (x *stream) seal(dst, chunk []byte, last bool) ([]byte, error) {
+	nonce, err := x.nextNonce(last)
+	if err != nil {
+		return nil, err
+	}
+
+	return x.aead.Seal(dst, nonce, chunk, nil), nil
+}
+
+func (x *stream) open(dst, chunk []byte, last bool) ([]byte, error) {
+	n := x.counter
+
+	nonce, err := x.nextNonce(last)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := x.aead.Open(dst, nonce, chunk, nil)
+	if err != nil {
+		return nil, fmt.Errorf("chunk #%d: %w", n, err)
+	}
+
+	return res, nil
+}
+
+type encryptingWriter struct {
+	stream *stream
+
+	w io.Writer
+
+	buf []byte
+
+	size int
+
+	closed bool
+}
+
+func (x *encryptingWriter) Write(p []byte) (int, error) {
+	if x.closed {
+		return 0, errors.New("write to closed encrypting writer")
+	}
+
+	var n int
+
+	for len(p) > 0 {
+		// flush only when the next byte arrives to know whether the chunk is last
+		if len(x.buf) == x.size {
+			if err := x.flush(false); err != nil {
+				return n, err
+			}
+		}
+
+		m := copy(x.buf[len(x.buf):x.size], p)
+		x.buf = x.buf[:len(x.buf)+m]
+		p = p[m:]
+		n += m
+	}
+
+	return n, nil
+}
+
+func (x *encryptingWriter) flush(last bool) error {
+	sealed, err := x.stream.seal(x.buf[:0], x.buf, last)
+	if err != nil {
+		return err
+	}
+
+	if _, err = x.w.Write(sealed); err != nil {
+		return fmt.Errorf("write encrypted chunk: %w", err)
+	}
+
+	x.buf = x.buf[:0]
+
+	return nil
+}
+
+func (x *encryptingWriter) Close() error {
+	if x.closed {
+		return nil
+	}
+
+	x.closed = true
+
+	if err := x.flush(true); err != nil {
+		return err
+	}
+
+	if c, ok := x.w.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+type encryptingReader struct {
+	stream *stream
+
+	r *bufio.Reader
+
+	chunk []byte
+
+	// sealed chunk not read yet
+	pending []byte
+
+	done bool
+}
+
+func (x *encryptingReader) Read(p []byte) (int, error) {
+	for len(x.pending) == 0 {
+		if x.done {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(x.r, x.chunk[:cap(x.chunk)-tagSize])
+		last := err != nil
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, err
+		}
+
+		if !last {
+			if _, err = x.r.Peek(1); err != nil {
+				if !errors.Is(err, io.EOF) {
+					return 0, err
+				}
+				last = true
+			}
+		}
+
+		x.pending, err = x.stream.seal(x.chunk[:0], x.chunk[:n], last)
+		if err != nil {
+			return 0, err
+		}
+
+		x.done = last
+	}
+
+	n := copy(p, x.pending)
+	x.pending = x.pending[n:]
+
+	return n, nil
+}
+
+// NewReader returns io.Reader of the decrypted payload of the object with
+// given attributes read from r. Key is selected by the identifier from the
+// attributes. Returns ErrNotEncrypted if attributes do not describe
+// encryption.
+//
+// Reader returns an error if payload has been corrupted or truncated, so data
+// read before the error MUST NOT be trusted.
+func NewReader(r io.Reader, attrs []object.Attribute, keys KeyProvider) (io.Reader, error) {
+	var alg, keyID, strategy, nonce, chunkSize string
+
+	for i := range attrs {
+		switch attrs[i].Key() {
+		case AttributeAlgorithm:
+			alg = attrs[i].Value()
+		case AttributeKeyID:
+			keyID = attrs[i].Value()
+		case AttributeNonceStrategy:
+			strategy = attrs[i].Value()
+		case AttributeNonce:
+			nonce = attrs[i].Value()
+		case AttributeChunkSize:
+			chunkSize = attrs[i].Value()
+		}
+	}
+
+	if alg == "" {
+		return nil, ErrNotEncrypted
+	}
+
+	if alg != AlgorithmAESGCM {
+		return nil, fmt.Errorf("unsupported encryption algorithm %q", alg)
+	}
+
+	if strategy != NonceStrategySTREAM {
+		return nil, fmt.Errorf("unsupported nonce strategy %q", strategy)
+	}
+
+	prefix, err := base64.StdEncoding.DecodeString(nonce)
+	if err != nil {
+		return nil, fmt.Errorf("decode nonce prefix: %w", err)
+	} else if len(prefix) != noncePrefixSize {
+		return nil, fmt.Errorf("invalid nonce prefix length %d", len(prefix))
+	}
+
+	size, err := strconv.Atoi(chunkSize)
+	if err != nil {
+		return nil, fmt.Errorf("decode chunk size: %w", err)
+	} else if size <= 0 || size > MaxChunkSize {
+		return nil, fmt.Errorf("invalid chunk size %d", size)
+	}
+
+	key, err := keys(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("get key %q: %w", keyID, err)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptingReader{
+		stream: newStream(aead, prefix),
+		r:      bufio.NewReader(r),
+		chunk:  make([]byte, size+tagSize),
+	}, nil
+}
+
+type decryptingReader struct {
+	stream *stream
+
+	r *bufio.Reader
+
+	chunk []byte
+
+	// opened chunk not read yet
+	pending []byte
+
+	done bool
+}
+
+func (x *decryptingReader) Read(p []byte) (int, error) {
+	for len(x.pending) == 0 {
+		if x.done {
+			return 0, io.EOF
+		}
+
+		n, err := io.ReadFull(x.r, x.chunk)
+		last := err != nil
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, err
+		}
+
+		if !last {
+			if _, err = x.r.Peek(1); err != nil {
+				if !errors.Is(err, io.EOF) {
+					return 0, err
+				}
+				last = true
+			}
+		}
+
+		if n < tagSize {
+			return 0, io.ErrUnexpectedEOF
+		}
+
+		x.pending, err = x.stream.open(x.chunk[:0], x.chunk[:n], last)
+		if err != nil {
+			return 0, err
+		}
+
+		x.done = last
+	}
+
+	n := copy(p, x.pending)
+	x.pending = x.pending[n:]
+
+	return n, nil
+}
@@ -0,0 +1,235 @@
+package encryption_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/encryption"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeyID = "test-key"
+
+func randomBytes(t testing.TB, n int) []byte {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func keys(key []byte) encryption.KeyProvider {
+	return func(id string) ([]byte, error) {
+		if id != testKeyID {
+			return nil, errors.New("unknown key")
+		}
+		return key, nil
+	}
+}
+
+func decrypt(t testing.TB, enc *encryption.Encryptor, key, data []byte) ([]byte, error) {
+	r, err := encryption.NewReader(bytes.NewReader(data), enc.Attributes(), keys(key))
+	require.NoError(t, err)
+	return io.ReadAll(r)
+}
+
+func TestEncryptor(t *testing.T) {
+	const chunkSize = 32
+
+	for _, keySize := range []int{16, 24, 32} {
+		key := randomBytes(t, keySize)
+
+		for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3 * chunkSize, 5*chunkSize + 7} {
+			data := randomBytes(t, size)
+
+			enc, err := encryption.NewEncryptor(testKeyID, key, chunkSize)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			w, err := enc.Writer(&buf)
+			require.NoError(t, err)
+			// write by small portions to cover buffering
+			for p := data; len(p) > 0; {
+				n := 5
+				if n > len(p) {
+					n = len(p)
+				}
+				_, err = w.Write(p[:n])
+				require.NoError(t, err)
+				p = p[n:]
+			}
+			require.NoError(t, w.Close())
+
+			require.EqualValues(t, enc.EncryptedSize(uint64(size)), buf.Len(), size)
+			if size >= chunkSize {
+				require.NotContains(t, buf.String(), string(data[:chunkSize]))
+			}
+
+			res, err := decrypt(t, enc, key, buf.Bytes())
+			require.NoError(t, err)
+			require.Equal(t, data, res, size)
+
+			// nonce prefix must not be reused
+			_, err = enc.Writer(io.Discard)
+			require.Error(t, err)
+			_, err = enc.Reader(bytes.NewReader(data))
+			require.Error(t, err)
+			_, err = enc.TransformWriter(io.Discard)
+			require.Error(t, err)
+
+			enc2, err := encryption.NewEncryptor(testKeyID, key, chunkSize)
+			require.NoError(t, err)
+			er, err := enc2.Reader(bytes.NewReader(data))
+			require.NoError(t, err)
+			fromReader, err := io.ReadAll(er)
+			require.NoError(t, err)
+			require.Len(t, fromReader, buf.Len())
+
+			res, err = decrypt(t, enc2, key, fromReader)
+			require.NoError(t, err)
+			require.Equal(t, data, res, size)
+
+			r, err := enc.RestoreReader(bytes.NewReader(buf.Bytes()), enc.Attributes())
+			require.NoError(t, err)
+			res, err = io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, data, res, size)
+		}
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	const chunkSize = 16
+
+	key := randomBytes(t, 32)
+	data := randomBytes(t, 4*chunkSize)
+
+	enc, err := encryption.NewEncryptor(testKeyID, key, chunkSize)
+	require.NoError(t, err)
+
+	er, err := enc.Reader(bytes.NewReader(data))
+	require.NoError(t, err)
+	encrypted, err := io.ReadAll(er)
+	require.NoError(t, err)
+
+	t.Run("not encrypted", func(t *testing.T) {
+		_, err := encryption.NewReader(bytes.NewReader(data), nil, keys(key))
+		require.ErrorIs(t, err, encryption.ErrNotEncrypted)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		attrs := enc.Attributes()
+		for i := range attrs {
+			if attrs[i].Key() == encryption.AttributeKeyID {
+				attrs[i].SetValue("other")
+			}
+		}
+		_, err := encryption.NewReader(bytes.NewReader(encrypted), attrs, keys(key))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid chunk size", func(t *testing.T) {
+		for _, val := range []string{"0", "-1", "67108865", "9223372036854775807", "99999999999999999999"} {
+			attrs := enc.Attributes()
+			for i := range attrs {
+				if attrs[i].Key() == encryption.AttributeChunkSize {
+					attrs[i].SetValue(val)
+				}
+			}
+			_, err := encryption.NewReader(bytes.NewReader(encrypted), attrs, keys(key))
+			require.Error(t, err, val)
+		}
+
+		_, err := encryption.NewEncryptor(testKeyID, key, encryption.MaxChunkSize+1)
+		require.Error(t, err)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := decrypt(t, enc, randomBytes(t, 32), encrypted)
+		require.Error(t, err)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		corrupted := append([]byte{}, encrypted...)
+		corrupted[len(corrupted)/2]++
+		_, err := decrypt(t, enc, key, corrupted)
+		require.Error(t, err)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		// drop the last chunk
+		_, err := decrypt(t, enc, key, encrypted[:len(encrypted)-(chunkSize+16)])
+		require.Error(t, err)
+		_, err = decrypt(t, enc, key, encrypted[:len(encrypted)-1])
+		require.Error(t, err)
+	})
+
+	t.Run("reordered", func(t *testing.T) {
+		const sealedSize = chunkSize + 16
+		reordered := append([]byte{}, encrypted...)
+		copy(reordered, encrypted[sealedSize:2*sealedSize])
+		copy(reordered[sealedSize:], encrypted[:sealedSize])
+		_, err := decrypt(t, enc, key, reordered)
+		require.Error(t, err)
+	})
+}
+
+type payloadCollector struct {
+	payload bytes.Buffer
+}
+
+func (x *payloadCollector) ObjectPutInit(context.Context, object.Object, user.Signer, client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	return x, nil
+}
+
+func (x *payloadCollector) Write(p []byte) (int, error) { return x.payload.Write(p) }
+
+func (x *payloadCollector) Close() error { return nil }
+
+func (x *payloadCollector) GetResult() client.ResObjectPut { return client.ResObjectPut{} }
+
+func TestEncryptor_Slicer(t *testing.T) {
+	key := randomBytes(t, 32)
+	data := randomBytes(t, 1000)
+	signer := test.RandomSignerRFC6979(t)
+
+	enc, err := encryption.NewEncryptor(testKeyID, key, 100)
+	require.NoError(t, err)
+
+	owner := signer.UserID()
+
+	var hdr object.Object
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(&owner)
+	hdr.SetAttributes(enc.Attributes()...)
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(1 << 20)
+
+	var c payloadCollector
+
+	w, err := slicer.InitPut(context.Background(), &c, hdr, signer, opts)
+	require.NoError(t, err)
+
+	ew, err := enc.Writer(w)
+	require.NoError(t, err)
+	_, err = io.Copy(ew, bytes.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, ew.Close())
+
+	r, err := encryption.NewReader(&c.payload, hdr.Attributes(), keys(key))
+	require.NoError(t, err)
+
+	res, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, res)
+}
@@ -0,0 +1,24 @@
+/*
+Package erasure provides Reed–Solomon erasure coding of the NeoFS object
+payload.
+
+Payload is split into data parts and supplemented with parity parts, each
+part is stored as a separate object. Any set of parts with the number equal to
+the number of data parts is enough to restore the payload, so the storage
+overhead of the redundancy is parity/data instead of full copies required by
+replication.
+
+	parts, err := erasure.NewScheme(4, 2).Split(payload)
+	// ...
+	for i := range parts {
+		_, err = s.Put(ctx, bytes.NewReader(parts[i].Data()), append(attrs, parts[i].Attributes()...))
+		// ...
+	}
+
+Parts may be joined by any of them:
+
+	r, err := erasure.NewReader(ctx, hdr.Attributes(), getPart)
+	// ...
+	payload, err := io.ReadAll(r)
+*/
+package erasure
@@ -0,0 +1,375 @@
+package erasure
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// Object attributes describing erasure-coded part of the payload.
+const (
+	// AttributeDataParts is an attribute key of the number of data parts.
+	AttributeDataParts = "Erasure-Data-Parts"
+
+	// AttributeParityParts is an attribute key of the number of parity parts.
+	AttributeParityParts = "Erasure-Parity-Parts"
+
+	// AttributePartIndex is an attribute key of the part index. Data parts
+	// go first.
+	AttributePartIndex = "Erasure-Part-Index"
+
+	// AttributePayloadSize is an attribute key of the original payload size.
+	AttributePayloadSize = "Erasure-Payload-Size"
+
+	// AttributePayloadHash is an attribute key of the hex-encoded SHA-256
+	// checksum of the original payload.
+	AttributePayloadHash = "Erasure-Payload-Hash"
+
+	// AttributePartHashes is an attribute key of the Base64-encoded
+	// concatenation of SHA-256 checksums of all parts in order of indices.
+	// Checksums allow to detect corrupted parts and replace them.
+	AttributePartHashes = "Erasure-Part-Hashes"
+)
+
+// MaxParts is the maximum total number of data and parity parts.
+const MaxParts = 256
+
+// ErrNotErasureCoded is returned by DecodePartInfo when object attributes do
+// not describe erasure-coded part.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrNotErasureCoded = errors.New("object is not an erasure-coded part")
+
+// Scheme describes erasure coding by the number of data and parity parts.
+type Scheme struct {
+	data, parity int
+}
+
+// NewScheme constructs Scheme with the given number of data and parity parts.
+// Both MUST be positive and their sum MUST NOT exceed MaxParts.
+func NewScheme(data, parity int) Scheme {
+	return Scheme{data: data, parity: parity}
+}
+
+// DataParts returns number of data parts.
+func (s Scheme) DataParts() int {
+	return s.data
+}
+
+// ParityParts returns number of parity parts.
+func (s Scheme) ParityParts() int {
+	return s.parity
+}
+
+// String implements fmt.Stringer.
+func (s Scheme) String() string {
+	return fmt.Sprintf("RS(%d,%d)", s.data, s.parity)
+}
+
+func (s Scheme) validate() error {
+	if s.data <= 0 || s.parity <= 0 {
+		return fmt.Errorf("invalid scheme %s: non-positive number of parts", s)
+	}
+
+	if s.data+s.parity > MaxParts {
+		return fmt.Errorf("invalid scheme %s: more than %d parts", s, MaxParts)
+	}
+
+	return nil
+}
+
+// PartInfo describes erasure-coded part stored in the object attributes.
+type PartInfo struct {
+	scheme Scheme
+
+	index int
+
+	size uint64
+
+	hash [sha256.Size]byte
+
+	// concatenated checksums of all parts
+	partHashes []byte
+}
+
+// Scheme returns erasure coding scheme of the part.
+func (x PartInfo) Scheme() Scheme {
+	return x.scheme
+}
+
+// Index returns index of the part. Indices of data parts are less than
+// Scheme.DataParts.
+func (x PartInfo) Index() int {
+	return x.index
+}
+
+// PayloadSize returns size of the original payload.
+func (x PartInfo) PayloadSize() uint64 {
+	return x.size
+}
+
+// checkPart checks whether the part with the given index matches its
+// checksum.
+func (x PartInfo) checkPart(index int, data []byte) bool {
+	h := sha256.Sum256(data)
+	return bytes.Equal(h[:], x.partHashes[index*sha256.Size:(index+1)*sha256.Size])
+}
+
+// partSize returns size of each part.
+func (x PartInfo) partSize() int {
+	return int((x.size + uint64(x.scheme.data) - 1) / uint64(x.scheme.data))
+}
+
+// Attributes returns object attributes describing the part. They MUST be
+// added to the object header of the part.
+func (x PartInfo) Attributes() []object.Attribute {
+	attrs := make([]object.Attribute, 6)
+
+	attrs[0].SetKey(AttributeDataParts)
+	attrs[0].SetValue(strconv.Itoa(x.scheme.data))
+	attrs[1].SetKey(AttributeParityParts)
+	attrs[1].SetValue(strconv.Itoa(x.scheme.parity))
+	attrs[2].SetKey(AttributePartIndex)
+	attrs[2].SetValue(strconv.Itoa(x.index))
+	attrs[3].SetKey(AttributePayloadSize)
+	attrs[3].SetValue(strconv.FormatUint(x.size, 10))
+	attrs[4].SetKey(AttributePayloadHash)
+	attrs[4].SetValue(hex.EncodeToString(x.hash[:]))
+	attrs[5].SetKey(AttributePartHashes)
+	attrs[5].SetValue(base64.StdEncoding.EncodeToString(x.partHashes))
+
+	return attrs
+}
+
+// DecodePartInfo decodes PartInfo from the object attributes. Returns
+// ErrNotErasureCoded if attributes do not describe erasure-coded part.
+func DecodePartInfo(attrs []object.Attribute) (PartInfo, error) {
+	var res PartInfo
+	var data, parity, index, size, hash, partHashes string
+
+	for i := range attrs {
+		switch attrs[i].Key() {
+		case AttributeDataParts:
+			data = attrs[i].Value()
+		case AttributeParityParts:
+			parity = attrs[i].Value()
+		case AttributePartIndex:
+			index = attrs[i].Value()
+		case AttributePayloadSize:
+			size = attrs[i].Value()
+		case AttributePayloadHash:
+			hash = attrs[i].Value()
+		case AttributePartHashes:
+			partHashes = attrs[i].Value()
+		}
+	}
+
+	if data == "" {
+		return res, ErrNotErasureCoded
+	}
+
+	var err error
+
+	if res.scheme.data, err = strconv.Atoi(data); err != nil {
+		return res, fmt.Errorf("decode number of data parts: %w", err)
+	}
+
+	if res.scheme.parity, err = strconv.Atoi(parity); err != nil {
+		return res, fmt.Errorf("decode number of parity parts: %w", err)
+	}
+
+	if err = res.scheme.validate(); err != nil {
+		return res, err
+	}
+
+	if res.index, err = strconv.Atoi(index); err != nil {
+		return res, fmt.Errorf("decode part index: %w", err)
+	} else if res.index < 0 || res.index >= res.scheme.data+res.scheme.parity {
+		return res, fmt.Errorf("part index %d out of scheme %s", res.index, res.scheme)
+	}
+
+	if res.size, err = strconv.ParseUint(size, 10, 64); err != nil {
+		return res, fmt.Errorf("decode payload size: %w", err)
+	}
+
+	b, err := hex.DecodeString(hash)
+	if err != nil {
+		return res, fmt.Errorf("decode payload hash: %w", err)
+	} else if len(b) != sha256.Size {
+		return res, fmt.Errorf("invalid payload hash length %d", len(b))
+	}
+
+	copy(res.hash[:], b)
+
+	if res.partHashes, err = base64.StdEncoding.DecodeString(partHashes); err != nil {
+		return res, fmt.Errorf("decode part hashes: %w", err)
+	} else if n := (res.scheme.data + res.scheme.parity) * sha256.Size; len(res.partHashes) != n {
+		return res, fmt.Errorf("invalid part hashes length %d instead of %d", len(res.partHashes), n)
+	}
+
+	return res, nil
+}
+
+// Part is a part of the erasure-coded payload.
+type Part struct {
+	PartInfo
+
+	data []byte
+}
+
+// Data returns payload of the part.
+func (x Part) Data() []byte {
+	return x.data
+}
+
+// Split splits payload into data parts of the same size (the last one is
+// padded with zeros) and calculates parity parts. Data parts go first, each
+// part MUST be stored as a separate object with Part.Attributes.
+func (s Scheme) Split(payload []byte) ([]Part, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	info := PartInfo{
+		scheme: s,
+		size:   uint64(len(payload)),
+		hash:   sha256.Sum256(payload),
+	}
+
+	partSize := info.partSize()
+	buf := make([]byte, (s.data+s.parity)*partSize)
+	copy(buf, payload)
+
+	res := make([]Part, s.data+s.parity)
+	for i := range res {
+		res[i].data = buf[i*partSize : (i+1)*partSize]
+	}
+
+	for i := 0; i < s.parity; i++ {
+		coefs := cauchyRow(s.data, i)
+		for j := 0; j < s.data; j++ {
+			mulAdd(res[s.data+i].data, res[j].data, coefs[j])
+		}
+	}
+
+	info.partHashes = make([]byte, 0, len(res)*sha256.Size)
+	for i := range res {
+		h := sha256.Sum256(res[i].data)
+		info.partHashes = append(info.partHashes, h[:]...)
+	}
+
+	for i := range res {
+		res[i].PartInfo = info
+		res[i].index = i
+	}
+
+	return res, nil
+}
+
+// PartGetter returns payload of the part by its index. PartGetter returns an
+// error if the part is unavailable.
+type PartGetter func(ctx context.Context, index int) ([]byte, error)
+
+// NewReader returns io.Reader of the original payload joined from the parts
+// of the erasure-coded payload described by the attributes of any of its
+// parts. Parts are requested via PartGetter with data parts first, missing
+// and invalid ones (having wrong size or checksum) are replaced by parity
+// parts. Payload is checked against the original checksum.
+func NewReader(ctx context.Context, attrs []object.Attribute, get PartGetter) (io.Reader, error) {
+	info, err := DecodePartInfo(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := join(ctx, info, get)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(payload), nil
+}
+
+func join(ctx context.Context, info PartInfo, get PartGetter) ([]byte, error) {
+	s := info.scheme
+	partSize := info.partSize()
+
+	var lastErr error
+	indices := make([]int, 0, s.data)
+	parts := make([][]byte, 0, s.data)
+
+	for i := 0; i < s.data+s.parity && len(parts) < s.data; i++ {
+		b, err := get(ctx, i)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			lastErr = fmt.Errorf("get part #%d: %w", i, err)
+			continue
+		}
+
+		if len(b) != partSize {
+			lastErr = fmt.Errorf("part #%d has invalid size %d instead of %d", i, len(b), partSize)
+			continue
+		}
+
+		if !info.checkPart(i, b) {
+			lastErr = fmt.Errorf("part #%d checksum mismatch", i)
+			continue
+		}
+
+		indices = append(indices, i)
+		parts = append(parts, b)
+	}
+
+	if len(parts) < s.data {
+		return nil, fmt.Errorf("not enough parts: %d of %d required: %w", len(parts), s.data, lastErr)
+	}
+
+	payload := make([]byte, s.data*partSize)
+
+	if indices[s.data-1] == s.data-1 {
+		// all data parts are available
+		for i := range parts {
+			copy(payload[i*partSize:], parts[i])
+		}
+	} else {
+		m := make([][]byte, s.data)
+		for i, idx := range indices {
+			if idx < s.data {
+				m[i] = make([]byte, s.data)
+				m[i][idx] = 1
+			} else {
+				m[i] = cauchyRow(s.data, idx-s.data)
+			}
+		}
+
+		if err := invertMatrix(m); err != nil {
+			return nil, fmt.Errorf("decode parts: %w", err)
+		}
+
+		for i := 0; i < s.data; i++ {
+			dst := payload[i*partSize : (i+1)*partSize]
+			for j := range parts {
+				mulAdd(dst, parts[j], m[i][j])
+			}
+		}
+	}
+
+	payload = payload[:info.size]
+
+	if sha256.Sum256(payload) != info.hash {
+		return nil, errors.New("payload checksum mismatch")
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,163 @@
+package erasure_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object/erasure"
+	"github.com/stretchr/testify/require"
+)
+
+func randomBytes(t testing.TB, n int) []byte {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+// getter returns PartGetter of the given parts failing on the missing ones.
+func getter(parts []erasure.Part, missing ...int) erasure.PartGetter {
+	return func(_ context.Context, index int) ([]byte, error) {
+		for _, i := range missing {
+			if i == index {
+				return nil, errors.New("part is unavailable")
+			}
+		}
+		return parts[index].Data(), nil
+	}
+}
+
+func join(parts []erasure.Part, get erasure.PartGetter) ([]byte, error) {
+	r, err := erasure.NewReader(context.Background(), parts[0].Attributes(), get)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestScheme_Split(t *testing.T) {
+	for _, tc := range []struct{ data, parity, size int }{
+		{1, 1, 10},
+		{4, 2, 0},
+		{4, 2, 1},
+		{4, 2, 1000},
+		{6, 3, 1001},
+		{10, 4, 4096},
+	} {
+		payload := randomBytes(t, tc.size)
+		s := erasure.NewScheme(tc.data, tc.parity)
+
+		parts, err := s.Split(payload)
+		require.NoError(t, err)
+		require.Len(t, parts, tc.data+tc.parity)
+
+		for i := range parts {
+			require.Equal(t, i, parts[i].Index())
+
+			info, err := erasure.DecodePartInfo(parts[i].Attributes())
+			require.NoError(t, err)
+			require.Equal(t, parts[i].PartInfo, info)
+		}
+
+		res, err := join(parts, getter(parts))
+		require.NoError(t, err)
+		require.Equal(t, payload, res)
+
+		// any parity number of missing parts
+		for first := 0; first+tc.parity <= len(parts); first++ {
+			var missing []int
+			for i := first; i < first+tc.parity; i++ {
+				missing = append(missing, i)
+			}
+
+			res, err = join(parts, getter(parts, missing...))
+			require.NoError(t, err, missing)
+			require.Equal(t, payload, res, missing)
+		}
+
+		missing := make([]int, tc.parity+1)
+		for i := range missing {
+			missing[i] = i
+		}
+
+		_, err = join(parts, getter(parts, missing...))
+		require.Error(t, err)
+	}
+}
+
+func TestScheme_Invalid(t *testing.T) {
+	for _, s := range []erasure.Scheme{
+		erasure.NewScheme(0, 1),
+		erasure.NewScheme(1, 0),
+		erasure.NewScheme(200, 57),
+	} {
+		_, err := s.Split([]byte("payload"))
+		require.Error(t, err, s)
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	payload := randomBytes(t, 1000)
+
+	parts, err := erasure.NewScheme(4, 2).Split(payload)
+	require.NoError(t, err)
+
+	t.Run("not erasure-coded", func(t *testing.T) {
+		_, err := erasure.NewReader(context.Background(), nil, getter(parts))
+		require.ErrorIs(t, err, erasure.ErrNotErasureCoded)
+	})
+
+	t.Run("invalid part size", func(t *testing.T) {
+		get := func(ctx context.Context, index int) ([]byte, error) {
+			if index == 1 {
+				return parts[index].Data()[1:], nil
+			}
+			return parts[index].Data(), nil
+		}
+
+		res, err := join(parts, get)
+		require.NoError(t, err)
+		require.Equal(t, payload, res)
+	})
+
+	corrupting := func(corrupted ...int) erasure.PartGetter {
+		return func(ctx context.Context, index int) ([]byte, error) {
+			b := parts[index].Data()
+			for _, i := range corrupted {
+				if i == index {
+					b = append([]byte{b[0] + 1}, b[1:]...)
+				}
+			}
+			return b, nil
+		}
+	}
+
+	t.Run("corrupted part", func(t *testing.T) {
+		// corrupted data part of the correct size is replaced by parity
+		res, err := join(parts, corrupting(0))
+		require.NoError(t, err)
+		require.Equal(t, payload, res)
+
+		res, err = join(parts, corrupting(1, 4))
+		require.NoError(t, err)
+		require.Equal(t, payload, res)
+
+		_, err = join(parts, corrupting(0, 2, 5))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid part hashes", func(t *testing.T) {
+		attrs := parts[0].Attributes()
+		for i := range attrs {
+			if attrs[i].Key() == erasure.AttributePartHashes {
+				attrs[i].SetValue(attrs[i].Value()[4:])
+			}
+		}
+
+		_, err := erasure.DecodePartInfo(attrs)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,112 @@
+package erasure
+
+import "errors"
+
+// arithmetic in GF(2^8) with primitive polynomial x^8+x^4+x^3+x^2+1.
+
+var gfExp [510]byte
+var gfLog [256]byte
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfLog[x] = byte(i)
+
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+
+	for i := 255; i < len(gfExp); i++ {
+		gfExp[i] = gfExp[i-255]
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfInv(a byte) byte {
+	return gfExp[255-int(gfLog[a])]
+}
+
+// cauchyRow returns coefficients of the parity part with the given index.
+// Cauchy matrix guarantees that any square submatrix of the coding matrix
+// made of the identity and Cauchy rows is invertible.
+func cauchyRow(data, index int) []byte {
+	res := make([]byte, data)
+	for j := range res {
+		res[j] = gfInv(byte(data+index) ^ byte(j))
+	}
+
+	return res
+}
+
+// invertMatrix inverts square matrix in place using Gauss-Jordan elimination.
+func invertMatrix(m [][]byte) error {
+	n := len(m)
+
+	inv := make([][]byte, n)
+	for i := range inv {
+		inv[i] = make([]byte, n)
+		inv[i][i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for pivot < n && m[pivot][col] == 0 {
+			pivot++
+		}
+
+		if pivot == n {
+			return errors.New("singular matrix")
+		}
+
+		m[col], m[pivot] = m[pivot], m[col]
+		inv[col], inv[pivot] = inv[pivot], inv[col]
+
+		if c := m[col][col]; c != 1 {
+			c = gfInv(c)
+			for j := 0; j < n; j++ {
+				m[col][j] = gfMul(m[col][j], c)
+				inv[col][j] = gfMul(inv[col][j], c)
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			if i == col || m[i][col] == 0 {
+				continue
+			}
+
+			c := m[i][col]
+			for j := 0; j < n; j++ {
+				m[i][j] ^= gfMul(m[col][j], c)
+				inv[i][j] ^= gfMul(inv[col][j], c)
+			}
+		}
+	}
+
+	copy(m, inv)
+
+	return nil
+}
+
+// mulAdd adds src multiplied by c to dst.
+func mulAdd(dst, src []byte, c byte) {
+	if c == 0 {
+		return
+	}
+
+	lc := int(gfLog[c])
+	for i := range src {
+		if src[i] != 0 {
+			dst[i] ^= gfExp[lc+int(gfLog[src[i]])]
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-sdk-go/checksum"
@@ -13,9 +14,8 @@ import (
 )
 
 var (
-	errCheckSumMismatch = errors.New("payload checksum mismatch")
-	errCheckSumNotSet   = errors.New("payload checksum is not set")
-	errIncorrectID      = errors.New("incorrect object identifier")
+	errCheckSumNotSet = errors.New("payload checksum is not set")
+	errIncorrectID    = errors.New("incorrect object identifier")
 )
 
 // CalculatePayloadChecksum calculates and returns checksum of
@@ -46,16 +46,30 @@ func (o *Object) VerifyPayloadChecksum() error {
 	}
 
 	if !bytes.Equal(cs.Value(), actual.Value()) {
-		return errCheckSumMismatch
+		return ErrPayloadChecksumMismatch
 	}
 
 	return nil
 }
 
+// headerBuffers pools buffers for the header binary form hashed on
+// identifier calculation.
+var headerBuffers = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 1024)
+		return &b
+	},
+}
+
 // CalculateID calculates identifier for the object.
 func (o *Object) CalculateID() (oid.ID, error) {
+	buf := headerBuffers.Get().(*[]byte)
+	*buf = o.MarshalHeaderTo(*buf)
+
 	var id oid.ID
-	id.SetSHA256(sha256.Sum256(o.ToV2().GetHeader().StableMarshal(nil)))
+	id.SetSHA256(sha256.Sum256(*buf))
+
+	headerBuffers.Put(buf)
 
 	return id, nil
 }
@@ -0,0 +1,280 @@
+package oid
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// field number of the addresses in the AddressSet binary format.
+const fieldAddressSetAddresses = 1
+
+// AddressSet is a set of unique object addresses preserving the order in which
+// they were added except for the Remove one. AddressSet is intended to accumulate members of the
+// tombstones, locks, storage groups and batch operations.
+//
+// Instances can be created using built-in var declaration, or by
+// NewAddressSet. AddressSet contains internal references, so it is used by
+// pointer only and MUST NOT be copied, use Clone instead. AddressSet is not
+// safe for concurrent use.
+type AddressSet struct {
+	idx map[Address]int
+
+	list []Address
+}
+
+// NewAddressSet constructs AddressSet of the given addresses. Duplicates are
+// ignored.
+func NewAddressSet(addrs ...Address) *AddressSet {
+	res := new(AddressSet)
+	res.Add(addrs...)
+
+	return res
+}
+
+// Clone returns deep copy of the set.
+func (x *AddressSet) Clone() *AddressSet {
+	return NewAddressSet(x.list...)
+}
+
+// Add adds the given addresses to the set and returns number of the addresses
+// which were not already present.
+func (x *AddressSet) Add(addrs ...Address) int {
+	if x.idx == nil {
+		x.idx = make(map[Address]int, len(addrs))
+	}
+
+	var n int
+
+	for i := range addrs {
+		if _, ok := x.idx[addrs[i]]; ok {
+			continue
+		}
+
+		x.idx[addrs[i]] = len(x.list)
+		x.list = append(x.list, addrs[i])
+		n++
+	}
+
+	return n
+}
+
+// Remove removes the given address from the set and returns true if it was
+// present. The last added address takes the place of the removed one.
+func (x *AddressSet) Remove(addr Address) bool {
+	i, ok := x.idx[addr]
+	if !ok {
+		return false
+	}
+
+	last := len(x.list) - 1
+	if i != last {
+		x.list[i] = x.list[last]
+		x.idx[x.list[i]] = i
+	}
+
+	delete(x.idx, addr)
+	x.list = x.list[:last]
+
+	return true
+}
+
+// Contains checks whether the set contains the given address.
+func (x *AddressSet) Contains(addr Address) bool {
+	_, ok := x.idx[addr]
+	return ok
+}
+
+// Len returns number of the addresses in the set.
+func (x *AddressSet) Len() int {
+	return len(x.list)
+}
+
+// Addresses returns all addresses of the set in order of addition. The result
+// is a copy, so it may be modified freely.
+func (x *AddressSet) Addresses() []Address {
+	if len(x.list) == 0 {
+		return nil
+	}
+
+	return append([]Address(nil), x.list...)
+}
+
+// Iterate calls f for each address of the set in order of addition and stops
+// when f returns true. The set MUST NOT be changed by f.
+func (x *AddressSet) Iterate(f func(Address) bool) {
+	for i := range x.list {
+		if f(x.list[i]) {
+			return
+		}
+	}
+}
+
+// Containers returns IDs of the containers of the set addresses in order of
+// their first occurrence.
+func (x *AddressSet) Containers() []cid.ID {
+	var res []cid.ID
+	seen := make(map[cid.ID]struct{})
+
+	for i := range x.list {
+		cnr := x.list[i].Container()
+		if _, ok := seen[cnr]; !ok {
+			seen[cnr] = struct{}{}
+			res = append(res, cnr)
+		}
+	}
+
+	return res
+}
+
+// Objects returns IDs of the objects from the specified container in order of
+// addition.
+func (x *AddressSet) Objects(cnr cid.ID) []ID {
+	var res []ID
+
+	for i := range x.list {
+		if x.list[i].Container() == cnr {
+			res = append(res, x.list[i].Object())
+		}
+	}
+
+	return res
+}
+
+// SingleContainer returns container of all the set addresses and object IDs
+// in order of addition. Returns an error if the set is empty or its addresses
+// belong to different containers.
+func (x *AddressSet) SingleContainer() (cid.ID, []ID, error) {
+	if len(x.list) == 0 {
+		return cid.ID{}, nil, errors.New("empty set")
+	}
+
+	cnr := x.list[0].Container()
+	ids := make([]ID, len(x.list))
+
+	for i := range x.list {
+		if x.list[i].Container() != cnr {
+			return cid.ID{}, nil, fmt.Errorf("address #%d is from container %s instead of %s", i, x.list[i].Container(), cnr)
+		}
+
+		ids[i] = x.list[i].Object()
+	}
+
+	return cnr, ids, nil
+}
+
+// Union returns new AddressSet of the addresses present in any of x and y.
+// Addresses of x go first.
+func (x *AddressSet) Union(y *AddressSet) *AddressSet {
+	res := NewAddressSet(x.list...)
+	res.Add(y.list...)
+
+	return res
+}
+
+// Intersection returns new AddressSet of the addresses present in both x and
+// y in order of x.
+func (x *AddressSet) Intersection(y *AddressSet) *AddressSet {
+	res := new(AddressSet)
+
+	for i := range x.list {
+		if y.Contains(x.list[i]) {
+			res.Add(x.list[i])
+		}
+	}
+
+	return res
+}
+
+// Difference returns new AddressSet of the addresses of x which are not
+// present in y in order of x.
+func (x *AddressSet) Difference(y *AddressSet) *AddressSet {
+	res := new(AddressSet)
+
+	for i := range x.list {
+		if !y.Contains(x.list[i]) {
+			res.Add(x.list[i])
+		}
+	}
+
+	return res
+}
+
+// Marshal encodes AddressSet into a Protocol Buffers V3 binary format of the
+// message with single repeated refs.Address field. Addresses are encoded in
+// order of addition.
+//
+// See also Unmarshal.
+func (x *AddressSet) Marshal() []byte {
+	var res []byte
+	var m refs.Address
+
+	for i := range x.list {
+		x.list[i].WriteToV2(&m)
+		res = protowire.AppendTag(res, fieldAddressSetAddresses, protowire.BytesType)
+		res = protowire.AppendBytes(res, m.StableMarshal(nil))
+	}
+
+	return res
+}
+
+// Unmarshal decodes AddressSet from the Protocol Buffers V3 binary format.
+// Returns an error describing a format violation. Duplicated addresses are
+// ignored, unknown fields are skipped.
+//
+// See also Marshal.
+func (x *AddressSet) Unmarshal(data []byte) error {
+	var res AddressSet
+
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return fmt.Errorf("invalid field tag: %w", protowire.ParseError(n))
+		}
+
+		data = data[n:]
+
+		if num != fieldAddressSetAddresses {
+			n = protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return fmt.Errorf("invalid field #%d: %w", num, protowire.ParseError(n))
+			}
+
+			data = data[n:]
+
+			continue
+		}
+
+		if typ != protowire.BytesType {
+			return fmt.Errorf("invalid address #%d: wrong wire type %d", res.Len(), typ)
+		}
+
+		b, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return fmt.Errorf("invalid address #%d: %w", res.Len(), protowire.ParseError(n))
+		}
+
+		data = data[n:]
+
+		var m refs.Address
+		var addr Address
+
+		err := m.Unmarshal(b)
+		if err == nil {
+			err = addr.ReadFromV2(m)
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid address #%d: %w", res.Len(), err)
+		}
+
+		res.Add(addr)
+	}
+
+	*x = res
+
+	return nil
+}
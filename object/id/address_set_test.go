@@ -0,0 +1,173 @@
+package oid_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestAddressSet(t *testing.T) {
+	var s oid.AddressSet
+
+	require.Zero(t, s.Len())
+	require.Nil(t, s.Addresses())
+	require.False(t, s.Remove(oidtest.Address()))
+
+	a1, a2, a3 := oidtest.Address(), oidtest.Address(), oidtest.Address()
+
+	require.Equal(t, 2, s.Add(a1, a2, a1))
+	require.Zero(t, s.Add(a2))
+	require.Equal(t, 1, s.Add(a3))
+	require.Equal(t, 3, s.Len())
+	require.Equal(t, []oid.Address{a1, a2, a3}, s.Addresses())
+	require.True(t, s.Contains(a2))
+
+	addrs := s.Addresses()
+	addrs[0] = a3
+	require.Equal(t, []oid.Address{a1, a2, a3}, s.Addresses())
+
+	var collected []oid.Address
+	s.Iterate(func(a oid.Address) bool {
+		collected = append(collected, a)
+		return len(collected) == 2
+	})
+	require.Equal(t, []oid.Address{a1, a2}, collected)
+
+	require.True(t, s.Remove(a2))
+	require.False(t, s.Remove(a2))
+	require.False(t, s.Contains(a2))
+	require.Equal(t, []oid.Address{a1, a3}, s.Addresses())
+
+	require.True(t, s.Remove(a1))
+	require.Equal(t, []oid.Address{a3}, s.Addresses())
+	require.Equal(t, 1, s.Add(a1))
+	require.Equal(t, []oid.Address{a3, a1}, s.Addresses())
+
+	// last address takes the place of the removed one
+	require.Equal(t, 1, s.Add(a2))
+	require.True(t, s.Remove(a3))
+	require.Equal(t, []oid.Address{a2, a1}, s.Addresses())
+	require.True(t, s.Contains(a1))
+	require.True(t, s.Remove(a1))
+	require.Equal(t, []oid.Address{a2}, s.Addresses())
+}
+
+func TestAddressSet_Clone(t *testing.T) {
+	a1, a2, a3 := oidtest.Address(), oidtest.Address(), oidtest.Address()
+
+	s := oid.NewAddressSet(a1, a2)
+	c := s.Clone()
+	require.Equal(t, s.Addresses(), c.Addresses())
+
+	require.True(t, c.Remove(a1))
+	require.Equal(t, 1, c.Add(a3))
+	require.Equal(t, []oid.Address{a1, a2}, s.Addresses())
+	require.True(t, s.Contains(a1))
+	require.False(t, s.Contains(a3))
+	require.Equal(t, []oid.Address{a2, a3}, c.Addresses())
+}
+
+func TestAddressSet_Containers(t *testing.T) {
+	cnr1, cnr2 := cidtest.ID(), cidtest.ID()
+
+	addr := func(cnr cid.ID) oid.Address {
+		a := oidtest.Address()
+		a.SetContainer(cnr)
+		return a
+	}
+
+	a1, a2, a3 := addr(cnr1), addr(cnr2), addr(cnr1)
+
+	s := oid.NewAddressSet(a1, a2, a3)
+	require.Equal(t, []cid.ID{cnr1, cnr2}, s.Containers())
+	require.Equal(t, []oid.ID{a1.Object(), a3.Object()}, s.Objects(cnr1))
+	require.Equal(t, []oid.ID{a2.Object()}, s.Objects(cnr2))
+	require.Empty(t, s.Objects(cidtest.ID()))
+
+	_, _, err := s.SingleContainer()
+	require.Error(t, err)
+
+	_, _, err = oid.NewAddressSet().SingleContainer()
+	require.Error(t, err)
+
+	s.Remove(a2)
+
+	cnr, ids, err := s.SingleContainer()
+	require.NoError(t, err)
+	require.Equal(t, cnr1, cnr)
+	require.Equal(t, []oid.ID{a1.Object(), a3.Object()}, ids)
+}
+
+func TestAddressSet_Operations(t *testing.T) {
+	a1, a2, a3, a4 := oidtest.Address(), oidtest.Address(), oidtest.Address(), oidtest.Address()
+
+	x := oid.NewAddressSet(a1, a2, a3)
+	y := oid.NewAddressSet(a4, a3, a2)
+
+	require.Equal(t, []oid.Address{a1, a2, a3, a4}, x.Union(y).Addresses())
+	require.Equal(t, []oid.Address{a2, a3}, x.Intersection(y).Addresses())
+	require.Equal(t, []oid.Address{a3, a2}, y.Intersection(x).Addresses())
+	require.Equal(t, []oid.Address{a1}, x.Difference(y).Addresses())
+	require.Equal(t, []oid.Address{a4}, y.Difference(x).Addresses())
+	require.Zero(t, x.Intersection(new(oid.AddressSet)).Len())
+	require.Equal(t, x.Addresses(), x.Difference(new(oid.AddressSet)).Addresses())
+
+	// operands are not changed
+	require.Equal(t, []oid.Address{a1, a2, a3}, x.Addresses())
+	require.Equal(t, []oid.Address{a4, a3, a2}, y.Addresses())
+}
+
+func TestAddressSet_Marshal(t *testing.T) {
+	s := oid.NewAddressSet(oidtest.Address(), oidtest.Address(), oidtest.Address())
+
+	var s2 oid.AddressSet
+	require.NoError(t, s2.Unmarshal(s.Marshal()))
+	require.Equal(t, s.Addresses(), s2.Addresses())
+
+	require.NoError(t, s2.Unmarshal(nil))
+	require.Zero(t, s2.Len())
+	require.Empty(t, new(oid.AddressSet).Marshal())
+
+	t.Run("compatibility", func(t *testing.T) {
+		a := oidtest.Address()
+
+		var m refs.Address
+		a.WriteToV2(&m)
+		b := m.StableMarshal(nil)
+
+		var data []byte
+		// unknown field
+		data = protowire.AppendTag(data, 2, protowire.VarintType)
+		data = protowire.AppendVarint(data, 42)
+		// duplicate
+		for i := 0; i < 2; i++ {
+			data = protowire.AppendTag(data, 1, protowire.BytesType)
+			data = protowire.AppendBytes(data, b)
+		}
+
+		require.NoError(t, s2.Unmarshal(data))
+		require.Equal(t, []oid.Address{a}, s2.Addresses())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct {
+			name string
+			data []byte
+		}{
+			{name: "tag", data: []byte{0xff}},
+			{name: "wire type", data: protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1)},
+			{name: "length", data: append(protowire.AppendTag(nil, 1, protowire.BytesType), 10)},
+			{name: "missing container", data: protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), nil)},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				require.Error(t, s2.Unmarshal(tc.data))
+			})
+		}
+	})
+}
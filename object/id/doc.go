@@ -2,7 +2,8 @@
 Package oid provides primitives to work with object identification in NeoFS.
 
 Address type is used for global object identity inside the NeoFS network,
-while ID represents identity within a fixed container.
+while ID represents identity within a fixed container. AddressSet collects
+unique addresses, e.g. members of the tombstones and storage groups.
 
 Using package types in an application is recommended to potentially work with
 different protocol versions with which these types are compatible.
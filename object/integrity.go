@@ -0,0 +1,253 @@
+package object
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// IntegrityCheck is an enumeration of the checks performed by VerifyIntegrity.
+type IntegrityCheck uint8
+
+const (
+	_ IntegrityCheck = iota
+
+	// IntegrityCheckID is an IntegrityCheck of the object ID calculated from
+	// the header.
+	IntegrityCheckID
+
+	// IntegrityCheckSignature is an IntegrityCheck of the object ID
+	// signature.
+	IntegrityCheckSignature
+
+	// IntegrityCheckSession is an IntegrityCheck of the session token: its
+	// signature, container and the key which signed the object.
+	IntegrityCheckSession
+
+	// IntegrityCheckOwner is an IntegrityCheck of the object owner: it must be
+	// either the session issuer or the user of the object signer.
+	IntegrityCheckOwner
+
+	// IntegrityCheckPayloadSize is an IntegrityCheck of the payload length.
+	IntegrityCheckPayloadSize
+
+	// IntegrityCheckPayloadChecksum is an IntegrityCheck of the payload
+	// SHA-256 checksum.
+	IntegrityCheckPayloadChecksum
+
+	// IntegrityCheckHomomorphicChecksum is an IntegrityCheck of the payload
+	// Tillich-Zémor checksum.
+	IntegrityCheckHomomorphicChecksum
+
+	// IntegrityCheckSplitHeader is an IntegrityCheck of the split header: the
+	// parent ID, signature and header.
+	IntegrityCheckSplitHeader
+)
+
+// String implements fmt.Stringer.
+func (x IntegrityCheck) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case IntegrityCheckID:
+		return "ID"
+	case IntegrityCheckSignature:
+		return "SIGNATURE"
+	case IntegrityCheckSession:
+		return "SESSION"
+	case IntegrityCheckOwner:
+		return "OWNER"
+	case IntegrityCheckPayloadSize:
+		return "PAYLOAD_SIZE"
+	case IntegrityCheckPayloadChecksum:
+		return "PAYLOAD_CHECKSUM"
+	case IntegrityCheckHomomorphicChecksum:
+		return "HOMOMORPHIC_CHECKSUM"
+	case IntegrityCheckSplitHeader:
+		return "SPLIT_HEADER"
+	}
+}
+
+// ErrIntegrityCheckFailed is returned by VerifyIntegrity when some check
+// fails. Use [errors.As] to get the details.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrIntegrityCheckFailed IntegrityCheckError
+
+// IntegrityCheckError describes failed integrity check of the object.
+type IntegrityCheckError struct {
+	check IntegrityCheck
+
+	cause error
+}
+
+// Check returns the failed check.
+func (e IntegrityCheckError) Check() IntegrityCheck {
+	return e.check
+}
+
+// Error implements the error interface.
+func (e IntegrityCheckError) Error() string {
+	return fmt.Sprintf("integrity check %s failed: %v", e.check, e.cause)
+}
+
+// Unwrap returns the reason of the check failure.
+func (e IntegrityCheckError) Unwrap() error {
+	return e.cause
+}
+
+// Is implements interface for correct checking current error type with [errors.Is].
+func (e IntegrityCheckError) Is(target error) bool {
+	switch target.(type) {
+	default:
+		return false
+	case IntegrityCheckError, *IntegrityCheckError:
+		return true
+	}
+}
+
+// VerifyIntegrity checks that the object is formed correctly and has not been
+// changed after signing. The object MUST be complete, i.e. carry the payload.
+// VerifyIntegrity checks:
+//   - ID corresponds to the header;
+//   - ID is signed correctly;
+//   - session token, if any, is signed correctly, issued for the object
+//     container and the key signed the object;
+//   - owner is the session issuer or, without the session, corresponds to
+//     the public key signed the object (for ECDSA signatures only);
+//   - payload corresponds to the declared size and checksums;
+//   - parent ID and signature, if any, correspond to the parent header
+//     which belongs to the same container.
+//
+// VerifyIntegrity returns IntegrityCheckError describing the first failed
+// check. Failures of the checks having dedicated errors also match them, e.g.
+// ErrPayloadChecksumMismatch.
+func VerifyIntegrity(obj Object) error {
+	failed := func(check IntegrityCheck, cause error) error {
+		return IntegrityCheckError{check: check, cause: cause}
+	}
+
+	if err := obj.VerifyID(); err != nil {
+		return failed(IntegrityCheckID, err)
+	}
+
+	if !obj.VerifyIDSignature() {
+		return failed(IntegrityCheckSignature, errInvalidSignature)
+	}
+
+	var sig refs.Signature
+	obj.Signature().WriteToV2(&sig)
+
+	pub, err := neofscrypto.NewPublicKey(neofscrypto.Scheme(sig.GetScheme()))
+	if err == nil {
+		err = pub.Decode(sig.GetKey())
+	}
+
+	if err != nil {
+		return failed(IntegrityCheckSignature, fmt.Errorf("invalid public key: %w", err))
+	}
+
+	owner := obj.OwnerID()
+
+	if tok := obj.SessionToken(); tok != nil {
+		if !tok.VerifySignature() {
+			return failed(IntegrityCheckSession, errInvalidSignature)
+		}
+
+		if cnr, ok := obj.ContainerID(); !ok || !tok.AssertContainer(cnr) {
+			return failed(IntegrityCheckSession, errors.New("session is not for the object container"))
+		}
+
+		if !tok.AssertAuthKey(pub) {
+			return failed(IntegrityCheckSession, errors.New("object is not signed by the session key"))
+		}
+
+		if issuer := tok.Issuer(); owner == nil || !owner.Equals(issuer) {
+			return failed(IntegrityCheckOwner, fmt.Errorf("owner is not the session issuer %s", issuer))
+		}
+	} else if owner == nil {
+		return failed(IntegrityCheckOwner, errors.New("missing owner"))
+	} else {
+		switch neofscrypto.Scheme(sig.GetScheme()) {
+		case neofscrypto.ECDSA_SHA512, neofscrypto.ECDSA_DETERMINISTIC_SHA256, neofscrypto.ECDSA_WALLETCONNECT:
+			key, err := keys.NewPublicKeyFromBytes(sig.GetKey(), elliptic.P256())
+			if err != nil {
+				return failed(IntegrityCheckOwner, fmt.Errorf("invalid ECDSA public key: %w", err))
+			}
+
+			var signer user.ID
+			signer.SetScriptHash(key.GetScriptHash())
+
+			if !owner.Equals(signer) {
+				return failed(IntegrityCheckOwner, fmt.Errorf("object is signed by %s", signer))
+			}
+		}
+	}
+
+	if sz := uint64(len(obj.Payload())); sz != obj.PayloadSize() {
+		return failed(IntegrityCheckPayloadSize, fmt.Errorf("%w: %d instead of %d", ErrPayloadSizeMismatch, sz, obj.PayloadSize()))
+	}
+
+	if err := obj.VerifyPayloadChecksum(); err != nil {
+		return failed(IntegrityCheckPayloadChecksum, err)
+	}
+
+	if cs, ok := obj.PayloadHomomorphicHash(); ok {
+		if cs.Type() != checksum.TZ {
+			return failed(IntegrityCheckHomomorphicChecksum, fmt.Errorf("unexpected checksum type %s", cs.Type()))
+		}
+
+		var actual checksum.Checksum
+		checksum.Calculate(&actual, checksum.TZ, obj.Payload())
+
+		if !bytes.Equal(cs.Value(), actual.Value()) {
+			return failed(IntegrityCheckHomomorphicChecksum, ErrPayloadChecksumMismatch)
+		}
+	}
+
+	if err := verifySplitHeader(obj); err != nil {
+		return failed(IntegrityCheckSplitHeader, err)
+	}
+
+	return nil
+}
+
+func verifySplitHeader(obj Object) error {
+	par := obj.Parent()
+	if par == nil {
+		return nil
+	}
+
+	if _, ok := obj.ParentID(); !ok {
+		return errors.New("parent header without parent ID")
+	}
+
+	if par.ToV2().GetHeader() == nil {
+		// parent signature without header cannot be verified
+		return nil
+	}
+
+	// parent ID is taken from the split header
+	if err := par.VerifyID(); err != nil {
+		return fmt.Errorf("parent: %w", err)
+	}
+
+	if par.Signature() != nil && !par.VerifyIDSignature() {
+		return fmt.Errorf("parent: %w", errInvalidSignature)
+	}
+
+	cnr, _ := obj.ContainerID()
+	if parCnr, ok := par.ContainerID(); !ok || parCnr != cnr {
+		return fmt.Errorf("parent container %s differs from %s", parCnr, cnr)
+	}
+
+	return nil
+}
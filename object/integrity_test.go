@@ -0,0 +1,173 @@
+package object_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrityCheck_String(t *testing.T) {
+	for _, tc := range []struct {
+		check object.IntegrityCheck
+		exp   string
+	}{
+		{0, "UNKNOWN"},
+		{object.IntegrityCheckID, "ID"},
+		{object.IntegrityCheckSignature, "SIGNATURE"},
+		{object.IntegrityCheckSession, "SESSION"},
+		{object.IntegrityCheckOwner, "OWNER"},
+		{object.IntegrityCheckPayloadSize, "PAYLOAD_SIZE"},
+		{object.IntegrityCheckPayloadChecksum, "PAYLOAD_CHECKSUM"},
+		{object.IntegrityCheckHomomorphicChecksum, "HOMOMORPHIC_CHECKSUM"},
+		{object.IntegrityCheckSplitHeader, "SPLIT_HEADER"},
+	} {
+		require.Equal(t, tc.exp, tc.check.String())
+	}
+}
+
+func TestVerifyIntegrity(t *testing.T) {
+	signer := test.RandomSignerRFC6979(t)
+	cnr := cidtest.ID()
+	payload := []byte("Hello, world!")
+
+	newObject := func(t *testing.T) *object.Object {
+		obj, err := object.NewBuilder().
+			Container(cnr).
+			Owner(signer.UserID()).
+			Attribute("k", "v").
+			Payload(payload).
+			HomomorphicHashing().
+			Build(signer)
+		require.NoError(t, err)
+
+		return obj
+	}
+
+	require.NoError(t, object.VerifyIntegrity(*newObject(t)))
+
+	t.Run("session", func(t *testing.T) {
+		sessionSigner := test.RandomSignerRFC6979(t)
+
+		var tok session.Object
+		tok.SetID(uuid.New())
+		tok.ForVerb(session.VerbObjectPut)
+		tok.BindContainer(cnr)
+		tok.SetAuthKey(sessionSigner.Public())
+		tok.SetExp(100)
+		require.NoError(t, tok.Sign(signer))
+
+		build := func(tok *session.Object, signer neofscrypto.Signer) *object.Object {
+			obj, err := object.NewBuilder().
+				Container(cnr).
+				Owner(tok.Issuer()).
+				Session(tok).
+				Payload(payload).
+				Build(signer)
+			require.NoError(t, err)
+
+			return obj
+		}
+
+		require.NoError(t, object.VerifyIntegrity(*build(&tok, sessionSigner)))
+
+		err := object.VerifyIntegrity(*build(&tok, signer))
+		requireIntegrityCheck(t, err, object.IntegrityCheckSession)
+
+		other := tok
+		other.SetExp(101)
+		obj := build(&other, sessionSigner)
+		err = object.VerifyIntegrity(*obj)
+		requireIntegrityCheck(t, err, object.IntegrityCheckSession)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		check  object.IntegrityCheck
+		is     error
+		modify func(*object.Object)
+	}{
+		{name: "ID", check: object.IntegrityCheckID, modify: func(o *object.Object) {
+			o.SetID(oidtest.ID())
+		}},
+		{name: "header", check: object.IntegrityCheckID, modify: func(o *object.Object) {
+			o.SetCreationEpoch(o.CreationEpoch() + 1)
+		}},
+		{name: "signature", check: object.IntegrityCheckSignature, modify: func(o *object.Object) {
+			o.SetSignature(nil)
+		}},
+		{name: "owner", check: object.IntegrityCheckOwner, modify: func(o *object.Object) {
+			o.SetOwnerID(usertest.ID(t))
+			require.NoError(t, o.SetIDWithSignature(signer))
+		}},
+		{name: "payload size", check: object.IntegrityCheckPayloadSize, is: object.ErrPayloadSizeMismatch, modify: func(o *object.Object) {
+			o.SetPayload(payload[1:])
+		}},
+		{name: "payload checksum", check: object.IntegrityCheckPayloadChecksum, is: object.ErrPayloadChecksumMismatch, modify: func(o *object.Object) {
+			o.SetPayload([]byte("Hello, World!"))
+		}},
+		{name: "homomorphic checksum", check: object.IntegrityCheckHomomorphicChecksum, is: object.ErrPayloadChecksumMismatch, modify: func(o *object.Object) {
+			var cs checksum.Checksum
+			checksum.Calculate(&cs, checksum.TZ, payload[1:])
+			o.SetPayloadHomomorphicHash(cs)
+			require.NoError(t, o.SetIDWithSignature(signer))
+		}},
+		{name: "parent ID", check: object.IntegrityCheckSplitHeader, modify: func(o *object.Object) {
+			par := newObject(t)
+			o.SetParent(par)
+			o.SetParentID(oidtest.ID())
+			require.NoError(t, o.SetIDWithSignature(signer))
+		}},
+		{name: "parent container", check: object.IntegrityCheckSplitHeader, modify: func(o *object.Object) {
+			par := newObject(t)
+			par.SetContainerID(cidtest.ID())
+			require.NoError(t, par.SetIDWithSignature(signer))
+			o.SetParent(par)
+			id, _ := par.ID()
+			o.SetParentID(id)
+			require.NoError(t, o.SetIDWithSignature(signer))
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := newObject(t)
+			tc.modify(obj)
+
+			err := object.VerifyIntegrity(*obj)
+			requireIntegrityCheck(t, err, tc.check)
+			if tc.is != nil {
+				require.ErrorIs(t, err, tc.is)
+			}
+		})
+	}
+
+	t.Run("parent", func(t *testing.T) {
+		par := newObject(t)
+		par.SetPayload(nil)
+		id, _ := par.ID()
+
+		obj := newObject(t)
+		obj.SetSplitID(object.NewSplitID())
+		obj.SetParent(par)
+		obj.SetParentID(id)
+		require.NoError(t, obj.SetIDWithSignature(signer))
+
+		require.NoError(t, object.VerifyIntegrity(*obj))
+	})
+}
+
+func requireIntegrityCheck(t testing.TB, err error, check object.IntegrityCheck) {
+	require.ErrorIs(t, err, object.ErrIntegrityCheckFailed)
+
+	var e object.IntegrityCheckError
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, check, e.Check(), err)
+}
@@ -0,0 +1,176 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+)
+
+// Limits of the object checked by Object.CheckLimits. Storage nodes reject
+// objects violating them, so the check allows to fail fast before the
+// payload upload.
+const (
+	// MaxHeaderSize is the maximum size of the object binary form without the
+	// payload (ID, signature and header including the parent one) in bytes.
+	MaxHeaderSize = 16 << 10
+
+	// MaxAttributes is the maximum number of the object attributes.
+	MaxAttributes = 256
+
+	// MaxAttributeKeyLength is the maximum length of the attribute key in
+	// bytes.
+	MaxAttributeKeyLength = 1024
+
+	// MaxAttributeValueLength is the maximum length of the attribute value in
+	// bytes.
+	MaxAttributeValueLength = 4096
+)
+
+// LimitType is an enumeration of the limits checked by Object.CheckLimits.
+type LimitType uint8
+
+const (
+	_ LimitType = iota
+
+	// LimitHeaderSize is a LimitType of MaxHeaderSize.
+	LimitHeaderSize
+
+	// LimitAttributes is a LimitType of MaxAttributes.
+	LimitAttributes
+
+	// LimitAttributeKeyLength is a LimitType of MaxAttributeKeyLength.
+	LimitAttributeKeyLength
+
+	// LimitAttributeValueLength is a LimitType of MaxAttributeValueLength.
+	LimitAttributeValueLength
+)
+
+// String implements fmt.Stringer.
+func (x LimitType) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case LimitHeaderSize:
+		return "HEADER_SIZE"
+	case LimitAttributes:
+		return "ATTRIBUTES"
+	case LimitAttributeKeyLength:
+		return "ATTRIBUTE_KEY_LENGTH"
+	case LimitAttributeValueLength:
+		return "ATTRIBUTE_VALUE_LENGTH"
+	}
+}
+
+// ErrLimitExceeded is returned by Object.CheckLimits when the object exceeds
+// some limit. Use [errors.As] to get the details.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrLimitExceeded LimitExceededError
+
+// LimitExceededError describes exceeded limit of the object.
+type LimitExceededError struct {
+	typ LimitType
+
+	limit, actual int
+
+	parent bool
+
+	// -1 if not applicable
+	attribute int
+}
+
+// Type returns type of the exceeded limit.
+func (e LimitExceededError) Type() LimitType {
+	return e.typ
+}
+
+// Limit returns value of the exceeded limit.
+func (e LimitExceededError) Limit() int {
+	return e.limit
+}
+
+// Actual returns actual value exceeding the limit.
+func (e LimitExceededError) Actual() int {
+	return e.actual
+}
+
+// Parent checks whether the limit is exceeded by the parent header.
+func (e LimitExceededError) Parent() bool {
+	return e.parent
+}
+
+// Attribute returns index of the attribute exceeding the limit. Returns -1 for
+// the limits of the whole header.
+func (e LimitExceededError) Attribute() int {
+	return e.attribute
+}
+
+// Error implements the error interface.
+func (e LimitExceededError) Error() string {
+	var where string
+
+	if e.parent {
+		where = "parent: "
+	}
+
+	if e.attribute >= 0 {
+		where += fmt.Sprintf("attribute #%d: ", e.attribute)
+	}
+
+	return fmt.Sprintf("%slimit %s exceeded: %d > %d", where, e.typ, e.actual, e.limit)
+}
+
+// Is implements interface for correct checking current error type with [errors.Is].
+func (e LimitExceededError) Is(target error) bool {
+	switch target.(type) {
+	default:
+		return false
+	case LimitExceededError, *LimitExceededError:
+		return true
+	}
+}
+
+// CheckLimits checks whether the object conforms to the limits (see
+// MaxHeaderSize and others). CheckLimits returns LimitExceededError
+// describing the first exceeded limit, if any. The size is checked for the
+// object as is, so CheckLimits SHOULD be called after the object is signed.
+func (o *Object) CheckLimits() error {
+	if err := checkAttributeLimits(o.Attributes(), false); err != nil {
+		return err
+	}
+
+	if par := o.Parent(); par != nil {
+		if err := checkAttributeLimits(par.Attributes(), true); err != nil {
+			return err
+		}
+	}
+
+	if n := (*object.Object)(o.CutPayload()).StableSize(); n > MaxHeaderSize {
+		return LimitExceededError{typ: LimitHeaderSize, limit: MaxHeaderSize, actual: n, attribute: -1}
+	}
+
+	return nil
+}
+
+func checkAttributeLimits(attrs []Attribute, parent bool) error {
+	exceeded := func(typ LimitType, limit, actual, attribute int) error {
+		return LimitExceededError{typ: typ, limit: limit, actual: actual, parent: parent, attribute: attribute}
+	}
+
+	if len(attrs) > MaxAttributes {
+		return exceeded(LimitAttributes, MaxAttributes, len(attrs), -1)
+	}
+
+	for i := range attrs {
+		if n := len(attrs[i].Key()); n > MaxAttributeKeyLength {
+			return exceeded(LimitAttributeKeyLength, MaxAttributeKeyLength, n, i)
+		}
+
+		if n := len(attrs[i].Value()); n > MaxAttributeValueLength {
+			return exceeded(LimitAttributeValueLength, MaxAttributeValueLength, n, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package object_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitType_String(t *testing.T) {
+	for _, tc := range []struct {
+		typ object.LimitType
+		exp string
+	}{
+		{0, "UNKNOWN"},
+		{object.LimitHeaderSize, "HEADER_SIZE"},
+		{object.LimitAttributes, "ATTRIBUTES"},
+		{object.LimitAttributeKeyLength, "ATTRIBUTE_KEY_LENGTH"},
+		{object.LimitAttributeValueLength, "ATTRIBUTE_VALUE_LENGTH"},
+	} {
+		require.Equal(t, tc.exp, tc.typ.String())
+	}
+}
+
+func TestObject_CheckLimits(t *testing.T) {
+	attrs := func(n, keyLen, valLen int) []object.Attribute {
+		res := make([]object.Attribute, n)
+		for i := range res {
+			res[i] = attribute(strconv.Itoa(i)+strings.Repeat("k", keyLen), strings.Repeat("v", valLen))
+		}
+
+		return res
+	}
+
+	require.NoError(t, objecttest.Object(t).CheckLimits())
+
+	for _, tc := range []struct {
+		name      string
+		typ       object.LimitType
+		limit     int
+		parent    bool
+		attribute int
+		modify    func(*object.Object)
+	}{
+		{name: "attributes", typ: object.LimitAttributes, limit: object.MaxAttributes, attribute: -1, modify: func(o *object.Object) {
+			o.SetAttributes(attrs(object.MaxAttributes+1, 0, 1)...)
+		}},
+		{name: "attribute key", typ: object.LimitAttributeKeyLength, limit: object.MaxAttributeKeyLength, attribute: 1, modify: func(o *object.Object) {
+			o.SetAttributes(append(attrs(1, 0, 1), attrs(1, object.MaxAttributeKeyLength, 1)...)...)
+		}},
+		{name: "attribute value", typ: object.LimitAttributeValueLength, limit: object.MaxAttributeValueLength, attribute: 0, modify: func(o *object.Object) {
+			o.SetAttributes(attrs(1, 0, object.MaxAttributeValueLength+1)...)
+		}},
+		{name: "parent attributes", typ: object.LimitAttributes, limit: object.MaxAttributes, parent: true, attribute: -1, modify: func(o *object.Object) {
+			par := o.Parent()
+			par.SetAttributes(attrs(object.MaxAttributes+1, 0, 1)...)
+			o.SetParent(par)
+		}},
+		{name: "header size", typ: object.LimitHeaderSize, limit: object.MaxHeaderSize, attribute: -1, modify: func(o *object.Object) {
+			o.SetAttributes(attrs(5, 0, object.MaxAttributeValueLength)...)
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := objecttest.Object(t)
+			tc.modify(o)
+
+			err := o.CheckLimits()
+			require.ErrorIs(t, err, object.ErrLimitExceeded)
+
+			var e object.LimitExceededError
+			require.True(t, errors.As(err, &e))
+			require.Equal(t, tc.typ, e.Type())
+			require.Equal(t, tc.limit, e.Limit())
+			require.Greater(t, e.Actual(), e.Limit())
+			require.Equal(t, tc.parent, e.Parent())
+			require.Equal(t, tc.attribute, e.Attribute())
+		})
+	}
+
+	t.Run("payload", func(t *testing.T) {
+		o := objecttest.Object(t)
+		o.SetPayload(make([]byte, 2*object.MaxHeaderSize))
+		require.NoError(t, o.CheckLimits())
+	})
+}
@@ -1,9 +1,14 @@
 package object
 
 import (
+	"errors"
+
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
 )
 
 // Lock represents record with locked objects. It is compatible with
@@ -76,3 +81,64 @@ func (x Lock) Marshal() []byte {
 func (x *Lock) Unmarshal(data []byte) error {
 	return (*v2object.Lock)(x).Unmarshal(data)
 }
+
+// NewLockObject constructs lock object protecting objects with the given
+// addresses from deletion until the specified epoch (inclusive). All addresses
+// MUST belong to the same container, duplicates are ignored. Resulting object
+// is filled with the container, owner, current version, lock payload and its
+// checksum, so it is ready to be signed and put into NeoFS.
+//
+// Zero expiration epoch means permanent lock: such objects can never be
+// deleted.
+//
+// Lock of the single object is associated with it via
+// [AttributeAssociatedObject], so such lock may be found by search. Locks of
+// several objects are not.
+//
+// If session token is specified, it MUST be issued by the owner for
+// [session.VerbObjectPut] in the container of the members. The token is
+// attached to the object.
+func NewLockObject(members []oid.Address, expirationEpoch uint64, owner user.ID, sessionToken *session.Object) (*Object, error) {
+	cnr, ids, err := splitMembers(members)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionToken != nil {
+		if !sessionToken.Issuer().Equals(owner) {
+			return nil, errors.New("session token is not issued by the owner")
+		}
+
+		if !sessionToken.AssertVerb(session.VerbObjectPut) {
+			return nil, errors.New("session token is not for the object creation")
+		}
+
+		if !sessionToken.AssertContainer(cnr) {
+			return nil, errors.New("session token is not for the container of the members")
+		}
+	}
+
+	var l Lock
+	l.WriteMembers(ids)
+
+	ver := version.Current()
+
+	obj := New()
+	obj.SetVersion(&ver)
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(&owner)
+	obj.SetSessionToken(sessionToken)
+	obj.WriteLock(l)
+	obj.SetPayloadSize(uint64(len(obj.Payload())))
+	obj.CalculateAndSetPayloadChecksum()
+
+	if len(ids) == 1 {
+		obj.setAttribute(AttributeAssociatedObject, ids[0].EncodeToString())
+	}
+
+	if expirationEpoch > 0 {
+		obj.SetExpirationEpoch(expirationEpoch)
+	}
+
+	return obj, nil
+}
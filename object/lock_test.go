@@ -3,8 +3,15 @@ package object_test
 import (
 	"testing"
 
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,3 +44,68 @@ func TestWriteLock(t *testing.T) {
 
 	require.Error(t, o.ReadLock(&l2))
 }
+
+func TestNewLockObject(t *testing.T) {
+	cnr := cidtest.ID()
+	signer := test.RandomSignerRFC6979(t)
+	owner := signer.UserID()
+
+	ids := []oid.ID{oidtest.ID(), oidtest.ID()}
+	members := make([]oid.Address, len(ids)+1)
+	for i := range ids {
+		members[i].SetContainer(cnr)
+		members[i].SetObject(ids[i])
+	}
+	members[len(ids)] = members[1]
+
+	obj, err := object.NewLockObject(members, 42, owner, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, object.TypeLock, obj.Type())
+	require.Equal(t, owner, *obj.OwnerID())
+	require.NoError(t, obj.VerifyPayloadChecksum())
+	require.EqualValues(t, len(obj.Payload()), obj.PayloadSize())
+
+	attrs := obj.Attributes()
+	require.Len(t, attrs, 1)
+	require.Equal(t, object.AttributeExpirationEpoch, attrs[0].Key())
+	require.Equal(t, "42", attrs[0].Value())
+
+	var l object.Lock
+	require.NoError(t, obj.ReadLock(&l))
+
+	res := make([]oid.ID, l.NumberOfMembers())
+	l.ReadMembers(res)
+	require.Equal(t, ids, res)
+
+	obj, err = object.NewLockObject(members, 0, owner, nil)
+	require.NoError(t, err)
+	require.Empty(t, obj.Attributes())
+
+	obj, err = object.NewLockObject(members[:1], 0, owner, nil)
+	require.NoError(t, err)
+	attrs = obj.Attributes()
+	require.Len(t, attrs, 1)
+	require.Equal(t, object.AttributeAssociatedObject, attrs[0].Key())
+	require.Equal(t, ids[0].EncodeToString(), attrs[0].Value())
+
+	_, err = object.NewLockObject(nil, 42, owner, nil)
+	require.Error(t, err)
+
+	t.Run("session", func(t *testing.T) {
+		tok := *sessiontest.Object()
+		tok.BindContainer(cnr)
+		require.NoError(t, tok.Sign(signer))
+
+		obj, err := object.NewLockObject(members, 42, owner, &tok)
+		require.NoError(t, err)
+		require.Equal(t, &tok, obj.SessionToken())
+
+		_, err = object.NewLockObject(members, 42, *usertest.ID(t), &tok)
+		require.Error(t, err)
+
+		tok.ForVerb(session.VerbObjectDelete)
+		_, err = object.NewLockObject(members, 42, owner, &tok)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,16 @@
+/*
+Package locks provides lifecycle helpers of the lock objects protecting
+objects from deletion.
+
+Lock objects are constructed by object.NewLockObject. Existing locks of the
+object may be found and analyzed:
+
+	src := locks.NewClientSource(c, signer, relations.Tokens{})
+	list, err := locks.Find(ctx, src, addr)
+	// ...
+	epoch, ok := locks.DeletableFrom(list)
+	if !ok {
+		// object is locked permanently
+	}
+*/
+package locks
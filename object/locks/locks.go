@@ -0,0 +1,214 @@
+package locks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/assembler"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Source provides lock objects.
+type Source interface {
+	// SearchLocks returns IDs of the lock objects associated with the given
+	// object via [object.AttributeAssociatedObject].
+	SearchLocks(ctx context.Context, addr oid.Address) ([]oid.ID, error)
+
+	// Get returns header and payload stream of the physically stored object.
+	Get(ctx context.Context, addr oid.Address) (object.Object, io.ReadCloser, error)
+}
+
+// Client describes methods of the NeoFS client required by NewClientSource.
+// Implemented by *client.Client.
+type Client interface {
+	assembler.Client
+	relations.SearchExecutor
+}
+
+type clientSource struct {
+	assembler.Source
+
+	c Client
+
+	signer user.Signer
+
+	tokens relations.Tokens
+}
+
+// NewClientSource returns Source reading lock objects via NeoFS client on
+// behalf of the given signer with optional tokens.
+func NewClientSource(c Client, signer user.Signer, tokens relations.Tokens) Source {
+	return &clientSource{
+		Source: assembler.NewClientSource(c, signer, tokens),
+		c:      c,
+		signer: signer,
+		tokens: tokens,
+	}
+}
+
+func (x *clientSource) SearchLocks(ctx context.Context, addr oid.Address) ([]oid.ID, error) {
+	var fs object.SearchFilters
+	fs.AddTypeFilter(object.MatchStringEqual, object.TypeLock)
+	fs.AddFilter(object.AttributeAssociatedObject, addr.Object().EncodeToString(), object.MatchStringEqual)
+
+	var prm client.PrmObjectSearch
+	prm.SetFilters(fs)
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	r, err := x.c.ObjectSearchInit(ctx, addr.Container(), x.signer, prm)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	var res []oid.ID
+	err = r.Iterate(func(id oid.ID) bool {
+		res = append(res, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate: %w", err)
+	}
+
+	return res, nil
+}
+
+// Info describes lock object.
+type Info struct {
+	id oid.ID
+
+	exp uint64
+}
+
+// ID returns ID of the lock object.
+func (x Info) ID() oid.ID {
+	return x.id
+}
+
+// ExpirationEpoch returns the last epoch of the lock lifetime. Zero means
+// permanent lock.
+func (x Info) ExpirationEpoch() uint64 {
+	return x.exp
+}
+
+// Expired checks whether the lock has expired by the given epoch.
+func (x Info) Expired(epoch uint64) bool {
+	return x.exp > 0 && x.exp < epoch
+}
+
+// Find returns lock objects protecting the referenced object from deletion
+// including expired ones. Expired but not yet removed locks can be filtered by
+// Info.Expired. Only locks associated with the object are found, see
+// [object.NewLockObject]. Locks removed after the search are skipped.
+func Find(ctx context.Context, src Source, addr oid.Address) ([]Info, error) {
+	ids, err := src.SearchLocks(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("search lock objects: %w", err)
+	}
+
+	var res []Info
+	var lockAddr oid.Address
+	lockAddr.SetContainer(addr.Container())
+
+	for i := range ids {
+		lockAddr.SetObject(ids[i])
+
+		info, locked, err := readLock(ctx, src, lockAddr, addr.Object())
+		if errors.Is(err, apistatus.ErrObjectNotFound) || errors.Is(err, apistatus.ErrObjectAlreadyRemoved) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("read lock object %s: %w", ids[i], err)
+		}
+
+		if locked {
+			res = append(res, info)
+		}
+	}
+
+	return res, nil
+}
+
+// readLock reads lock object and checks whether it protects the given object.
+func readLock(ctx context.Context, src Source, addr oid.Address, obj oid.ID) (Info, bool, error) {
+	var res Info
+
+	hdr, r, err := src.Get(ctx, addr)
+	if err != nil {
+		return res, false, err
+	}
+
+	payload, err := io.ReadAll(r)
+	if err == nil {
+		err = r.Close()
+	} else {
+		_ = r.Close()
+	}
+
+	if err != nil {
+		return res, false, fmt.Errorf("read payload: %w", err)
+	}
+
+	if hdr.Type() != object.TypeLock {
+		return res, false, fmt.Errorf("object type is %s instead of %s", hdr.Type(), object.TypeLock)
+	}
+
+	var l object.Lock
+	if err = l.Unmarshal(payload); err != nil {
+		return res, false, fmt.Errorf("decode lock: %w", err)
+	}
+
+	members := make([]oid.ID, l.NumberOfMembers())
+	l.ReadMembers(members)
+
+	locked := false
+	for i := range members {
+		if members[i] == obj {
+			locked = true
+			break
+		}
+	}
+
+	if !locked {
+		return res, false, nil
+	}
+
+	res.id = addr.Object()
+
+	if res.exp, err = hdr.ExpirationEpoch(); err != nil && !errors.Is(err, object.ErrAttributeNotFound) {
+		return res, false, err
+	}
+
+	return res, true, nil
+}
+
+// DeletableFrom returns the first epoch from which the object protected by
+// the given locks can be deleted. Returns false if any lock is permanent.
+// Object without locks is deletable from any epoch.
+func DeletableFrom(locks []Info) (uint64, bool) {
+	var res uint64
+
+	for i := range locks {
+		if locks[i].exp == 0 {
+			return 0, false
+		}
+
+		if locks[i].exp+1 > res {
+			res = locks[i].exp + 1
+		}
+	}
+
+	return res, true
+}
@@ -0,0 +1,186 @@
+package locks_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object/locks"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+var _ locks.Client = (*client.Client)(nil)
+
+type source map[oid.ID]object.Object
+
+func (x source) SearchLocks(_ context.Context, addr oid.Address) ([]oid.ID, error) {
+	var res []oid.ID
+	for id, obj := range x {
+		if obj.Type() != object.TypeLock {
+			continue
+		}
+
+		for _, a := range obj.Attributes() {
+			if a.Key() == object.AttributeAssociatedObject && a.Value() == addr.Object().EncodeToString() {
+				res = append(res, id)
+			}
+		}
+	}
+	return res, nil
+}
+
+// removedSource is a source which finds locks removed after the search.
+type removedSource struct {
+	source
+
+	removed map[oid.ID]error
+}
+
+func (x removedSource) SearchLocks(ctx context.Context, addr oid.Address) ([]oid.ID, error) {
+	res, err := x.source.SearchLocks(ctx, addr)
+	for id := range x.removed {
+		res = append(res, id)
+	}
+	return res, err
+}
+
+func (x removedSource) Get(ctx context.Context, addr oid.Address) (object.Object, io.ReadCloser, error) {
+	if err, ok := x.removed[addr.Object()]; ok {
+		return object.Object{}, nil, err
+	}
+	return x.source.Get(ctx, addr)
+}
+
+func (x source) Get(_ context.Context, addr oid.Address) (object.Object, io.ReadCloser, error) {
+	obj, ok := x[addr.Object()]
+	if !ok {
+		return object.Object{}, nil, apistatus.ErrObjectNotFound
+	}
+	return *obj.CutPayload(), io.NopCloser(bytes.NewReader(obj.Payload())), nil
+}
+
+func TestFind(t *testing.T) {
+	cnr := cidtest.ID()
+	owner := *usertest.ID(t)
+
+	var addr, other oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(oidtest.ID())
+	other.SetContainer(cnr)
+	other.SetObject(oidtest.ID())
+
+	src := make(source)
+	expected := make(map[oid.ID]uint64)
+
+	for i, tc := range []struct {
+		members []oid.Address
+		exp     uint64
+	}{
+		{[]oid.Address{addr}, 10},
+		{[]oid.Address{addr}, 20},
+		{[]oid.Address{other}, 30},
+		// not associated with any object
+		{[]oid.Address{other, addr}, 40},
+	} {
+		obj, err := object.NewLockObject(tc.members, tc.exp, owner, nil)
+		require.NoError(t, err)
+
+		id := oidtest.ID()
+		src[id] = *obj
+
+		if i < 2 {
+			expected[id] = tc.exp
+		}
+	}
+
+	res, err := locks.Find(context.Background(), src, addr)
+	require.NoError(t, err)
+	require.Len(t, res, len(expected))
+
+	for i := range res {
+		exp, ok := expected[res[i].ID()]
+		require.True(t, ok)
+		require.Equal(t, exp, res[i].ExpirationEpoch())
+	}
+
+	epoch, ok := locks.DeletableFrom(res)
+	require.True(t, ok)
+	require.EqualValues(t, 21, epoch)
+
+	t.Run("removed locks", func(t *testing.T) {
+		src := removedSource{
+			source: src,
+			removed: map[oid.ID]error{
+				oidtest.ID(): apistatus.ErrObjectNotFound,
+				oidtest.ID(): apistatus.ErrObjectAlreadyRemoved,
+			},
+		}
+
+		res, err := locks.Find(context.Background(), src, addr)
+		require.NoError(t, err)
+		require.Len(t, res, len(expected))
+
+		src.removed[oidtest.ID()] = apistatus.ErrObjectAccessDenied
+		_, err = locks.Find(context.Background(), src, addr)
+		require.ErrorIs(t, err, apistatus.ErrObjectAccessDenied)
+	})
+
+	t.Run("invalid expiration", func(t *testing.T) {
+		obj, err := object.NewLockObject([]oid.Address{addr}, 0, owner, nil)
+		require.NoError(t, err)
+
+		var a object.Attribute
+		a.SetKey(object.AttributeExpirationEpoch)
+		a.SetValue("not a number")
+		obj.SetAttributes(append(obj.Attributes(), a)...)
+
+		src := source{oidtest.ID(): *obj}
+
+		_, err = locks.Find(context.Background(), src, addr)
+		require.Error(t, err)
+	})
+}
+
+func TestDeletableFrom(t *testing.T) {
+	epoch, ok := locks.DeletableFrom(nil)
+	require.True(t, ok)
+	require.Zero(t, epoch)
+
+	src := make(source)
+	owner := *usertest.ID(t)
+
+	var addr oid.Address
+	addr.SetContainer(cidtest.ID())
+	addr.SetObject(oidtest.ID())
+
+	for _, exp := range []uint64{5, 0} {
+		obj, err := object.NewLockObject([]oid.Address{addr}, exp, owner, nil)
+		require.NoError(t, err)
+		src[oidtest.ID()] = *obj
+	}
+
+	infos, err := locks.Find(context.Background(), src, addr)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	_, ok = locks.DeletableFrom(infos)
+	require.False(t, ok, "permanent lock")
+
+	for i := range infos {
+		if infos[i].ExpirationEpoch() == 5 {
+			require.False(t, infos[i].Expired(5))
+			require.True(t, infos[i].Expired(6))
+		} else {
+			require.False(t, infos[i].Expired(math.MaxUint64))
+		}
+	}
+}
@@ -0,0 +1,29 @@
+/*
+Package multipart provides multipart uploads of the NeoFS objects.
+
+Multipart upload allows to write the object payload in parts of arbitrary
+size one by one, e.g. by different application instances, and to assemble the
+object from them later. Parts are stored as elements of the object split-chain,
+completion writes the element with the root header and the linking object
+listing all elements in order.
+
+	u := multipart.NewUploader(c, signer, cnr, owner, opts)
+	upload := u.Initiate()
+
+	part1, err := u.UploadPart(ctx, upload, nil, 1, data1)
+	// ...
+	part2, err := u.UploadPart(ctx, upload, &part1, 2, data2)
+	// ...
+	id, err := u.Complete(ctx, upload, attrs, []multipart.Part{part1, part2})
+
+Upload and Part can be saved between the calls using their encoding methods,
+so the upload may be processed by different application instances. Parts
+not included into the completed object and parts of the aborted upload should
+be deleted by Uploader.Abort.
+
+Each part continues the previous one: its first element references the last
+element of the previous part, and the payload checksum is accumulated across
+the parts, so completion does not read the payload again. Replacing a part
+requires the following parts to be uploaded again.
+*/
+package multipart
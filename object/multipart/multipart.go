@@ -0,0 +1,492 @@
+package multipart
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+const defaultPayloadSizeLimit = 1 << 20
+
+// Deleter describes methods of the NeoFS client required by Uploader.Abort.
+// Implemented by *client.Client.
+type Deleter interface {
+	ObjectDelete(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectDelete) (oid.ID, error)
+}
+
+// Upload identifies multipart upload. Upload must be created via
+// Uploader.Initiate or decoded.
+type Upload struct {
+	splitID *object.SplitID
+}
+
+// EncodeToString encodes Upload into a string.
+//
+// See also DecodeString.
+func (x Upload) EncodeToString() string {
+	return x.splitID.String()
+}
+
+// DecodeString decodes string into Upload according to the format of
+// EncodeToString. Returns an error if s is malformed.
+func (x *Upload) DecodeString(s string) error {
+	var id object.SplitID
+	if err := id.Parse(s); err != nil {
+		return err
+	}
+
+	x.splitID = &id
+
+	return nil
+}
+
+// Part describes uploaded part of the multipart upload.
+type Part struct {
+	number int
+
+	children []oid.ID
+
+	// last element of the previous part, nil for the first one
+	previous *oid.ID
+
+	size uint64
+
+	// state of the SHA-256 hasher of the upload payload including this part
+	hashState []byte
+
+	// nil if homomorphic hashing is disabled
+	homomorphicChecksum []byte
+}
+
+// Number returns number of the part in the upload.
+func (x Part) Number() int {
+	return x.number
+}
+
+// Size returns payload size of the part.
+func (x Part) Size() uint64 {
+	return x.size
+}
+
+// Children returns split-chain elements storing the part payload.
+func (x Part) Children() []oid.ID {
+	return x.children
+}
+
+// partJSON is a JSON representation of the Part.
+type partJSON struct {
+	Number              int      `json:"number"`
+	Children            []string `json:"children"`
+	Previous            string   `json:"previous,omitempty"`
+	Size                uint64   `json:"size"`
+	HashState           []byte   `json:"hashState"`
+	HomomorphicChecksum []byte   `json:"homomorphicChecksum,omitempty"`
+}
+
+// Marshal encodes Part into a binary form for the persistent storage.
+//
+// See also Unmarshal.
+func (x Part) Marshal() []byte {
+	v := partJSON{
+		Number:              x.number,
+		Children:            make([]string, len(x.children)),
+		Size:                x.size,
+		HashState:           x.hashState,
+		HomomorphicChecksum: x.homomorphicChecksum,
+	}
+
+	if x.previous != nil {
+		v.Previous = x.previous.EncodeToString()
+	}
+
+	for i := range x.children {
+		v.Children[i] = x.children[i].EncodeToString()
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("unexpected part encoding failure: %v", err))
+	}
+
+	return b
+}
+
+// Unmarshal decodes Part from the binary form produced by Marshal. Returns an
+// error if data is not a valid Part.
+//
+// See also Marshal.
+func (x *Part) Unmarshal(data []byte) error {
+	var v partJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if v.Number <= 0 {
+		return fmt.Errorf("invalid part number %d", v.Number)
+	}
+
+	if len(v.Children) == 0 {
+		return errors.New("missing children")
+	}
+
+	children := make([]oid.ID, len(v.Children))
+	for i := range v.Children {
+		if err := children[i].DecodeString(v.Children[i]); err != nil {
+			return fmt.Errorf("invalid child #%d: %w", i, err)
+		}
+	}
+
+	var previous *oid.ID
+	if v.Previous != "" {
+		previous = new(oid.ID)
+		if err := previous.DecodeString(v.Previous); err != nil {
+			return fmt.Errorf("invalid previous element: %w", err)
+		}
+	}
+
+	if err := sha256.New().(encoding.BinaryUnmarshaler).UnmarshalBinary(v.HashState); err != nil {
+		return fmt.Errorf("invalid hash state: %w", err)
+	}
+
+	if v.HomomorphicChecksum != nil && len(v.HomomorphicChecksum) != tz.Size {
+		return fmt.Errorf("invalid homomorphic checksum length %d", len(v.HomomorphicChecksum))
+	}
+
+	*x = Part{
+		number:              v.Number,
+		children:            children,
+		previous:            previous,
+		size:                v.Size,
+		hashState:           v.HashState,
+		homomorphicChecksum: v.HomomorphicChecksum,
+	}
+
+	return nil
+}
+
+// Uploader writes multipart uploads of the objects owned by particular user
+// into the specified container. Uploader must be constructed via NewUploader.
+type Uploader struct {
+	w slicer.ObjectWriter
+
+	signer user.Signer
+
+	cnr cid.ID
+
+	owner user.ID
+
+	opts slicer.Options
+
+	prm client.PrmObjectPutInit
+
+	prmDelete client.PrmObjectDelete
+}
+
+// NewUploader constructs Uploader writing objects via provided ObjectWriter
+// on behalf of the signer. Payload limit, current epoch, homomorphic hashing
+// and session are taken from the Options similar to the slicer. With session,
+// objects are owned by the session issuer.
+func NewUploader(w slicer.ObjectWriter, signer user.Signer, cnr cid.ID, owner user.ID, opts slicer.Options) *Uploader {
+	res := &Uploader{
+		w:      w,
+		signer: signer,
+		cnr:    cnr,
+		owner:  owner,
+		opts:   opts,
+	}
+
+	if sess := opts.Session(); sess != nil {
+		res.prm.WithinSession(*sess)
+		res.prmDelete.WithinSession(*sess)
+		res.owner = sess.Issuer()
+	}
+
+	return res
+}
+
+// header returns new header template of the uploaded objects. Headers
+// share internal structures on copying, so each object needs its own one.
+func (x *Uploader) header() object.Object {
+	var hdr object.Object
+	ver := version.Current()
+	owner := x.owner
+
+	hdr.SetVersion(&ver)
+	hdr.SetContainerID(x.cnr)
+	hdr.SetType(object.TypeRegular)
+	hdr.SetOwnerID(&owner)
+	hdr.SetCreationEpoch(x.opts.CurrentNeoFSEpoch())
+	hdr.SetSessionToken(x.opts.Session())
+
+	return hdr
+}
+
+// Initiate starts new multipart upload.
+func (x *Uploader) Initiate() Upload {
+	return Upload{splitID: object.NewSplitID()}
+}
+
+// UploadPart writes the part of the upload with the given positive number
+// following the previous part, nil prev means the first part. The payload is
+// read from data until EOF and sliced into one or more objects limited by the
+// configured payload limit. The first object of the part references the last
+// object of the previous part, so the parts form a single split-chain, and
+// SHA-256 hashing of the object payload is continued from the previous part.
+// Number of the part must be greater than the previous one.
+//
+// Same part may be uploaded several times after the same previous part, only
+// the part passed to Complete becomes a part of the object. Parts uploaded
+// after the replaced one must be uploaded again.
+func (x *Uploader) UploadPart(ctx context.Context, u Upload, prev *Part, number int, data io.Reader) (Part, error) {
+	if number <= 0 {
+		return Part{}, fmt.Errorf("invalid part number %d", number)
+	}
+
+	h := sha256.New()
+	res := Part{number: number}
+
+	if prev != nil {
+		if number <= prev.number {
+			return Part{}, fmt.Errorf("part number %d is not greater than previous %d", number, prev.number)
+		}
+
+		if err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(prev.hashState); err != nil {
+			return Part{}, fmt.Errorf("invalid hash state of the previous part: %w", err)
+		}
+
+		last := prev.children[len(prev.children)-1]
+		res.previous = &last
+	}
+
+	limit := x.opts.ObjectPayloadLimit()
+	if limit == 0 {
+		limit = defaultPayloadSizeLimit
+	}
+
+	var tzHash *checksum.TZHasher
+	if x.opts.IsHomomorphicChecksumEnabled() {
+		tzHash = checksum.NewTZHasher()
+	}
+
+	buf := make([]byte, limit)
+
+	for {
+		n, err := io.ReadFull(data, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return Part{}, fmt.Errorf("read part payload: %w", err)
+		}
+
+		if n == 0 && len(res.children) > 0 {
+			break
+		}
+
+		hdr := x.child(u)
+		if len(res.children) > 0 {
+			hdr.SetPreviousID(res.children[len(res.children)-1])
+		} else if res.previous != nil {
+			hdr.SetPreviousID(*res.previous)
+		}
+
+		id, err := x.write(ctx, hdr, buf[:n])
+		if err != nil {
+			return Part{}, fmt.Errorf("write element #%d: %w", len(res.children), err)
+		}
+
+		res.children = append(res.children, id)
+		res.size += uint64(n)
+
+		h.Write(buf[:n])
+		if tzHash != nil {
+			tzHash.Write(buf[:n])
+		}
+
+		if uint64(n) < limit {
+			break
+		}
+	}
+
+	state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return Part{}, fmt.Errorf("save hash state: %w", err)
+	}
+
+	res.hashState = state
+
+	if tzHash != nil {
+		res.homomorphicChecksum = tzHash.Sum(nil)
+	}
+
+	return res, nil
+}
+
+// Complete finishes the upload by writing the last split-chain element with
+// the root header and the linking object of the parts listed in ascending
+// order of their numbers, and returns ID of the resulting object with the
+// given attributes. Each part must be uploaded after the preceding one from
+// the list. Checksums of the object payload are derived from the ones
+// accumulated by the parts, so the payload is not read again.
+func (x *Uploader) Complete(ctx context.Context, u Upload, attrs []object.Attribute, parts []Part) (oid.ID, error) {
+	if len(parts) == 0 {
+		return oid.ID{}, errors.New("no parts")
+	}
+
+	if parts[0].previous != nil {
+		return oid.ID{}, errors.New("first part follows another part")
+	}
+
+	var children []oid.ID
+	var homo []checksum.Checksum
+	var size uint64
+
+	for i := range parts {
+		if i > 0 {
+			if parts[i].number <= parts[i-1].number {
+				return oid.ID{}, fmt.Errorf("part #%d is out of order: number %d after %d", i, parts[i].number, parts[i-1].number)
+			}
+
+			if parts[i].previous == nil || *parts[i].previous != children[len(children)-1] {
+				return oid.ID{}, fmt.Errorf("part #%d does not follow part #%d", i, i-1)
+			}
+		}
+
+		children = append(children, parts[i].children...)
+		size += parts[i].size
+
+		if parts[i].homomorphicChecksum != nil {
+			var cs checksum.Checksum
+			var v [tz.Size]byte
+			copy(v[:], parts[i].homomorphicChecksum)
+			cs.SetTillichZemor(v)
+			homo = append(homo, cs)
+		}
+	}
+
+	h := sha256.New()
+	if err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(parts[len(parts)-1].hashState); err != nil {
+		return oid.ID{}, fmt.Errorf("invalid hash state of the last part: %w", err)
+	}
+
+	var sum checksum.Checksum
+	var v [sha256.Size]byte
+	copy(v[:], h.Sum(nil))
+	sum.SetSHA256(v)
+
+	root := x.header()
+	root.SetAttributes(attrs...)
+	root.SetPayloadSize(size)
+	root.SetPayloadChecksum(sum)
+
+	if x.opts.IsHomomorphicChecksumEnabled() {
+		if len(homo) != len(parts) {
+			return oid.ID{}, errors.New("some parts have no homomorphic checksum")
+		}
+
+		cs, err := checksum.CombineTZ(homo...)
+		if err != nil {
+			return oid.ID{}, fmt.Errorf("combine homomorphic checksums: %w", err)
+		}
+
+		root.SetPayloadHomomorphicHash(cs)
+	}
+
+	if err := root.SetIDWithSignature(x.signer); err != nil {
+		return oid.ID{}, fmt.Errorf("sign root object: %w", err)
+	}
+
+	id, _ := root.ID()
+
+	// parts are written before the root header is known, so the chain is
+	// finished by the empty element referencing the root object
+	last := x.child(u)
+	last.SetPreviousID(children[len(children)-1])
+	last.SetParentID(id)
+	last.SetParent(&root)
+
+	lastID, err := x.write(ctx, last, nil)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("write last element: %w", err)
+	}
+
+	children = append(children, lastID)
+
+	link := x.child(u)
+	link.SetChildren(children...)
+	link.SetParent(&root)
+
+	if _, err = x.write(ctx, link, nil); err != nil {
+		return oid.ID{}, fmt.Errorf("write linking object: %w", err)
+	}
+
+	return id, nil
+}
+
+// Abort deletes uploaded parts. Abort should be called for the parts of the
+// aborted uploads and the parts not included into the completed object.
+// Objects are deleted within the session from the Options if any.
+func (x *Uploader) Abort(ctx context.Context, d Deleter, parts []Part) error {
+	for i := range parts {
+		for _, id := range parts[i].children {
+			if _, err := d.ObjectDelete(ctx, x.cnr, id, x.signer, x.prmDelete); err != nil {
+				return fmt.Errorf("delete element %s of part #%d: %w", id, parts[i].number, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// child returns header template of the split-chain element.
+func (x *Uploader) child(u Upload) object.Object {
+	hdr := x.header()
+	hdr.SetSplitID(u.splitID)
+
+	return hdr
+}
+
+// write writes the object with the given header template and payload.
+func (x *Uploader) write(ctx context.Context, hdr object.Object, payload []byte) (oid.ID, error) {
+	hdr.SetPayloadSize(uint64(len(payload)))
+	hdr.SetPayloadChecksum(object.CalculatePayloadChecksum(payload))
+
+	if x.opts.IsHomomorphicChecksumEnabled() {
+		var cs checksum.Checksum
+		checksum.Calculate(&cs, checksum.TZ, payload)
+		hdr.SetPayloadHomomorphicHash(cs)
+	}
+
+	if err := hdr.SetIDWithSignature(x.signer); err != nil {
+		return oid.ID{}, err
+	}
+
+	id, _ := hdr.ID()
+
+	stream, err := x.w.ObjectPutInit(ctx, hdr, x.signer, x.prm)
+	if err != nil {
+		return id, fmt.Errorf("init object stream: %w", err)
+	}
+
+	if _, err = stream.Write(payload); err != nil {
+		return id, fmt.Errorf("write object payload: %w", err)
+	}
+
+	if err = stream.Close(); err != nil {
+		return id, fmt.Errorf("finish object stream: %w", err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,324 @@
+package multipart_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/assembler"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/multipart"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+var _ multipart.Deleter = (*client.Client)(nil)
+
+// storage is an in-memory object storage.
+type storage struct {
+	mtx     sync.Mutex
+	objects map[oid.ID]object.Object
+	links   map[oid.ID]oid.ID
+
+	// sessions of the delete requests
+	deleteSessions []*session.Object
+}
+
+func newStorage() *storage {
+	return &storage{
+		objects: make(map[oid.ID]object.Object),
+		links:   make(map[oid.ID]oid.ID),
+	}
+}
+
+type objectWriter struct {
+	s   *storage
+	hdr object.Object
+	buf bytes.Buffer
+}
+
+func (x *objectWriter) Write(p []byte) (int, error) { return x.buf.Write(p) }
+
+func (x *objectWriter) Close() error {
+	x.s.mtx.Lock()
+	defer x.s.mtx.Unlock()
+
+	id, _ := x.hdr.ID()
+	x.hdr.SetPayload(x.buf.Bytes())
+	x.s.objects[id] = x.hdr
+
+	if par := x.hdr.Parent(); par != nil && len(x.hdr.Children()) > 0 {
+		parID, _ := par.ID()
+		x.s.links[parID] = id
+	}
+
+	return nil
+}
+
+func (x *objectWriter) GetResult() client.ResObjectPut { return client.ResObjectPut{} }
+
+func (x *storage) ObjectPutInit(_ context.Context, hdr object.Object, _ user.Signer, _ client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	return &objectWriter{s: x, hdr: hdr}, nil
+}
+
+func (x *storage) get(addr oid.Address) (object.Object, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if link, ok := x.links[addr.Object()]; ok {
+		si := object.NewSplitInfo()
+		si.SetLink(link)
+		return object.Object{}, object.NewSplitInfoError(si)
+	}
+
+	obj, ok := x.objects[addr.Object()]
+	if !ok {
+		return obj, apistatus.ErrObjectNotFound
+	}
+
+	return obj, nil
+}
+
+func (x *storage) Head(_ context.Context, addr oid.Address) (object.Object, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return obj, err
+	}
+
+	return *obj.CutPayload(), nil
+}
+
+func (x *storage) Get(_ context.Context, addr oid.Address) (object.Object, io.ReadCloser, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return obj, nil, err
+	}
+
+	return *obj.CutPayload(), io.NopCloser(bytes.NewReader(obj.Payload())), nil
+}
+
+func (x *storage) ObjectDelete(_ context.Context, _ cid.ID, id oid.ID, _ user.Signer, prm client.PrmObjectDelete) (oid.ID, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	delete(x.objects, id)
+
+	sess, _ := prm.GetSession()
+	x.deleteSessions = append(x.deleteSessions, sess)
+
+	return oid.ID{}, nil
+}
+
+func randomPayload(t testing.TB, size int) []byte {
+	b := make([]byte, size)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func newUploader(t testing.TB, s *storage, cnr cid.ID, withHomo bool) *multipart.Uploader {
+	signer := test.RandomSignerRFC6979(t)
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(100)
+	opts.SetCurrentNeoFSEpoch(10)
+	if withHomo {
+		opts.CalculateHomomorphicChecksum()
+	}
+
+	return multipart.NewUploader(s, signer, cnr, signer.UserID(), opts)
+}
+
+func TestUploader(t *testing.T) {
+	ctx := context.Background()
+	cnr := cidtest.ID()
+
+	for _, withHomo := range []bool{false, true} {
+		s := newStorage()
+		u := newUploader(t, s, cnr, withHomo)
+		upload := u.Initiate()
+
+		sizes := []int{250, 0, 100, 33}
+		payloads := make([][]byte, len(sizes))
+		parts := make([]multipart.Part, len(sizes))
+
+		for i := range sizes {
+			payloads[i] = randomPayload(t, sizes[i])
+
+			var prev *multipart.Part
+			if i > 0 {
+				prev = &parts[i-1]
+			}
+
+			var err error
+			parts[i], err = u.UploadPart(ctx, upload, prev, i+1, bytes.NewReader(payloads[i]))
+			require.NoError(t, err)
+		}
+
+		require.Len(t, parts[0].Children(), 3)
+		require.Len(t, parts[1].Children(), 1)
+		require.Len(t, parts[2].Children(), 1)
+		require.Len(t, parts[3].Children(), 1)
+
+		for i := range parts {
+			require.Equal(t, i+1, parts[i].Number())
+			require.EqualValues(t, sizes[i], parts[i].Size())
+		}
+
+		// same part uploaded again replaces the previous one, following parts
+		// are uploaded again
+		discarded := parts[2:]
+		parts = append([]multipart.Part(nil), parts...)
+		var err error
+		payloads[2] = randomPayload(t, 150)
+		parts[2], err = u.UploadPart(ctx, upload, &parts[1], 3, bytes.NewReader(payloads[2]))
+		require.NoError(t, err)
+		parts[3], err = u.UploadPart(ctx, upload, &parts[2], 4, bytes.NewReader(payloads[3]))
+		require.NoError(t, err)
+
+		_, err = u.Complete(ctx, upload, nil, append(parts[:3:3], discarded[1]))
+		require.Error(t, err)
+
+		// parts form a single chain
+		var addr oid.Address
+		addr.SetContainer(cnr)
+
+		for i := 1; i < len(parts); i++ {
+			addr.SetObject(parts[i].Children()[0])
+			hdr, err := s.Head(ctx, addr)
+			require.NoError(t, err)
+
+			prev, ok := hdr.PreviousID()
+			require.True(t, ok)
+			require.Equal(t, parts[i-1].Children()[len(parts[i-1].Children())-1], prev)
+		}
+
+		var attr object.Attribute
+		attr.SetKey("k")
+		attr.SetValue("v")
+
+		id, err := u.Complete(ctx, upload, []object.Attribute{attr}, parts)
+		require.NoError(t, err)
+
+		require.NoError(t, u.Abort(ctx, s, discarded))
+
+		addr.SetObject(id)
+
+		hdr, r, err := assembler.New(s).Assemble(ctx, addr)
+		require.NoError(t, err)
+
+		res, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+
+		require.Equal(t, bytes.Join(payloads, nil), res)
+		require.Equal(t, []object.Attribute{attr}, hdr.Attributes())
+		require.EqualValues(t, 10, hdr.CreationEpoch())
+		_, ok := hdr.PayloadHomomorphicHash()
+		require.Equal(t, withHomo, ok)
+	}
+}
+
+func TestUploader_Complete(t *testing.T) {
+	ctx := context.Background()
+	cnr := cidtest.ID()
+	s := newStorage()
+	u := newUploader(t, s, cnr, false)
+	upload := u.Initiate()
+
+	_, err := u.UploadPart(ctx, upload, nil, 0, bytes.NewReader(nil))
+	require.Error(t, err)
+
+	_, err = u.Complete(ctx, upload, nil, nil)
+	require.Error(t, err)
+
+	part1, err := u.UploadPart(ctx, upload, nil, 1, bytes.NewReader(randomPayload(t, 10)))
+	require.NoError(t, err)
+	part2, err := u.UploadPart(ctx, upload, &part1, 2, bytes.NewReader(randomPayload(t, 10)))
+	require.NoError(t, err)
+
+	_, err = u.UploadPart(ctx, upload, &part2, 2, bytes.NewReader(nil))
+	require.Error(t, err)
+
+	t.Run("out of order", func(t *testing.T) {
+		_, err := u.Complete(ctx, upload, nil, []multipart.Part{part2, part1})
+		require.Error(t, err)
+	})
+
+	t.Run("missing previous part", func(t *testing.T) {
+		_, err := u.Complete(ctx, upload, nil, []multipart.Part{part2})
+		require.Error(t, err)
+	})
+
+	t.Run("unrelated parts", func(t *testing.T) {
+		other, err := u.UploadPart(ctx, upload, nil, 2, bytes.NewReader(randomPayload(t, 10)))
+		require.NoError(t, err)
+
+		_, err = u.Complete(ctx, upload, nil, []multipart.Part{part1, other})
+		require.Error(t, err)
+	})
+}
+
+func TestUploader_Abort(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage()
+	signer := test.RandomSignerRFC6979(t)
+
+	sess := sessiontest.ObjectSigned(signer)
+
+	var opts slicer.Options
+	opts.SetSession(sess)
+
+	u := multipart.NewUploader(s, signer, cidtest.ID(), signer.UserID(), opts)
+	upload := u.Initiate()
+
+	part, err := u.UploadPart(ctx, upload, nil, 1, bytes.NewReader(randomPayload(t, 10)))
+	require.NoError(t, err)
+
+	require.NoError(t, u.Abort(ctx, s, []multipart.Part{part}))
+	require.Len(t, s.deleteSessions, 1)
+	require.Equal(t, sess, s.deleteSessions[0])
+
+	var addr oid.Address
+	addr.SetObject(part.Children()[0])
+	_, err = s.Head(ctx, addr)
+	require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+}
+
+func TestEncoding(t *testing.T) {
+	s := newStorage()
+	u := newUploader(t, s, cidtest.ID(), true)
+	upload := u.Initiate()
+
+	var upload2 multipart.Upload
+	require.NoError(t, upload2.DecodeString(upload.EncodeToString()))
+	require.Equal(t, upload, upload2)
+	require.Error(t, upload2.DecodeString("not an UUID"))
+
+	part, err := u.UploadPart(context.Background(), upload, nil, 3, bytes.NewReader(randomPayload(t, 150)))
+	require.NoError(t, err)
+
+	var part2 multipart.Part
+	require.NoError(t, part2.Unmarshal(part.Marshal()))
+	require.Equal(t, part, part2)
+
+	next, err := u.UploadPart(context.Background(), upload, &part, 4, bytes.NewReader(randomPayload(t, 10)))
+	require.NoError(t, err)
+	require.NoError(t, part2.Unmarshal(next.Marshal()))
+	require.Equal(t, next, part2)
+
+	require.Error(t, part2.Unmarshal([]byte("{}")))
+	require.Error(t, part2.Unmarshal([]byte("not a JSON")))
+}
@@ -683,7 +683,36 @@ func (o *Object) InitRelations() {
 //
 // See also [Object.Unmarshal].
 func (o *Object) Marshal() ([]byte, error) {
-	return (*object.Object)(o).StableMarshal(nil), nil
+	return o.MarshalTo(nil), nil
+}
+
+// MarshalTo marshals object into a protobuf binary form reusing the given
+// buffer. The buffer is grown if its capacity is not enough and may be nil.
+// Returns the slice of the buffer holding the result. MarshalTo is intended
+// for the hot paths encoding many objects sequentially.
+//
+// See also [Object.Marshal].
+func (o *Object) MarshalTo(buf []byte) []byte {
+	m := (*object.Object)(o)
+	return m.StableMarshal(resizeBuffer(buf, m.StableSize()))
+}
+
+// MarshalHeaderTo marshals object header into a protobuf binary form reusing
+// the given buffer similar to [Object.MarshalTo]. Object ID is the SHA-256
+// hash of this form.
+func (o *Object) MarshalHeaderTo(buf []byte) []byte {
+	h := (*object.Object)(o).GetHeader()
+	return h.StableMarshal(resizeBuffer(buf, h.StableSize()))
+}
+
+// resizeBuffer returns the buffer of the given length reusing buf if it has
+// enough capacity.
+func resizeBuffer(buf []byte, size int) []byte {
+	if cap(buf) < size {
+		return make([]byte, size)
+	}
+
+	return buf[:size]
 }
 
 // Unmarshal unmarshals protobuf binary representation of object.
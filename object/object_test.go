@@ -1,10 +1,14 @@
 package object_test
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
 	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 )
@@ -24,3 +28,80 @@ func TestInitCreation(t *testing.T) {
 	require.Equal(t, cnr, cID)
 	require.Equal(t, &own, o.OwnerID())
 }
+
+func TestObject_MarshalTo(t *testing.T) {
+	obj := objecttest.Object(t)
+
+	b, err := obj.Marshal()
+	require.NoError(t, err)
+
+	require.Equal(t, b, obj.MarshalTo(nil))
+
+	buf := make([]byte, 10, len(b)+10)
+	res := obj.MarshalTo(buf)
+	require.Equal(t, b, res)
+	require.Equal(t, &buf[:1][0], &res[0], "buffer with enough capacity must be reused")
+
+	require.Equal(t, b, obj.MarshalTo(make([]byte, 10)))
+
+	hdr := obj.ToV2().GetHeader().StableMarshal(nil)
+	require.Equal(t, hdr, obj.MarshalHeaderTo(nil))
+	require.Equal(t, hdr, obj.MarshalHeaderTo(make([]byte, 0, len(hdr))))
+	require.Empty(t, object.New().MarshalHeaderTo(nil))
+
+	id, err := obj.CalculateID()
+	require.NoError(t, err)
+
+	var expected oid.ID
+	expected.SetSHA256(sha256.Sum256(hdr))
+	require.Equal(t, expected, id)
+}
+
+func benchmarkObject(b *testing.B) *object.Object {
+	signer := test.RandomSignerRFC6979(b)
+
+	obj, err := object.NewBuilder().
+		Container(cidtest.ID()).
+		Owner(signer.UserID()).
+		Attribute("FileName", "cat.jpg").
+		Attribute("Timestamp", "1700000000").
+		Payload(make([]byte, 1024)).
+		HomomorphicHashing().
+		Build(signer)
+	require.NoError(b, err)
+
+	return obj
+}
+
+func BenchmarkObject_CalculateID(b *testing.B) {
+	obj := benchmarkObject(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = obj.CalculateID()
+	}
+}
+
+func BenchmarkObject_Marshal(b *testing.B) {
+	obj := benchmarkObject(b)
+
+	b.Run("new buffer", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _ = obj.Marshal()
+		}
+	})
+
+	b.Run("reused buffer", func(b *testing.B) {
+		var buf []byte
+
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			buf = obj.MarshalTo(buf)
+		}
+	})
+}
@@ -0,0 +1,245 @@
+package object
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// ErrPatchOutOfRange is returned when the patched payload range does not fit
+// the original payload.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrPatchOutOfRange = errors.New("patched range is out of payload bounds")
+
+// Patch describes modification of the existing object: replacement of the
+// payload range with the new data and update of the attributes. The result is
+// a new object with the same properties except the changed ones. Patch may be
+// applied locally using Patch.Apply.
+//
+// Zero Patch changes nothing.
+type Patch struct {
+	offset, length uint64
+
+	chunk io.Reader
+
+	attrs []Attribute
+
+	replaceAttrs bool
+}
+
+// SetPayloadRange sets payload range to be replaced with the chunk. Zero
+// length means insertion of the chunk at the offset, offset equal to the
+// payload size - appending the chunk to the payload.
+//
+// See also PayloadRange, SetChunk.
+func (x *Patch) SetPayloadRange(offset, length uint64) {
+	x.offset, x.length = offset, length
+}
+
+// PayloadRange returns payload range to be replaced with the chunk.
+//
+// See also SetPayloadRange.
+func (x Patch) PayloadRange() (offset, length uint64) {
+	return x.offset, x.length
+}
+
+// SetChunk sets stream of the data replacing the payload range. The chunk may
+// be of any length, nil means removal of the range. The stream is read once
+// by Patch.Apply.
+//
+// See also Chunk, SetPayloadRange.
+func (x *Patch) SetChunk(r io.Reader) {
+	x.chunk = r
+}
+
+// Chunk returns stream of the data replacing the payload range.
+//
+// See also SetChunk.
+func (x Patch) Chunk() io.Reader {
+	return x.chunk
+}
+
+// SetNewAttributes sets attributes to be added. By default, attributes with
+// the same keys are overwritten, the others are appended. Empty value removes
+// the attribute.
+//
+// See also NewAttributes, SetReplaceAttributes.
+func (x *Patch) SetNewAttributes(attrs ...Attribute) {
+	x.attrs = attrs
+}
+
+// NewAttributes returns attributes to be added.
+//
+// See also SetNewAttributes.
+func (x Patch) NewAttributes() []Attribute {
+	return x.attrs
+}
+
+// SetReplaceAttributes makes Patch to replace all the object attributes with
+// the new ones instead of merging them.
+//
+// See also ReplaceAttributes, SetNewAttributes.
+func (x *Patch) SetReplaceAttributes(v bool) {
+	x.replaceAttrs = v
+}
+
+// ReplaceAttributes checks whether Patch replaces all the object attributes.
+//
+// See also SetReplaceAttributes.
+func (x Patch) ReplaceAttributes() bool {
+	return x.replaceAttrs
+}
+
+func (x Patch) patchAttributes(attrs []Attribute) ([]Attribute, error) {
+	var res []Attribute
+	if !x.replaceAttrs {
+		res = append(res, attrs...)
+	}
+
+	mNew := make(map[string]struct{}, len(x.attrs))
+
+loop:
+	for i := range x.attrs {
+		key, val := x.attrs[i].Key(), x.attrs[i].Value()
+		if key == "" {
+			return nil, fmt.Errorf("empty key of the new attribute #%d", i)
+		}
+
+		if _, ok := mNew[key]; ok {
+			return nil, fmt.Errorf("duplicated new attribute %s", key)
+		}
+
+		mNew[key] = struct{}{}
+
+		for j := range res {
+			if res[j].Key() == key {
+				if val == "" {
+					res = append(res[:j], res[j+1:]...)
+				} else {
+					res[j].SetValue(val)
+				}
+
+				continue loop
+			}
+		}
+
+		if val != "" {
+			res = append(res, *NewAttribute())
+			res[len(res)-1].SetKey(key)
+			res[len(res)-1].SetValue(val)
+		}
+	}
+
+	return res, nil
+}
+
+// Apply applies Patch to the object with the given header and payload stream
+// and writes the resulting payload to w. Apply returns header of the resulting
+// object with updated attributes, payload size and checksums. Homomorphic
+// checksum is calculated only if it is present in the original header. The
+// result has no ID, signature and relations with other objects, so it MUST
+// be signed (see Object.SetIDWithSignature) before storage.
+//
+// Exactly hdr.PayloadSize bytes are read from the payload stream, the original
+// payload checksums are not verified (see NewVerifyingPayloadReader). Apply
+// returns ErrPatchOutOfRange if the range to replace does not fit the payload.
+func (x Patch) Apply(hdr Object, payload io.Reader, w io.Writer) (Object, error) {
+	size := hdr.PayloadSize()
+	if x.offset > size || x.length > size-x.offset {
+		return Object{}, fmt.Errorf("%w: [%d:%d] with payload size %d", ErrPatchOutOfRange, x.offset, x.offset+x.length, size)
+	}
+
+	attrs, err := x.patchAttributes(hdr.Attributes())
+	if err != nil {
+		return Object{}, err
+	}
+
+	hashers := []hash.Hash{sha256.New()}
+	_, homomorphic := hdr.PayloadHomomorphicHash()
+	if homomorphic {
+		hashers = append(hashers, tz.New())
+	}
+
+	mw := make([]io.Writer, 0, len(hashers)+1)
+	mw = append(mw, w)
+	for i := range hashers {
+		mw = append(mw, hashers[i])
+	}
+
+	dst := &countingWriter{w: io.MultiWriter(mw...)}
+
+	if _, err = io.CopyN(dst, payload, int64(x.offset)); err != nil {
+		return Object{}, fmt.Errorf("copy payload prefix: %w", unexpectedEOF(err))
+	}
+
+	if x.chunk != nil {
+		if _, err = io.Copy(dst, x.chunk); err != nil {
+			return Object{}, fmt.Errorf("copy chunk: %w", err)
+		}
+	}
+
+	if _, err = io.CopyN(io.Discard, payload, int64(x.length)); err != nil {
+		return Object{}, fmt.Errorf("skip replaced range: %w", unexpectedEOF(err))
+	}
+
+	if _, err = io.CopyN(dst, payload, int64(size-x.offset-x.length)); err != nil {
+		return Object{}, fmt.Errorf("copy payload suffix: %w", unexpectedEOF(err))
+	}
+
+	b, err := hdr.CutPayload().Marshal()
+	if err != nil {
+		return Object{}, fmt.Errorf("copy header: %w", err)
+	}
+
+	var res Object
+	if err = res.Unmarshal(b); err != nil {
+		return Object{}, fmt.Errorf("copy header: %w", err)
+	}
+
+	res.ResetID()
+	res.SetSignature(nil)
+	res.ResetRelations()
+	res.SetAttributes(attrs...)
+	res.SetPayloadSize(dst.n)
+
+	var cs checksum.Checksum
+	var sha [sha256.Size]byte
+	hashers[0].Sum(sha[:0])
+	cs.SetSHA256(sha)
+	res.SetPayloadChecksum(cs)
+
+	if homomorphic {
+		var tzh [tz.Size]byte
+		hashers[1].Sum(tzh[:0])
+		cs.SetTillichZemor(tzh)
+		res.SetPayloadHomomorphicHash(cs)
+	}
+
+	return res, nil
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n uint64
+}
+
+func (x *countingWriter) Write(p []byte) (int, error) {
+	n, err := x.w.Write(p)
+	x.n += uint64(n)
+	return n, err
+}
@@ -0,0 +1,162 @@
+package object_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+func attribute(k, v string) object.Attribute {
+	a := object.NewAttribute()
+	a.SetKey(k)
+	a.SetValue(v)
+	return *a
+}
+
+func sha256Checksum(data []byte) checksum.Checksum {
+	var cs checksum.Checksum
+	cs.SetSHA256(sha256.Sum256(data))
+	return cs
+}
+
+func tzChecksum(data []byte) checksum.Checksum {
+	var cs checksum.Checksum
+	cs.SetTillichZemor(tz.Sum(data))
+	return cs
+}
+
+func patchedObject(t *testing.T, payload []byte, attrs ...string) object.Object {
+	obj := objecttest.Object(t)
+	obj.ResetRelations()
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayloadChecksum(sha256Checksum(payload))
+	obj.SetPayloadHomomorphicHash(tzChecksum(payload))
+
+	var as []object.Attribute
+	for i := 0; i < len(attrs); i += 2 {
+		as = append(as, attribute(attrs[i], attrs[i+1]))
+	}
+
+	obj.SetAttributes(as...)
+	require.NoError(t, obj.SetIDWithSignature(test.RandomSignerRFC6979(t)))
+
+	return *obj
+}
+
+func TestPatch_Apply(t *testing.T) {
+	payload := []byte("Hello, world!")
+
+	for _, tc := range []struct {
+		name           string
+		offset, length uint64
+		chunk          string
+		exp            string
+	}{
+		{name: "zero"},
+		{name: "replace", offset: 7, length: 5, chunk: "NeoFS", exp: "Hello, NeoFS!"},
+		{name: "insert", offset: 5, chunk: " there", exp: "Hello there, world!"},
+		{name: "remove", offset: 5, length: 7, exp: "Hello!"},
+		{name: "prepend", chunk: ">> ", exp: ">> Hello, world!"},
+		{name: "append", offset: 13, chunk: "!!", exp: "Hello, world!!!"},
+		{name: "whole", length: 13, chunk: "bye", exp: "bye"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.exp == "" {
+				tc.exp = string(payload)
+			}
+
+			hdr := patchedObject(t, payload, "k1", "v1")
+
+			var p object.Patch
+			p.SetPayloadRange(tc.offset, tc.length)
+			if tc.chunk != "" {
+				p.SetChunk(bytes.NewReader([]byte(tc.chunk)))
+			}
+
+			var buf bytes.Buffer
+			res, err := p.Apply(*hdr.CutPayload(), bytes.NewReader(payload), &buf)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, buf.String())
+
+			_, ok := res.ID()
+			require.False(t, ok)
+			require.Nil(t, res.Signature())
+			require.EqualValues(t, len(tc.exp), res.PayloadSize())
+			res.SetPayload(buf.Bytes())
+			require.NoError(t, res.VerifyPayloadChecksum())
+			cs, ok := res.PayloadHomomorphicHash()
+			require.True(t, ok)
+			require.Equal(t, tzChecksum(buf.Bytes()), cs)
+			require.Equal(t, hdr.Attributes(), res.Attributes())
+			require.Equal(t, hdr.OwnerID(), res.OwnerID())
+
+			require.NoError(t, res.SetIDWithSignature(test.RandomSignerRFC6979(t)))
+			require.NoError(t, res.CheckHeaderVerificationFields())
+		})
+	}
+
+	t.Run("without homomorphic hash", func(t *testing.T) {
+		hdr := patchedObject(t, payload)
+		hdr.ToV2().GetHeader().SetHomomorphicHash(nil)
+
+		var p object.Patch
+		res, err := p.Apply(hdr, bytes.NewReader(payload), io.Discard)
+		require.NoError(t, err)
+		_, ok := res.PayloadHomomorphicHash()
+		require.False(t, ok)
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		hdr := patchedObject(t, payload, "k1", "v1", "k2", "v2", "k3", "v3")
+
+		var p object.Patch
+		p.SetNewAttributes(attribute("k2", "v2'"), attribute("k3", ""), attribute("k4", "v4"))
+
+		res, err := p.Apply(hdr, bytes.NewReader(payload), io.Discard)
+		require.NoError(t, err)
+		require.Equal(t, []object.Attribute{attribute("k1", "v1"), attribute("k2", "v2'"), attribute("k4", "v4")}, res.Attributes())
+		require.Len(t, hdr.Attributes(), 3)
+
+		p.SetReplaceAttributes(true)
+		res, err = p.Apply(hdr, bytes.NewReader(payload), io.Discard)
+		require.NoError(t, err)
+		require.Equal(t, []object.Attribute{attribute("k2", "v2'"), attribute("k4", "v4")}, res.Attributes())
+
+		p.SetNewAttributes(attribute("k1", "v1"), attribute("k1", "v2"))
+		_, err = p.Apply(hdr, bytes.NewReader(payload), io.Discard)
+		require.Error(t, err)
+
+		p.SetNewAttributes(attribute("", "v1"))
+		_, err = p.Apply(hdr, bytes.NewReader(payload), io.Discard)
+		require.Error(t, err)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		hdr := patchedObject(t, payload)
+
+		for _, rng := range [][2]uint64{{14, 0}, {13, 1}, {0, 14}, {10, 1 << 63}} {
+			var p object.Patch
+			p.SetPayloadRange(rng[0], rng[1])
+
+			_, err := p.Apply(hdr, bytes.NewReader(payload), io.Discard)
+			require.ErrorIs(t, err, object.ErrPatchOutOfRange, rng)
+		}
+	})
+
+	t.Run("short payload", func(t *testing.T) {
+		hdr := patchedObject(t, payload)
+
+		var p object.Patch
+		_, err := p.Apply(hdr, bytes.NewReader(payload[:5]), io.Discard)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
@@ -0,0 +1,139 @@
+package object
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+)
+
+// ErrPayloadChecksumMismatch is returned when the object payload does not
+// correspond to the checksum declared in the object header.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrPayloadChecksumMismatch = errors.New("payload checksum mismatch")
+
+// ErrPayloadSizeMismatch is returned when the object payload length differs
+// from the size declared in the object header.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrPayloadSizeMismatch = errors.New("payload size mismatch")
+
+// NewVerifyingPayloadReader wraps the payload stream of the object with the
+// given header into the reader verifying the payload against the header on
+// the fly. The payload may be read in any portions without buffering.
+//
+// The resulting reader fails with [ErrPayloadSizeMismatch] as soon as the
+// stream exceeds the declared payload size, or when it ends before. When the
+// declared number of bytes is reached, the reader checks SHA-256 checksum and,
+// if homomorphic is set, Tillich-Zémor one: the mismatch is reported by the
+// same Read call that returns the last payload bytes as
+// [ErrPayloadChecksumMismatch]. Reader returns [io.EOF] only if the payload is
+// fully verified.
+//
+// NewVerifyingPayloadReader returns an error if the header misses checksums
+// to verify.
+func NewVerifyingPayloadReader(hdr Object, r io.Reader, homomorphic bool) (io.Reader, error) {
+	cs, ok := hdr.PayloadChecksum()
+	if !ok {
+		return nil, errCheckSumNotSet
+	} else if cs.Type() != checksum.SHA256 {
+		return nil, fmt.Errorf("unsupported payload checksum type %s", cs.Type())
+	}
+
+	res := &verifyingPayloadReader{
+		r:    r,
+		size: hdr.PayloadSize(),
+		cs:   cs.Value(),
+		h:    sha256.New(),
+	}
+
+	if homomorphic {
+		cs, ok = hdr.PayloadHomomorphicHash()
+		if !ok {
+			return nil, errors.New("payload homomorphic checksum is not set")
+		} else if cs.Type() != checksum.TZ {
+			return nil, fmt.Errorf("unsupported payload homomorphic checksum type %s", cs.Type())
+		}
+
+		res.tzCS = cs.Value()
+		res.tzH = checksum.NewTZHasher()
+	}
+
+	return res, nil
+}
+
+type verifyingPayloadReader struct {
+	r io.Reader
+
+	size, read uint64
+
+	cs []byte
+	h  hash.Hash
+
+	// nil if homomorphic checksum is not verified
+	tzCS []byte
+	tzH  hash.Hash
+
+	verified bool
+
+	// sticky error of the previous reads
+	err error
+}
+
+func (x *verifyingPayloadReader) Read(p []byte) (int, error) {
+	if x.err != nil {
+		return 0, x.err
+	}
+
+	n, err := x.r.Read(p)
+	if n > 0 {
+		if x.read+uint64(n) > x.size {
+			x.err = fmt.Errorf("%w: more than %d bytes", ErrPayloadSizeMismatch, x.size)
+			return 0, x.err
+		}
+
+		x.h.Write(p[:n])
+		if x.tzH != nil {
+			x.tzH.Write(p[:n])
+		}
+
+		x.read += uint64(n)
+	}
+
+	if !x.verified && x.read == x.size {
+		x.verified = true
+
+		if x.err = x.verify(); x.err != nil {
+			return n, x.err
+		}
+	}
+
+	if err != nil {
+		if errors.Is(err, io.EOF) && x.read < x.size {
+			err = fmt.Errorf("%w: %d bytes instead of %d", ErrPayloadSizeMismatch, x.read, x.size)
+		}
+
+		x.err = err
+	}
+
+	return n, err
+}
+
+func (x *verifyingPayloadReader) verify() error {
+	if !bytes.Equal(x.h.Sum(nil), x.cs) {
+		return fmt.Errorf("%w: %s", ErrPayloadChecksumMismatch, checksum.SHA256)
+	}
+
+	if x.tzH != nil && !bytes.Equal(x.tzH.Sum(nil), x.tzCS) {
+		return fmt.Errorf("%w: %s", ErrPayloadChecksumMismatch, checksum.TZ)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package object_test
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func payloadHeader(payload []byte) object.Object {
+	var hdr object.Object
+	var cs checksum.Checksum
+
+	hdr.SetPayloadSize(uint64(len(payload)))
+	checksum.Calculate(&cs, checksum.SHA256, payload)
+	hdr.SetPayloadChecksum(cs)
+	checksum.Calculate(&cs, checksum.TZ, payload)
+	hdr.SetPayloadHomomorphicHash(cs)
+
+	return hdr
+}
+
+func TestNewVerifyingPayloadReader(t *testing.T) {
+	payload := make([]byte, 4<<10)
+	rand.Read(payload)
+
+	hdr := payloadHeader(payload)
+
+	t.Run("missing checksums", func(t *testing.T) {
+		_, err := object.NewVerifyingPayloadReader(object.Object{}, bytes.NewReader(payload), false)
+		require.Error(t, err)
+
+		var hdr object.Object
+		var cs checksum.Checksum
+		checksum.Calculate(&cs, checksum.SHA256, payload)
+		hdr.SetPayloadChecksum(cs)
+
+		_, err = object.NewVerifyingPayloadReader(hdr, bytes.NewReader(payload), false)
+		require.NoError(t, err)
+
+		_, err = object.NewVerifyingPayloadReader(hdr, bytes.NewReader(payload), true)
+		require.Error(t, err)
+	})
+
+	for _, homomorphic := range []bool{false, true} {
+		r, err := object.NewVerifyingPayloadReader(hdr, iotest.HalfReader(bytes.NewReader(payload)), homomorphic)
+		require.NoError(t, err)
+		require.NoError(t, iotest.TestReader(r, payload))
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		r, err := object.NewVerifyingPayloadReader(payloadHeader(nil), bytes.NewReader(nil), true)
+		require.NoError(t, err)
+
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Empty(t, b)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		for _, homomorphic := range []bool{false, true} {
+			corrupted := append([]byte{}, payload...)
+			corrupted[len(corrupted)-1]++
+
+			r, err := object.NewVerifyingPayloadReader(hdr, bytes.NewReader(corrupted), homomorphic)
+			require.NoError(t, err)
+
+			_, err = io.ReadAll(r)
+			require.ErrorIs(t, err, object.ErrPayloadChecksumMismatch)
+		}
+	})
+
+	t.Run("short", func(t *testing.T) {
+		r, err := object.NewVerifyingPayloadReader(hdr, bytes.NewReader(payload[:len(payload)-1]), true)
+		require.NoError(t, err)
+
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, object.ErrPayloadSizeMismatch)
+	})
+
+	t.Run("long", func(t *testing.T) {
+		r, err := object.NewVerifyingPayloadReader(hdr, io.MultiReader(bytes.NewReader(payload), bytes.NewReader([]byte{1})), true)
+		require.NoError(t, err)
+
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, object.ErrPayloadSizeMismatch)
+	})
+}
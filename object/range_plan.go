@@ -0,0 +1,260 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// PlanPayloadRanges splits the payload of the given size into contiguous
+// ranges to be downloaded in parallel (e.g. by the NeoFS client or pool
+// object range requests). Number of ranges is equal to the parallelism unless
+// the ranges have to be shorter than maxLength or the payload has fewer bytes.
+// Ranges have equal lengths up to one byte, the longer ones go first.
+// Non-positive parallelism means 1, zero maxLength means no limit. Returns
+// nil for empty payload.
+//
+// See also DownloadPayload, OrderedRangeWriter.
+func PlanPayloadRanges(size uint64, parallelism int, maxLength uint64) []Range {
+	if size == 0 {
+		return nil
+	}
+
+	n := uint64(1)
+	if parallelism > 1 {
+		n = uint64(parallelism)
+	}
+
+	if maxLength > 0 {
+		if m := (size + maxLength - 1) / maxLength; m > n {
+			n = m
+		}
+	}
+
+	if n > size {
+		n = size
+	}
+
+	res := make([]Range, n)
+	base, rest := size/n, size%n
+
+	var off uint64
+	for i := range res {
+		ln := base
+		if uint64(i) < rest {
+			ln++
+		}
+
+		res[i].SetOffset(off)
+		res[i].SetLength(ln)
+		off += ln
+	}
+
+	return res
+}
+
+// OrderedRangeWriter writes payload ranges downloaded in arbitrary order to
+// the underlying writer in the order of the plan. Ranges which are not next
+// in order are buffered. OrderedRangeWriter must be constructed via
+// NewOrderedRangeWriter. OrderedRangeWriter is safe for concurrent use.
+type OrderedRangeWriter struct {
+	w io.Writer
+
+	mtx sync.Mutex
+
+	n, next int
+
+	pending map[int][]byte
+
+	err error
+
+	// called after the range is written, optional
+	onWrite func(i int)
+}
+
+// NewOrderedRangeWriter constructs OrderedRangeWriter of n ranges into w.
+func NewOrderedRangeWriter(w io.Writer, n int) *OrderedRangeWriter {
+	return &OrderedRangeWriter{
+		w:       w,
+		n:       n,
+		pending: make(map[int][]byte),
+	}
+}
+
+// WriteRange passes data of the i-th range. The data is written immediately
+// if all previous ranges have been written, otherwise it is buffered, so the
+// data MUST NOT be changed after the call. WriteRange returns an error if the
+// index is out of range or duplicated, or if writing to the underlying writer
+// has failed, the first such error is returned for all subsequent calls.
+func (x *OrderedRangeWriter) WriteRange(i int, data []byte) error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.err != nil {
+		return x.err
+	}
+
+	if i < 0 || i >= x.n {
+		return fmt.Errorf("range index %d out of [0:%d)", i, x.n)
+	}
+
+	if _, ok := x.pending[i]; ok || i < x.next {
+		return fmt.Errorf("duplicated range #%d", i)
+	}
+
+	x.pending[i] = data
+
+	for {
+		b, ok := x.pending[x.next]
+		if !ok {
+			return nil
+		}
+
+		delete(x.pending, x.next)
+
+		if _, err := x.w.Write(b); err != nil {
+			x.err = fmt.Errorf("write range #%d: %w", x.next, err)
+			return x.err
+		}
+
+		if x.onWrite != nil {
+			x.onWrite(x.next)
+		}
+
+		x.next++
+	}
+}
+
+// Close checks that all ranges have been written. Close does not close the
+// underlying writer.
+func (x *OrderedRangeWriter) Close() error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.err != nil {
+		return x.err
+	}
+
+	if x.next < x.n {
+		return fmt.Errorf("%d of %d ranges are missing starting from #%d", x.n-x.next, x.n, x.next)
+	}
+
+	return nil
+}
+
+// DownloadPayload reads the given payload ranges from src using up to
+// parallelism concurrent requests and writes their concatenation to w in
+// order. Number of ranges buffered in memory is limited by twice the
+// parallelism. DownloadPayload stops on the first failure. Non-positive
+// parallelism means 1.
+//
+// See also PlanPayloadRanges.
+func DownloadPayload(ctx context.Context, src PayloadRangeSource, ranges []Range, parallelism int, w io.Writer) error {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	if parallelism > len(ranges) {
+		parallelism = len(ranges)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	window := make(chan struct{}, 2*parallelism)
+	ow := NewOrderedRangeWriter(w, len(ranges))
+	ow.onWrite = func(int) { <-window }
+
+	var errOnce sync.Once
+	var res error
+	fail := func(err error) {
+		errOnce.Do(func() {
+			res = err
+			cancel()
+		})
+	}
+
+	idx := make(chan int)
+
+	go func() {
+		defer close(idx)
+
+		for i := range ranges {
+			select {
+			case <-ctx.Done():
+				return
+			case window <- struct{}{}:
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case idx <- i:
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+
+	for j := 0; j < parallelism; j++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range idx {
+				b, err := readPayloadRange(ctx, src, ranges[i].GetOffset(), ranges[i].GetLength())
+				if err == nil {
+					err = ow.WriteRange(i, b)
+				}
+
+				if err != nil {
+					fail(fmt.Errorf("range #%d: %w", i, err))
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if res != nil {
+		return res
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return ow.Close()
+}
+
+func readPayloadRange(ctx context.Context, src PayloadRangeSource, off, ln uint64) ([]byte, error) {
+	if ln == 0 {
+		return nil, errors.New("zero length")
+	}
+
+	r, err := src.ReadPayloadRange(ctx, off, ln)
+	if err != nil {
+		return nil, fmt.Errorf("read payload range [%d:%d]: %w", off, off+ln, err)
+	}
+
+	b := make([]byte, ln)
+
+	_, err = io.ReadFull(r, b)
+	if errClose := r.Close(); err == nil {
+		err = errClose
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read payload range [%d:%d]: %w", off, off+ln, err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,136 @@
+package object_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+// concurrentRangeSource is a memoryRangeSource safe for concurrent use
+// serving ranges with random delays.
+type concurrentRangeSource struct {
+	payload  []byte
+	requests int32
+	failOn   uint64 // fails range with this offset + 1
+}
+
+func (x *concurrentRangeSource) ReadPayloadRange(_ context.Context, offset, length uint64) (io.ReadCloser, error) {
+	atomic.AddInt32(&x.requests, 1)
+	time.Sleep(time.Duration(rand.Intn(1000)) * time.Microsecond)
+
+	if x.failOn == offset+1 {
+		return nil, errors.New("any error")
+	}
+
+	return io.NopCloser(bytes.NewReader(x.payload[offset : offset+length])), nil
+}
+
+func rangeBounds(rs []object.Range) [][2]uint64 {
+	res := make([][2]uint64, len(rs))
+	for i := range rs {
+		res[i] = [2]uint64{rs[i].GetOffset(), rs[i].GetLength()}
+	}
+	return res
+}
+
+func TestPlanPayloadRanges(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		size        uint64
+		parallelism int
+		maxLength   uint64
+		exp         [][2]uint64
+	}{
+		{name: "empty", size: 0, parallelism: 4},
+		{name: "sequential", size: 10, parallelism: 0, exp: [][2]uint64{{0, 10}}},
+		{name: "even", size: 12, parallelism: 4, exp: [][2]uint64{{0, 3}, {3, 3}, {6, 3}, {9, 3}}},
+		{name: "uneven", size: 10, parallelism: 4, exp: [][2]uint64{{0, 3}, {3, 3}, {6, 2}, {8, 2}}},
+		{name: "small", size: 2, parallelism: 4, exp: [][2]uint64{{0, 1}, {1, 1}}},
+		{name: "max length", size: 10, parallelism: 2, maxLength: 3, exp: [][2]uint64{{0, 3}, {3, 3}, {6, 2}, {8, 2}}},
+		{name: "loose max length", size: 10, parallelism: 2, maxLength: 100, exp: [][2]uint64{{0, 5}, {5, 5}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := object.PlanPayloadRanges(tc.size, tc.parallelism, tc.maxLength)
+			if tc.exp == nil {
+				require.Empty(t, rs)
+				return
+			}
+			require.Equal(t, tc.exp, rangeBounds(rs))
+		})
+	}
+}
+
+func TestOrderedRangeWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := object.NewOrderedRangeWriter(&buf, 3)
+
+	require.NoError(t, w.WriteRange(2, []byte("c")))
+	require.NoError(t, w.WriteRange(1, []byte("b")))
+	require.Empty(t, buf.Bytes())
+	require.Error(t, w.Close())
+	require.Error(t, w.WriteRange(1, []byte("b")))
+	require.Error(t, w.WriteRange(3, []byte("d")))
+	require.Error(t, w.WriteRange(-1, []byte("d")))
+
+	require.NoError(t, w.WriteRange(0, []byte("a")))
+	require.Equal(t, "abc", buf.String())
+	require.NoError(t, w.Close())
+	require.Error(t, w.WriteRange(0, []byte("a")))
+
+	t.Run("write failure", func(t *testing.T) {
+		w := object.NewOrderedRangeWriter(failingWriter{}, 2)
+		require.NoError(t, w.WriteRange(1, []byte("b")))
+		require.Error(t, w.WriteRange(0, []byte("a")))
+		require.Error(t, w.Close())
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("any error") }
+
+func TestDownloadPayload(t *testing.T) {
+	ctx := context.Background()
+
+	payload := make([]byte, 100<<10)
+	rand.Read(payload)
+
+	for _, parallelism := range []int{0, 1, 4, 16} {
+		src := &concurrentRangeSource{payload: payload}
+		rs := object.PlanPayloadRanges(uint64(len(payload)), parallelism, 1<<10)
+
+		var buf bytes.Buffer
+		require.NoError(t, object.DownloadPayload(ctx, src, rs, parallelism, &buf), parallelism)
+		require.Equal(t, payload, buf.Bytes(), parallelism)
+		require.EqualValues(t, len(rs), atomic.LoadInt32(&src.requests), parallelism)
+	}
+
+	require.NoError(t, object.DownloadPayload(ctx, &concurrentRangeSource{}, nil, 4, io.Discard))
+
+	t.Run("failure", func(t *testing.T) {
+		rs := object.PlanPayloadRanges(uint64(len(payload)), 4, 1<<10)
+		src := &concurrentRangeSource{payload: payload, failOn: rs[10].GetOffset() + 1}
+
+		var buf bytes.Buffer
+		require.Error(t, object.DownloadPayload(ctx, src, rs, 4, &buf))
+		require.LessOrEqual(t, buf.Len(), int(rs[10].GetOffset()))
+
+		require.Error(t, object.DownloadPayload(ctx, &concurrentRangeSource{payload: payload}, rs, 4, failingWriter{}))
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		rs := object.PlanPayloadRanges(uint64(len(payload)), 4, 1<<10)
+		require.ErrorIs(t, object.DownloadPayload(ctx, &concurrentRangeSource{payload: payload}, rs, 4, io.Discard), context.Canceled)
+	})
+}
@@ -0,0 +1,533 @@
+package object
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// Readable form of the object is intended for debugging and inspection by
+// humans, e.g. in the command line tools:
+//
+//	id: <base58 object ID>
+//	signature:
+//	  scheme: ECDSA_SHA512
+//	  publicKey: <hex>
+//	  value: <hex>
+//	header:
+//	  version: v2.14
+//	  container: <base58 container ID>
+//	  owner: <base58 user ID>
+//	  creationEpoch: 13
+//	  payloadSize: 1024
+//	  payloadChecksum: SHA256:<hex>
+//	  homomorphicChecksum: TZ:<hex>
+//	  type: REGULAR
+//	  attributes:
+//	    - FileName: cat.jpg
+//	    - Timestamp: "1700000000"
+//	  sessionToken: <hex of the binary form>
+//	  split:
+//	    splitID: <UUID>
+//	    previous: <base58 object ID>
+//	    children: [<base58 object ID>, ...]
+//	    parentID: <base58 object ID>
+//	    parentSignature: <same as signature>
+//	    parentHeader: <same as header>
+//	payload: <hex>
+//
+// IDs are written in the EncodeToString form of the corresponding types,
+// enumerations and checksum types in the String one. Attributes are a list of
+// single-entry maps to keep their order which affects object ID. Missing
+// fields are omitted. Payload is included if present: use Object.CutPayload to
+// dump the header only.
+//
+// Same structure is produced by Object.MarshalReadableJSON and
+// Object.MarshalYAML, while Object.MarshalJSON keeps the protocol JSON form.
+
+// readableObject is a readable representation of the Object.
+type readableObject struct {
+	ID        string             `json:"id,omitempty" yaml:"id,omitempty"`
+	Signature *readableSignature `json:"signature,omitempty" yaml:"signature,omitempty"`
+	Header    *readableHeader    `json:"header,omitempty" yaml:"header,omitempty"`
+	Payload   string             `json:"payload,omitempty" yaml:"payload,omitempty"`
+}
+
+// readableSignature is a readable representation of the neofscrypto.Signature.
+type readableSignature struct {
+	Scheme    string `json:"scheme" yaml:"scheme"`
+	PublicKey string `json:"publicKey" yaml:"publicKey"`
+	Value     string `json:"value" yaml:"value"`
+}
+
+// readableHeader is a readable representation of the object header.
+type readableHeader struct {
+	Version             string              `json:"version,omitempty" yaml:"version,omitempty"`
+	Container           string              `json:"container,omitempty" yaml:"container,omitempty"`
+	Owner               string              `json:"owner,omitempty" yaml:"owner,omitempty"`
+	CreationEpoch       uint64              `json:"creationEpoch,omitempty" yaml:"creationEpoch,omitempty"`
+	PayloadSize         uint64              `json:"payloadSize,omitempty" yaml:"payloadSize,omitempty"`
+	PayloadChecksum     string              `json:"payloadChecksum,omitempty" yaml:"payloadChecksum,omitempty"`
+	HomomorphicChecksum string              `json:"homomorphicChecksum,omitempty" yaml:"homomorphicChecksum,omitempty"`
+	Type                string              `json:"type" yaml:"type"`
+	Attributes          []map[string]string `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+	SessionToken        string              `json:"sessionToken,omitempty" yaml:"sessionToken,omitempty"`
+	Split               *readableSplit      `json:"split,omitempty" yaml:"split,omitempty"`
+}
+
+// readableSplit is a readable representation of the object split header.
+type readableSplit struct {
+	SplitID         string             `json:"splitID,omitempty" yaml:"splitID,omitempty"`
+	Previous        string             `json:"previous,omitempty" yaml:"previous,omitempty"`
+	Children        []string           `json:"children,omitempty" yaml:"children,omitempty"`
+	ParentID        string             `json:"parentID,omitempty" yaml:"parentID,omitempty"`
+	ParentSignature *readableSignature `json:"parentSignature,omitempty" yaml:"parentSignature,omitempty"`
+	ParentHeader    *readableHeader    `json:"parentHeader,omitempty" yaml:"parentHeader,omitempty"`
+}
+
+// MarshalReadableJSON returns indented JSON of the readable Object form.
+//
+// See also UnmarshalReadableJSON.
+func (o *Object) MarshalReadableJSON() ([]byte, error) {
+	v, err := o.toReadable()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(v, "", "  ")
+}
+
+// UnmarshalReadableJSON decodes Object from JSON of its readable form.
+//
+// See also MarshalReadableJSON.
+func (o *Object) UnmarshalReadableJSON(data []byte) error {
+	var src readableObject
+	if err := json.Unmarshal(data, &src); err != nil {
+		return err
+	}
+
+	return o.fromReadable(src)
+}
+
+// MarshalYAML returns YAML representation of the readable Object form.
+//
+// See also UnmarshalYAML.
+func (o *Object) MarshalYAML() (any, error) {
+	return o.toReadable()
+}
+
+// UnmarshalYAML decodes Object from YAML of its readable form.
+//
+// See also MarshalYAML.
+func (o *Object) UnmarshalYAML(unmarshal func(any) error) error {
+	var src readableObject
+	if err := unmarshal(&src); err != nil {
+		return err
+	}
+
+	return o.fromReadable(src)
+}
+
+func (o *Object) toReadable() (readableObject, error) {
+	var res readableObject
+
+	if id, ok := o.ID(); ok {
+		res.ID = id.EncodeToString()
+	}
+
+	v2 := (*object.Object)(o)
+
+	res.Signature = signatureToReadable(v2.GetSignature())
+
+	if h := v2.GetHeader(); h != nil {
+		res.Header = headerToReadable(h)
+	}
+
+	if p := o.Payload(); len(p) > 0 {
+		res.Payload = hex.EncodeToString(p)
+	}
+
+	return res, nil
+}
+
+func (o *Object) fromReadable(src readableObject) error {
+	res := New()
+
+	if src.ID != "" {
+		var id oid.ID
+		if err := id.DecodeString(src.ID); err != nil {
+			return fmt.Errorf("invalid ID: %w", err)
+		}
+
+		res.SetID(id)
+	}
+
+	if src.Signature != nil {
+		sig, err := signatureFromReadable(*src.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature: %w", err)
+		}
+
+		res.SetSignature(&sig)
+	}
+
+	if src.Header != nil {
+		if err := res.headerFromReadable(*src.Header); err != nil {
+			return fmt.Errorf("invalid header: %w", err)
+		}
+	}
+
+	if src.Payload != "" {
+		p, err := hex.DecodeString(src.Payload)
+		if err != nil {
+			return fmt.Errorf("invalid payload: %w", err)
+		}
+
+		res.SetPayload(p)
+	}
+
+	*o = *res
+
+	return nil
+}
+
+func signatureToReadable(sig *refs.Signature) *readableSignature {
+	if sig == nil {
+		return nil
+	}
+
+	return &readableSignature{
+		Scheme:    neofscrypto.Scheme(sig.GetScheme()).String(),
+		PublicKey: hex.EncodeToString(sig.GetKey()),
+		Value:     hex.EncodeToString(sig.GetSign()),
+	}
+}
+
+func signatureFromReadable(src readableSignature) (neofscrypto.Signature, error) {
+	var res neofscrypto.Signature
+	var m refs.Signature
+
+	scheme, err := decodeScheme(src.Scheme)
+	if err != nil {
+		return res, err
+	}
+
+	key, err := hex.DecodeString(src.PublicKey)
+	if err != nil {
+		return res, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	val, err := hex.DecodeString(src.Value)
+	if err != nil {
+		return res, fmt.Errorf("invalid value: %w", err)
+	}
+
+	m.SetScheme(refs.SignatureScheme(scheme))
+	m.SetKey(key)
+	m.SetSign(val)
+
+	return res, res.ReadFromV2(m)
+}
+
+func decodeScheme(s string) (neofscrypto.Scheme, error) {
+	for scheme := neofscrypto.ECDSA_SHA512; scheme <= neofscrypto.ECDSA_WALLETCONNECT; scheme++ {
+		if scheme.String() == s {
+			return scheme, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown scheme %q", s)
+}
+
+func checksumToReadable(cs *refs.Checksum) string {
+	if cs == nil {
+		return ""
+	}
+
+	return cs.GetType().String() + ":" + hex.EncodeToString(cs.GetSum())
+}
+
+func checksumFromReadable(s string) (checksum.Checksum, error) {
+	var res checksum.Checksum
+
+	typ, val, ok := strings.Cut(s, ":")
+	if !ok {
+		return res, errors.New("missing type prefix")
+	}
+
+	var m refs.Checksum
+	var t refs.ChecksumType
+
+	if !t.FromString(typ) {
+		return res, fmt.Errorf("unknown type %q", typ)
+	}
+
+	b, err := hex.DecodeString(val)
+	if err != nil {
+		return res, fmt.Errorf("invalid value: %w", err)
+	}
+
+	m.SetType(t)
+	m.SetSum(b)
+
+	return res, res.ReadFromV2(m)
+}
+
+func headerToReadable(h *object.Header) *readableHeader {
+	res := &readableHeader{
+		CreationEpoch:       h.GetCreationEpoch(),
+		PayloadSize:         h.GetPayloadLength(),
+		PayloadChecksum:     checksumToReadable(h.GetPayloadHash()),
+		HomomorphicChecksum: checksumToReadable(h.GetHomomorphicHash()),
+		Type:                TypeFromV2(h.GetObjectType()).EncodeToString(),
+	}
+
+	if m := h.GetVersion(); m != nil {
+		var ver version.Version
+		if ver.ReadFromV2(*m) == nil {
+			res.Version = version.EncodeToString(ver)
+		}
+	}
+
+	if m := h.GetContainerID(); m != nil {
+		var cnr cid.ID
+		if cnr.ReadFromV2(*m) == nil {
+			res.Container = cnr.EncodeToString()
+		}
+	}
+
+	if m := h.GetOwnerID(); m != nil {
+		var owner user.ID
+		if owner.ReadFromV2(*m) == nil {
+			res.Owner = owner.EncodeToString()
+		}
+	}
+
+	attrs := h.GetAttributes()
+	if len(attrs) > 0 {
+		res.Attributes = make([]map[string]string, len(attrs))
+		for i := range attrs {
+			res.Attributes[i] = map[string]string{attrs[i].GetKey(): attrs[i].GetValue()}
+		}
+	}
+
+	if m := h.GetSessionToken(); m != nil {
+		res.SessionToken = hex.EncodeToString(m.StableMarshal(nil))
+	}
+
+	if s := h.GetSplit(); s != nil {
+		res.Split = &readableSplit{
+			ParentSignature: signatureToReadable(s.GetParentSignature()),
+		}
+
+		if splitID := NewSplitIDFromV2(s.GetSplitID()); splitID != nil {
+			res.Split.SplitID = splitID.String()
+		}
+
+		res.Split.Previous = idToReadable(s.GetPrevious())
+		res.Split.ParentID = idToReadable(s.GetParent())
+
+		for _, m := range s.GetChildren() {
+			res.Split.Children = append(res.Split.Children, idToReadable(&m))
+		}
+
+		if par := s.GetParentHeader(); par != nil {
+			res.Split.ParentHeader = headerToReadable(par)
+		}
+	}
+
+	return res
+}
+
+func idToReadable(m *refs.ObjectID) string {
+	if m == nil {
+		return ""
+	}
+
+	var id oid.ID
+	if id.ReadFromV2(*m) != nil {
+		return ""
+	}
+
+	return id.EncodeToString()
+}
+
+func (o *Object) headerFromReadable(src readableHeader) error {
+	if src.Version != "" {
+		var major, minor uint32
+		if _, err := fmt.Sscanf(src.Version, "v%d.%d", &major, &minor); err != nil {
+			return fmt.Errorf("invalid version %q: %w", src.Version, err)
+		}
+
+		var ver version.Version
+		ver.SetMajor(major)
+		ver.SetMinor(minor)
+
+		if ver.String() != src.Version {
+			return fmt.Errorf("invalid version %q", src.Version)
+		}
+
+		o.SetVersion(&ver)
+	}
+
+	if src.Container != "" {
+		var cnr cid.ID
+		if err := cnr.DecodeString(src.Container); err != nil {
+			return fmt.Errorf("invalid container: %w", err)
+		}
+
+		o.SetContainerID(cnr)
+	}
+
+	if src.Owner != "" {
+		var owner user.ID
+		if err := owner.DecodeString(src.Owner); err != nil {
+			return fmt.Errorf("invalid owner: %w", err)
+		}
+
+		o.SetOwnerID(&owner)
+	}
+
+	o.SetCreationEpoch(src.CreationEpoch)
+	o.SetPayloadSize(src.PayloadSize)
+
+	if src.PayloadChecksum != "" {
+		cs, err := checksumFromReadable(src.PayloadChecksum)
+		if err != nil {
+			return fmt.Errorf("invalid payload checksum: %w", err)
+		}
+
+		o.SetPayloadChecksum(cs)
+	}
+
+	if src.HomomorphicChecksum != "" {
+		cs, err := checksumFromReadable(src.HomomorphicChecksum)
+		if err != nil {
+			return fmt.Errorf("invalid homomorphic checksum: %w", err)
+		}
+
+		o.SetPayloadHomomorphicHash(cs)
+	}
+
+	var typ Type
+	if !typ.DecodeString(src.Type) {
+		return fmt.Errorf("invalid type %q", src.Type)
+	}
+
+	o.SetType(typ)
+
+	if len(src.Attributes) > 0 {
+		attrs := make([]Attribute, len(src.Attributes))
+		for i := range src.Attributes {
+			if len(src.Attributes[i]) != 1 {
+				return fmt.Errorf("invalid attribute #%d: %d entries instead of 1", i, len(src.Attributes[i]))
+			}
+
+			for k, v := range src.Attributes[i] {
+				attrs[i].SetKey(k)
+				attrs[i].SetValue(v)
+			}
+		}
+
+		o.SetAttributes(attrs...)
+	}
+
+	if src.SessionToken != "" {
+		b, err := hex.DecodeString(src.SessionToken)
+		if err != nil {
+			return fmt.Errorf("invalid session token: %w", err)
+		}
+
+		var tok session.Object
+		if err = tok.Unmarshal(b); err != nil {
+			return fmt.Errorf("invalid session token: %w", err)
+		}
+
+		o.SetSessionToken(&tok)
+	}
+
+	if src.Split != nil {
+		if err := o.splitFromReadable(*src.Split); err != nil {
+			return fmt.Errorf("invalid split header: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (o *Object) splitFromReadable(src readableSplit) error {
+	o.InitRelations()
+
+	if src.SplitID != "" {
+		var splitID SplitID
+		if err := splitID.Parse(src.SplitID); err != nil {
+			return fmt.Errorf("invalid split ID: %w", err)
+		}
+
+		o.SetSplitID(&splitID)
+	}
+
+	if src.Previous != "" {
+		var id oid.ID
+		if err := id.DecodeString(src.Previous); err != nil {
+			return fmt.Errorf("invalid previous: %w", err)
+		}
+
+		o.SetPreviousID(id)
+	}
+
+	if len(src.Children) > 0 {
+		children := make([]oid.ID, len(src.Children))
+		for i := range src.Children {
+			if err := children[i].DecodeString(src.Children[i]); err != nil {
+				return fmt.Errorf("invalid child #%d: %w", i, err)
+			}
+		}
+
+		o.SetChildren(children...)
+	}
+
+	if src.ParentSignature != nil || src.ParentHeader != nil {
+		par := New()
+
+		if src.ParentSignature != nil {
+			sig, err := signatureFromReadable(*src.ParentSignature)
+			if err != nil {
+				return fmt.Errorf("invalid parent signature: %w", err)
+			}
+
+			par.SetSignature(&sig)
+		}
+
+		if src.ParentHeader != nil {
+			if err := par.headerFromReadable(*src.ParentHeader); err != nil {
+				return fmt.Errorf("invalid parent header: %w", err)
+			}
+		}
+
+		o.SetParent(par)
+	}
+
+	if src.ParentID != "" {
+		var id oid.ID
+		if err := id.DecodeString(src.ParentID); err != nil {
+			return fmt.Errorf("invalid parent ID: %w", err)
+		}
+
+		o.SetParentID(id)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func readableObject(t *testing.T) *object.Object {
+	obj := objecttest.Object(t)
+	require.NoError(t, obj.Parent().SetIDWithSignature(test.RandomSigner(t)))
+	require.NoError(t, obj.SetIDWithSignature(test.RandomSignerRFC6979(t)))
+
+	return obj
+}
+
+func requireEqualObjects(t testing.TB, exp, act object.Object) {
+	bExp, err := exp.Marshal()
+	require.NoError(t, err)
+	bAct, err := act.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, bExp, bAct)
+}
+
+func TestObject_YAML(t *testing.T) {
+	obj := readableObject(t)
+
+	data, err := yaml.Marshal(obj)
+	require.NoError(t, err)
+
+	var res object.Object
+	require.NoError(t, yaml.Unmarshal(data, &res))
+	requireEqualObjects(t, *obj, res)
+
+	t.Run("human-written", func(t *testing.T) {
+		const src = `
+header:
+  version: v2.14
+  container: %s
+  owner: %s
+  creationEpoch: 13
+  payloadSize: 3
+  payloadChecksum: SHA256:039058c6f2c0cb492c533b0a4d14ef77cc0f78abccced5287d84a1a2011cfb81
+  type: REGULAR
+  attributes:
+    - FileName: cat.jpg
+    - Timestamp: "1700000000"
+payload: "010203"
+`
+		cnr := cidtest.ID()
+		owner := usertest.ID(t)
+
+		var res object.Object
+		require.NoError(t, yaml.Unmarshal([]byte(fmt.Sprintf(src, cnr, owner)), &res))
+
+		ver := res.Version()
+		require.NotNil(t, ver)
+		require.EqualValues(t, 2, ver.Major())
+		require.EqualValues(t, 14, ver.Minor())
+		resCnr, ok := res.ContainerID()
+		require.True(t, ok)
+		require.Equal(t, cnr, resCnr)
+		require.Equal(t, owner, res.OwnerID())
+		require.EqualValues(t, 13, res.CreationEpoch())
+		require.EqualValues(t, 3, res.PayloadSize())
+		require.Equal(t, object.TypeRegular, res.Type())
+		require.Equal(t, []byte{1, 2, 3}, res.Payload())
+		require.NoError(t, res.VerifyPayloadChecksum())
+
+		attrs := res.Attributes()
+		require.Len(t, attrs, 2)
+		require.Equal(t, "FileName", attrs[0].Key())
+		require.Equal(t, "cat.jpg", attrs[0].Value())
+		require.Equal(t, "Timestamp", attrs[1].Key())
+		require.Equal(t, "1700000000", attrs[1].Value())
+
+		_, ok = res.ID()
+		require.False(t, ok)
+		require.Nil(t, res.Signature())
+		require.Nil(t, res.Parent())
+	})
+
+	t.Run("header only", func(t *testing.T) {
+		data, err := yaml.Marshal(obj.CutPayload())
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "payload:")
+
+		var res object.Object
+		require.NoError(t, yaml.Unmarshal(data, &res))
+		require.Empty(t, res.Payload())
+		requireEqualObjects(t, *obj.CutPayload(), res)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct{ name, src string }{
+			{"ID", "id: abc"},
+			{"signature scheme", "signature: {scheme: RSA, publicKey: ab, value: cd}"},
+			{"signature key", "signature: {scheme: ECDSA_SHA512, publicKey: xyz, value: cd}"},
+			{"version", "header: {version: 2.14, type: REGULAR}"},
+			{"container", "header: {container: abc, type: REGULAR}"},
+			{"owner", "header: {owner: 0OIl, type: REGULAR}"},
+			{"type", "header: {type: HUGE}"},
+			{"checksum type", "header: {type: REGULAR, payloadChecksum: 'MD5:ab'}"},
+			{"checksum prefix", "header: {type: REGULAR, payloadChecksum: ab}"},
+			{"checksum value", "header: {type: REGULAR, homomorphicChecksum: 'TZ:xyz'}"},
+			{"attribute", "header: {type: REGULAR, attributes: [{k1: v1, k2: v2}]}"},
+			{"session token", "header: {type: REGULAR, sessionToken: ab}"},
+			{"split ID", "header: {type: REGULAR, split: {splitID: abc}}"},
+			{"child", "header: {type: REGULAR, split: {children: [abc]}}"},
+			{"parent header", "header: {type: REGULAR, split: {parentHeader: {type: HUGE}}}"},
+			{"payload", "payload: xyz"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				var res object.Object
+				require.Error(t, yaml.Unmarshal([]byte(tc.src), &res))
+			})
+		}
+	})
+}
+
+func TestObject_ReadableJSON(t *testing.T) {
+	obj := readableObject(t)
+
+	data, err := obj.MarshalReadableJSON()
+	require.NoError(t, err)
+
+	var res object.Object
+	require.NoError(t, res.UnmarshalReadableJSON(data))
+	requireEqualObjects(t, *obj, res)
+
+	id, _ := obj.ID()
+	require.Contains(t, string(data), `"id": "`+id.EncodeToString()+`"`)
+	require.Contains(t, string(data), `"version": "`+version.Current().String()+`"`)
+
+	require.Error(t, res.UnmarshalReadableJSON([]byte(`{"header":{"type":"HUGE"}}`)))
+}
@@ -0,0 +1,189 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// PayloadRangeSource provides ranges of the stored object payload, e.g. by
+// the object range requests of the NeoFS client.
+type PayloadRangeSource interface {
+	// ReadPayloadRange returns stream of the payload range of the given non-zero
+	// length starting from the given offset.
+	ReadPayloadRange(ctx context.Context, offset, length uint64) (io.ReadCloser, error)
+}
+
+// Default block cache parameters of the ReaderAt.
+const (
+	// DefaultReaderAtBlockSize is a default size of the ReaderAt block.
+	DefaultReaderAtBlockSize = 64 << 10
+
+	// DefaultReaderAtCacheBlocks is a default number of blocks cached by the
+	// ReaderAt.
+	DefaultReaderAtCacheBlocks = 16
+)
+
+// ReaderAt provides random access to the stored object payload by reading
+// its ranges. Payload is read in blocks of fixed size, the least recently used
+// blocks are cached, so small reads nearby are served by single range request.
+// ReaderAt implements io.ReaderAt, io.Reader and io.Seeker allowing to process
+// stored objects by standard archive/zip and similar readers directly.
+//
+// ReaderAt must be constructed via NewReaderAt. ReadAt is safe for concurrent
+// use, Read and Seek share the offset.
+type ReaderAt struct {
+	ctx context.Context
+
+	src PayloadRangeSource
+
+	size uint64
+
+	blockSize uint64
+
+	// nil if caching is disabled
+	cache *lru.Cache
+
+	mtx sync.Mutex
+
+	offset int64
+}
+
+// NewReaderAt constructs ReaderAt of the stored object payload of the given
+// size (see Object.PayloadSize) provided by src. The context is used for all
+// range requests. Block cache parameters are DefaultReaderAtBlockSize and
+// DefaultReaderAtCacheBlocks, see SetBlockCache to change them.
+func NewReaderAt(ctx context.Context, src PayloadRangeSource, size uint64) *ReaderAt {
+	res := &ReaderAt{
+		ctx:  ctx,
+		src:  src,
+		size: size,
+	}
+
+	res.SetBlockCache(DefaultReaderAtBlockSize, DefaultReaderAtCacheBlocks)
+
+	return res
+}
+
+// SetBlockCache sets size of the block requested at once and maximum number of
+// the cached blocks. Zero block size means DefaultReaderAtBlockSize,
+// non-positive number of blocks disables caching. Cached blocks are
+// discarded.
+//
+// SetBlockCache MUST NOT be called concurrently with reads.
+func (x *ReaderAt) SetBlockCache(blockSize uint64, blocks int) {
+	if blockSize == 0 {
+		blockSize = DefaultReaderAtBlockSize
+	}
+
+	x.blockSize = blockSize
+	x.cache = nil
+
+	if blocks > 0 {
+		x.cache, _ = lru.New(blocks) // error is returned for non-positive size only
+	}
+}
+
+// Size returns size of the payload.
+func (x *ReaderAt) Size() int64 {
+	return int64(x.size)
+}
+
+// ReadAt implements io.ReaderAt.
+func (x *ReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+
+	if uint64(off) >= x.size {
+		return 0, io.EOF
+	}
+
+	var n int
+
+	for n < len(p) && uint64(off) < x.size {
+		block, err := x.block(uint64(off) / x.blockSize)
+		if err != nil {
+			return n, err
+		}
+
+		c := copy(p[n:], block[uint64(off)%x.blockSize:])
+		n += c
+		off += int64(c)
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+// block returns payload block with the given index.
+func (x *ReaderAt) block(i uint64) ([]byte, error) {
+	if x.cache != nil {
+		if b, ok := x.cache.Get(i); ok {
+			return b.([]byte), nil
+		}
+	}
+
+	off := i * x.blockSize
+	ln := x.blockSize
+	if rest := x.size - off; rest < ln {
+		ln = rest
+	}
+
+	b, err := readPayloadRange(x.ctx, x.src, off, ln)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.cache != nil {
+		x.cache.Add(i, b)
+	}
+
+	return b, nil
+}
+
+// Read implements io.Reader.
+func (x *ReaderAt) Read(p []byte) (int, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	n, err := x.ReadAt(p, x.offset)
+	x.offset += int64(n)
+
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+
+	return n, err
+}
+
+// Seek implements io.Seeker.
+func (x *ReaderAt) Seek(offset int64, whence int) (int64, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	switch whence {
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += x.offset
+	case io.SeekEnd:
+		offset += int64(x.size)
+	}
+
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	x.offset = offset
+
+	return offset, nil
+}
@@ -0,0 +1,121 @@
+package object_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRangeSource struct {
+	payload  []byte
+	requests int
+	err      error
+}
+
+func (x *memoryRangeSource) ReadPayloadRange(_ context.Context, offset, length uint64) (io.ReadCloser, error) {
+	x.requests++
+
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	return io.NopCloser(bytes.NewReader(x.payload[offset : offset+length])), nil
+}
+
+func TestReaderAt(t *testing.T) {
+	payload := make([]byte, 1000)
+	rand.Read(payload)
+
+	for _, tc := range []struct {
+		name      string
+		blockSize uint64
+		blocks    int
+	}{
+		{name: "default"},
+		{name: "small blocks", blockSize: 33, blocks: 3},
+		{name: "no cache", blockSize: 100},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := object.NewReaderAt(context.Background(), &memoryRangeSource{payload: payload}, uint64(len(payload)))
+			if tc.blockSize > 0 {
+				r.SetBlockCache(tc.blockSize, tc.blocks)
+			}
+
+			require.EqualValues(t, len(payload), r.Size())
+			require.NoError(t, iotest.TestReader(r, payload))
+		})
+	}
+
+	t.Run("cache", func(t *testing.T) {
+		src := &memoryRangeSource{payload: payload}
+		r := object.NewReaderAt(context.Background(), src, uint64(len(payload)))
+		r.SetBlockCache(100, 2)
+
+		b := make([]byte, 10)
+		for _, off := range []int64{0, 50, 90, 110, 5} {
+			_, err := r.ReadAt(b, off)
+			require.NoError(t, err)
+			require.Equal(t, payload[off:off+10], b)
+		}
+		require.Equal(t, 2, src.requests)
+
+		// evicts the least recently used 2nd block
+		_, err := r.ReadAt(b, 250)
+		require.NoError(t, err)
+		_, err = r.ReadAt(b, 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, src.requests)
+		_, err = r.ReadAt(b, 110)
+		require.NoError(t, err)
+		require.Equal(t, 4, src.requests)
+
+		n, err := r.ReadAt(b, 995)
+		require.ErrorIs(t, err, io.EOF)
+		require.Equal(t, 5, n)
+
+		_, err = r.ReadAt(b, -1)
+		require.Error(t, err)
+	})
+
+	t.Run("source failure", func(t *testing.T) {
+		errSrc := errors.New("any error")
+		r := object.NewReaderAt(context.Background(), &memoryRangeSource{payload: payload, err: errSrc}, uint64(len(payload)))
+
+		_, err := r.ReadAt(make([]byte, 10), 0)
+		require.ErrorIs(t, err, errSrc)
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		zw := zip.NewWriter(&buf)
+		for _, name := range []string{"a", "b", "c"} {
+			w, err := zw.Create(name)
+			require.NoError(t, err)
+			_, err = w.Write(append(payload, name...))
+			require.NoError(t, err)
+		}
+		require.NoError(t, zw.Close())
+
+		archive := buf.Bytes()
+		r := object.NewReaderAt(context.Background(), &memoryRangeSource{payload: archive}, uint64(len(archive)))
+
+		zr, err := zip.NewReader(r, r.Size())
+		require.NoError(t, err)
+		require.Len(t, zr.File, 3)
+
+		f, err := zr.File[1].Open()
+		require.NoError(t, err)
+		b, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, append(payload, "b"...), b)
+	})
+}
@@ -3,5 +3,18 @@ Package relations provides feature to process inner object structure.
 
 Relations is an interface of entity that can receive object header or
 the information about the object relations.
+
+Discover collects the whole graph of the objects related to the given one:
+split-chain parts, linking object, parent, as well as lock objects and
+tombstones referencing them. This is useful for deletion, audit and repair
+tools:
+
+	src := relations.NewClientSource(c, signer, relations.Tokens{})
+	g, err := relations.Discover(ctx, src, addr)
+	// ...
+	phy := g.Physical() // all physically stored objects
+	tombs := g.Tombstones()
+
+NewPoolSource provides the same via NeoFS connection pool.
 */
 package relations
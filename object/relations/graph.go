@@ -0,0 +1,516 @@
+package relations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Source provides physically stored objects to Discover.
+type Source interface {
+	// Head returns header of the physically stored object. Returns
+	// *object.SplitInfoError for objects sliced into split-chains.
+	Head(ctx context.Context, addr oid.Address) (object.Object, error)
+
+	// Get returns header and payload stream of the physically stored object.
+	Get(ctx context.Context, addr oid.Address) (object.Object, io.ReadCloser, error)
+
+	// Search returns IDs of the objects from the container matching the
+	// filters.
+	Search(ctx context.Context, cnr cid.ID, fs object.SearchFilters) ([]oid.ID, error)
+}
+
+// Client describes methods of the NeoFS client required by NewClientSource.
+// Implemented by *client.Client.
+type Client interface {
+	ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer neofscrypto.Signer, prm client.PrmObjectHead) (*client.ResObjectHead, error)
+	ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer neofscrypto.Signer, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error)
+	SearchExecutor
+}
+
+// GetExecutor describes methods to get object.
+type GetExecutor interface {
+	ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error)
+}
+
+// PoolExecutor describes methods of the NeoFS connection pool required by
+// NewPoolSource. Implemented by *pool.Pool.
+type PoolExecutor interface {
+	Executor
+	GetExecutor
+}
+
+type sourceFuncs struct {
+	head   func(ctx context.Context, cnr cid.ID, obj oid.ID, prm client.PrmObjectHead) (*client.ResObjectHead, error)
+	get    func(ctx context.Context, cnr cid.ID, obj oid.ID, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error)
+	search func(ctx context.Context, cnr cid.ID, prm client.PrmObjectSearch) (*client.ObjectListReader, error)
+
+	tokens Tokens
+}
+
+// NewClientSource returns Source reading objects via NeoFS client on behalf
+// of the given signer with optional tokens.
+func NewClientSource(c Client, signer user.Signer, tokens Tokens) Source {
+	return &sourceFuncs{
+		head: func(ctx context.Context, cnr cid.ID, obj oid.ID, prm client.PrmObjectHead) (*client.ResObjectHead, error) {
+			return c.ObjectHead(ctx, cnr, obj, signer, prm)
+		},
+		get: func(ctx context.Context, cnr cid.ID, obj oid.ID, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error) {
+			return c.ObjectGetInit(ctx, cnr, obj, signer, prm)
+		},
+		search: func(ctx context.Context, cnr cid.ID, prm client.PrmObjectSearch) (*client.ObjectListReader, error) {
+			return c.ObjectSearchInit(ctx, cnr, signer, prm)
+		},
+		tokens: tokens,
+	}
+}
+
+// NewPoolSource returns Source reading objects via NeoFS connection pool on
+// behalf of the given signer with optional tokens.
+func NewPoolSource(p PoolExecutor, signer user.Signer, tokens Tokens) Source {
+	return &sourceFuncs{
+		head: func(ctx context.Context, cnr cid.ID, obj oid.ID, prm client.PrmObjectHead) (*client.ResObjectHead, error) {
+			return p.ObjectHead(ctx, cnr, obj, signer, prm)
+		},
+		get: func(ctx context.Context, cnr cid.ID, obj oid.ID, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error) {
+			return p.ObjectGetInit(ctx, cnr, obj, signer, prm)
+		},
+		search: func(ctx context.Context, cnr cid.ID, prm client.PrmObjectSearch) (*client.ObjectListReader, error) {
+			return p.ObjectSearchInit(ctx, cnr, signer, prm)
+		},
+		tokens: tokens,
+	}
+}
+
+func (x *sourceFuncs) Head(ctx context.Context, addr oid.Address) (object.Object, error) {
+	var prm client.PrmObjectHead
+	prm.MarkRaw()
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	var hdr object.Object
+
+	res, err := x.head(ctx, addr.Container(), addr.Object(), prm)
+	if err != nil {
+		return hdr, err
+	}
+
+	if !res.ReadHeader(&hdr) {
+		return hdr, errors.New("missing header in response")
+	}
+
+	return hdr, nil
+}
+
+func (x *sourceFuncs) Get(ctx context.Context, addr oid.Address) (object.Object, io.ReadCloser, error) {
+	var prm client.PrmObjectGet
+	prm.MarkRaw()
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	hdr, r, err := x.get(ctx, addr.Container(), addr.Object(), prm)
+	if err != nil {
+		return hdr, nil, err
+	}
+
+	return hdr, r, nil
+}
+
+func (x *sourceFuncs) Search(ctx context.Context, cnr cid.ID, fs object.SearchFilters) ([]oid.ID, error) {
+	var prm client.PrmObjectSearch
+	prm.SetFilters(fs)
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	r, err := x.search(ctx, cnr, prm)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	var res []oid.ID
+	err = r.Iterate(func(id oid.ID) bool {
+		res = append(res, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate: %w", err)
+	}
+
+	return res, nil
+}
+
+// Graph describes all objects related to the one requested from Discover.
+type Graph struct {
+	addr oid.Address
+
+	parent *oid.ID
+
+	parts []oid.ID
+
+	link *oid.ID
+
+	locks, tombstones []oid.ID
+}
+
+// Address returns address of the requested object.
+func (x Graph) Address() oid.Address {
+	return x.addr
+}
+
+// Parent returns ID of the root object sliced into split-chain. The second
+// return value indicates whether the requested object is the root or its part.
+// If so, the requested object may be any of the parent, parts or the
+// linking object.
+func (x Graph) Parent() (oid.ID, bool) {
+	if x.parent == nil {
+		return oid.ID{}, false
+	}
+
+	return *x.parent, true
+}
+
+// Parts returns IDs of the physically stored objects carrying the payload in
+// order. For objects which are not sliced, Parts returns ID of the requested
+// object only.
+func (x Graph) Parts() []oid.ID {
+	return x.parts
+}
+
+// Link returns ID of the linking object of the split-chain. The second return
+// value indicates whether the linking object exists.
+func (x Graph) Link() (oid.ID, bool) {
+	if x.link == nil {
+		return oid.ID{}, false
+	}
+
+	return *x.link, true
+}
+
+// Locks returns IDs of the lock objects protecting the parent or any of the
+// physically stored objects from deletion including expired ones.
+func (x Graph) Locks() []oid.ID {
+	return x.locks
+}
+
+// Tombstones returns IDs of the tombstones deleting the parent or any of the
+// physically stored objects including expired ones.
+func (x Graph) Tombstones() []oid.ID {
+	return x.tombstones
+}
+
+// Physical returns set of the addresses of all physically stored objects
+// forming the requested one: parts and the linking object if any. Relatives
+// are not included.
+func (x Graph) Physical() *oid.AddressSet {
+	res := new(oid.AddressSet)
+	var addr oid.Address
+	addr.SetContainer(x.addr.Container())
+
+	for i := range x.parts {
+		addr.SetObject(x.parts[i])
+		res.Add(addr)
+	}
+
+	if x.link != nil {
+		addr.SetObject(*x.link)
+		res.Add(addr)
+	}
+
+	return res
+}
+
+// Discover finds all objects related to the referenced one: split-chain
+// parts, linking object and the parent, if the object is sliced, as well as
+// lock objects and tombstones referencing any of them. Relatives are searched
+// in the container of the object.
+//
+// The first part of the split-chain may carry no split header, in this case
+// it is processed as a regular object.
+func Discover(ctx context.Context, src Source, addr oid.Address) (Graph, error) {
+	res := Graph{addr: addr}
+
+	chain, err := collectSplitChain(ctx, src, addr)
+	if err != nil {
+		return res, err
+	}
+
+	if chain == nil {
+		res.parts = []oid.ID{addr.Object()}
+	} else {
+		if res.parts, err = chain.Parts(); err != nil {
+			return res, err
+		}
+
+		if link, ok := chain.Link(); ok {
+			res.link = &link
+		}
+
+		if parent, ok := chain.ParentID(); ok {
+			res.parent = &parent
+		} else if !isElement(res, addr.Object()) {
+			// split info of the root may be enough to collect the chain
+			parent = addr.Object()
+			res.parent = &parent
+		}
+	}
+
+	members := make(map[oid.ID]struct{}, len(res.parts)+2)
+	for i := range res.parts {
+		members[res.parts[i]] = struct{}{}
+	}
+
+	if res.parent != nil {
+		members[*res.parent] = struct{}{}
+	}
+
+	if res.link != nil {
+		members[*res.link] = struct{}{}
+	}
+
+	if res.locks, err = findRelatives(ctx, src, addr.Container(), object.TypeLock, members); err != nil {
+		return res, fmt.Errorf("find locks: %w", err)
+	}
+
+	if res.tombstones, err = findRelatives(ctx, src, addr.Container(), object.TypeTombstone, members); err != nil {
+		return res, fmt.Errorf("find tombstones: %w", err)
+	}
+
+	return res, nil
+}
+
+// isElement checks whether the object is a part or linking object of the
+// Graph.
+func isElement(g Graph, id oid.ID) bool {
+	if g.link != nil && *g.link == id {
+		return true
+	}
+
+	for i := range g.parts {
+		if g.parts[i] == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// collectSplitChain returns split-chain of the referenced object. Returns nil
+// if the object is not sliced.
+func collectSplitChain(ctx context.Context, src Source, addr oid.Address) (*object.SplitChain, error) {
+	chain := object.NewSplitChain()
+
+	hdr, err := src.Head(ctx, addr)
+	if err != nil {
+		var errSplit *object.SplitInfoError
+		if !errors.As(err, &errSplit) {
+			return nil, fmt.Errorf("head object: %w", err)
+		}
+
+		if err = chain.AddSplitInfo(*errSplit.SplitInfo()); err != nil {
+			return nil, fmt.Errorf("invalid split info: %w", err)
+		}
+	} else {
+		if !hdr.HasParent() {
+			return nil, nil
+		}
+
+		if err = addChainElement(chain, addr, hdr); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = headMissing(ctx, src, chain, addr.Container()); err != nil {
+		return nil, err
+	}
+
+	if parent, ok := chain.ParentID(); ok && !splitChainCollected(chain) && parent != addr.Object() {
+		var parAddr oid.Address
+		parAddr.SetContainer(addr.Container())
+		parAddr.SetObject(parent)
+
+		_, err = src.Head(ctx, parAddr)
+
+		var errSplit *object.SplitInfoError
+		if errors.As(err, &errSplit) {
+			if err = chain.AddSplitInfo(*errSplit.SplitInfo()); err != nil {
+				return nil, fmt.Errorf("invalid split info of the parent: %w", err)
+			}
+		} else if err != nil {
+			return nil, fmt.Errorf("head parent object: %w", err)
+		}
+
+		if err = headMissing(ctx, src, chain, addr.Container()); err != nil {
+			return nil, err
+		}
+	}
+
+	if splitID := chain.SplitID(); splitID != nil && !splitChainCollected(chain) {
+		var fs object.SearchFilters
+		fs.AddSplitIDFilter(object.MatchStringEqual, splitID)
+
+		ids, err := src.Search(ctx, addr.Container(), fs)
+		if err != nil {
+			return nil, fmt.Errorf("search split-chain elements: %w", err)
+		}
+
+		var elemAddr oid.Address
+		elemAddr.SetContainer(addr.Container())
+
+		for i := range ids {
+			if _, ok := chain.Header(ids[i]); ok {
+				continue
+			}
+
+			elemAddr.SetObject(ids[i])
+
+			hdr, err := src.Head(ctx, elemAddr)
+			if err != nil {
+				return nil, fmt.Errorf("head split-chain element %s: %w", ids[i], err)
+			}
+
+			if err = addChainElement(chain, elemAddr, hdr); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = headMissing(ctx, src, chain, addr.Container()); err != nil {
+			return nil, err
+		}
+	}
+
+	return chain, nil
+}
+
+// splitChainCollected checks whether all parts, their parent and the linking
+// object are collected.
+func splitChainCollected(chain *object.SplitChain) bool {
+	_, ok := chain.Link()
+	return ok && chain.Complete()
+}
+
+// headMissing adds headers of all split-chain elements referenced by the
+// added data but not added yet.
+func headMissing(ctx context.Context, src Source, chain *object.SplitChain, cnr cid.ID) error {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+
+	for missing := chain.Missing(); len(missing) > 0; missing = chain.Missing() {
+		for i := range missing {
+			addr.SetObject(missing[i])
+
+			hdr, err := src.Head(ctx, addr)
+			if err != nil {
+				return fmt.Errorf("head split-chain element %s: %w", missing[i], err)
+			}
+
+			if err = addChainElement(chain, addr, hdr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// addChainElement adds the split-chain element to the chain.
+func addChainElement(chain *object.SplitChain, addr oid.Address, hdr object.Object) error {
+	if err := chain.Add(addr.Object(), hdr); err != nil {
+		return fmt.Errorf("invalid split-chain element: %w", err)
+	}
+
+	return nil
+}
+
+// findRelatives returns IDs of the objects of the given type (lock or
+// tombstone) in the container listing any of the members.
+func findRelatives(ctx context.Context, src Source, cnr cid.ID, typ object.Type, members map[oid.ID]struct{}) ([]oid.ID, error) {
+	var fs object.SearchFilters
+	fs.AddTypeFilter(object.MatchStringEqual, typ)
+
+	ids, err := src.Search(ctx, cnr, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []oid.ID
+	var addr oid.Address
+	addr.SetContainer(cnr)
+
+	for i := range ids {
+		addr.SetObject(ids[i])
+
+		payload, err := readPayload(ctx, src, addr)
+		if err != nil {
+			return nil, fmt.Errorf("object %s: %w", ids[i], err)
+		}
+
+		var list []oid.ID
+
+		switch typ {
+		case object.TypeLock:
+			var l object.Lock
+			if err = l.Unmarshal(payload); err != nil {
+				return nil, fmt.Errorf("object %s: decode lock: %w", ids[i], err)
+			}
+
+			list = make([]oid.ID, l.NumberOfMembers())
+			l.ReadMembers(list)
+		case object.TypeTombstone:
+			t := object.NewTombstone()
+			if err = t.Unmarshal(payload); err != nil {
+				return nil, fmt.Errorf("object %s: decode tombstone: %w", ids[i], err)
+			}
+
+			list = t.Members()
+		}
+
+		for j := range list {
+			if _, ok := members[list[j]]; ok {
+				res = append(res, ids[i])
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func readPayload(ctx context.Context, src Source, addr oid.Address) ([]byte, error) {
+	_, r, err := src.Get(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", err)
+	}
+
+	payload, err := io.ReadAll(r)
+	if err == nil {
+		err = r.Close()
+	} else {
+		_ = r.Close()
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read payload: %w", err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,321 @@
+package relations_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/pool"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ relations.Client       = (*client.Client)(nil)
+	_ relations.PoolExecutor = (*pool.Pool)(nil)
+)
+
+// storage is an in-memory relations.Source filled by slicer.
+type storage struct {
+	objects map[oid.ID]object.Object
+	// latest split info by root ID
+	splitInfo map[oid.ID]*object.SplitInfo
+	hideLink  bool
+	err       error
+}
+
+func newStorage() *storage {
+	return &storage{
+		objects:   make(map[oid.ID]object.Object),
+		splitInfo: make(map[oid.ID]*object.SplitInfo),
+	}
+}
+
+type objectWriter struct {
+	s   *storage
+	hdr object.Object
+	buf bytes.Buffer
+}
+
+func (x *objectWriter) Write(p []byte) (int, error) { return x.buf.Write(p) }
+
+func (x *objectWriter) Close() error {
+	x.hdr.SetPayload(x.buf.Bytes())
+	x.s.store(x.hdr)
+	return nil
+}
+
+func (x *objectWriter) GetResult() client.ResObjectPut { return client.ResObjectPut{} }
+
+func (x *storage) ObjectPutInit(_ context.Context, hdr object.Object, _ user.Signer, _ client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	// slicer reuses headers, so they are copied
+	b, err := hdr.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var cp object.Object
+	if err = cp.Unmarshal(b); err != nil {
+		return nil, err
+	}
+
+	return &objectWriter{s: x, hdr: cp}, nil
+}
+
+func (x *storage) store(obj object.Object) {
+	id, _ := obj.ID()
+	x.objects[id] = obj
+
+	if par := obj.Parent(); par != nil {
+		parID, _ := par.ID()
+		si, ok := x.splitInfo[parID]
+		if !ok {
+			si = object.NewSplitInfo()
+			x.splitInfo[parID] = si
+		}
+
+		if len(obj.Children()) > 0 {
+			si.SetLink(id)
+		} else {
+			si.SetLastPart(id)
+		}
+	}
+}
+
+func (x *storage) get(addr oid.Address) (object.Object, error) {
+	if x.err != nil {
+		return object.Object{}, x.err
+	}
+
+	if si, ok := x.splitInfo[addr.Object()]; ok {
+		if x.hideLink {
+			last, _ := si.LastPart()
+			si = object.NewSplitInfo()
+			si.SetLastPart(last)
+		}
+		return object.Object{}, object.NewSplitInfoError(si)
+	}
+
+	obj, ok := x.objects[addr.Object()]
+	if !ok {
+		return object.Object{}, apistatus.ErrObjectNotFound
+	}
+
+	return obj, nil
+}
+
+func (x *storage) Head(_ context.Context, addr oid.Address) (object.Object, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return obj, err
+	}
+
+	return *obj.CutPayload(), nil
+}
+
+func (x *storage) Get(_ context.Context, addr oid.Address) (object.Object, io.ReadCloser, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return obj, nil, err
+	}
+
+	return *obj.CutPayload(), io.NopCloser(bytes.NewReader(obj.Payload())), nil
+}
+
+func (x *storage) Search(_ context.Context, _ cid.ID, fs object.SearchFilters) ([]oid.ID, error) {
+	var res []oid.ID
+
+	for id, obj := range x.objects {
+		match := true
+
+		for i := range fs {
+			var v string
+
+			switch fs[i].Header() {
+			case v2object.FilterHeaderObjectType:
+				v = obj.Type().EncodeToString()
+			case v2object.FilterHeaderSplitID:
+				v = obj.SplitID().String()
+			default:
+				return nil, errors.New("unsupported filter")
+			}
+
+			if v != fs[i].Value() {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			res = append(res, id)
+		}
+	}
+
+	return res, nil
+}
+
+func put(t testing.TB, s *storage, cnr cid.ID, size int) oid.ID {
+	signer := test.RandomSignerRFC6979(t)
+	owner := signer.UserID()
+
+	var hdr object.Object
+	hdr.SetContainerID(cnr)
+	hdr.SetOwnerID(&owner)
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(100)
+
+	payload := make([]byte, size)
+	_, err := rand.Read(payload)
+	require.NoError(t, err)
+
+	id, err := slicer.Put(context.Background(), s, hdr, signer, bytes.NewReader(payload), opts)
+	require.NoError(t, err)
+
+	return id
+}
+
+func putRelative(t testing.TB, s *storage, typ object.Type, members ...oid.Address) oid.ID {
+	var obj *object.Object
+	var err error
+
+	owner := test.RandomSignerRFC6979(t).UserID()
+
+	if typ == object.TypeLock {
+		obj, err = object.NewLockObject(members, 0, owner, nil)
+	} else {
+		obj, err = object.NewTombstoneObject(members, 100, owner, nil)
+	}
+	require.NoError(t, err)
+	require.NoError(t, obj.CalculateAndSetID())
+
+	s.store(*obj)
+
+	id, _ := obj.ID()
+	return id
+}
+
+func TestDiscover(t *testing.T) {
+	ctx := context.Background()
+	cnr := cidtest.ID()
+
+	address := func(id oid.ID) oid.Address {
+		var res oid.Address
+		res.SetContainer(cnr)
+		res.SetObject(id)
+		return res
+	}
+
+	t.Run("regular", func(t *testing.T) {
+		s := newStorage()
+		id := put(t, s, cnr, 50)
+		other := put(t, s, cnr, 50)
+
+		lock := putRelative(t, s, object.TypeLock, address(id))
+		tomb := putRelative(t, s, object.TypeTombstone, address(other), address(id))
+		putRelative(t, s, object.TypeLock, address(other))
+
+		g, err := relations.Discover(ctx, s, address(id))
+		require.NoError(t, err)
+		require.Equal(t, address(id), g.Address())
+		require.Equal(t, []oid.ID{id}, g.Parts())
+		_, ok := g.Parent()
+		require.False(t, ok)
+		_, ok = g.Link()
+		require.False(t, ok)
+		require.Equal(t, []oid.ID{lock}, g.Locks())
+		require.Equal(t, []oid.ID{tomb}, g.Tombstones())
+		require.Equal(t, []oid.Address{address(id)}, g.Physical().Addresses())
+	})
+
+	s := newStorage()
+	root := put(t, s, cnr, 350)
+
+	si := s.splitInfo[root]
+	link, _ := si.Link()
+	last, _ := si.LastPart()
+	linkObj := s.objects[link]
+	parts := linkObj.Children()
+	require.Len(t, parts, 4)
+	require.Equal(t, last, parts[3])
+
+	lock := putRelative(t, s, object.TypeLock, address(parts[1]))
+	tomb := putRelative(t, s, object.TypeTombstone, address(root))
+	putRelative(t, s, object.TypeTombstone, address(oidtest.ID()))
+
+	check := func(t *testing.T, g relations.Graph) {
+		require.Equal(t, parts, g.Parts())
+		par, ok := g.Parent()
+		require.True(t, ok)
+		require.Equal(t, root, par)
+		l, ok := g.Link()
+		require.True(t, ok)
+		require.Equal(t, link, l)
+		require.Equal(t, []oid.ID{lock}, g.Locks())
+		require.Equal(t, []oid.ID{tomb}, g.Tombstones())
+
+		phy := g.Physical()
+		require.Equal(t, len(parts)+1, phy.Len())
+		require.True(t, phy.Contains(address(link)))
+		require.False(t, phy.Contains(address(root)))
+	}
+
+	for _, tc := range []struct {
+		name string
+		id   oid.ID
+	}{
+		{name: "root", id: root},
+		{name: "middle part", id: parts[2]},
+		{name: "last part", id: last},
+		{name: "link", id: link},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g, err := relations.Discover(ctx, s, address(tc.id))
+			require.NoError(t, err)
+			require.Equal(t, address(tc.id), g.Address())
+			check(t, g)
+		})
+	}
+
+	t.Run("hidden link", func(t *testing.T) {
+		s.hideLink = true
+		defer func() { s.hideLink = false }()
+
+		for _, id := range []oid.ID{root, parts[1]} {
+			g, err := relations.Discover(ctx, s, address(id))
+			require.NoError(t, err)
+			check(t, g)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		s.err = errors.New("any error")
+		defer func() { s.err = nil }()
+
+		_, err := relations.Discover(ctx, s, address(root))
+		require.ErrorIs(t, err, s.err)
+	})
+
+	t.Run("missing part", func(t *testing.T) {
+		hdr := s.objects[parts[1]]
+		delete(s.objects, parts[1])
+		defer func() { s.objects[parts[1]] = hdr }()
+
+		_, err := relations.Discover(ctx, s, address(last))
+		require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+	})
+}
@@ -0,0 +1,21 @@
+/*
+Package s3meta provides mapping between S3-style object metadata and NeoFS
+object attributes.
+
+S3 object metadata consists of the system headers (Content-Type,
+Cache-Control, etc.) and user-defined metadata passed in the x-amz-meta-*
+headers. Gateways and migration tools store them in the NeoFS object
+attributes named the same way, so objects uploaded by one tool are served
+correctly by another:
+
+	attrs, err := s3meta.ToAttributes(req.Header)
+	// ...
+	_, err = slc.Put(ctx, req.Body, attrs)
+
+	// on download
+	hdr := s3meta.FromAttributes(obj.Attributes())
+	for k, v := range hdr {
+		w.Header()[k] = v
+	}
+*/
+package s3meta
@@ -0,0 +1,215 @@
+package s3meta
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+const (
+	// HeaderUserMetadataPrefix is a prefix of the S3 headers carrying
+	// user-defined metadata.
+	HeaderUserMetadataPrefix = "X-Amz-Meta-"
+
+	// AttributeUserMetadataPrefix is a prefix of the NeoFS object attributes
+	// carrying S3 user-defined metadata. The rest of the key is the metadata
+	// name in lower case.
+	AttributeUserMetadataPrefix = "S3-Meta-"
+)
+
+// SystemHeaders lists S3 system headers mapped to the NeoFS object attributes
+// with the same keys. Content-Type is mapped to [object.AttributeContentType].
+// Last-Modified is mapped to [object.AttributeTimestamp] separately.
+var SystemHeaders = []string{
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Content-Type",
+	"Expires",
+	"Website-Redirect-Location",
+}
+
+const headerLastModified = "Last-Modified"
+
+const headerAmzPrefix = "X-Amz-"
+
+// isSystemHeader checks whether the canonical header key is one of the
+// SystemHeaders.
+func isSystemHeader(key string) bool {
+	for i := range SystemHeaders {
+		if SystemHeaders[i] == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanonicalHeaderKey returns canonical form of the S3 header key. Keys of the
+// system headers are canonicalized according to the MIME header rules (see
+// [textproto.CanonicalMIMEHeaderKey]), user metadata names are turned into
+// lower case as S3 does:
+//
+//	content-TYPE -> Content-Type
+//	x-amz-meta-Color -> X-Amz-Meta-color
+func CanonicalHeaderKey(key string) string {
+	key = textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(key))
+
+	if strings.HasPrefix(key, HeaderUserMetadataPrefix) {
+		return HeaderUserMetadataPrefix + strings.ToLower(key[len(HeaderUserMetadataPrefix):])
+	}
+
+	// some S3 system headers are passed with x-amz- prefix
+	if strings.HasPrefix(key, headerAmzPrefix) {
+		if k := key[len(headerAmzPrefix):]; isSystemHeader(k) {
+			return k
+		}
+	}
+
+	return key
+}
+
+// AttributeKey returns key of the NeoFS object attribute corresponding to
+// the S3 header. Returns false if the header is not mapped to attributes.
+func AttributeKey(header string) (string, bool) {
+	header = CanonicalHeaderKey(header)
+
+	switch {
+	case strings.HasPrefix(header, HeaderUserMetadataPrefix):
+		name := header[len(HeaderUserMetadataPrefix):]
+		if name == "" {
+			return "", false
+		}
+
+		return AttributeUserMetadataPrefix + name, true
+	case header == headerLastModified:
+		return object.AttributeTimestamp, true
+	case isSystemHeader(header):
+		return header, true
+	}
+
+	return "", false
+}
+
+// HeaderKey returns canonical key of the S3 header corresponding to the NeoFS
+// object attribute. Returns false if the attribute is not mapped to headers.
+// HeaderKey is a reverse action to AttributeKey.
+func HeaderKey(attribute string) (string, bool) {
+	switch {
+	case strings.HasPrefix(attribute, AttributeUserMetadataPrefix):
+		name := attribute[len(AttributeUserMetadataPrefix):]
+		if name == "" || strings.ToLower(name) != name {
+			return "", false
+		}
+
+		return HeaderUserMetadataPrefix + name, true
+	case attribute == object.AttributeTimestamp:
+		return headerLastModified, true
+	case isSystemHeader(attribute):
+		return attribute, true
+	}
+
+	return "", false
+}
+
+// ToAttributes converts S3 object metadata headers into NeoFS object
+// attributes. Headers which are not mapped (see AttributeKey) are ignored.
+// Header keys are canonicalized (see CanonicalHeaderKey), so the result does
+// not depend on the key case. Multiple values of the same header are joined
+// by comma as allowed by HTTP, values are trimmed from spaces, empty values
+// are ignored. Last-Modified MUST be in [http.TimeFormat] and is converted to
+// Unix Timestamp.
+//
+// The attributes are ordered by keys for deterministic results. ToAttributes
+// does not check attribute limits, see [object.Object.CheckLimits].
+//
+// See also FromAttributes.
+func ToAttributes(h http.Header) ([]object.Attribute, error) {
+	vals := make(map[string][]string, len(h))
+	var keys []string
+
+	for hk, hv := range h {
+		key, ok := AttributeKey(hk)
+		if !ok {
+			continue
+		}
+
+		for i := range hv {
+			if v := strings.TrimSpace(hv[i]); v != "" {
+				if _, ok := vals[key]; !ok {
+					keys = append(keys, key)
+				}
+
+				vals[key] = append(vals[key], v)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+
+	res := make([]object.Attribute, 0, len(keys))
+
+	for _, key := range keys {
+		val := strings.Join(vals[key], ",")
+
+		if key == object.AttributeTimestamp {
+			if len(vals[key]) > 1 {
+				return nil, errors.New("multiple Last-Modified values")
+			}
+
+			t, err := http.ParseTime(val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Last-Modified header: %w", err)
+			}
+
+			val = strconv.FormatInt(t.Unix(), 10)
+		}
+
+		res = append(res, *object.NewAttribute())
+		res[len(res)-1].SetKey(key)
+		res[len(res)-1].SetValue(val)
+	}
+
+	return res, nil
+}
+
+// FromAttributes converts NeoFS object attributes into S3 object metadata
+// headers with canonical keys. Attributes which are not mapped (see
+// HeaderKey) are ignored as well as Timestamp attribute in invalid format.
+// Timestamp is converted to Last-Modified header in [http.TimeFormat].
+//
+// See also ToAttributes.
+func FromAttributes(attrs []object.Attribute) http.Header {
+	res := make(http.Header)
+
+	for i := range attrs {
+		key, ok := HeaderKey(attrs[i].Key())
+		if !ok {
+			continue
+		}
+
+		val := attrs[i].Value()
+
+		if key == headerLastModified {
+			sec, err := strconv.ParseInt(val, 10, 64)
+			if err != nil {
+				continue
+			}
+
+			val = time.Unix(sec, 0).UTC().Format(http.TimeFormat)
+		}
+
+		// direct assignment to keep user metadata names in lower case
+		res[key] = append(res[key], val)
+	}
+
+	return res
+}
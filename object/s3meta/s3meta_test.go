@@ -0,0 +1,111 @@
+package s3meta_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/s3meta"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalHeaderKey(t *testing.T) {
+	for in, out := range map[string]string{
+		"content-TYPE":                    "Content-Type",
+		" cache-control ":                 "Cache-Control",
+		"x-amz-meta-Color":                "X-Amz-Meta-color",
+		"X-AMZ-META-my-KEY":               "X-Amz-Meta-my-key",
+		"x-amz-website-redirect-location": "Website-Redirect-Location",
+		"x-amz-acl":                       "X-Amz-Acl",
+	} {
+		require.Equal(t, out, s3meta.CanonicalHeaderKey(in), in)
+	}
+}
+
+func TestAttributeKey(t *testing.T) {
+	for in, out := range map[string]string{
+		"content-type":     object.AttributeContentType,
+		"Expires":          "Expires",
+		"last-modified":    object.AttributeTimestamp,
+		"x-amz-meta-Color": "S3-Meta-color",
+		"X-Amz-Meta-a-b-c": "S3-Meta-a-b-c",
+	} {
+		key, ok := s3meta.AttributeKey(in)
+		require.True(t, ok, in)
+		require.Equal(t, out, key, in)
+
+		h, ok := s3meta.HeaderKey(key)
+		require.True(t, ok, key)
+		require.Equal(t, s3meta.CanonicalHeaderKey(in), h, key)
+	}
+
+	for _, in := range []string{"Authorization", "x-amz-acl", "x-amz-meta-", "Content-Length"} {
+		_, ok := s3meta.AttributeKey(in)
+		require.False(t, ok, in)
+	}
+
+	for _, in := range []string{object.AttributeFilePath, "S3-Meta-", "S3-Meta-Upper", "content-type"} {
+		_, ok := s3meta.HeaderKey(in)
+		require.False(t, ok, in)
+	}
+}
+
+func TestToAttributes(t *testing.T) {
+	ts := time.Date(2023, 5, 1, 10, 20, 30, 0, time.UTC)
+
+	h := http.Header{
+		"Content-Type":     {"image/jpeg"},
+		"cache-control":    {"no-cache", " max-age=0 "},
+		"X-Amz-Meta-Color": {"black"},
+		"x-amz-meta-color": {"white"},
+		"X-Amz-Meta-Empty": {" "},
+		"Last-Modified":    {ts.Format(http.TimeFormat)},
+		"Authorization":    {"secret"},
+	}
+
+	attrs, err := s3meta.ToAttributes(h)
+	require.NoError(t, err)
+
+	keys := make([]string, len(attrs))
+	m := make(map[string]string, len(attrs))
+	for i := range attrs {
+		keys[i] = attrs[i].Key()
+		m[attrs[i].Key()] = attrs[i].Value()
+	}
+
+	require.Equal(t, []string{"Cache-Control", "Content-Type", "S3-Meta-color", "Timestamp"}, keys)
+	require.Equal(t, "no-cache,max-age=0", m["Cache-Control"])
+	require.Equal(t, "image/jpeg", m["Content-Type"])
+	require.Contains(t, []string{"black,white", "white,black"}, m["S3-Meta-color"])
+	require.Equal(t, "1682936430", m["Timestamp"])
+
+	var obj object.Object
+	obj.SetAttributes(attrs...)
+	tsAttr, err := obj.Timestamp()
+	require.NoError(t, err)
+	require.True(t, ts.Equal(tsAttr))
+
+	res := s3meta.FromAttributes(append(attrs, *object.NewAttribute()))
+	require.Equal(t, http.Header{
+		"Cache-Control":    {"no-cache,max-age=0"},
+		"Content-Type":     {"image/jpeg"},
+		"X-Amz-Meta-color": {m["S3-Meta-color"]},
+		"Last-Modified":    {ts.Format(http.TimeFormat)},
+	}, res)
+
+	t.Run("invalid Last-Modified", func(t *testing.T) {
+		_, err := s3meta.ToAttributes(http.Header{"Last-Modified": {"yesterday"}})
+		require.Error(t, err)
+
+		_, err = s3meta.ToAttributes(http.Header{"Last-Modified": {ts.Format(http.TimeFormat), ts.Format(http.TimeFormat)}})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid Timestamp", func(t *testing.T) {
+		var a object.Attribute
+		a.SetKey(object.AttributeTimestamp)
+		a.SetValue("yesterday")
+		require.Empty(t, s3meta.FromAttributes([]object.Attribute{a}))
+	})
+}
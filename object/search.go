@@ -25,6 +25,31 @@ const (
 	MatchCommonPrefix
 )
 
+// Numeric matchers. Both header and filter values are interpreted as base-10
+// integers, non-numeric values never match. Values go in the NeoFS API order.
+const (
+	// MatchNumGT is a SearchMatchType of "greater than" numeric relation.
+	MatchNumGT SearchMatchType = iota + 5
+
+	// MatchNumGE is a SearchMatchType of "greater or equal" numeric relation.
+	MatchNumGE
+
+	// MatchNumLT is a SearchMatchType of "less than" numeric relation.
+	MatchNumLT
+
+	// MatchNumLE is a SearchMatchType of "less or equal" numeric relation.
+	MatchNumLE
+)
+
+// v2 object.MatchType values of the matchers defined by NeoFS API but not
+// declared in neofs-api-go.
+const (
+	v2MatchNumGT v2object.MatchType = iota + 5
+	v2MatchNumGE
+	v2MatchNumLT
+	v2MatchNumLE
+)
+
 // ToV2 converts [SearchMatchType] to v2 [v2object.MatchType] enum value.
 func (m SearchMatchType) ToV2() v2object.MatchType {
 	switch m {
@@ -36,6 +61,14 @@ func (m SearchMatchType) ToV2() v2object.MatchType {
 		return v2object.MatchNotPresent
 	case MatchCommonPrefix:
 		return v2object.MatchCommonPrefix
+	case MatchNumGT:
+		return v2MatchNumGT
+	case MatchNumGE:
+		return v2MatchNumGE
+	case MatchNumLT:
+		return v2MatchNumLT
+	case MatchNumLE:
+		return v2MatchNumLE
 	default:
 		return v2object.MatchUnknown
 	}
@@ -52,6 +85,14 @@ func SearchMatchFromV2(t v2object.MatchType) (m SearchMatchType) {
 		m = MatchNotPresent
 	case v2object.MatchCommonPrefix:
 		m = MatchCommonPrefix
+	case v2MatchNumGT:
+		m = MatchNumGT
+	case v2MatchNumGE:
+		m = MatchNumGE
+	case v2MatchNumLT:
+		m = MatchNumLT
+	case v2MatchNumLE:
+		m = MatchNumLE
 	default:
 		m = MatchUnknown
 	}
@@ -66,9 +107,24 @@ func SearchMatchFromV2(t v2object.MatchType) (m SearchMatchType) {
 //   - [MatchStringNotEqual]: STRING_NOT_EQUAL;
 //   - [MatchNotPresent]: NOT_PRESENT;
 //   - [MatchCommonPrefix]: COMMON_PREFIX;
+//   - [MatchNumGT]: NUM_GT;
+//   - [MatchNumGE]: NUM_GE;
+//   - [MatchNumLT]: NUM_LT;
+//   - [MatchNumLE]: NUM_LE;
 //   - [MatchUnknown], default: MATCH_TYPE_UNSPECIFIED.
 func (m SearchMatchType) EncodeToString() string {
-	return m.ToV2().String()
+	switch m {
+	case MatchNumGT:
+		return "NUM_GT"
+	case MatchNumGE:
+		return "NUM_GE"
+	case MatchNumLT:
+		return "NUM_LT"
+	case MatchNumLE:
+		return "NUM_LE"
+	default:
+		return m.ToV2().String()
+	}
 }
 
 // String implements [fmt.Stringer].
@@ -85,6 +141,21 @@ func (m SearchMatchType) String() string {
 //
 // Returns true if s was parsed successfully.
 func (m *SearchMatchType) DecodeString(s string) bool {
+	switch s {
+	case "NUM_GT":
+		*m = MatchNumGT
+		return true
+	case "NUM_GE":
+		*m = MatchNumGE
+		return true
+	case "NUM_LT":
+		*m = MatchNumLT
+		return true
+	case "NUM_LE":
+		*m = MatchNumLE
+		return true
+	}
+
 	var g v2object.MatchType
 
 	ok := g.FromString(s)
@@ -256,6 +327,18 @@ func (f *SearchFilters) AddObjectOwnerIDFilter(m SearchMatchType, id user.ID) {
 	f.addReservedFilter(m, fKeyOwnerID, id)
 }
 
+// AddCreationEpochFilter adds a filter by creation epoch. Numeric matchers
+// (e.g. [MatchNumGE]) allow to select epoch ranges.
+func (f *SearchFilters) AddCreationEpochFilter(m SearchMatchType, epoch uint64) {
+	f.addReservedFilter(m, fKeyCreationEpoch, staticStringer(strconv.FormatUint(epoch, 10)))
+}
+
+// AddPayloadSizeFilter adds a filter by payload size. Numeric matchers (e.g.
+// [MatchNumLE]) allow to select size ranges.
+func (f *SearchFilters) AddPayloadSizeFilter(m SearchMatchType, size uint64) {
+	f.addReservedFilter(m, fKeyPayloadLength, staticStringer(strconv.FormatUint(size, 10)))
+}
+
 // AddNotificationEpochFilter adds a filter by epoch. This epoch is not about expiration, but about notification production.
 func (f *SearchFilters) AddNotificationEpochFilter(epoch uint64) {
 	f.addFilter(MatchStringEqual, 0, v2object.SysAttributeTickEpoch, staticStringer(strconv.FormatUint(epoch, 10)))
@@ -0,0 +1,161 @@
+package object
+
+import (
+	"crypto/sha256"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// SearchFiltersBuilder builds [SearchFilters] by chaining method calls with
+// the matchers selected by the method semantics, so the query reads as a
+// sentence and can't have the matchers mixed up:
+//
+//	fs := object.NewSearchFiltersBuilder().
+//		AttributeEquals(object.AttributeFileName, "cat.jpg").
+//		AttributePresent(object.AttributeTimestamp).
+//		CreatedBetween(100, 200).
+//		PayloadSizeAtMost(1 << 20).
+//		RootOnly().
+//		Build()
+//
+// All filters are combined by conjunction. Zero SearchFiltersBuilder is ready
+// to use and produces empty filters selecting all objects.
+type SearchFiltersBuilder struct {
+	fs SearchFilters
+}
+
+// NewSearchFiltersBuilder returns new SearchFiltersBuilder.
+func NewSearchFiltersBuilder() *SearchFiltersBuilder {
+	return new(SearchFiltersBuilder)
+}
+
+// Build returns built [SearchFilters]. Builder can be used further, the
+// result is not affected.
+func (b *SearchFiltersBuilder) Build() SearchFilters {
+	res := make(SearchFilters, len(b.fs))
+	copy(res, b.fs)
+	return res
+}
+
+// AttributeEquals selects objects with the attribute having exactly the given
+// value.
+func (b *SearchFiltersBuilder) AttributeEquals(key, value string) *SearchFiltersBuilder {
+	b.fs.AddFilter(key, value, MatchStringEqual)
+	return b
+}
+
+// AttributeNotEquals selects objects with the attribute having a value other
+// than the given one. Objects without the attribute are not selected.
+func (b *SearchFiltersBuilder) AttributeNotEquals(key, value string) *SearchFiltersBuilder {
+	b.fs.AddFilter(key, value, MatchStringNotEqual)
+	return b
+}
+
+// AttributeHasPrefix selects objects with the attribute value starting with
+// the given prefix.
+func (b *SearchFiltersBuilder) AttributeHasPrefix(key, prefix string) *SearchFiltersBuilder {
+	b.fs.AddFilter(key, prefix, MatchCommonPrefix)
+	return b
+}
+
+// AttributePresent selects objects having the attribute with any value.
+func (b *SearchFiltersBuilder) AttributePresent(key string) *SearchFiltersBuilder {
+	// NeoFS attributes can't have empty values
+	b.fs.AddFilter(key, "", MatchStringNotEqual)
+	return b
+}
+
+// AttributeAbsent selects objects without the attribute.
+func (b *SearchFiltersBuilder) AttributeAbsent(key string) *SearchFiltersBuilder {
+	b.fs.AddFilter(key, "", MatchNotPresent)
+	return b
+}
+
+// CreatedAt selects objects created in the given epoch.
+func (b *SearchFiltersBuilder) CreatedAt(epoch uint64) *SearchFiltersBuilder {
+	b.fs.AddCreationEpochFilter(MatchStringEqual, epoch)
+	return b
+}
+
+// CreatedSince selects objects created in the given epoch or later.
+func (b *SearchFiltersBuilder) CreatedSince(epoch uint64) *SearchFiltersBuilder {
+	b.fs.AddCreationEpochFilter(MatchNumGE, epoch)
+	return b
+}
+
+// CreatedUntil selects objects created in the given epoch or earlier.
+func (b *SearchFiltersBuilder) CreatedUntil(epoch uint64) *SearchFiltersBuilder {
+	b.fs.AddCreationEpochFilter(MatchNumLE, epoch)
+	return b
+}
+
+// CreatedBetween selects objects created in the given epoch range including
+// bounds.
+func (b *SearchFiltersBuilder) CreatedBetween(from, to uint64) *SearchFiltersBuilder {
+	return b.CreatedSince(from).CreatedUntil(to)
+}
+
+// PayloadSizeAtLeast selects objects with the payload not smaller than the
+// given size.
+func (b *SearchFiltersBuilder) PayloadSizeAtLeast(size uint64) *SearchFiltersBuilder {
+	b.fs.AddPayloadSizeFilter(MatchNumGE, size)
+	return b
+}
+
+// PayloadSizeAtMost selects objects with the payload not bigger than the given
+// size.
+func (b *SearchFiltersBuilder) PayloadSizeAtMost(size uint64) *SearchFiltersBuilder {
+	b.fs.AddPayloadSizeFilter(MatchNumLE, size)
+	return b
+}
+
+// PayloadSizeBetween selects objects with the payload size in the given range
+// including bounds.
+func (b *SearchFiltersBuilder) PayloadSizeBetween(min, max uint64) *SearchFiltersBuilder {
+	return b.PayloadSizeAtLeast(min).PayloadSizeAtMost(max)
+}
+
+// RootOnly selects objects created by users explicitly, i.e. without parts of
+// the split-chains.
+func (b *SearchFiltersBuilder) RootOnly() *SearchFiltersBuilder {
+	b.fs.AddRootFilter()
+	return b
+}
+
+// PhyOnly selects objects physically stored in NeoFS.
+func (b *SearchFiltersBuilder) PhyOnly() *SearchFiltersBuilder {
+	b.fs.AddPhyFilter()
+	return b
+}
+
+// OfType selects objects of the given type.
+func (b *SearchFiltersBuilder) OfType(typ Type) *SearchFiltersBuilder {
+	b.fs.AddTypeFilter(MatchStringEqual, typ)
+	return b
+}
+
+// OwnedBy selects objects owned by the given user.
+func (b *SearchFiltersBuilder) OwnedBy(owner user.ID) *SearchFiltersBuilder {
+	b.fs.AddObjectOwnerIDFilter(MatchStringEqual, owner)
+	return b
+}
+
+// InContainer selects objects from the given container.
+func (b *SearchFiltersBuilder) InContainer(cnr cid.ID) *SearchFiltersBuilder {
+	b.fs.AddObjectContainerIDFilter(MatchStringEqual, cnr)
+	return b
+}
+
+// ChildrenOf selects parts of the split-chain of the given root object.
+func (b *SearchFiltersBuilder) ChildrenOf(parent oid.ID) *SearchFiltersBuilder {
+	b.fs.AddParentIDFilter(MatchStringEqual, parent)
+	return b
+}
+
+// WithPayloadHash selects objects with the given SHA-256 payload checksum.
+func (b *SearchFiltersBuilder) WithPayloadHash(sum [sha256.Size]byte) *SearchFiltersBuilder {
+	b.fs.AddPayloadHashFilter(MatchStringEqual, sum)
+	return b
+}
@@ -0,0 +1,71 @@
+package object_test
+
+import (
+	"testing"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchFiltersBuilder(t *testing.T) {
+	require.Empty(t, new(object.SearchFiltersBuilder).Build())
+
+	owner := *usertest.ID(t)
+	cnr := cidtest.ID()
+	parent := oidtest.ID()
+	cs := testChecksumSha256()
+
+	b := object.NewSearchFiltersBuilder().
+		AttributeEquals("k1", "v1").
+		AttributeNotEquals("k2", "v2").
+		AttributeHasPrefix("k3", "v3").
+		AttributePresent("k4").
+		AttributeAbsent("k5").
+		CreatedAt(10).
+		CreatedBetween(20, 30).
+		PayloadSizeBetween(40, 50).
+		RootOnly().
+		PhyOnly().
+		OfType(object.TypeLock).
+		OwnedBy(owner).
+		InContainer(cnr).
+		ChildrenOf(parent).
+		WithPayloadHash(cs)
+
+	fs := b.Build()
+
+	var exp object.SearchFilters
+	exp.AddFilter("k1", "v1", object.MatchStringEqual)
+	exp.AddFilter("k2", "v2", object.MatchStringNotEqual)
+	exp.AddFilter("k3", "v3", object.MatchCommonPrefix)
+	exp.AddFilter("k4", "", object.MatchStringNotEqual)
+	exp.AddFilter("k5", "", object.MatchNotPresent)
+	exp.AddCreationEpochFilter(object.MatchStringEqual, 10)
+	exp.AddCreationEpochFilter(object.MatchNumGE, 20)
+	exp.AddCreationEpochFilter(object.MatchNumLE, 30)
+	exp.AddPayloadSizeFilter(object.MatchNumGE, 40)
+	exp.AddPayloadSizeFilter(object.MatchNumLE, 50)
+	exp.AddRootFilter()
+	exp.AddPhyFilter()
+	exp.AddTypeFilter(object.MatchStringEqual, object.TypeLock)
+	exp.AddObjectOwnerIDFilter(object.MatchStringEqual, owner)
+	exp.AddObjectContainerIDFilter(object.MatchStringEqual, cnr)
+	exp.AddParentIDFilter(object.MatchStringEqual, parent)
+	exp.AddPayloadHashFilter(object.MatchStringEqual, cs)
+
+	require.Equal(t, exp.ToV2(), fs.ToV2())
+
+	fsV2 := fs.ToV2()
+	require.Equal(t, v2object.FilterHeaderCreationEpoch, fsV2[6].GetKey())
+	require.Equal(t, "20", fsV2[6].GetValue())
+
+	t.Run("build isolation", func(t *testing.T) {
+		b.AttributeEquals("k6", "v6")
+		require.Len(t, fs, len(exp))
+		require.Len(t, b.Build(), len(exp)+1)
+	})
+}
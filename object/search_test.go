@@ -21,6 +21,10 @@ var eqV2Matches = map[object.SearchMatchType]v2object.MatchType{
 	object.MatchStringNotEqual: v2object.MatchStringNotEqual,
 	object.MatchNotPresent:     v2object.MatchNotPresent,
 	object.MatchCommonPrefix:   v2object.MatchCommonPrefix,
+	object.MatchNumGT:          5,
+	object.MatchNumGE:          6,
+	object.MatchNumLT:          7,
+	object.MatchNumLE:          8,
 }
 
 func TestMatch(t *testing.T) {
@@ -208,6 +212,10 @@ func TestSearchMatchType_String(t *testing.T) {
 		{val: toPtr(object.MatchStringEqual), str: "STRING_EQUAL"},
 		{val: toPtr(object.MatchStringNotEqual), str: "STRING_NOT_EQUAL"},
 		{val: toPtr(object.MatchNotPresent), str: "NOT_PRESENT"},
+		{val: toPtr(object.MatchNumGT), str: "NUM_GT"},
+		{val: toPtr(object.MatchNumGE), str: "NUM_GE"},
+		{val: toPtr(object.MatchNumLT), str: "NUM_LT"},
+		{val: toPtr(object.MatchNumLE), str: "NUM_LE"},
 		{val: toPtr(object.MatchUnknown), str: "MATCH_TYPE_UNSPECIFIED"},
 	})
 }
@@ -273,6 +281,30 @@ func TestSearchFilters_AddHomomorphicHashFilter(t *testing.T) {
 	})
 }
 
+func TestSearchFilters_AddCreationEpochFilter(t *testing.T) {
+	fs := new(object.SearchFilters)
+	fs.AddCreationEpochFilter(object.MatchNumGE, 42)
+
+	fsV2 := fs.ToV2()
+
+	require.Len(t, fsV2, 1)
+	require.Equal(t, v2object.FilterHeaderCreationEpoch, fsV2[0].GetKey())
+	require.Equal(t, "42", fsV2[0].GetValue())
+	require.EqualValues(t, 6, fsV2[0].GetMatchType())
+}
+
+func TestSearchFilters_AddPayloadSizeFilter(t *testing.T) {
+	fs := new(object.SearchFilters)
+	fs.AddPayloadSizeFilter(object.MatchNumLT, 1024)
+
+	fsV2 := fs.ToV2()
+
+	require.Len(t, fsV2, 1)
+	require.Equal(t, v2object.FilterHeaderPayloadLength, fsV2[0].GetKey())
+	require.Equal(t, "1024", fsV2[0].GetValue())
+	require.EqualValues(t, 7, fsV2[0].GetMatchType())
+}
+
 func ExampleSearchFilters_AddHomomorphicHashFilter() {
 	hash, _ := hex.DecodeString("7e302ebb3937e810feb501965580c746048db99cebd095c3ce27022407408bf904dde8d9aa8085d2cf7202345341cc947fa9d722c6b6699760d307f653815d0c")
 
@@ -0,0 +1,191 @@
+package slicer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// bufferChunkSize is a size of the memory chunks buffering object payload.
+const bufferChunkSize = 64 << 10
+
+// BufferPool is a shared allocator of the memory buffering object payload
+// before it is sent. Slicer buffers payload of each object to calculate its
+// header, so concurrent uploads require up to the object payload limit per
+// upload. BufferPool allocates the memory by fixed chunks on demand, reuses
+// them between uploads and limits total amount of the memory reserved by the
+// uploads sharing the BufferPool: if the budget is exhausted, the next object
+// waits until some other one is sent.
+//
+// Each object reserves the full payload limit from the budget when its first
+// byte is buffered, so the budget MUST NOT be less than the payload limit.
+// The reservation is released when the object is sent or its upload fails.
+//
+// BufferPool must be constructed via NewBufferPool. BufferPool is safe for
+// concurrent use.
+type BufferPool struct {
+	chunks sync.Pool
+
+	mtx sync.Mutex
+
+	budget, reserved uint64
+
+	// closed and replaced on each release
+	released chan struct{}
+}
+
+// defaultBufferPool is used by Slicer when no BufferPool is configured.
+var defaultBufferPool = NewBufferPool(0)
+
+// NewBufferPool constructs new BufferPool with the given memory budget in
+// bytes. Zero budget means unlimited memory, chunks are still reused.
+func NewBufferPool(budget uint64) *BufferPool {
+	return &BufferPool{
+		chunks: sync.Pool{
+			New: func() any {
+				b := make([]byte, bufferChunkSize)
+				return &b
+			},
+		},
+		budget:   budget,
+		released: make(chan struct{}),
+	}
+}
+
+// Budget returns memory budget of the BufferPool in bytes. Zero means
+// unlimited memory.
+func (x *BufferPool) Budget() uint64 {
+	return x.budget
+}
+
+// reserve reserves n bytes from the budget. Blocks until the memory is
+// available or the context is done.
+func (x *BufferPool) reserve(ctx context.Context, n uint64) error {
+	for {
+		x.mtx.Lock()
+		if x.budget == 0 || x.reserved+n <= x.budget {
+			x.reserved += n
+			x.mtx.Unlock()
+			return nil
+		}
+
+		ch := x.released
+		x.mtx.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for memory budget: %w", ctx.Err())
+		case <-ch:
+		}
+	}
+}
+
+// release returns n bytes reserved before to the budget.
+func (x *BufferPool) release(n uint64) {
+	x.mtx.Lock()
+	x.reserved -= n
+	close(x.released)
+	x.released = make(chan struct{})
+	x.mtx.Unlock()
+}
+
+func (x *BufferPool) getChunk() *[]byte {
+	return x.chunks.Get().(*[]byte)
+}
+
+func (x *BufferPool) putChunk(b *[]byte) {
+	x.chunks.Put(b)
+}
+
+// chunkedBuffer is an io.Writer buffering data in chunks allocated from the
+// BufferPool. Budget is reserved on the first write and released on reset.
+type chunkedBuffer struct {
+	ctx context.Context
+
+	pool *BufferPool
+
+	// amount of budget to reserve
+	limit uint64
+
+	reserved bool
+
+	chunks []*[]byte
+
+	// number of bytes in the last chunk
+	tail int
+}
+
+func newChunkedBuffer(ctx context.Context, pool *BufferPool, limit uint64) chunkedBuffer {
+	return chunkedBuffer{
+		ctx:   ctx,
+		pool:  pool,
+		limit: limit,
+	}
+}
+
+func (x *chunkedBuffer) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	if !x.reserved {
+		if err := x.pool.reserve(x.ctx, x.limit); err != nil {
+			return 0, err
+		}
+
+		x.reserved = true
+	}
+
+	var n int
+
+	for len(p) > 0 {
+		if len(x.chunks) == 0 || x.tail == bufferChunkSize {
+			x.chunks = append(x.chunks, x.pool.getChunk())
+			x.tail = 0
+		}
+
+		c := copy((*x.chunks[len(x.chunks)-1])[x.tail:], p)
+		x.tail += c
+		n += c
+		p = p[c:]
+	}
+
+	return n, nil
+}
+
+// WriteTo writes buffered data to w chunk by chunk.
+func (x *chunkedBuffer) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+
+	for i := range x.chunks {
+		b := *x.chunks[i]
+		if i == len(x.chunks)-1 {
+			b = b[:x.tail]
+		}
+
+		c, err := w.Write(b)
+		n += int64(c)
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// reset discards buffered data returning the memory to the BufferPool.
+func (x *chunkedBuffer) reset() {
+	for i := range x.chunks {
+		x.pool.putChunk(x.chunks[i])
+		x.chunks[i] = nil
+	}
+
+	x.chunks = x.chunks[:0]
+	x.tail = 0
+
+	if x.reserved {
+		x.pool.release(x.limit)
+		x.reserved = false
+	}
+}
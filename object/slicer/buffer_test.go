@@ -0,0 +1,146 @@
+package slicer_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferPool(t *testing.T) {
+	const limit = 100 << 10
+	ctx := context.Background()
+
+	in, opts := randomInput(t, 3*limit+1, limit)
+
+	t.Run("insufficient budget", func(t *testing.T) {
+		s, err := slicer.New(ctx, discardObject{opts: opts}, in.signer, in.container, in.owner, in.sessionToken)
+		require.NoError(t, err)
+
+		s.SetBufferPool(slicer.NewBufferPool(limit - 1))
+		_, err = s.InitPut(ctx, in.attributes)
+		require.Error(t, err)
+	})
+
+	t.Run("exhausted budget", func(t *testing.T) {
+		pool := slicer.NewBufferPool(limit)
+		require.EqualValues(t, limit, pool.Budget())
+
+		checker := &slicedObjectChecker{
+			opts:           opts,
+			tb:             t,
+			input:          in,
+			chainCollector: newChainCollector(t),
+		}
+
+		s, err := slicer.New(ctx, checker, in.signer, in.container, in.owner, in.sessionToken)
+		require.NoError(t, err)
+		s.SetBufferPool(pool)
+
+		w1, err := s.InitPut(ctx, in.attributes)
+		require.NoError(t, err)
+		_, err = w1.Write(in.payload[:1])
+		require.NoError(t, err)
+
+		other, err := slicer.New(ctx, discardObject{opts: opts}, in.signer, in.container, in.owner, in.sessionToken)
+		require.NoError(t, err)
+		other.SetBufferPool(pool)
+
+		tctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		// budget is held by w1
+		_, err = other.Put(tctx, bytes.NewReader(in.payload), in.attributes)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		done := make(chan error, 1)
+		go func() {
+			_, err := other.Put(ctx, bytes.NewReader(in.payload), in.attributes)
+			done <- err
+		}()
+
+		_, err = w1.Write(in.payload[1:])
+		require.NoError(t, err)
+		require.NoError(t, w1.Close())
+		checker.chainCollector.verify(in, w1.ID())
+
+		require.NoError(t, <-done)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		pool := slicer.NewBufferPool(2 * limit)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 10)
+
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				s, err := slicer.New(ctx, discardObject{opts: opts}, in.signer, in.container, in.owner, in.sessionToken)
+				if err == nil {
+					s.SetBufferPool(pool)
+					_, err = s.Put(ctx, bytes.NewReader(in.payload), in.attributes)
+				}
+
+				errs[i] = err
+			}(i)
+		}
+
+		wg.Wait()
+
+		for i := range errs {
+			require.NoError(t, errs[i], i)
+		}
+	})
+}
+
+func BenchmarkBufferPool(b *testing.B) {
+	const limit = 1 << 20
+	const concurrency = 16
+	ctx := context.Background()
+
+	in, opts := randomInput(b, 4*limit, limit)
+	for in.withHomo {
+		in, opts = randomInput(b, 4*limit, limit)
+	}
+
+	for _, budget := range []uint64{0, limit, 4 * limit} {
+		b.Run(fmt.Sprintf("budget=%d", budget), func(b *testing.B) {
+			pool := slicer.NewBufferPool(budget)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+
+				for j := 0; j < concurrency; j++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+
+						s, err := slicer.New(ctx, discardObject{opts: opts}, in.signer, in.container, in.owner, in.sessionToken)
+						if err != nil {
+							panic(err)
+						}
+
+						s.SetBufferPool(pool)
+
+						if _, err = s.Put(ctx, bytes.NewReader(in.payload), in.attributes); err != nil {
+							panic(err)
+						}
+					}()
+				}
+
+				wg.Wait()
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package slicer
+
+import (
+	"crypto/sha256"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// Checkpoint describes progress of the object upload sliced into several
+// physically stored objects: split-chain elements written so far and
+// accumulated state of the root object metadata. Checkpoint allows to resume
+// interrupted upload from the end of the last written element instead of
+// restarting it.
+//
+// Checkpoint is produced by PayloadWriter after each written split-chain
+// element (see PayloadWriter.Checkpoint and Options.SetCheckpointHandler) and
+// consumed by Options.ResumeFrom. Checkpoint can be saved in the persistent
+// storage using Marshal and restored using Unmarshal.
+type Checkpoint struct {
+	splitID *object.SplitID
+
+	children []oid.ID
+
+	offset uint64
+
+	epoch uint64
+
+	// binary state of the root payload SHA-256 hasher
+	checksumState []byte
+
+	// Tillich-Zémor hash of the first offset bytes of the payload, nil if
+	// homomorphic hashing is disabled
+	homomorphicChecksum []byte
+}
+
+// Offset returns number of payload bytes already stored in the NeoFS. On
+// resumption (see Options.ResumeFrom), the payload MUST be continued from this
+// offset.
+func (x Checkpoint) Offset() uint64 {
+	return x.offset
+}
+
+// Children returns list of split-chain elements stored so far.
+func (x Checkpoint) Children() []oid.ID {
+	return x.children
+}
+
+// checkpointJSON is a JSON representation of the Checkpoint.
+type checkpointJSON struct {
+	SplitID             []byte   `json:"splitID"`
+	Children            []string `json:"children"`
+	Offset              uint64   `json:"offset"`
+	Epoch               uint64   `json:"epoch"`
+	ChecksumState       []byte   `json:"checksumState"`
+	HomomorphicChecksum []byte   `json:"homomorphicChecksum,omitempty"`
+}
+
+// Marshal encodes Checkpoint into a binary form for the persistent storage.
+//
+// See also Unmarshal.
+func (x Checkpoint) Marshal() []byte {
+	v := checkpointJSON{
+		SplitID:             x.splitID.ToV2(),
+		Children:            make([]string, len(x.children)),
+		Offset:              x.offset,
+		Epoch:               x.epoch,
+		ChecksumState:       x.checksumState,
+		HomomorphicChecksum: x.homomorphicChecksum,
+	}
+
+	for i := range x.children {
+		v.Children[i] = x.children[i].EncodeToString()
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("unexpected checkpoint encoding failure: %v", err))
+	}
+
+	return b
+}
+
+// Unmarshal decodes Checkpoint from the binary form produced by Marshal.
+// Returns an error if data is not a valid Checkpoint.
+//
+// See also Marshal.
+func (x *Checkpoint) Unmarshal(data []byte) error {
+	var v checkpointJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if len(v.Children) == 0 {
+		return errors.New("missing children")
+	}
+
+	splitID := object.NewSplitIDFromV2(v.SplitID)
+	if splitID == nil {
+		return errors.New("invalid split ID")
+	}
+
+	children := make([]oid.ID, len(v.Children))
+	for i := range v.Children {
+		if err := children[i].DecodeString(v.Children[i]); err != nil {
+			return fmt.Errorf("invalid child #%d: %w", i, err)
+		}
+	}
+
+	if err := sha256.New().(encoding.BinaryUnmarshaler).UnmarshalBinary(v.ChecksumState); err != nil {
+		return fmt.Errorf("invalid checksum state: %w", err)
+	}
+
+	if v.HomomorphicChecksum != nil && len(v.HomomorphicChecksum) != tz.Size {
+		return fmt.Errorf("invalid homomorphic checksum length %d", len(v.HomomorphicChecksum))
+	}
+
+	*x = Checkpoint{
+		splitID:             splitID,
+		children:            children,
+		offset:              v.Offset,
+		epoch:               v.Epoch,
+		checksumState:       v.ChecksumState,
+		homomorphicChecksum: v.HomomorphicChecksum,
+	}
+
+	return nil
+}
+
+// restoreChecksum returns SHA-256 hasher with the state saved in the
+// Checkpoint.
+func (x Checkpoint) restoreChecksum() (hash.Hash, error) {
+	h := sha256.New()
+
+	if err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(x.checksumState); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
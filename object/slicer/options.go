@@ -13,6 +13,12 @@ type Options struct {
 	withHomoChecksum bool
 
 	sessionToken *session.Object
+
+	checkpointHandler func(Checkpoint)
+
+	resumeFrom *Checkpoint
+
+	bufferPool *BufferPool
 }
 
 // SetObjectPayloadLimit specifies data size limit for produced physically
@@ -56,3 +62,37 @@ func (x *Options) IsHomomorphicChecksumEnabled() bool {
 func (x *Options) Session() *session.Object {
 	return x.sessionToken
 }
+
+// SetCheckpointHandler sets handler of the upload progress called by Slicer
+// after each stored split-chain element. Handler MAY save the Checkpoint to
+// resume the upload later if it is interrupted (see ResumeFrom). Handler is
+// called synchronously and blocks the upload, so it SHOULD be fast.
+func (x *Options) SetCheckpointHandler(f func(Checkpoint)) {
+	x.checkpointHandler = f
+}
+
+// ResumeFrom makes Slicer to continue the upload interrupted after the given
+// Checkpoint instead of starting a new one. Payload data MUST be continued
+// from the Checkpoint.Offset, object header MUST be the same as in the
+// interrupted upload. Creation epoch of the interrupted upload is used
+// regardless of SetCurrentNeoFSEpoch. Homomorphic checksum calculation MUST
+// be configured the same way too.
+//
+// Zero Checkpoint leads to the new upload.
+func (x *Options) ResumeFrom(cp Checkpoint) {
+	x.resumeFrom = &cp
+}
+
+// SetBufferPool sets allocator of the memory buffering object payload. The
+// pool MAY be shared between concurrent uploads to limit the total memory
+// consumption, its budget MUST NOT be less than the object payload limit. By
+// default, the process-wide pool with unlimited budget is used.
+func (x *Options) SetBufferPool(p *BufferPool) {
+	x.bufferPool = p
+}
+
+// BufferPool returns allocator of the memory buffering object payload. Nil
+// means the default one.
+func (x *Options) BufferPool() *BufferPool {
+	return x.bufferPool
+}
@@ -1,9 +1,9 @@
 package slicer
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding"
 	"errors"
 	"fmt"
 	"hash"
@@ -135,6 +135,47 @@ func (x *Slicer) InitPut(ctx context.Context, attrs []object.Attribute) (*Payloa
 	return initPayloadStream(ctx, x.w, x.hdr, x.signer, x.opts)
 }
 
+// CalculateID calculates ID of the object which is returned by [Slicer.Put]
+// for the same arguments in the current epoch. See [CalculateID] for details.
+func (x *Slicer) CalculateID(data io.Reader, attrs []object.Attribute) (oid.ID, error) {
+	x.hdr.SetAttributes(attrs...)
+	return CalculateID(x.hdr, data, x.opts)
+}
+
+// SetBufferPool sets allocator of the memory buffering object payload, see
+// [Options.SetBufferPool].
+func (x *Slicer) SetBufferPool(p *BufferPool) {
+	x.opts.SetBufferPool(p)
+}
+
+// SetCheckpointHandler sets handler of the upload progress, see
+// [Options.SetCheckpointHandler].
+func (x *Slicer) SetCheckpointHandler(f func(Checkpoint)) {
+	x.opts.SetCheckpointHandler(f)
+}
+
+// ResumePut works similar to [Slicer.Put] but continues the upload interrupted
+// after the given [Checkpoint]. Data MUST be continued from the
+// [Checkpoint.Offset], attributes MUST be the same as in the interrupted
+// upload. See also [Options.ResumeFrom].
+func (x *Slicer) ResumePut(ctx context.Context, data io.Reader, attrs []object.Attribute, cp Checkpoint) (oid.ID, error) {
+	opts := x.opts
+	opts.ResumeFrom(cp)
+
+	x.hdr.SetAttributes(attrs...)
+	return slice(ctx, x.w, x.hdr, data, x.signer, opts)
+}
+
+// InitResumedPut works similar to [Slicer.ResumePut] but provides
+// [PayloadWriter] allowing the caller to write the rest of data himself.
+func (x *Slicer) InitResumedPut(ctx context.Context, attrs []object.Attribute, cp Checkpoint) (*PayloadWriter, error) {
+	opts := x.opts
+	opts.ResumeFrom(cp)
+
+	x.hdr.SetAttributes(attrs...)
+	return initPayloadStream(ctx, x.w, x.hdr, x.signer, opts)
+}
+
 // Put works similar to [Slicer.Put], but allows flexible configuration of object header.
 // The method accepts [Options] for adjusting max object size, epoch, session token, etc.
 func Put(ctx context.Context, ow ObjectWriter, header object.Object, signer user.Signer, data io.Reader, opts Options) (oid.ID, error) {
@@ -164,7 +205,10 @@ func slice(ctx context.Context, ow ObjectWriter, header object.Object, data io.R
 	objectPayloadLimit := childPayloadSizeLimit(opts)
 
 	var n int
-	bChunk := make([]byte, objectPayloadLimit)
+	bChunk := make([]byte, bufferChunkSize)
+	if objectPayloadLimit < bufferChunkSize {
+		bChunk = bChunk[:objectPayloadLimit]
+	}
 
 	writer, err := initPayloadStream(ctx, ow, header, signer, opts)
 	if err != nil {
@@ -175,6 +219,7 @@ func slice(ctx context.Context, ow ObjectWriter, header object.Object, data io.R
 		n, err = data.Read(bChunk)
 		if err != nil {
 			if !errors.Is(err, io.EOF) {
+				writer.buf.reset()
 				return rootID, fmt.Errorf("read payload chunk: %w", err)
 			}
 
@@ -211,8 +256,62 @@ func headerData(header object.Object) (cid.ID, user.ID, error) {
 	return containerID, *owner, nil
 }
 
+// prepareHeader sets fields of the root object header which do not depend on
+// the payload according to the options. Returns container, owner and creation
+// epoch of the resulting objects.
+func prepareHeader(header *object.Object, opts Options) (cid.ID, user.ID, uint64, error) {
+	containerID, owner, err := headerData(*header)
+	if err != nil {
+		return cid.ID{}, user.ID{}, 0, err
+	}
+
+	if opts.sessionToken != nil {
+		header.SetSessionToken(opts.sessionToken)
+		// session issuer is a container owner.
+		owner = opts.sessionToken.Issuer()
+		header.SetOwnerID(&owner)
+	}
+
+	epoch := opts.currentNeoFSEpoch
+	if opts.resumeFrom != nil && len(opts.resumeFrom.children) > 0 {
+		epoch = opts.resumeFrom.epoch
+	}
+
+	header.SetCreationEpoch(epoch)
+	currentVersion := version.Current()
+	header.SetVersion(&currentVersion)
+
+	return containerID, owner, epoch, nil
+}
+
+// CalculateID calculates ID of the root object which is returned by [Put] for
+// the same arguments without storing the objects, so the reference may be
+// recorded before the upload completes. All the data is read from the given
+// stream. The ID does not depend on the payload size limit, i.e. whether the
+// object is sliced or not. The ID is the same only if the upload is done with
+// the same options in the same epoch (or resumed).
+func CalculateID(header object.Object, data io.Reader, opts Options) (oid.ID, error) {
+	if _, _, _, err := prepareHeader(&header, opts); err != nil {
+		return oid.ID{}, err
+	}
+
+	meta := newDynamicObjectMetadata(opts.withHomoChecksum)
+	if _, err := io.Copy(&meta, data); err != nil {
+		return oid.ID{}, fmt.Errorf("read payload: %w", err)
+	}
+
+	setPayloadMetadata(meta, &header)
+
+	id, err := header.CalculateID()
+	if err != nil {
+		return id, fmt.Errorf("calculate ID: %w", err)
+	}
+
+	return id, nil
+}
+
 func initPayloadStream(ctx context.Context, ow ObjectWriter, header object.Object, signer user.Signer, opts Options) (*PayloadWriter, error) {
-	containerID, owner, err := headerData(header)
+	containerID, owner, epoch, err := prepareHeader(&header, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -221,21 +320,15 @@ func initPayloadStream(ctx context.Context, ow ObjectWriter, header object.Objec
 
 	if opts.sessionToken != nil {
 		prm.WithinSession(*opts.sessionToken)
-		header.SetSessionToken(opts.sessionToken)
-		// session issuer is a container owner.
-		issuer := opts.sessionToken.Issuer()
-		owner = issuer
-		header.SetOwnerID(&owner)
 	}
 
-	header.SetCreationEpoch(opts.currentNeoFSEpoch)
+	resume := opts.resumeFrom != nil && len(opts.resumeFrom.children) > 0
 	currentVersion := version.Current()
-	header.SetVersion(&currentVersion)
 
 	var stubObject object.Object
 	stubObject.SetVersion(&currentVersion)
 	stubObject.SetContainerID(containerID)
-	stubObject.SetCreationEpoch(opts.currentNeoFSEpoch)
+	stubObject.SetCreationEpoch(epoch)
 	stubObject.SetType(object.TypeRegular)
 	stubObject.SetOwnerID(&owner)
 	stubObject.SetSessionToken(opts.sessionToken)
@@ -248,23 +341,69 @@ func initPayloadStream(ctx context.Context, ow ObjectWriter, header object.Objec
 		signer:            signer,
 		container:         containerID,
 		owner:             owner,
-		currentEpoch:      opts.currentNeoFSEpoch,
+		currentEpoch:      epoch,
 		sessionToken:      opts.sessionToken,
 		rootMeta:          newDynamicObjectMetadata(opts.withHomoChecksum),
 		childMeta:         newDynamicObjectMetadata(opts.withHomoChecksum),
 		prmObjectPutInit:  prm,
 		stubObject:        &stubObject,
+		checkpointHandler: opts.checkpointHandler,
 	}
 
 	maxObjSize := childPayloadSizeLimit(opts)
 
-	res.buf.Grow(int(maxObjSize))
+	pool := opts.bufferPool
+	if pool == nil {
+		pool = defaultBufferPool
+	} else if budget := pool.Budget(); budget > 0 && budget < maxObjSize {
+		return nil, fmt.Errorf("memory budget %d is less than object payload limit %d", budget, maxObjSize)
+	}
+
+	res.buf = newChunkedBuffer(ctx, pool, maxObjSize)
 	res.rootMeta.reset()
-	res.currentWriter = newLimitedWriter(io.MultiWriter(&res.buf, &res.rootMeta), maxObjSize)
+
+	if resume {
+		if err = res.resume(*opts.resumeFrom, maxObjSize); err != nil {
+			return nil, fmt.Errorf("resume from checkpoint: %w", err)
+		}
+	} else {
+		res.currentWriter = newLimitedWriter(io.MultiWriter(&res.buf, &res.rootMeta), maxObjSize)
+	}
 
 	return res, nil
 }
 
+// resume restores state of the split-chain upload from the Checkpoint.
+func (x *PayloadWriter) resume(cp Checkpoint, maxObjSize uint64) error {
+	if (cp.homomorphicChecksum != nil) != (x.rootMeta.homomorphicChecksum != nil) {
+		return errors.New("homomorphic checksum calculation differs from the interrupted upload")
+	}
+
+	cs, err := cp.restoreChecksum()
+	if err != nil {
+		return fmt.Errorf("restore checksum state: %w", err)
+	}
+
+	x.rootMeta.checksum = cs
+	x.rootMeta.length = cp.offset
+
+	if cp.homomorphicChecksum != nil {
+		var prefix [tz.Size]byte
+		copy(prefix[:], cp.homomorphicChecksum)
+		x.rootMeta.homomorphicChecksum = checksum.NewTZHasherFrom(prefix)
+	}
+
+	x.isHeaderWriteStep = false
+	x.withSplit = true
+	x.splitID = cp.splitID
+	x.writtenChildren = append([]oid.ID(nil), cp.children...)
+	x.checkpoint = &cp
+
+	x.currentWriter = newLimitedWriter(io.MultiWriter(&x.buf, &x.rootMeta, &x.childMeta), maxObjSize)
+
+	return nil
+}
+
 // PayloadWriter is a single-object payload stream provided by Slicer.
 type PayloadWriter struct {
 	ctx    context.Context
@@ -280,7 +419,7 @@ type PayloadWriter struct {
 	currentEpoch uint64
 	sessionToken *session.Object
 
-	buf bytes.Buffer
+	buf chunkedBuffer
 
 	rootMeta  dynamicObjectMetadata
 	childMeta dynamicObjectMetadata
@@ -293,12 +432,29 @@ type PayloadWriter struct {
 	writtenChildren  []oid.ID
 	prmObjectPutInit client.PrmObjectPutInit
 	stubObject       *object.Object
+
+	checkpoint        *Checkpoint
+	checkpointHandler func(Checkpoint)
 }
 
 // Write writes next chunk of the object data. Concatenation of all chunks forms
 // the payload of the final object. When the data is over, the PayloadWriter
 // should be closed.
+//
+// Written data is buffered until the next object is formed. The memory is
+// taken from the [BufferPool] (see [Options.SetBufferPool]) and held until the
+// object is sent, Close is called or Write fails. Abandoned PayloadWriter
+// holds the memory budget forever, so it MUST be closed.
 func (x *PayloadWriter) Write(chunk []byte) (int, error) {
+	n, err := x.write(chunk)
+	if err != nil {
+		x.buf.reset()
+	}
+
+	return n, err
+}
+
+func (x *PayloadWriter) write(chunk []byte) (int, error) {
 	if len(chunk) == 0 {
 		// not explicitly prohibited in the io.Writer documentation
 		return 0, nil
@@ -320,6 +476,10 @@ func (x *PayloadWriter) Write(chunk []byte) (int, error) {
 			return n, fmt.Errorf("write 1st child: %w", err)
 		}
 
+		if err = x.saveCheckpoint(); err != nil {
+			return n, err
+		}
+
 		x.currentWriter.reset(io.MultiWriter(&x.buf, &x.rootMeta, &x.childMeta))
 	} else {
 		err = x.writeIntermediateChild(x.ctx, x.childMeta)
@@ -327,21 +487,68 @@ func (x *PayloadWriter) Write(chunk []byte) (int, error) {
 			return n, fmt.Errorf("write next child: %w", err)
 		}
 
+		if err = x.saveCheckpoint(); err != nil {
+			return n, err
+		}
+
 		x.currentWriter.resetProgress()
 	}
 
-	x.buf.Reset()
+	x.buf.reset()
 	x.childMeta.reset()
 	x.isHeaderWriteStep = false
 
-	n2, err := x.Write(chunk[n:]) // here n > 0 so infinite recursion shouldn't occur
+	n2, err := x.write(chunk[n:]) // here n > 0 so infinite recursion shouldn't occur
 
 	return n + n2, err
 }
 
+// Checkpoint returns progress of the upload as of the last stored split-chain
+// element. Second value is false if no element has been stored yet, so there
+// is nothing to resume. Data written after the last stored element and not
+// stored yet is not included, see [Checkpoint.Offset].
+func (x *PayloadWriter) Checkpoint() (Checkpoint, bool) {
+	if x.checkpoint == nil {
+		return Checkpoint{}, false
+	}
+
+	return *x.checkpoint, true
+}
+
+// saveCheckpoint saves progress of the upload right after the split-chain
+// element is stored and passes it to the configured handler.
+func (x *PayloadWriter) saveCheckpoint() error {
+	st, err := x.rootMeta.checksum.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("save checksum state: %w", err)
+	}
+
+	cp := Checkpoint{
+		splitID:       x.splitID,
+		children:      append([]oid.ID(nil), x.writtenChildren...),
+		offset:        x.rootMeta.length,
+		epoch:         x.currentEpoch,
+		checksumState: st,
+	}
+
+	if x.rootMeta.homomorphicChecksum != nil {
+		cp.homomorphicChecksum = x.rootMeta.homomorphicChecksum.Sum(nil)
+	}
+
+	x.checkpoint = &cp
+
+	if x.checkpointHandler != nil {
+		x.checkpointHandler(cp)
+	}
+
+	return nil
+}
+
 // Close finalizes object with written payload data, saves the object and closes
 // the stream. Reference to the stored object can be obtained by ID method.
 func (x *PayloadWriter) Close() error {
+	defer x.buf.reset()
+
 	if x.withSplit {
 		return x.writeLastChild(x.ctx, x.childMeta, x.setID)
 	}
@@ -410,9 +617,9 @@ func (x *PayloadWriter) _writeChild(ctx context.Context, meta dynamicObjectMetad
 	// The first object must be a header. Note: if object is less than MaxObjectSize, we don't need to slice it.
 	// Thus, we have a legitimate situation when, last == true and x.isHeaderWriteStep == true.
 	if x.isHeaderWriteStep {
-		id, err = writeInMemObject(ctx, x.signer, x.stream, x.headerObject, x.buf.Bytes(), meta, x.prmObjectPutInit)
+		id, err = writeInMemObject(ctx, x.signer, x.stream, x.headerObject, &x.buf, meta, x.prmObjectPutInit)
 	} else {
-		id, err = writeInMemObject(ctx, x.signer, x.stream, obj, x.buf.Bytes(), meta, x.prmObjectPutInit)
+		id, err = writeInMemObject(ctx, x.signer, x.stream, obj, &x.buf, meta, x.prmObjectPutInit)
 	}
 
 	if err != nil {
@@ -438,7 +645,9 @@ func (x *PayloadWriter) _writeChild(ctx context.Context, meta dynamicObjectMetad
 	return nil
 }
 
-func flushObjectMetadata(signer neofscrypto.Signer, meta dynamicObjectMetadata, header *object.Object) (oid.ID, error) {
+// setPayloadMetadata sets payload size and checksums accumulated in meta to
+// the header.
+func setPayloadMetadata(meta dynamicObjectMetadata, header *object.Object) {
 	var cs checksum.Checksum
 
 	var csBytes [sha256.Size]byte
@@ -456,6 +665,10 @@ func flushObjectMetadata(signer neofscrypto.Signer, meta dynamicObjectMetadata,
 	}
 
 	header.SetPayloadSize(meta.length)
+}
+
+func flushObjectMetadata(signer neofscrypto.Signer, meta dynamicObjectMetadata, header *object.Object) (oid.ID, error) {
+	setPayloadMetadata(meta, header)
 
 	id, err := header.CalculateID()
 	if err != nil {
@@ -481,7 +694,7 @@ func flushObjectMetadata(signer neofscrypto.Signer, meta dynamicObjectMetadata,
 	return id, nil
 }
 
-func writeInMemObject(ctx context.Context, signer user.Signer, w ObjectWriter, header object.Object, payload []byte, meta dynamicObjectMetadata, prm client.PrmObjectPutInit) (oid.ID, error) {
+func writeInMemObject(ctx context.Context, signer user.Signer, w ObjectWriter, header object.Object, payload io.WriterTo, meta dynamicObjectMetadata, prm client.PrmObjectPutInit) (oid.ID, error) {
 	var (
 		id    oid.ID
 		err   error
@@ -502,9 +715,10 @@ func writeInMemObject(ctx context.Context, signer user.Signer, w ObjectWriter, h
 		return id, fmt.Errorf("init data stream for next object: %w", err)
 	}
 
-	_, err = stream.Write(payload)
-	if err != nil {
-		return id, fmt.Errorf("write object payload: %w", err)
+	if payload != nil {
+		if _, err = payload.WriteTo(stream); err != nil {
+			return id, fmt.Errorf("write object payload: %w", err)
+		}
 	}
 
 	if c, ok := stream.(io.Closer); ok {
@@ -8,6 +8,7 @@ import (
 	cryptorand "crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"hash"
 	"io"
@@ -785,3 +786,198 @@ func TestSlicedObjectsHaveSplitID(t *testing.T) {
 		}
 	})
 }
+
+// interruptingWriter is a slicedObjectChecker failing after the specified
+// number of written objects.
+type interruptingWriter struct {
+	*slicedObjectChecker
+	left int
+}
+
+func (x *interruptingWriter) ObjectPutInit(ctx context.Context, hdr object.Object, signer user.Signer, prm client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	if x.left == 0 {
+		return nil, errors.New("interrupted")
+	}
+	x.left--
+	return x.slicedObjectChecker.ObjectPutInit(ctx, hdr, signer, prm)
+}
+
+func TestSlicer_ResumePut(t *testing.T) {
+	const limit = 100
+	const size = 10*limit + limit/2
+	const interruptAfter = 4
+
+	for _, withHomo := range []bool{false, true} {
+		t.Run(fmt.Sprintf("homomorphic=%t", withHomo), func(t *testing.T) {
+			ctx := context.Background()
+
+			in, opts := randomInput(t, size, limit)
+			for in.withHomo != withHomo {
+				in, opts = randomInput(t, size, limit)
+			}
+
+			checker := &slicedObjectChecker{
+				opts:           opts,
+				tb:             t,
+				input:          in,
+				chainCollector: newChainCollector(t),
+			}
+
+			w := &interruptingWriter{slicedObjectChecker: checker, left: interruptAfter}
+
+			s, err := slicer.New(ctx, w, in.signer, in.container, in.owner, in.sessionToken)
+			require.NoError(t, err)
+
+			var cps []slicer.Checkpoint
+			s.SetCheckpointHandler(func(cp slicer.Checkpoint) {
+				cps = append(cps, cp)
+			})
+
+			_, err = s.Put(ctx, bytes.NewReader(in.payload), in.attributes)
+			require.Error(t, err)
+			require.Len(t, cps, interruptAfter)
+
+			cp := cps[len(cps)-1]
+			require.EqualValues(t, interruptAfter*limit, cp.Offset())
+			require.Len(t, cp.Children(), interruptAfter)
+
+			var restored slicer.Checkpoint
+			require.NoError(t, restored.Unmarshal(cp.Marshal()))
+			require.Equal(t, cp, restored)
+
+			w.left = -1
+
+			rootID, err := s.ResumePut(ctx, bytes.NewReader(in.payload[restored.Offset():]), in.attributes, restored)
+			require.NoError(t, err)
+
+			checker.chainCollector.verify(in, rootID)
+		})
+	}
+
+	t.Run("PayloadWriter", func(t *testing.T) {
+		ctx := context.Background()
+		in, opts := randomInput(t, size, limit)
+
+		checker := &slicedObjectChecker{
+			opts:           opts,
+			tb:             t,
+			input:          in,
+			chainCollector: newChainCollector(t),
+		}
+
+		w := &interruptingWriter{slicedObjectChecker: checker, left: interruptAfter}
+
+		s, err := slicer.New(ctx, w, in.signer, in.container, in.owner, in.sessionToken)
+		require.NoError(t, err)
+
+		pw, err := s.InitPut(ctx, in.attributes)
+		require.NoError(t, err)
+
+		_, ok := pw.Checkpoint()
+		require.False(t, ok)
+
+		_, err = pw.Write(in.payload)
+		require.Error(t, err)
+
+		cp, ok := pw.Checkpoint()
+		require.True(t, ok)
+		require.EqualValues(t, interruptAfter*limit, cp.Offset())
+
+		w.left = -1
+
+		pw, err = s.InitResumedPut(ctx, in.attributes, cp)
+		require.NoError(t, err)
+
+		_, err = pw.Write(in.payload[cp.Offset():])
+		require.NoError(t, err)
+		require.NoError(t, pw.Close())
+
+		checker.chainCollector.verify(in, pw.ID())
+	})
+
+	t.Run("homomorphic hashing mismatch", func(t *testing.T) {
+		ctx := context.Background()
+		in, opts := randomInput(t, size, limit)
+		for !in.withHomo {
+			in, opts = randomInput(t, size, limit)
+		}
+
+		checker := &slicedObjectChecker{
+			opts:           opts,
+			tb:             t,
+			input:          in,
+			chainCollector: newChainCollector(t),
+		}
+
+		w := &interruptingWriter{slicedObjectChecker: checker, left: interruptAfter}
+
+		var hdr object.Object
+		hdr.SetSessionToken(opts.Session())
+		hdr.SetContainerID(in.container)
+		hdr.SetOwnerID(&in.owner)
+
+		pw, err := slicer.InitPut(ctx, w, hdr, in.signer, opts)
+		require.NoError(t, err)
+
+		_, err = pw.Write(in.payload)
+		require.Error(t, err)
+
+		cp, ok := pw.Checkpoint()
+		require.True(t, ok)
+
+		var noHomoOpts slicer.Options
+		noHomoOpts.SetObjectPayloadLimit(limit)
+		noHomoOpts.SetSession(opts.Session())
+		noHomoOpts.ResumeFrom(cp)
+
+		_, err = slicer.InitPut(ctx, w, hdr, in.signer, noHomoOpts)
+		require.Error(t, err)
+	})
+}
+
+func TestCalculateID(t *testing.T) {
+	const limit = 100
+
+	for _, size := range []uint64{0, limit - 1, limit, 3*limit + 1} {
+		t.Run(fmt.Sprintf("size=%d", size), func(t *testing.T) {
+			ctx := context.Background()
+			in, opts := randomInput(t, size, limit)
+
+			checker := &slicedObjectChecker{
+				opts:           opts,
+				tb:             t,
+				input:          in,
+				chainCollector: newChainCollector(t),
+			}
+
+			s, err := slicer.New(ctx, checker, in.signer, in.container, in.owner, in.sessionToken)
+			require.NoError(t, err)
+
+			expected, err := s.CalculateID(bytes.NewReader(in.payload), in.attributes)
+			require.NoError(t, err)
+
+			rootID, err := s.Put(ctx, bytes.NewReader(in.payload), in.attributes)
+			require.NoError(t, err)
+			require.Equal(t, expected, rootID)
+			checker.chainCollector.verify(in, rootID)
+
+			var hdr object.Object
+			hdr.SetContainerID(in.container)
+			hdr.SetOwnerID(&in.owner)
+			hdr.SetAttributes(in.attributes...)
+
+			id, err := slicer.CalculateID(hdr, bytes.NewReader(in.payload), opts)
+			require.NoError(t, err)
+
+			checker.chainCollector = newChainCollector(t)
+			rootID, err = slicer.Put(ctx, checker, hdr, in.signer, bytes.NewReader(in.payload), opts)
+			require.NoError(t, err)
+			require.Equal(t, id, rootID)
+		})
+	}
+
+	t.Run("incomplete header", func(t *testing.T) {
+		_, err := slicer.CalculateID(object.Object{}, bytes.NewReader(nil), slicer.Options{})
+		require.ErrorIs(t, err, slicer.ErrIncompleteHeader)
+	})
+}
@@ -0,0 +1,354 @@
+package object
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ErrIncompleteSplitChain is returned by SplitChain.Parts when the collected
+// headers are not enough to restore the full split-chain. Missing elements are
+// listed by SplitChain.Missing.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrIncompleteSplitChain = errors.New("incomplete split-chain")
+
+// SplitChain accumulates headers of the objects forming split-chain of the
+// single root object and restores the chain structure from them: the root
+// object header, the linking object and the ordered list of the parts.
+// SplitChain does not fetch anything itself, so it may be used with any data
+// source: NeoFS client, storage node engine, local dump, etc. The typical
+// usage is:
+//
+//	chain := object.NewSplitChain()
+//	err := chain.AddSplitInfo(si)
+//	// handle error
+//	for missing := chain.Missing(); len(missing) > 0; missing = chain.Missing() {
+//		// read headers of the missing objects and pass them to chain.Add,
+//		// stop if some object is unavailable
+//	}
+//	parts, err := chain.Parts()
+//
+// Instances MUST be created using NewSplitChain. SplitChain is not
+// thread-safe.
+type SplitChain struct {
+	splitID *SplitID
+
+	parentID *oid.ID
+
+	parent *Object
+
+	link *oid.ID
+
+	// children listed by the linking object
+	linkChildren []oid.ID
+
+	last *oid.ID
+
+	elems map[oid.ID]Object
+
+	prev map[oid.ID]oid.ID
+
+	// referenced IDs in order of discovery
+	refs    []oid.ID
+	refsSet map[oid.ID]struct{}
+}
+
+// NewSplitChain constructs new SplitChain without any elements.
+func NewSplitChain() *SplitChain {
+	return &SplitChain{
+		elems:   make(map[oid.ID]Object),
+		prev:    make(map[oid.ID]oid.ID),
+		refsSet: make(map[oid.ID]struct{}),
+	}
+}
+
+func (x *SplitChain) reference(id oid.ID) {
+	if _, ok := x.refsSet[id]; !ok {
+		x.refsSet[id] = struct{}{}
+		x.refs = append(x.refs, id)
+	}
+}
+
+func (x *SplitChain) setSplitID(splitID *SplitID) error {
+	if splitID == nil {
+		return nil
+	}
+
+	if x.splitID == nil {
+		x.splitID = splitID
+		return nil
+	}
+
+	if !bytes.Equal(x.splitID.ToV2(), splitID.ToV2()) {
+		return fmt.Errorf("split ID %s differs from %s", splitID, x.splitID)
+	}
+
+	return nil
+}
+
+func (x *SplitChain) setLink(id oid.ID) error {
+	if x.link != nil && *x.link != id {
+		return fmt.Errorf("linking object %s differs from %s", id, *x.link)
+	}
+
+	x.link = &id
+	x.reference(id)
+
+	return nil
+}
+
+func (x *SplitChain) setLast(id oid.ID) error {
+	if x.last != nil && *x.last != id {
+		return fmt.Errorf("last part %s differs from %s", id, *x.last)
+	}
+
+	x.last = &id
+	x.reference(id)
+
+	return nil
+}
+
+// AddSplitInfo adds references of the split-chain returned by NeoFS on the
+// root object request. AddSplitInfo returns an error if the SplitInfo
+// contradicts the data added before.
+func (x *SplitChain) AddSplitInfo(si SplitInfo) error {
+	if err := x.setSplitID(si.SplitID()); err != nil {
+		return err
+	}
+
+	if link, ok := si.Link(); ok {
+		if err := x.setLink(link); err != nil {
+			return err
+		}
+	}
+
+	if last, ok := si.LastPart(); ok {
+		if err := x.setLast(last); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Add adds header of the split-chain element with the given ID. Linking
+// object is detected by the children list, the last part - by the root object
+// header. The first part referenced by the added elements may have no split
+// header. Add returns an error if the object is not a split-chain element or
+// contradicts the elements added before (e.g. belongs to another root object).
+// Repeated addition of the same element is a no-op.
+func (x *SplitChain) Add(id oid.ID, hdr Object) error {
+	if _, ok := x.elems[id]; ok {
+		return nil
+	}
+
+	if !hdr.HasParent() {
+		// slicer omits split header in the first part
+		if !x.isFirst(id) {
+			return fmt.Errorf("object %s is not a split-chain element", id)
+		}
+
+		x.elems[id] = hdr
+
+		return nil
+	}
+
+	if err := x.setSplitID(hdr.SplitID()); err != nil {
+		return fmt.Errorf("object %s: %w", id, err)
+	}
+
+	if parID, ok := hdr.ParentID(); ok {
+		if x.parentID != nil && *x.parentID != parID {
+			return fmt.Errorf("object %s: parent %s differs from %s", id, parID, *x.parentID)
+		}
+
+		x.parentID = &parID
+	}
+
+	par := hdr.Parent()
+
+	children := hdr.Children()
+	if len(children) > 0 {
+		if err := x.setLink(id); err != nil {
+			return err
+		}
+
+		x.linkChildren = children
+		for i := range children {
+			x.reference(children[i])
+		}
+	} else if par != nil {
+		if err := x.setLast(id); err != nil {
+			return err
+		}
+	}
+
+	if par != nil && x.parent == nil {
+		x.parent = par
+	}
+
+	if prev, ok := hdr.PreviousID(); ok {
+		x.prev[id] = prev
+		x.reference(prev)
+	}
+
+	x.reference(id)
+	x.elems[id] = hdr
+
+	return nil
+}
+
+// isFirst checks whether the object is referenced as the first part by the
+// added data.
+func (x *SplitChain) isFirst(id oid.ID) bool {
+	if len(x.linkChildren) > 0 {
+		return x.linkChildren[0] == id
+	}
+
+	for _, prev := range x.prev {
+		if prev == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SplitID returns split ID of the chain, nil if unknown.
+func (x SplitChain) SplitID() *SplitID {
+	return x.splitID
+}
+
+// ParentID returns ID of the root object. The second return value indicates
+// whether the ID is known.
+func (x SplitChain) ParentID() (oid.ID, bool) {
+	if x.parentID == nil {
+		return oid.ID{}, false
+	}
+
+	return *x.parentID, true
+}
+
+// Parent returns header of the root object carried by the last part or the
+// linking object, nil if no such element has been added yet.
+func (x SplitChain) Parent() *Object {
+	return x.parent
+}
+
+// Link returns ID of the linking object. The second return value indicates
+// whether the ID is known.
+func (x SplitChain) Link() (oid.ID, bool) {
+	if x.link == nil {
+		return oid.ID{}, false
+	}
+
+	return *x.link, true
+}
+
+// LastPart returns ID of the last part. The second return value indicates
+// whether the ID is known.
+func (x SplitChain) LastPart() (oid.ID, bool) {
+	if x.last == nil {
+		return oid.ID{}, false
+	}
+
+	return *x.last, true
+}
+
+// Header returns header of the added element with the given ID. The second
+// return value indicates whether the element has been added.
+func (x SplitChain) Header(id oid.ID) (Object, bool) {
+	hdr, ok := x.elems[id]
+	return hdr, ok
+}
+
+// Previous returns ID of the part preceding the given one. The second return
+// value indicates whether the given part has been added and references the
+// previous one.
+func (x SplitChain) Previous(id oid.ID) (oid.ID, bool) {
+	prev, ok := x.prev[id]
+	return prev, ok
+}
+
+// Missing returns IDs of the objects referenced by the added data but not
+// added themselves, in order of their discovery. Empty result means that all
+// known elements are added, but the chain may still be incomplete, see Parts.
+func (x SplitChain) Missing() []oid.ID {
+	var res []oid.ID
+
+	for i := range x.refs {
+		if _, ok := x.elems[x.refs[i]]; !ok {
+			res = append(res, x.refs[i])
+		}
+	}
+
+	return res
+}
+
+// Parts returns ordered list of the split-chain parts, i.e. objects carrying
+// the root payload. The list is taken from the linking object if it has been
+// added, otherwise the parts are walked from the last one by the previous
+// references. Parts returns ErrIncompleteSplitChain if the list cannot be
+// restored from the added data yet.
+func (x SplitChain) Parts() ([]oid.ID, error) {
+	if len(x.linkChildren) > 0 {
+		return append([]oid.ID(nil), x.linkChildren...), nil
+	}
+
+	if x.last == nil {
+		return nil, fmt.Errorf("%w: neither linking object nor last part is known", ErrIncompleteSplitChain)
+	}
+
+	res := []oid.ID{*x.last}
+	mRes := map[oid.ID]struct{}{*x.last: {}}
+
+	for id := *x.last; ; {
+		if _, ok := x.elems[id]; !ok {
+			return nil, fmt.Errorf("%w: missing part %s", ErrIncompleteSplitChain, id)
+		}
+
+		prev, ok := x.prev[id]
+		if !ok {
+			break
+		}
+
+		if _, ok = mRes[prev]; ok {
+			return nil, fmt.Errorf("split-chain is cycled on %s", prev)
+		}
+
+		mRes[prev] = struct{}{}
+		res = append(res, prev)
+		id = prev
+	}
+
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return res, nil
+}
+
+// Complete checks whether all split-chain parts and the root object header
+// are available.
+func (x SplitChain) Complete() bool {
+	if x.parent == nil {
+		return false
+	}
+
+	parts, err := x.Parts()
+	if err != nil {
+		return false
+	}
+
+	for i := range parts {
+		if _, ok := x.elems[parts[i]]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
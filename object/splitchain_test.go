@@ -0,0 +1,189 @@
+package object_test
+
+import (
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testSplitChain struct {
+	rootID oid.ID
+	root   *object.Object
+	ids    []oid.ID
+	parts  []object.Object
+	linkID oid.ID
+	link   object.Object
+}
+
+func newTestSplitChain(n int) testSplitChain {
+	var res testSplitChain
+	splitID := object.NewSplitID()
+
+	res.rootID = oidtest.ID()
+	res.root = object.New()
+	res.root.SetID(res.rootID)
+	res.root.SetContainerID(cidtest.ID())
+
+	for i := 0; i < n; i++ {
+		var part object.Object
+		part.SetSplitID(splitID)
+
+		if i > 0 {
+			part.SetPreviousID(res.ids[i-1])
+		}
+
+		if i == n-1 {
+			part.SetParent(res.root)
+			part.SetParentID(res.rootID)
+		}
+
+		res.ids = append(res.ids, oidtest.ID())
+		res.parts = append(res.parts, part)
+	}
+
+	res.linkID = oidtest.ID()
+	res.link.SetSplitID(splitID)
+	res.link.SetParent(res.root)
+	res.link.SetParentID(res.rootID)
+	res.link.SetChildren(res.ids...)
+
+	return res
+}
+
+func TestSplitChain(t *testing.T) {
+	t.Run("by previous", func(t *testing.T) {
+		tc := newTestSplitChain(3)
+		chain := object.NewSplitChain()
+
+		var si object.SplitInfo
+		si.SetSplitID(tc.parts[0].SplitID())
+		si.SetLastPart(tc.ids[2])
+		require.NoError(t, chain.AddSplitInfo(si))
+
+		_, err := chain.Parts()
+		require.ErrorIs(t, err, object.ErrIncompleteSplitChain)
+		require.False(t, chain.Complete())
+
+		for i := len(tc.ids) - 1; i >= 0; i-- {
+			require.Equal(t, []oid.ID{tc.ids[i]}, chain.Missing())
+			require.NoError(t, chain.Add(tc.ids[i], tc.parts[i]))
+		}
+
+		require.Empty(t, chain.Missing())
+		require.True(t, chain.Complete())
+
+		parts, err := chain.Parts()
+		require.NoError(t, err)
+		require.Equal(t, tc.ids, parts)
+
+		parID, ok := chain.ParentID()
+		require.True(t, ok)
+		require.Equal(t, tc.rootID, parID)
+		require.NotNil(t, chain.Parent())
+		last, ok := chain.LastPart()
+		require.True(t, ok)
+		require.Equal(t, tc.ids[2], last)
+		_, ok = chain.Link()
+		require.False(t, ok)
+
+		prev, ok := chain.Previous(tc.ids[1])
+		require.True(t, ok)
+		require.Equal(t, tc.ids[0], prev)
+		_, ok = chain.Previous(tc.ids[0])
+		require.False(t, ok)
+
+		_, ok = chain.Header(tc.ids[1])
+		require.True(t, ok)
+	})
+
+	t.Run("first part without split header", func(t *testing.T) {
+		tc := newTestSplitChain(3)
+		chain := object.NewSplitChain()
+
+		var first object.Object
+		require.Error(t, chain.Add(tc.ids[0], first))
+
+		require.NoError(t, chain.Add(tc.ids[2], tc.parts[2]))
+		require.Error(t, chain.Add(tc.ids[0], first))
+		require.NoError(t, chain.Add(tc.ids[1], tc.parts[1]))
+		require.NoError(t, chain.Add(tc.ids[0], first))
+		require.Empty(t, chain.Missing())
+		require.True(t, chain.Complete())
+
+		parts, err := chain.Parts()
+		require.NoError(t, err)
+		require.Equal(t, tc.ids, parts)
+
+		chain = object.NewSplitChain()
+		require.NoError(t, chain.Add(tc.linkID, tc.link))
+		require.Error(t, chain.Add(tc.ids[1], first))
+		require.NoError(t, chain.Add(tc.ids[0], first))
+	})
+
+	t.Run("by link", func(t *testing.T) {
+		tc := newTestSplitChain(3)
+		chain := object.NewSplitChain()
+
+		var si object.SplitInfo
+		si.SetLink(tc.linkID)
+		require.NoError(t, chain.AddSplitInfo(si))
+		require.Equal(t, []oid.ID{tc.linkID}, chain.Missing())
+
+		require.NoError(t, chain.Add(tc.linkID, tc.link))
+
+		parts, err := chain.Parts()
+		require.NoError(t, err)
+		require.Equal(t, tc.ids, parts)
+		require.Equal(t, tc.ids, chain.Missing())
+		require.False(t, chain.Complete())
+
+		for i := range tc.ids {
+			require.NoError(t, chain.Add(tc.ids[i], tc.parts[i]))
+		}
+
+		require.Empty(t, chain.Missing())
+		require.True(t, chain.Complete())
+	})
+
+	t.Run("without references", func(t *testing.T) {
+		_, err := object.NewSplitChain().Parts()
+		require.ErrorIs(t, err, object.ErrIncompleteSplitChain)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		tc := newTestSplitChain(2)
+		tc.parts[0].SetPreviousID(tc.ids[1])
+
+		chain := object.NewSplitChain()
+		require.NoError(t, chain.Add(tc.ids[1], tc.parts[1]))
+		require.NoError(t, chain.Add(tc.ids[0], tc.parts[0]))
+
+		_, err := chain.Parts()
+		require.Error(t, err)
+		require.NotErrorIs(t, err, object.ErrIncompleteSplitChain)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tc := newTestSplitChain(2)
+
+		chain := object.NewSplitChain()
+		require.Error(t, chain.Add(oidtest.ID(), *object.New()))
+
+		require.NoError(t, chain.Add(tc.ids[1], tc.parts[1]))
+		require.NoError(t, chain.Add(tc.ids[1], tc.parts[1]))
+
+		other := newTestSplitChain(2)
+		require.Error(t, chain.Add(other.ids[0], other.parts[0]), "split ID")
+
+		other.parts[1].SetSplitID(tc.parts[1].SplitID())
+		require.Error(t, chain.Add(other.ids[1], other.parts[1]), "parent")
+
+		var si object.SplitInfo
+		si.SetLastPart(tc.ids[0])
+		require.Error(t, chain.AddSplitInfo(si), "last part")
+	})
+}
@@ -0,0 +1,207 @@
+package objecttest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+)
+
+// Params groups parameters of the objects generated by ValidObject and
+// SplitChain. Zero Params are valid and mean empty payload, random container
+// and signer.
+type Params struct {
+	// PayloadSize is a size of the random payload in bytes.
+	PayloadSize uint64
+
+	// Attributes is a number of the random user attributes added to the
+	// FileName, Timestamp and Content-Type ones.
+	Attributes int
+
+	// Homomorphic enables homomorphic payload checksum.
+	Homomorphic bool
+
+	// Container is a container of the objects, random if zero.
+	Container cid.ID
+
+	// Signer signs the objects, its user is the owner. Random if nil.
+	Signer user.Signer
+
+	// CreationEpoch is an epoch of the object creation.
+	CreationEpoch uint64
+}
+
+// Payload returns random payload of the given size.
+func Payload(tb testing.TB, size uint64) []byte {
+	b := make([]byte, size)
+	_, err := rand.Read(b)
+	require.NoError(tb, err)
+
+	return b
+}
+
+// Attributes returns realistic object attributes: FileName, Timestamp and
+// Content-Type with random values followed by n random user attributes.
+func Attributes(tb testing.TB, n int) []object.Attribute {
+	name := hex.EncodeToString(Payload(tb, 8))
+
+	res := make([]object.Attribute, 3, 3+n)
+	res[0].SetKey(object.AttributeFileName)
+	res[0].SetValue(name + ".bin")
+	res[1].SetKey(object.AttributeTimestamp)
+	res[1].SetValue(strconv.FormatInt(time.Now().Unix(), 10))
+	res[2].SetKey(object.AttributeContentType)
+	res[2].SetValue("application/octet-stream")
+
+	for i := 0; i < n; i++ {
+		var a object.Attribute
+		a.SetKey(fmt.Sprintf("Attribute-%d", i))
+		a.SetValue(hex.EncodeToString(Payload(tb, 16)))
+
+		res = append(res, a)
+	}
+
+	return res
+}
+
+// ValidObject returns object.Object with the random payload and attributes
+// according to the parameters. The object is ready to be stored in NeoFS:
+// its payload checksums, ID and signature are valid.
+func ValidObject(tb testing.TB, prm Params) *object.Object {
+	prm = fillParams(tb, prm)
+
+	obj := newHeader(prm)
+	obj.SetAttributes(Attributes(tb, prm.Attributes)...)
+	setPayload(obj, Payload(tb, prm.PayloadSize), prm.Homomorphic)
+	sign(tb, obj, prm.Signer)
+
+	return obj
+}
+
+// Chain is a split-chain returned by SplitChain.
+type Chain struct {
+	// Parent is a header of the root object without payload.
+	Parent *object.Object
+
+	// Parts are the split-chain parts in order.
+	Parts []*object.Object
+
+	// Link is the linking object.
+	Link *object.Object
+
+	// Payload is a full payload of the root object.
+	Payload []byte
+}
+
+// SplitChain returns the root object with the random payload and attributes
+// according to the parameters sliced into parts of the given payload size
+// according to the legacy split scheme. All objects are valid and ready to be
+// stored in NeoFS. PartSize MUST be positive.
+func SplitChain(tb testing.TB, prm Params, partSize uint64) Chain {
+	require.Positive(tb, partSize)
+
+	prm = fillParams(tb, prm)
+
+	var res Chain
+	res.Payload = Payload(tb, prm.PayloadSize)
+
+	res.Parent = newHeader(prm)
+	res.Parent.SetAttributes(Attributes(tb, prm.Attributes)...)
+	setPayload(res.Parent, res.Payload, prm.Homomorphic)
+	sign(tb, res.Parent, prm.Signer)
+	res.Parent.SetPayload(nil)
+
+	parID, _ := res.Parent.ID()
+	splitID := object.NewSplitID()
+
+	var ids []oid.ID
+
+	for off := uint64(0); off == 0 || off < prm.PayloadSize; off += partSize {
+		end := off + partSize
+		if end > prm.PayloadSize {
+			end = prm.PayloadSize
+		}
+
+		part := newHeader(prm)
+		part.SetSplitID(splitID)
+		if len(ids) > 0 {
+			part.SetPreviousID(ids[len(ids)-1])
+		}
+
+		if end == prm.PayloadSize {
+			part.SetParent(res.Parent)
+			part.SetParentID(parID)
+		}
+
+		setPayload(part, res.Payload[off:end], prm.Homomorphic)
+		sign(tb, part, prm.Signer)
+
+		id, _ := part.ID()
+		ids = append(ids, id)
+		res.Parts = append(res.Parts, part)
+	}
+
+	res.Link = newHeader(prm)
+	res.Link.SetSplitID(splitID)
+	res.Link.SetParent(res.Parent)
+	res.Link.SetParentID(parID)
+	res.Link.SetChildren(ids...)
+	setPayload(res.Link, nil, prm.Homomorphic)
+	sign(tb, res.Link, prm.Signer)
+
+	return res
+}
+
+func fillParams(tb testing.TB, prm Params) Params {
+	if prm.Container == (cid.ID{}) {
+		prm.Container = cidtest.ID()
+	}
+
+	if prm.Signer == nil {
+		prm.Signer = test.RandomSignerRFC6979(tb)
+	}
+
+	return prm
+}
+
+func newHeader(prm Params) *object.Object {
+	ver := version.Current()
+	owner := prm.Signer.UserID()
+
+	obj := object.New()
+	obj.SetVersion(&ver)
+	obj.SetContainerID(prm.Container)
+	obj.SetOwnerID(&owner)
+	obj.SetCreationEpoch(prm.CreationEpoch)
+	obj.SetType(object.TypeRegular)
+
+	return obj
+}
+
+func setPayload(obj *object.Object, payload []byte, homomorphic bool) {
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.CalculateAndSetPayloadChecksum()
+
+	if homomorphic {
+		var cs checksum.Checksum
+		checksum.Calculate(&cs, checksum.TZ, payload)
+		obj.SetPayloadHomomorphicHash(cs)
+	}
+}
+
+func sign(tb testing.TB, obj *object.Object, signer user.Signer) {
+	require.NoError(tb, obj.SetIDWithSignature(signer))
+}
@@ -1,9 +1,16 @@
 package object
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	"github.com/nspcc-dev/neofs-api-go/v2/tombstone"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
 )
 
 // Tombstone represents v2-compatible tombstone structure.
@@ -140,3 +147,89 @@ func (t *Tombstone) MarshalJSON() ([]byte, error) {
 func (t *Tombstone) UnmarshalJSON(data []byte) error {
 	return (*tombstone.Tombstone)(t).UnmarshalJSON(data)
 }
+
+// WriteTombstone writes [Tombstone] to the [Object], sets its type to
+// [TypeTombstone] and sets [AttributeExpirationEpoch] to the
+// [Tombstone.ExpirationEpoch] as required by NeoFS.
+//
+// See also ReadTombstone.
+func (o *Object) WriteTombstone(t Tombstone) {
+	b, _ := t.Marshal()
+
+	o.SetType(TypeTombstone)
+	o.SetPayload(b)
+	o.SetPayloadSize(uint64(len(b)))
+	o.SetExpirationEpoch(t.ExpirationEpoch())
+}
+
+// ReadTombstone reads [Tombstone] from the [Object]. The tombstone must not be
+// nil. Returns an error describing incorrect format. Makes sense only if
+// object has [TypeTombstone] type.
+//
+// See also [Object.WriteTombstone].
+func (o *Object) ReadTombstone(t *Tombstone) error {
+	return t.Unmarshal(o.Payload())
+}
+
+// NewTombstoneObject constructs tombstone object deleting objects with the
+// given addresses after the specified epoch. All addresses MUST belong to the
+// same container, duplicates are ignored. Resulting object is filled with the
+// container, owner, current version, tombstone payload and its checksum, so
+// it is ready to be signed and put into NeoFS.
+//
+// If session token is specified, it MUST be issued by the owner for
+// [session.VerbObjectDelete] in the container of the members and apply to all
+// of them. The token is attached to the object.
+func NewTombstoneObject(members []oid.Address, expirationEpoch uint64, owner user.ID, sessionToken *session.Object) (*Object, error) {
+	cnr, ids, err := splitMembers(members)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionToken != nil {
+		if !sessionToken.Issuer().Equals(owner) {
+			return nil, errors.New("session token is not issued by the owner")
+		}
+
+		if !sessionToken.AssertVerb(session.VerbObjectDelete) {
+			return nil, errors.New("session token is not for the object deletion")
+		}
+
+		if !sessionToken.AssertContainer(cnr) {
+			return nil, errors.New("session token is not for the container of the members")
+		}
+
+		for i := range ids {
+			if !sessionToken.AssertObject(ids[i]) {
+				return nil, fmt.Errorf("session token does not apply to object %s", ids[i])
+			}
+		}
+	}
+
+	t := NewTombstone()
+	t.SetExpirationEpoch(expirationEpoch)
+	t.SetMembers(ids)
+
+	ver := version.Current()
+
+	obj := New()
+	obj.SetVersion(&ver)
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(&owner)
+	obj.SetSessionToken(sessionToken)
+	obj.WriteTombstone(*t)
+	obj.CalculateAndSetPayloadChecksum()
+
+	return obj, nil
+}
+
+// splitMembers returns container and deduplicated IDs of the objects with the
+// given addresses. Returns an error if addresses are empty or belong to
+// different containers.
+func splitMembers(members []oid.Address) (cid.ID, []oid.ID, error) {
+	if len(members) == 0 {
+		return cid.ID{}, nil, errors.New("missing members")
+	}
+
+	return oid.NewAddressSet(members...).SingleContainer()
+}
@@ -6,7 +6,12 @@ import (
 	"testing"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/tombstone"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -93,3 +98,102 @@ func TestNewTombstone(t *testing.T) {
 		require.Zero(t, tsV2.GetExpirationEpoch())
 	})
 }
+
+func TestNewTombstoneObject(t *testing.T) {
+	cnr := cidtest.ID()
+	signer := test.RandomSignerRFC6979(t)
+	owner := signer.UserID()
+
+	ids := generateIDList(3)
+	members := make([]oid.Address, len(ids)+1)
+	for i := range ids {
+		members[i].SetContainer(cnr)
+		members[i].SetObject(ids[i])
+	}
+	members[len(ids)] = members[0]
+
+	obj, err := NewTombstoneObject(members, 42, owner, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, TypeTombstone, obj.Type())
+	objCnr, ok := obj.ContainerID()
+	require.True(t, ok)
+	require.Equal(t, cnr, objCnr)
+	require.Equal(t, owner, *obj.OwnerID())
+	require.NoError(t, obj.VerifyPayloadChecksum())
+	require.EqualValues(t, len(obj.Payload()), obj.PayloadSize())
+
+	attrs := obj.Attributes()
+	require.Len(t, attrs, 1)
+	require.Equal(t, AttributeExpirationEpoch, attrs[0].Key())
+	require.Equal(t, "42", attrs[0].Value())
+
+	var ts Tombstone
+	require.NoError(t, obj.ReadTombstone(&ts))
+	require.EqualValues(t, 42, ts.ExpirationEpoch())
+	require.Equal(t, ids, ts.Members())
+
+	t.Run("invalid members", func(t *testing.T) {
+		_, err := NewTombstoneObject(nil, 42, owner, nil)
+		require.Error(t, err)
+
+		other := append([]oid.Address{}, members...)
+		other[1].SetContainer(cidtest.ID())
+
+		_, err = NewTombstoneObject(other, 42, owner, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("session", func(t *testing.T) {
+		tok := *sessiontest.Object()
+		tok.ForVerb(session.VerbObjectDelete)
+		tok.BindContainer(cnr)
+		tok.LimitByObjects()
+		require.NoError(t, tok.Sign(signer))
+
+		obj, err := NewTombstoneObject(members, 42, owner, &tok)
+		require.NoError(t, err)
+		require.Equal(t, &tok, obj.SessionToken())
+
+		_, err = NewTombstoneObject(members, 42, *usertest.ID(t), &tok)
+		require.Error(t, err)
+
+		tok.LimitByObjects(ids[0])
+		_, err = NewTombstoneObject(members, 42, owner, &tok)
+		require.Error(t, err)
+
+		tok.LimitByObjects()
+		tok.ForVerb(session.VerbObjectPut)
+		_, err = NewTombstoneObject(members, 42, owner, &tok)
+		require.Error(t, err)
+
+		tok.ForVerb(session.VerbObjectDelete)
+		tok.BindContainer(cidtest.ID())
+		_, err = NewTombstoneObject(members, 42, owner, &tok)
+		require.Error(t, err)
+	})
+}
+
+func TestObject_WriteTombstone(t *testing.T) {
+	var o Object
+
+	var a Attribute
+	a.SetKey(AttributeExpirationEpoch)
+	a.SetValue("1")
+	o.SetAttributes(a)
+
+	ts := NewTombstone()
+	ts.SetExpirationEpoch(13)
+	ts.SetMembers(generateIDList(2))
+
+	o.WriteTombstone(*ts)
+	require.Equal(t, TypeTombstone, o.Type())
+
+	attrs := o.Attributes()
+	require.Len(t, attrs, 1)
+	require.Equal(t, "13", attrs[0].Value())
+
+	var ts2 Tombstone
+	require.NoError(t, o.ReadTombstone(&ts2))
+	require.Equal(t, *ts, ts2)
+}
@@ -0,0 +1,209 @@
+package object
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+)
+
+// PayloadRestorer restores the original object payload from the stored one on
+// download.
+type PayloadRestorer interface {
+	// RestoreReader returns io.Reader of the original data restored from the
+	// stored payload stream r of the object with the given attributes.
+	// RestoreReader returns an error if attributes do not describe the
+	// transformation to be reverted.
+	RestoreReader(r io.Reader, attrs []Attribute) (io.Reader, error)
+}
+
+// PayloadTransformer transforms object payload on upload (e.g. compresses or
+// encrypts it) and restores it on download. Parameters of the transformation
+// are stored in the object attributes.
+type PayloadTransformer interface {
+	PayloadRestorer
+
+	// Attributes returns object attributes describing the transformation.
+	// They MUST be added to the object header on upload.
+	Attributes() []Attribute
+
+	// TransformWriter returns io.WriteCloser transforming the data written
+	// to it and writing the result to w. Close MUST flush the transformed
+	// data and close w if it implements io.Closer.
+	TransformWriter(w io.Writer) (io.WriteCloser, error)
+}
+
+// PayloadPipeline is a sequence of PayloadTransformer applied to the payload
+// one by one. On upload, the first transformer receives the original payload
+// and the last one writes into the destination (e.g. slicer.PayloadWriter).
+// On download, transformations are reverted in the reverse order. So, for
+// example, compression MUST precede encryption:
+//
+//	p := object.NewPayloadPipeline(compressor, encryptor)
+//	w, err := s.InitPut(ctx, append(attrs, p.Attributes()...))
+//	// ...
+//	pw, err := p.TransformWriter(w)
+//	// ...
+//	_, err = io.Copy(pw, data)
+//	// ...
+//	err = pw.Close() // also closes w
+//	// ...
+//	r, err := p.RestoreReader(payload, hdr.Attributes())
+//
+// PayloadPipeline is a PayloadTransformer itself, so pipelines may be nested.
+// Zero PayloadPipeline passes the payload as is.
+type PayloadPipeline []PayloadTransformer
+
+// NewPayloadPipeline constructs PayloadPipeline of the given transformers in
+// order of their application on upload.
+func NewPayloadPipeline(ts ...PayloadTransformer) PayloadPipeline {
+	return ts
+}
+
+// Attributes returns attributes of all transformers in the PayloadPipeline
+// order.
+func (x PayloadPipeline) Attributes() []Attribute {
+	var res []Attribute
+	for i := range x {
+		res = append(res, x[i].Attributes()...)
+	}
+
+	return res
+}
+
+// TransformWriter returns io.WriteCloser passing the written data through all
+// transformers and writing the result to w. Close MUST be called to flush all
+// transformers, it also closes w if it implements io.Closer.
+func (x PayloadPipeline) TransformWriter(w io.Writer) (io.WriteCloser, error) {
+	res := io.WriteCloser(nopWriteCloser{w})
+	if c, ok := w.(io.WriteCloser); ok {
+		res = c
+	}
+
+	for i := len(x) - 1; i >= 0; i-- {
+		var err error
+		if res, err = x[i].TransformWriter(res); err != nil {
+			return nil, fmt.Errorf("transformer #%d: %w", i, err)
+		}
+	}
+
+	return res, nil
+}
+
+// RestoreReader implements PayloadRestorer reverting all transformations of
+// the PayloadPipeline in the reverse order.
+//
+// See also RestorePayload.
+func (x PayloadPipeline) RestoreReader(r io.Reader, attrs []Attribute) (io.Reader, error) {
+	rs := make([]PayloadRestorer, len(x))
+	for i := range x {
+		rs[i] = x[i]
+	}
+
+	return RestorePayload(r, attrs, rs...)
+}
+
+// RestorePayload returns io.Reader of the original payload of the object with
+// the given attributes restored from the stored payload stream r by the given
+// restorers. Restorers are listed in order of the corresponding
+// transformations on upload, so they are applied in the reverse order.
+// Restorers allow to read the payload on the side which does not have
+// parameters of the transformation on upload (e.g. encryption key nonce).
+func RestorePayload(r io.Reader, attrs []Attribute, rs ...PayloadRestorer) (io.Reader, error) {
+	for i := len(rs) - 1; i >= 0; i-- {
+		var err error
+		if r, err = rs[i].RestoreReader(r, attrs); err != nil {
+			return nil, fmt.Errorf("restorer #%d: %w", i, err)
+		}
+	}
+
+	return r, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// PayloadHasher is a PayloadTransformer passing the payload as is while
+// calculating its size and SHA-256 checksum at its stage of the
+// PayloadPipeline. For example, PayloadHasher at the beginning of the pipeline
+// accounts the original payload, at the end - the stored one. PayloadHasher
+// does not add any attributes. PayloadHasher must be constructed via
+// NewPayloadHasher, single instance MUST be used for exactly one stream.
+type PayloadHasher struct {
+	h hash.Hash
+
+	size uint64
+}
+
+// NewPayloadHasher constructs new PayloadHasher.
+func NewPayloadHasher() *PayloadHasher {
+	return &PayloadHasher{h: sha256.New()}
+}
+
+// Attributes implements PayloadTransformer returning nil.
+func (x *PayloadHasher) Attributes() []Attribute {
+	return nil
+}
+
+// TransformWriter implements PayloadTransformer.
+func (x *PayloadHasher) TransformWriter(w io.Writer) (io.WriteCloser, error) {
+	return &hashingWriter{
+		hasher: x,
+		w:      w,
+	}, nil
+}
+
+// RestoreReader implements PayloadRestorer.
+func (x *PayloadHasher) RestoreReader(r io.Reader, _ []Attribute) (io.Reader, error) {
+	return io.TeeReader(r, x), nil
+}
+
+func (x *PayloadHasher) Write(p []byte) (int, error) {
+	x.size += uint64(len(p))
+	return x.h.Write(p)
+}
+
+// Size returns number of bytes processed so far.
+func (x *PayloadHasher) Size() uint64 {
+	return x.size
+}
+
+// Checksum returns SHA-256 checksum of the data processed so far. Checksum
+// of the whole stream is available after the transforming writer is closed or
+// the restoring reader returns io.EOF.
+func (x *PayloadHasher) Checksum() checksum.Checksum {
+	var sum [sha256.Size]byte
+	x.h.Sum(sum[:0])
+
+	var res checksum.Checksum
+	res.SetSHA256(sum)
+
+	return res
+}
+
+type hashingWriter struct {
+	hasher *PayloadHasher
+
+	w io.Writer
+}
+
+func (x *hashingWriter) Write(p []byte) (int, error) {
+	n, err := x.w.Write(p)
+	_, _ = x.hasher.Write(p[:n])
+	return n, err
+}
+
+func (x *hashingWriter) Close() error {
+	if c, ok := x.w.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
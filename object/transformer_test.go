@@ -0,0 +1,150 @@
+package object_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+// xorTransformer XORs each byte with the key and marks the object with the
+// key attribute.
+type xorTransformer struct {
+	key byte
+}
+
+func (x xorTransformer) attrKey() string { return "XOR-" + string('A'+x.key%26) }
+
+func (x xorTransformer) Attributes() []object.Attribute {
+	return []object.Attribute{attribute(x.attrKey(), "true")}
+}
+
+func (x xorTransformer) TransformWriter(w io.Writer) (io.WriteCloser, error) {
+	return &xorWriter{key: x.key, w: w}, nil
+}
+
+func (x xorTransformer) RestoreReader(r io.Reader, attrs []object.Attribute) (io.Reader, error) {
+	for i := range attrs {
+		if attrs[i].Key() == x.attrKey() {
+			return &xorReader{key: x.key, r: r}, nil
+		}
+	}
+
+	return nil, errors.New("not transformed")
+}
+
+type xorWriter struct {
+	key byte
+	w   io.Writer
+}
+
+func (x *xorWriter) Write(p []byte) (int, error) {
+	b := make([]byte, len(p))
+	for i := range p {
+		b[i] = p[i] ^ x.key
+	}
+	return x.w.Write(b)
+}
+
+func (x *xorWriter) Close() error {
+	if c, ok := x.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+type xorReader struct {
+	key byte
+	r   io.Reader
+}
+
+func (x *xorReader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	for i := range p[:n] {
+		p[i] ^= x.key
+	}
+	return n, err
+}
+
+type closeCounter struct {
+	bytes.Buffer
+	closed int
+}
+
+func (x *closeCounter) Close() error {
+	x.closed++
+	return nil
+}
+
+func TestPayloadPipeline(t *testing.T) {
+	data := []byte("Hello, world!")
+
+	t.Run("zero", func(t *testing.T) {
+		var p object.PayloadPipeline
+		require.Empty(t, p.Attributes())
+
+		var buf bytes.Buffer
+		w, err := p.TransformWriter(&buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		require.Equal(t, data, buf.Bytes())
+
+		r, err := p.RestoreReader(&buf, nil)
+		require.NoError(t, err)
+		res, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	})
+
+	origHasher, storedHasher := object.NewPayloadHasher(), object.NewPayloadHasher()
+	t1, t2 := xorTransformer{key: 1}, xorTransformer{key: 2}
+	p := object.NewPayloadPipeline(origHasher, t1, object.NewPayloadPipeline(t2), storedHasher)
+
+	require.Equal(t, append(t1.Attributes(), t2.Attributes()...), p.Attributes())
+
+	var dst closeCounter
+	w, err := p.TransformWriter(&dst)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, 1, dst.closed)
+
+	expected := make([]byte, len(data))
+	for i := range data {
+		expected[i] = data[i] ^ 1 ^ 2
+	}
+
+	require.Equal(t, expected, dst.Bytes())
+
+	require.EqualValues(t, len(data), origHasher.Size())
+	require.Equal(t, sha256Checksum(data), origHasher.Checksum())
+	require.EqualValues(t, len(expected), storedHasher.Size())
+	require.Equal(t, sha256Checksum(expected), storedHasher.Checksum())
+
+	t.Run("restore", func(t *testing.T) {
+		h := object.NewPayloadHasher()
+
+		r, err := object.RestorePayload(bytes.NewReader(dst.Bytes()), p.Attributes(), t1, t2, h)
+		require.NoError(t, err)
+		res, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+		require.EqualValues(t, len(expected), h.Size())
+		require.Equal(t, sha256Checksum(expected), h.Checksum())
+
+		r, err = p.RestoreReader(bytes.NewReader(dst.Bytes()), p.Attributes())
+		require.NoError(t, err)
+		res, err = io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+
+		_, err = p.RestoreReader(bytes.NewReader(dst.Bytes()), t1.Attributes())
+		require.Error(t, err)
+	})
+}
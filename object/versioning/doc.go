@@ -0,0 +1,24 @@
+/*
+Package versioning provides conventions and helpers for version chains of the
+NeoFS objects.
+
+Objects are immutable, so new content of the same logical entity (e.g. file
+or S3 object key) is stored as a new object. Versions of the logical name
+are linked by well-known attributes: each version carries the logical name,
+its sequence number and, except the first one, ID of the previous version.
+The first version is uploaded with the attributes returned by First:
+
+	_, err := slc.Put(ctx, data, versioning.First("photos/cat.jpg"))
+
+Successors are built from the header of the previous version:
+
+	b, err := versioning.Successor(prev)
+	// ...
+	obj, err := b.Payload(data).Build(signer)
+
+Versions of the logical name may be listed from the newest to the oldest:
+
+	src := versioning.NewClientSource(c, signer, relations.Tokens{})
+	list, err := versioning.List(ctx, src, cnr, "photos/cat.jpg")
+*/
+package versioning
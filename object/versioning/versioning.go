@@ -0,0 +1,358 @@
+package versioning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Well-known attributes of the object versions.
+const (
+	// AttributeName is an attribute key of the logical name shared by all the
+	// versions. The name is stored in the file path attribute, so versioned
+	// objects are compatible with the HTTP and S3 gateways.
+	AttributeName = object.AttributeFilePath
+
+	// AttributeVersion is an attribute key of the version sequence number in
+	// decimal. The first version has number 1.
+	AttributeVersion = "Version"
+
+	// AttributePreviousVersion is an attribute key of the previous version ID
+	// in string format (see oid.ID.EncodeToString). The first version has no
+	// such attribute.
+	AttributePreviousVersion = "Previous-Version"
+)
+
+// ErrNotVersioned is returned when the object has no version attributes.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrNotVersioned = errors.New("object is not versioned")
+
+// First returns attributes of the first version of the object with the given
+// logical name. The name MUST NOT be empty.
+//
+// See also Successor.
+func First(name string) []object.Attribute {
+	attrs := make([]object.Attribute, 2)
+
+	attrs[0].SetKey(AttributeName)
+	attrs[0].SetValue(name)
+	attrs[1].SetKey(AttributeVersion)
+	attrs[1].SetValue("1")
+
+	return attrs
+}
+
+// Next returns attributes of the version following the given one: the same
+// logical name, incremented number and reference to the previous version.
+// The previous version MUST have ID. Next returns ErrNotVersioned if the
+// previous object is not a version.
+//
+// See also Successor.
+func Next(prev object.Object) ([]object.Attribute, error) {
+	info, err := ReadInfo(prev)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := make([]object.Attribute, 3)
+
+	attrs[0].SetKey(AttributeName)
+	attrs[0].SetValue(info.name)
+	attrs[1].SetKey(AttributeVersion)
+	attrs[1].SetValue(strconv.FormatUint(info.num+1, 10))
+	attrs[2].SetKey(AttributePreviousVersion)
+	attrs[2].SetValue(info.id.EncodeToString())
+
+	return attrs, nil
+}
+
+// Successor returns builder of the object following the given version. The
+// builder is prefilled with container, owner and version attributes (see
+// Next) of the previous version. Other attributes are not inherited, so they
+// SHOULD be set explicitly.
+func Successor(prev object.Object) (*object.Builder, error) {
+	attrs, err := Next(prev)
+	if err != nil {
+		return nil, err
+	}
+
+	b := object.NewBuilder()
+
+	if cnr, ok := prev.ContainerID(); ok {
+		b.Container(cnr)
+	}
+
+	if owner := prev.OwnerID(); owner != nil {
+		b.Owner(*owner)
+	}
+
+	for i := range attrs {
+		b.Attribute(attrs[i].Key(), attrs[i].Value())
+	}
+
+	return b, nil
+}
+
+// Info describes the object version.
+type Info struct {
+	id oid.ID
+
+	name string
+
+	num uint64
+
+	prev *oid.ID
+
+	epoch uint64
+}
+
+// ReadInfo reads version attributes of the object. The object MUST have ID.
+// ReadInfo returns ErrNotVersioned if the object has no version attributes.
+func ReadInfo(obj object.Object) (Info, error) {
+	var res Info
+	var ok bool
+
+	if res.id, ok = obj.ID(); !ok {
+		return res, errors.New("missing object ID")
+	}
+
+	var num, prev string
+
+	attrs := obj.Attributes()
+	for i := range attrs {
+		switch attrs[i].Key() {
+		case AttributeName:
+			res.name = attrs[i].Value()
+		case AttributeVersion:
+			num = attrs[i].Value()
+		case AttributePreviousVersion:
+			prev = attrs[i].Value()
+		}
+	}
+
+	if res.name == "" || num == "" {
+		return res, ErrNotVersioned
+	}
+
+	var err error
+	if res.num, err = strconv.ParseUint(num, 10, 64); err != nil {
+		return res, fmt.Errorf("invalid version number attribute: %w", err)
+	} else if res.num == 0 {
+		return res, errors.New("zero version number")
+	}
+
+	if prev != "" {
+		res.prev = new(oid.ID)
+		if err = res.prev.DecodeString(prev); err != nil {
+			return res, fmt.Errorf("invalid previous version attribute: %w", err)
+		}
+	} else if res.num > 1 {
+		return res, fmt.Errorf("missing previous version of the version %d", res.num)
+	}
+
+	res.epoch = obj.CreationEpoch()
+
+	return res, nil
+}
+
+// ID returns ID of the version object.
+func (x Info) ID() oid.ID {
+	return x.id
+}
+
+// Name returns logical name of the version.
+func (x Info) Name() string {
+	return x.name
+}
+
+// Number returns sequence number of the version starting from 1.
+func (x Info) Number() uint64 {
+	return x.num
+}
+
+// Previous returns ID of the previous version. Returns false for the first
+// version.
+func (x Info) Previous() (oid.ID, bool) {
+	if x.prev == nil {
+		return oid.ID{}, false
+	}
+
+	return *x.prev, true
+}
+
+// CreationEpoch returns epoch when the version has been created.
+func (x Info) CreationEpoch() uint64 {
+	return x.epoch
+}
+
+// Source provides versions of the objects.
+type Source interface {
+	// SearchVersions returns IDs of the root objects in the container with
+	// the given logical name.
+	SearchVersions(ctx context.Context, cnr cid.ID, name string) ([]oid.ID, error)
+
+	// Head returns header of the root object.
+	Head(ctx context.Context, addr oid.Address) (object.Object, error)
+}
+
+// Client describes methods of the NeoFS client required by NewClientSource.
+// Implemented by *client.Client.
+type Client interface {
+	relations.SearchExecutor
+	ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer neofscrypto.Signer, prm client.PrmObjectHead) (*client.ResObjectHead, error)
+}
+
+type clientSource struct {
+	c Client
+
+	signer user.Signer
+
+	tokens relations.Tokens
+}
+
+// NewClientSource returns Source reading versions via NeoFS client on behalf
+// of the given signer with optional tokens.
+func NewClientSource(c Client, signer user.Signer, tokens relations.Tokens) Source {
+	return &clientSource{
+		c:      c,
+		signer: signer,
+		tokens: tokens,
+	}
+}
+
+func (x *clientSource) SearchVersions(ctx context.Context, cnr cid.ID, name string) ([]oid.ID, error) {
+	fs := object.NewSearchFiltersBuilder().
+		RootOnly().
+		AttributeEquals(AttributeName, name).
+		AttributePresent(AttributeVersion).
+		Build()
+
+	var prm client.PrmObjectSearch
+	prm.SetFilters(fs)
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	r, err := x.c.ObjectSearchInit(ctx, cnr, x.signer, prm)
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	var res []oid.ID
+	err = r.Iterate(func(id oid.ID) bool {
+		res = append(res, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate: %w", err)
+	}
+
+	return res, nil
+}
+
+func (x *clientSource) Head(ctx context.Context, addr oid.Address) (object.Object, error) {
+	var prm client.PrmObjectHead
+	if x.tokens.Bearer != nil {
+		prm.WithBearerToken(*x.tokens.Bearer)
+	}
+	if x.tokens.Session != nil {
+		prm.WithinSession(*x.tokens.Session)
+	}
+
+	var hdr object.Object
+
+	res, err := x.c.ObjectHead(ctx, addr.Container(), addr.Object(), x.signer, prm)
+	if err != nil {
+		return hdr, err
+	}
+
+	if !res.ReadHeader(&hdr) {
+		return hdr, errors.New("missing header in response")
+	}
+
+	hdr.SetID(addr.Object())
+
+	return hdr, nil
+}
+
+// List returns all versions of the logical name in the container sorted from
+// the newest to the oldest. Versions with the same number (e.g. concurrent
+// successors of the same version) are ordered by creation epoch and then by
+// ID, so the order is deterministic. Objects with the name but without version
+// attributes are skipped.
+func List(ctx context.Context, src Source, cnr cid.ID, name string) ([]Info, error) {
+	ids, err := src.SearchVersions(ctx, cnr, name)
+	if err != nil {
+		return nil, fmt.Errorf("search versions: %w", err)
+	}
+
+	var res []Info
+	var addr oid.Address
+	addr.SetContainer(cnr)
+
+	for i := range ids {
+		addr.SetObject(ids[i])
+
+		hdr, err := src.Head(ctx, addr)
+		if err != nil {
+			return nil, fmt.Errorf("read version %s: %w", ids[i], err)
+		}
+
+		hdr.SetID(ids[i])
+
+		info, err := ReadInfo(hdr)
+		if err != nil {
+			if errors.Is(err, ErrNotVersioned) {
+				continue
+			}
+
+			return nil, fmt.Errorf("version %s: %w", ids[i], err)
+		}
+
+		if info.name != name {
+			continue
+		}
+
+		res = append(res, info)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].num != res[j].num {
+			return res[i].num > res[j].num
+		}
+
+		if res[i].epoch != res[j].epoch {
+			return res[i].epoch > res[j].epoch
+		}
+
+		return res[i].id.EncodeToString() > res[j].id.EncodeToString()
+	})
+
+	return res, nil
+}
+
+// Latest returns the newest version of the logical name in the container.
+// Returns false if there are no versions.
+func Latest(ctx context.Context, src Source, cnr cid.ID, name string) (Info, bool, error) {
+	list, err := List(ctx, src, cnr, name)
+	if err != nil || len(list) == 0 {
+		return Info{}, false, err
+	}
+
+	return list[0], true, nil
+}
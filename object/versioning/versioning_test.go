@@ -0,0 +1,229 @@
+package versioning_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object/versioning"
+	"github.com/stretchr/testify/require"
+)
+
+// storage is an in-memory container of the root objects.
+type storage struct {
+	cnr     cid.ID
+	objects map[oid.ID]object.Object
+	err     error
+}
+
+func (x *storage) SearchVersions(_ context.Context, cnr cid.ID, name string) ([]oid.ID, error) {
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	var res []oid.ID
+	if cnr != x.cnr {
+		return res, nil
+	}
+
+	for id, obj := range x.objects {
+		for _, a := range obj.Attributes() {
+			if a.Key() == versioning.AttributeName && a.Value() == name {
+				res = append(res, id)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (x *storage) Head(_ context.Context, addr oid.Address) (object.Object, error) {
+	obj, ok := x.objects[addr.Object()]
+	if !ok {
+		return obj, errors.New("not found")
+	}
+	return obj, nil
+}
+
+func (x *storage) put(obj object.Object) {
+	id, _ := obj.ID()
+	x.objects[id] = obj
+}
+
+func attributesMap(attrs []object.Attribute) map[string]string {
+	res := make(map[string]string, len(attrs))
+	for i := range attrs {
+		res[attrs[i].Key()] = attrs[i].Value()
+	}
+	return res
+}
+
+func TestFirst(t *testing.T) {
+	require.Equal(t, map[string]string{
+		versioning.AttributeName:    "cat.jpg",
+		versioning.AttributeVersion: "1",
+	}, attributesMap(versioning.First("cat.jpg")))
+}
+
+func TestSuccessor(t *testing.T) {
+	signer := test.RandomSignerRFC6979(t)
+	cnr := cidtest.ID()
+
+	b := object.NewBuilder().Container(cnr).Owner(signer.UserID()).Payload([]byte("v1"))
+	for _, a := range versioning.First("cat.jpg") {
+		b.Attribute(a.Key(), a.Value())
+	}
+
+	v1, err := b.Build(signer)
+	require.NoError(t, err)
+
+	b, err = versioning.Successor(*v1)
+	require.NoError(t, err)
+
+	v2, err := b.Payload([]byte("v2")).Build(signer)
+	require.NoError(t, err)
+
+	cnr2, _ := v2.ContainerID()
+	require.Equal(t, cnr, cnr2)
+	require.Equal(t, signer.UserID(), *v2.OwnerID())
+
+	id1, _ := v1.ID()
+	require.Equal(t, map[string]string{
+		versioning.AttributeName:            "cat.jpg",
+		versioning.AttributeVersion:         "2",
+		versioning.AttributePreviousVersion: id1.EncodeToString(),
+	}, attributesMap(v2.Attributes()))
+
+	info, err := versioning.ReadInfo(*v2)
+	require.NoError(t, err)
+	id2, _ := v2.ID()
+	require.Equal(t, id2, info.ID())
+	require.Equal(t, "cat.jpg", info.Name())
+	require.EqualValues(t, 2, info.Number())
+	prev, ok := info.Previous()
+	require.True(t, ok)
+	require.Equal(t, id1, prev)
+
+	info, err = versioning.ReadInfo(*v1)
+	require.NoError(t, err)
+	_, ok = info.Previous()
+	require.False(t, ok)
+
+	var plain object.Object
+	plain.SetID(oidtest.ID())
+	_, err = versioning.Successor(plain)
+	require.ErrorIs(t, err, versioning.ErrNotVersioned)
+}
+
+func TestReadInfo(t *testing.T) {
+	newObject := func(kv ...string) object.Object {
+		var obj object.Object
+		obj.SetID(oidtest.ID())
+
+		attrs := make([]object.Attribute, len(kv)/2)
+		for i := range attrs {
+			attrs[i].SetKey(kv[2*i])
+			attrs[i].SetValue(kv[2*i+1])
+		}
+		obj.SetAttributes(attrs...)
+
+		return obj
+	}
+
+	_, err := versioning.ReadInfo(object.Object{})
+	require.Error(t, err)
+
+	for _, tc := range []struct {
+		name string
+		obj  object.Object
+	}{
+		{name: "invalid number", obj: newObject(versioning.AttributeName, "a", versioning.AttributeVersion, "one")},
+		{name: "zero number", obj: newObject(versioning.AttributeName, "a", versioning.AttributeVersion, "0")},
+		{name: "missing previous", obj: newObject(versioning.AttributeName, "a", versioning.AttributeVersion, "2")},
+		{name: "invalid previous", obj: newObject(versioning.AttributeName, "a", versioning.AttributeVersion, "2",
+			versioning.AttributePreviousVersion, "not an ID")},
+	} {
+		_, err := versioning.ReadInfo(tc.obj)
+		require.Error(t, err, tc.name)
+		require.NotErrorIs(t, err, versioning.ErrNotVersioned, tc.name)
+	}
+
+	_, err = versioning.ReadInfo(newObject(versioning.AttributeName, "a"))
+	require.ErrorIs(t, err, versioning.ErrNotVersioned)
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	signer := test.RandomSignerRFC6979(t)
+	s := &storage{cnr: cidtest.ID(), objects: make(map[oid.ID]object.Object)}
+
+	build := func(b *object.Builder, epoch uint64, attrs []object.Attribute) object.Object {
+		b.Container(s.cnr).Owner(signer.UserID()).CreationEpoch(epoch)
+		for i := range attrs {
+			b.Attribute(attrs[i].Key(), attrs[i].Value())
+		}
+
+		obj, err := b.Build(signer)
+		require.NoError(t, err)
+		s.put(*obj)
+
+		return *obj
+	}
+
+	_, ok, err := versioning.Latest(ctx, s, s.cnr, "cat.jpg")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	v1 := build(object.NewBuilder(), 1, versioning.First("cat.jpg"))
+
+	b, err := versioning.Successor(v1)
+	require.NoError(t, err)
+	v2 := build(b, 2, nil)
+
+	// concurrent successor
+	b, err = versioning.Successor(v1)
+	require.NoError(t, err)
+	v2c := build(b, 3, nil)
+
+	b, err = versioning.Successor(v2c)
+	require.NoError(t, err)
+	v3 := build(b, 4, nil)
+
+	// unversioned object with the same name and another versioned name
+	var attr object.Attribute
+	attr.SetKey(versioning.AttributeName)
+	attr.SetValue("cat.jpg")
+	build(object.NewBuilder(), 5, []object.Attribute{attr})
+	build(object.NewBuilder(), 6, versioning.First("dog.jpg"))
+
+	list, err := versioning.List(ctx, s, s.cnr, "cat.jpg")
+	require.NoError(t, err)
+
+	var ids []oid.ID
+	for i := range list {
+		ids = append(ids, list[i].ID())
+	}
+
+	id := func(obj object.Object) oid.ID {
+		res, _ := obj.ID()
+		return res
+	}
+
+	require.Equal(t, []oid.ID{id(v3), id(v2c), id(v2), id(v1)}, ids)
+
+	latest, ok, err := versioning.Latest(ctx, s, s.cnr, "cat.jpg")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, list[0], latest)
+	require.EqualValues(t, 4, latest.CreationEpoch())
+
+	s.err = errors.New("any error")
+	_, err = versioning.List(ctx, s, s.cnr, "cat.jpg")
+	require.ErrorIs(t, err, s.err)
+}
@@ -1,5 +1,17 @@
 package object
 
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
 const (
 	// AttributeName is an attribute key that is commonly used to denote
 	// human-friendly name.
@@ -26,3 +38,290 @@ const (
 	// MIME Content Type of object's payload.
 	AttributeContentType = "Content-Type"
 )
+
+// AttributeExpirationEpoch is a system attribute key of the last NeoFS epoch
+// of the object lifetime. Object is deleted by the storage nodes after this
+// epoch.
+const AttributeExpirationEpoch = object.SysAttributeExpEpoch
+
+// AttributeAssociatedObject is a system attribute key of the ID of the object
+// associated with the lock object. The attribute allows to search locks of the
+// particular object. See also [NewLockObject].
+const AttributeAssociatedObject = object.SysAttributePrefix + "ASSOCIATE"
+
+const (
+	// AttributeNotificationEpoch is a system attribute key of the NeoFS epoch
+	// in which the storage nodes produce notification about the object. See
+	// also [NotificationInfo].
+	AttributeNotificationEpoch = object.SysAttributeTickEpoch
+
+	// AttributeNotificationTopic is a system attribute key of the topic of the
+	// notification about the object. Notification is produced to the default
+	// topic if the attribute is missing.
+	AttributeNotificationTopic = object.SysAttributeTickTopic
+)
+
+// ErrAttributeNotFound is returned by the typed accessors of the well-known
+// attributes when the object does not have the requested attribute.
+//
+// This variable is intended to be used as documentation and for [errors.Is]
+// purposes and MUST NOT be changed.
+var ErrAttributeNotFound = errors.New("attribute not found")
+
+// ExpirationEpoch returns value of the [AttributeExpirationEpoch] attribute.
+// Returns [ErrAttributeNotFound] if the attribute is missing.
+//
+// See also [Object.SetExpirationEpoch].
+func (o *Object) ExpirationEpoch() (uint64, error) {
+	v, ok := o.attribute(AttributeExpirationEpoch)
+	if !ok {
+		return 0, ErrAttributeNotFound
+	}
+
+	res, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid expiration epoch attribute: %w", err)
+	}
+
+	return res, nil
+}
+
+// SetExpirationEpoch sets the last NeoFS epoch of the object lifetime to the
+// [AttributeExpirationEpoch] attribute.
+//
+// See also [Object.ExpirationEpoch], [Object.SetExpirationDuration].
+func (o *Object) SetExpirationEpoch(epoch uint64) {
+	o.setAttribute(AttributeExpirationEpoch, strconv.FormatUint(epoch, 10))
+}
+
+// SetExpirationDuration sets the [AttributeExpirationEpoch] attribute so that
+// the object lives at least for the given duration. Duration is converted to
+// epochs using the current network settings: epoch duration in blocks and
+// block interval. Returns an error if duration is not positive or network
+// settings are missing.
+//
+// See also [Object.SetExpirationEpoch], [Object.SetExpirationTime],
+// [Object.ExpirationDuration].
+func (o *Object) SetExpirationDuration(ni netmap.NetworkInfo, d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("non-positive duration %s", d)
+	}
+
+	epochDuration, err := networkEpochDuration(ni)
+	if err != nil {
+		return err
+	}
+
+	epochs := uint64((d + epochDuration - 1) / epochDuration)
+
+	o.SetExpirationEpoch(ni.CurrentEpoch() + epochs)
+
+	return nil
+}
+
+// SetExpirationTime sets the [AttributeExpirationEpoch] attribute so that the
+// object lives at least until the given moment. The current epoch from the
+// network info is considered as started now, see
+// [Object.SetExpirationDuration] for details. Returns an error if t is not in
+// the future.
+//
+// See also [Object.ExpirationTime].
+func (o *Object) SetExpirationTime(ni netmap.NetworkInfo, t time.Time) error {
+	return o.SetExpirationDuration(ni, time.Until(t))
+}
+
+// ExpirationDuration returns guaranteed remaining lifetime of the object
+// calculated from the [AttributeExpirationEpoch] attribute and the current
+// network settings. The result is a whole number of epochs without the current
+// one, so the object may actually live longer by up to one epoch. Negative
+// result means that the object has expired. Returns [ErrAttributeNotFound] if
+// the attribute is missing.
+//
+// See also [Object.SetExpirationDuration], [Object.ExpirationTime].
+func (o *Object) ExpirationDuration(ni netmap.NetworkInfo) (time.Duration, error) {
+	exp, err := o.ExpirationEpoch()
+	if err != nil {
+		return 0, err
+	}
+
+	epochDuration, err := networkEpochDuration(ni)
+	if err != nil {
+		return 0, err
+	}
+
+	cur := ni.CurrentEpoch()
+	if exp >= cur {
+		return time.Duration(exp-cur) * epochDuration, nil
+	}
+
+	return -time.Duration(cur-exp) * epochDuration, nil
+}
+
+// ExpirationTime returns moment until which the object is guaranteed to live,
+// see [Object.ExpirationDuration] for details.
+//
+// See also [Object.SetExpirationTime].
+func (o *Object) ExpirationTime(ni netmap.NetworkInfo) (time.Time, error) {
+	d, err := o.ExpirationDuration(ni)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Now().Add(d), nil
+}
+
+// networkEpochDuration returns wall-clock duration of the NeoFS epoch
+// according to the network settings.
+func networkEpochDuration(ni netmap.NetworkInfo) (time.Duration, error) {
+	blocks := ni.EpochDuration()
+	if blocks == 0 {
+		return 0, errors.New("missing epoch duration in network info")
+	}
+
+	msPerBlock := ni.MsPerBlock()
+	if msPerBlock <= 0 {
+		return 0, errors.New("missing block interval in network info")
+	}
+
+	return time.Duration(blocks) * time.Duration(msPerBlock) * time.Millisecond, nil
+}
+
+// NotificationEpoch returns value of the [AttributeNotificationEpoch]
+// attribute. Returns [ErrAttributeNotFound] if the attribute is missing, i.e.
+// the notification is not requested.
+//
+// See also [Object.SetNotificationEpoch], [Object.NotificationInfo].
+func (o *Object) NotificationEpoch() (uint64, error) {
+	v, ok := o.attribute(AttributeNotificationEpoch)
+	if !ok {
+		return 0, ErrAttributeNotFound
+	}
+
+	res, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid notification epoch attribute: %w", err)
+	}
+
+	return res, nil
+}
+
+// SetNotificationEpoch requests notification about the object in the given
+// NeoFS epoch by setting the [AttributeNotificationEpoch] attribute.
+//
+// See also [Object.NotificationEpoch], [Object.SetNotificationTopic].
+func (o *Object) SetNotificationEpoch(epoch uint64) {
+	o.setAttribute(AttributeNotificationEpoch, strconv.FormatUint(epoch, 10))
+}
+
+// NotificationTopic returns value of the [AttributeNotificationTopic]
+// attribute. Returns [ErrAttributeNotFound] if the attribute is missing, i.e.
+// the default topic is used.
+//
+// See also [Object.SetNotificationTopic].
+func (o *Object) NotificationTopic() (string, error) {
+	v, ok := o.attribute(AttributeNotificationTopic)
+	if !ok {
+		return "", ErrAttributeNotFound
+	}
+
+	return v, nil
+}
+
+// SetNotificationTopic sets the [AttributeNotificationTopic] attribute. Topic
+// MUST NOT be empty. The topic makes sense only along with the
+// [AttributeNotificationEpoch] attribute.
+//
+// See also [Object.NotificationTopic], [Object.SetNotificationEpoch].
+func (o *Object) SetNotificationTopic(topic string) error {
+	if topic == "" {
+		return errors.New("empty notification topic")
+	}
+
+	o.setAttribute(AttributeNotificationTopic, topic)
+
+	return nil
+}
+
+// FileName returns value of the [AttributeFileName] attribute. Returns
+// [ErrAttributeNotFound] if the attribute is missing.
+//
+// See also [Object.SetFileName].
+func (o *Object) FileName() (string, error) {
+	v, ok := o.attribute(AttributeFileName)
+	if !ok {
+		return "", ErrAttributeNotFound
+	}
+
+	return v, nil
+}
+
+// SetFileName sets the [AttributeFileName] attribute. Name MUST NOT be empty
+// and MUST NOT contain '/' which delimits [AttributeFilePath].
+//
+// See also [Object.FileName].
+func (o *Object) SetFileName(name string) error {
+	if name == "" {
+		return errors.New("empty file name")
+	}
+
+	if strings.Contains(name, "/") {
+		return fmt.Errorf("file name %q contains path delimiter", name)
+	}
+
+	o.setAttribute(AttributeFileName, name)
+
+	return nil
+}
+
+// ContentType returns value of the [AttributeContentType] attribute. Returns
+// [ErrAttributeNotFound] if the attribute is missing.
+//
+// See also [Object.SetContentType].
+func (o *Object) ContentType() (string, error) {
+	v, ok := o.attribute(AttributeContentType)
+	if !ok {
+		return "", ErrAttributeNotFound
+	}
+
+	return v, nil
+}
+
+// SetContentType sets the [AttributeContentType] attribute. Value MUST be a
+// valid MIME media type (RFC 1521, RFC 2183).
+//
+// See also [Object.ContentType].
+func (o *Object) SetContentType(v string) error {
+	if _, _, err := mime.ParseMediaType(v); err != nil {
+		return fmt.Errorf("invalid content type: %w", err)
+	}
+
+	o.setAttribute(AttributeContentType, v)
+
+	return nil
+}
+
+// Timestamp returns value of the [AttributeTimestamp] attribute. Returns
+// [ErrAttributeNotFound] if the attribute is missing.
+//
+// See also [Object.SetTimestamp].
+func (o *Object) Timestamp() (time.Time, error) {
+	v, ok := o.attribute(AttributeTimestamp)
+	if !ok {
+		return time.Time{}, ErrAttributeNotFound
+	}
+
+	sec, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp attribute: %w", err)
+	}
+
+	return time.Unix(sec, 0), nil
+}
+
+// SetTimestamp sets the [AttributeTimestamp] attribute in Unix Timestamp
+// format with seconds precision.
+//
+// See also [Object.Timestamp].
+func (o *Object) SetTimestamp(t time.Time) {
+	o.setAttribute(AttributeTimestamp, strconv.FormatInt(t.Unix(), 10))
+}
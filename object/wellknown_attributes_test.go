@@ -0,0 +1,222 @@
+package object_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func setAttribute(o *object.Object, key, value string) {
+	var a object.Attribute
+	a.SetKey(key)
+	a.SetValue(value)
+	o.SetAttributes(a)
+}
+
+func TestObject_ExpirationEpoch(t *testing.T) {
+	var o object.Object
+
+	_, err := o.ExpirationEpoch()
+	require.ErrorIs(t, err, object.ErrAttributeNotFound)
+
+	o.SetExpirationEpoch(13)
+	o.SetExpirationEpoch(42)
+
+	require.Len(t, o.Attributes(), 1)
+	exp, err := o.ExpirationEpoch()
+	require.NoError(t, err)
+	require.EqualValues(t, 42, exp)
+
+	setAttribute(&o, object.AttributeExpirationEpoch, "-1")
+	_, err = o.ExpirationEpoch()
+	require.Error(t, err)
+	require.NotErrorIs(t, err, object.ErrAttributeNotFound)
+}
+
+func TestObject_SetExpirationDuration(t *testing.T) {
+	var ni netmap.NetworkInfo
+	var o object.Object
+
+	require.Error(t, o.SetExpirationDuration(ni, time.Hour))
+
+	ni.SetCurrentEpoch(10)
+	ni.SetEpochDuration(240)
+	require.Error(t, o.SetExpirationDuration(ni, time.Hour))
+
+	ni.SetMsPerBlock(15000) // epoch is 1 hour
+	require.Error(t, o.SetExpirationDuration(ni, 0))
+
+	for _, tc := range []struct {
+		d   time.Duration
+		exp uint64
+	}{
+		{time.Second, 11},
+		{time.Hour, 11},
+		{time.Hour + time.Second, 12},
+		{24 * time.Hour, 34},
+	} {
+		require.NoError(t, o.SetExpirationDuration(ni, tc.d))
+
+		exp, err := o.ExpirationEpoch()
+		require.NoError(t, err)
+		require.Equal(t, tc.exp, exp, tc.d)
+	}
+}
+
+func TestObject_SetExpirationTime(t *testing.T) {
+	var ni netmap.NetworkInfo
+	var o object.Object
+
+	ni.SetCurrentEpoch(10)
+	ni.SetEpochDuration(240)
+	ni.SetMsPerBlock(15000) // epoch is 1 hour
+
+	require.Error(t, o.SetExpirationTime(ni, time.Now().Add(-time.Second)))
+
+	require.NoError(t, o.SetExpirationTime(ni, time.Now().Add(90*time.Minute)))
+	exp, err := o.ExpirationEpoch()
+	require.NoError(t, err)
+	require.EqualValues(t, 12, exp)
+}
+
+func TestObject_ExpirationDuration(t *testing.T) {
+	var ni netmap.NetworkInfo
+	var o object.Object
+
+	_, err := o.ExpirationDuration(ni)
+	require.ErrorIs(t, err, object.ErrAttributeNotFound)
+	_, err = o.ExpirationTime(ni)
+	require.ErrorIs(t, err, object.ErrAttributeNotFound)
+
+	o.SetExpirationEpoch(15)
+
+	_, err = o.ExpirationDuration(ni)
+	require.Error(t, err)
+
+	ni.SetCurrentEpoch(10)
+	ni.SetEpochDuration(240)
+	ni.SetMsPerBlock(15000) // epoch is 1 hour
+
+	d, err := o.ExpirationDuration(ni)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Hour, d)
+
+	before := time.Now()
+	tm, err := o.ExpirationTime(ni)
+	require.NoError(t, err)
+	require.WithinRange(t, tm, before.Add(5*time.Hour), time.Now().Add(5*time.Hour))
+
+	ni.SetCurrentEpoch(17)
+	d, err = o.ExpirationDuration(ni)
+	require.NoError(t, err)
+	require.Equal(t, -2*time.Hour, d)
+
+	require.NoError(t, o.SetExpirationDuration(ni, 3*time.Hour))
+	d, err = o.ExpirationDuration(ni)
+	require.NoError(t, err)
+	require.Equal(t, 3*time.Hour, d)
+}
+
+func TestObject_NotificationEpoch(t *testing.T) {
+	var o object.Object
+
+	_, err := o.NotificationEpoch()
+	require.ErrorIs(t, err, object.ErrAttributeNotFound)
+
+	o.SetNotificationEpoch(13)
+	o.SetNotificationEpoch(42)
+
+	require.Len(t, o.Attributes(), 1)
+	epoch, err := o.NotificationEpoch()
+	require.NoError(t, err)
+	require.EqualValues(t, 42, epoch)
+
+	ni, err := o.NotificationInfo()
+	require.NoError(t, err)
+	require.EqualValues(t, 42, ni.Epoch())
+	require.Empty(t, ni.Topic())
+
+	setAttribute(&o, object.AttributeNotificationEpoch, "-1")
+	_, err = o.NotificationEpoch()
+	require.Error(t, err)
+	require.NotErrorIs(t, err, object.ErrAttributeNotFound)
+}
+
+func TestObject_NotificationTopic(t *testing.T) {
+	var o object.Object
+
+	_, err := o.NotificationTopic()
+	require.ErrorIs(t, err, object.ErrAttributeNotFound)
+
+	require.Error(t, o.SetNotificationTopic(""))
+	require.NoError(t, o.SetNotificationTopic("topic1"))
+	require.NoError(t, o.SetNotificationTopic("topic2"))
+
+	require.Len(t, o.Attributes(), 1)
+	topic, err := o.NotificationTopic()
+	require.NoError(t, err)
+	require.Equal(t, "topic2", topic)
+
+	var ni object.NotificationInfo
+	ni.SetEpoch(10)
+	ni.SetTopic("topic3")
+	o.SetNotification(ni)
+
+	epoch, err := o.NotificationEpoch()
+	require.NoError(t, err)
+	require.EqualValues(t, 10, epoch)
+	topic, err = o.NotificationTopic()
+	require.NoError(t, err)
+	require.Equal(t, "topic3", topic)
+}
+
+func TestObject_FileName(t *testing.T) {
+	var o object.Object
+
+	_, err := o.FileName()
+	require.ErrorIs(t, err, object.ErrAttributeNotFound)
+
+	require.Error(t, o.SetFileName(""))
+	require.Error(t, o.SetFileName("dir/file.txt"))
+	require.NoError(t, o.SetFileName("file.txt"))
+
+	name, err := o.FileName()
+	require.NoError(t, err)
+	require.Equal(t, "file.txt", name)
+}
+
+func TestObject_ContentType(t *testing.T) {
+	var o object.Object
+
+	_, err := o.ContentType()
+	require.ErrorIs(t, err, object.ErrAttributeNotFound)
+
+	require.Error(t, o.SetContentType(""))
+	require.Error(t, o.SetContentType("text/plain; charset"))
+	require.NoError(t, o.SetContentType("text/plain; charset=utf-8"))
+
+	ct, err := o.ContentType()
+	require.NoError(t, err)
+	require.Equal(t, "text/plain; charset=utf-8", ct)
+}
+
+func TestObject_Timestamp(t *testing.T) {
+	var o object.Object
+
+	_, err := o.Timestamp()
+	require.ErrorIs(t, err, object.ErrAttributeNotFound)
+
+	now := time.Now()
+	o.SetTimestamp(now)
+
+	ts, err := o.Timestamp()
+	require.NoError(t, err)
+	require.Equal(t, now.Unix(), ts.Unix())
+
+	setAttribute(&o, object.AttributeTimestamp, "yesterday")
+	_, err = o.Timestamp()
+	require.Error(t, err)
+}
@@ -11,10 +11,11 @@ import (
 //
 // See details in [client.Client.BalanceGet].
 func (p *Pool) BalanceGet(ctx context.Context, prm client.PrmBalanceGet) (accounting.Decimal, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, nil)
 	if err != nil {
 		return accounting.Decimal{}, err
 	}
+	defer c.finish()
 
 	return c.BalanceGet(ctx, prm)
 }
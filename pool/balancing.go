@@ -0,0 +1,77 @@
+package pool
+
+// BalancingStrategy is an enumerator of the algorithms used by Pool to select
+// the node for the next request among the nodes with the same priority.
+type BalancingStrategy uint8
+
+const (
+	// BalancingWeightedRandom selects nodes randomly according to their
+	// weights. It is the default strategy.
+	BalancingWeightedRandom BalancingStrategy = iota
+	// BalancingRoundRobin selects nodes one by one ignoring their weights.
+	BalancingRoundRobin
+	// BalancingLeastInFlight selects the node executing the least number of
+	// requests at the moment. Weights are ignored.
+	//
+	// Note that stream operations (e.g. Pool.ObjectPutInit) are counted until
+	// initiating method returns only.
+	BalancingLeastInFlight
+)
+
+// String implements fmt.Stringer.
+func (x BalancingStrategy) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case BalancingWeightedRandom:
+		return "WEIGHTED_RANDOM"
+	case BalancingRoundRobin:
+		return "ROUND_ROBIN"
+	case BalancingLeastInFlight:
+		return "LEAST_IN_FLIGHT"
+	}
+}
+
+// SetBalancingStrategy specifies the algorithm used to select the node for
+// the next request within the group of nodes with the same priority. Nodes
+// with higher priority are still preferred while they are healthy.
+// By default, [BalancingWeightedRandom] is used.
+func (x *InitParameters) SetBalancingStrategy(strategy BalancingStrategy) {
+	x.balancingStrategy = strategy
+}
+
+// nextIndex returns index of the next client to try according to the
+// configured balancing strategy. Must be called under read lock.
+func (p *innerPool) nextIndex() int {
+	switch p.strategy {
+	default:
+		return p.sampler.Next()
+	case BalancingRoundRobin:
+		return int((p.counter.Inc() - 1) % uint32(len(p.clients)))
+	}
+}
+
+// leastInFlight returns healthy client executing the least number of
+// requests at the moment. Clients with the same number of requests are
+// selected in turn. Must be called under read lock.
+func (p *innerPool) leastInFlight(accept func(internalClient) bool) internalClient {
+	var (
+		res    internalClient
+		minReq int64
+		n      = len(p.clients)
+		start  = int((p.counter.Inc() - 1) % uint32(n))
+	)
+
+	for k := 0; k < n; k++ {
+		cp := p.clients[(start+k)%n]
+		if cp == nil || !cp.isHealthy() || (accept != nil && !accept(cp)) {
+			continue
+		}
+
+		if req := cp.inFlightRequests(); res == nil || req < minReq {
+			res, minReq = cp, req
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,96 @@
+package pool
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInnerPool(t *testing.T, strategy BalancingStrategy, weights []float64) *innerPool {
+	clients := make([]internalClient, len(weights))
+	for i := range clients {
+		clients[i] = newMockClient("peer"+strconv.Itoa(i), test.RandomSigner(t))
+	}
+
+	return &innerPool{
+		sampler:  newSampler(weights, rand.NewSource(0)),
+		clients:  clients,
+		strategy: strategy,
+	}
+}
+
+func TestBalancingRoundRobin(t *testing.T) {
+	inner := newTestInnerPool(t, BalancingRoundRobin, []float64{0.9, 0.1, 0})
+
+	for i := 0; i < 6; i++ {
+		cp, err := inner.connection(nil)
+		require.NoError(t, err)
+		require.Equal(t, inner.clients[i%3], cp)
+		cp.requestFinished()
+	}
+
+	inner.clients[1].setUnhealthy()
+
+	for i := 0; i < 6; i++ {
+		cp, err := inner.connection(nil)
+		require.NoError(t, err)
+		require.NotEqual(t, inner.clients[1], cp)
+		cp.requestFinished()
+	}
+}
+
+func TestBalancingLeastInFlight(t *testing.T) {
+	inner := newTestInnerPool(t, BalancingLeastInFlight, []float64{0.5, 0.5, 0})
+
+	var got []internalClient
+	for i := 0; i < 3; i++ {
+		cp, err := inner.connection(nil)
+		require.NoError(t, err)
+		got = append(got, cp)
+	}
+
+	// each node executes one request
+	require.ElementsMatch(t, inner.clients, got)
+
+	got[1].requestFinished()
+
+	cp, err := inner.connection(nil)
+	require.NoError(t, err)
+	require.Equal(t, got[1], cp)
+	require.EqualValues(t, 1, cp.inFlightRequests())
+
+	got[2].setUnhealthy()
+	got[2].requestFinished()
+
+	cp, err = inner.connection(nil)
+	require.NoError(t, err)
+	require.NotEqual(t, got[2], cp)
+	require.EqualValues(t, 2, cp.inFlightRequests())
+}
+
+func TestBalancingWeightedRandom(t *testing.T) {
+	inner := newTestInnerPool(t, BalancingWeightedRandom, []float64{1, 0})
+
+	for i := 0; i < 10; i++ {
+		cp, err := inner.connection(nil)
+		require.NoError(t, err)
+		require.Equal(t, inner.clients[0], cp)
+		cp.requestFinished()
+	}
+
+	require.Zero(t, inner.clients[0].inFlightRequests())
+}
+
+func TestBalancingStrategy_String(t *testing.T) {
+	for s, str := range map[BalancingStrategy]string{
+		BalancingWeightedRandom: "WEIGHTED_RANDOM",
+		BalancingRoundRobin:     "ROUND_ROBIN",
+		BalancingLeastInFlight:  "LEAST_IN_FLIGHT",
+		BalancingStrategy(100):  "UNKNOWN",
+	} {
+		require.Equal(t, str, s.String())
+	}
+}
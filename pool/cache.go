@@ -1,6 +1,7 @@
 package pool
 
 import (
+	"fmt"
 	"strings"
 	"sync/atomic"
 
@@ -10,10 +11,16 @@ import (
 
 const (
 	defaultSessionCacheSize = 700
+	// defaultSessionCacheShards is a number of independent LRU caches used to
+	// reduce lock contention. Must be a power of 2.
+	defaultSessionCacheShards = 16
 )
 
+// sessionCache is a session token LRU cache split into several shards by key
+// hash. Each shard has its own lock, so highly parallel requests don't
+// serialize on a single mutex.
 type sessionCache struct {
-	cache        *lru.Cache
+	shards       []*lru.Cache
 	currentEpoch uint64
 }
 
@@ -22,26 +29,65 @@ type cacheValue struct {
 }
 
 func newCache(cacheSize int) (*sessionCache, error) {
-	cache, err := lru.New(cacheSize)
-	if err != nil {
-		return nil, err
+	return newShardedCache(cacheSize, defaultSessionCacheShards)
+}
+
+// newShardedCache creates sessionCache with the given total capacity split
+// into the given number of shards. Returns an error if number of shards is
+// not a positive power of 2.
+func newShardedCache(cacheSize int, shards int) (*sessionCache, error) {
+	if shards <= 0 || shards&(shards-1) != 0 {
+		return nil, fmt.Errorf("number of cache shards %d is not a positive power of 2", shards)
+	}
+
+	if shards > cacheSize {
+		shards = 1
+	}
+
+	res := &sessionCache{
+		shards: make([]*lru.Cache, shards),
+	}
+
+	shardSize := (cacheSize + shards - 1) / shards
+
+	for i := range res.shards {
+		cache, err := lru.New(shardSize)
+		if err != nil {
+			return nil, err
+		}
+
+		res.shards[i] = cache
+	}
+
+	return res, nil
+}
+
+// shard returns the cache shard responsible for the given key.
+func (c *sessionCache) shard(key string) *lru.Cache {
+	// FNV-1a, inlined to avoid allocations
+	h := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		h ^= uint32(key[i])
+		h *= 16777619
 	}
 
-	return &sessionCache{cache: cache}, nil
+	return c.shards[h&uint32(len(c.shards)-1)]
 }
 
 // Get returns a copy of the session token from the cache without signature
 // and context related fields. Returns nil if token is missing in the cache.
 // It is safe to modify and re-sign returned session token.
 func (c *sessionCache) Get(key string) (session.Object, bool) {
-	valueRaw, ok := c.cache.Get(key)
+	shard := c.shard(key)
+
+	valueRaw, ok := shard.Get(key)
 	if !ok {
 		return session.Object{}, false
 	}
 
 	value := valueRaw.(*cacheValue)
 	if c.expired(value) {
-		c.cache.Remove(key)
+		shard.Remove(key)
 		return session.Object{}, false
 	}
 
@@ -49,15 +95,17 @@ func (c *sessionCache) Get(key string) (session.Object, bool) {
 }
 
 func (c *sessionCache) Put(key string, token session.Object) bool {
-	return c.cache.Add(key, &cacheValue{
+	return c.shard(key).Add(key, &cacheValue{
 		token: token,
 	})
 }
 
 func (c *sessionCache) DeleteByPrefix(prefix string) {
-	for _, key := range c.cache.Keys() {
-		if strings.HasPrefix(key.(string), prefix) {
-			c.cache.Remove(key)
+	for _, shard := range c.shards {
+		for _, key := range shard.Keys() {
+			if strings.HasPrefix(key.(string), prefix) {
+				shard.Remove(key)
+			}
 		}
 	}
 }
@@ -1,6 +1,8 @@
 package pool
 
 import (
+	"runtime"
+	"strconv"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
@@ -32,3 +34,84 @@ func TestSessionCache_GetUnmodifiedToken(t *testing.T) {
 	require.True(t, ok)
 	check(t, value, "after sign")
 }
+
+func TestSessionCache_Sharding(t *testing.T) {
+	const prefix = "peer0"
+
+	cache, err := newShardedCache(1024, 8)
+	require.NoError(t, err)
+	require.Len(t, cache.shards, 8)
+
+	tok := *sessiontest.Object()
+
+	for i := 0; i < 32; i++ {
+		cache.Put(prefix+strconv.Itoa(i), tok)
+		cache.Put("peer1"+strconv.Itoa(i), tok)
+	}
+
+	for i := 0; i < 32; i++ {
+		_, ok := cache.Get(prefix + strconv.Itoa(i))
+		require.True(t, ok)
+	}
+
+	cache.DeleteByPrefix(prefix)
+
+	for i := 0; i < 32; i++ {
+		_, ok := cache.Get(prefix + strconv.Itoa(i))
+		require.False(t, ok)
+		_, ok = cache.Get("peer1" + strconv.Itoa(i))
+		require.True(t, ok)
+	}
+
+	cache, err = newShardedCache(4, 8)
+	require.NoError(t, err)
+	require.Len(t, cache.shards, 1)
+
+	for _, shards := range []int{-1, 0, 3, 6} {
+		_, err = newShardedCache(1024, shards)
+		require.Error(t, err, shards)
+	}
+}
+
+// BenchmarkSessionCache measures cache throughput under 10k+ concurrent
+// goroutines for the single-lock and the sharded caches.
+func BenchmarkSessionCache(b *testing.B) {
+	const keys = 512
+
+	tok := *sessiontest.Object()
+
+	ks := make([]string, keys)
+	for i := range ks {
+		ks[i] = "peer" + strconv.Itoa(i%8) + "key" + strconv.Itoa(i)
+	}
+
+	parallelism := 10000/runtime.GOMAXPROCS(0) + 1
+
+	for _, shards := range []int{1, defaultSessionCacheShards} {
+		b.Run(strconv.Itoa(shards)+" shards", func(b *testing.B) {
+			cache, err := newShardedCache(defaultSessionCacheSize, shards)
+			require.NoError(b, err)
+
+			for _, k := range ks {
+				cache.Put(k, tok)
+			}
+
+			b.ReportAllocs()
+			b.SetParallelism(parallelism)
+			b.ResetTimer()
+
+			b.RunParallel(func(pb *testing.PB) {
+				var i int
+				for pb.Next() {
+					k := ks[i%keys]
+					if i%10 == 0 {
+						cache.Put(k, tok)
+					} else {
+						cache.Get(k)
+					}
+					i++
+				}
+			})
+		})
+	}
+}
@@ -15,10 +15,11 @@ import (
 //
 // See details in [client.Client.ContainerPut].
 func (p *Pool) ContainerPut(ctx context.Context, cont container.Container, signer neofscrypto.Signer, prm client.PrmContainerPut) (cid.ID, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, nil)
 	if err != nil {
 		return cid.ID{}, err
 	}
+	defer c.finish()
 
 	return c.ContainerPut(ctx, cont, signer, prm)
 }
@@ -27,10 +28,11 @@ func (p *Pool) ContainerPut(ctx context.Context, cont container.Container, signe
 //
 // See details in [client.Client.ContainerGet].
 func (p *Pool) ContainerGet(ctx context.Context, id cid.ID, prm client.PrmContainerGet) (container.Container, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &id)
 	if err != nil {
 		return container.Container{}, err
 	}
+	defer c.finish()
 
 	return c.ContainerGet(ctx, id, prm)
 }
@@ -39,10 +41,11 @@ func (p *Pool) ContainerGet(ctx context.Context, id cid.ID, prm client.PrmContai
 //
 // See details in [client.Client.ContainerList].
 func (p *Pool) ContainerList(ctx context.Context, ownerID user.ID, prm client.PrmContainerList) ([]cid.ID, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, nil)
 	if err != nil {
 		return []cid.ID{}, err
 	}
+	defer c.finish()
 
 	return c.ContainerList(ctx, ownerID, prm)
 }
@@ -51,10 +54,11 @@ func (p *Pool) ContainerList(ctx context.Context, ownerID user.ID, prm client.Pr
 //
 // See details in [client.Client.ContainerDelete].
 func (p *Pool) ContainerDelete(ctx context.Context, id cid.ID, signer neofscrypto.Signer, prm client.PrmContainerDelete) error {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &id)
 	if err != nil {
 		return err
 	}
+	defer c.finish()
 
 	return c.ContainerDelete(ctx, id, signer, prm)
 }
@@ -63,10 +67,11 @@ func (p *Pool) ContainerDelete(ctx context.Context, id cid.ID, signer neofscrypt
 //
 // See details in [client.Client.ContainerEACL].
 func (p *Pool) ContainerEACL(ctx context.Context, id cid.ID, prm client.PrmContainerEACL) (eacl.Table, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &id)
 	if err != nil {
 		return eacl.Table{}, err
 	}
+	defer c.finish()
 
 	return c.ContainerEACL(ctx, id, prm)
 }
@@ -75,10 +80,16 @@ func (p *Pool) ContainerEACL(ctx context.Context, id cid.ID, prm client.PrmConta
 //
 // See details in [client.Client.ContainerSetEACL].
 func (p *Pool) ContainerSetEACL(ctx context.Context, table eacl.Table, signer user.Signer, prm client.PrmContainerSetEACL) error {
-	c, err := p.sdkClient()
+	var cnr *cid.ID
+	if id, set := table.CID(); set {
+		cnr = &id
+	}
+
+	c, err := p.sdkClient(ctx, cnr)
 	if err != nil {
 		return err
 	}
+	defer c.finish()
 
 	return c.ContainerSetEACL(ctx, table, signer, prm)
 }
@@ -3,7 +3,8 @@ Package pool provides a wrapper for several NeoFS API clients.
 
 The main component is Pool type. It is a virtual connection to the network
 and provides methods for executing operations on the server. It also supports
-a weighted random selection of the underlying client to make requests.
+a weighted random selection of the underlying client to make requests. Other
+selection algorithms may be configured via InitParameters.SetBalancingStrategy.
 
 Pool has an auto-session mechanism for object operations. It is enabled by default.
 The mechanism allows to manipulate objects like upload, download, delete, etc, without explicit session passing.
@@ -26,6 +27,11 @@ This InitParameters will make pool use 192.168.130.71 node while it is healthy.
 	p, err := pool.NewPool(prm)
 	// ...
 
+Requests related to particular containers may be restricted to a subset of
+the pool nodes, e.g. to isolate hot and cold data:
+
+	prm.SetContainerNodes(hotCnr, []string{"192.168.130.72"})
+
 Connect to the NeoFS server:
 
 	err := p.Dial(ctx)
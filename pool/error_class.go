@@ -0,0 +1,83 @@
+package pool
+
+import (
+	"errors"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// ErrorClass is an enumerator of the error kinds distinguished by Pool when
+// it decides whether the node is healthy.
+type ErrorClass uint8
+
+const (
+	// ErrorClassTransport includes network/transport failures and other
+	// errors not related to NeoFS API statuses.
+	ErrorClassTransport ErrorClass = iota
+	// ErrorClassServer includes NeoFS API statuses signaling node-side
+	// failures: internal server error, wrong magic number, signature
+	// verification failure and node maintenance.
+	ErrorClassServer
+	// ErrorClassLogical includes the rest of NeoFS API statuses (e.g. object
+	// not found or access denied) which are caused by the request itself
+	// rather than by the node state.
+	ErrorClassLogical
+
+	errorClassNum
+)
+
+// String implements fmt.Stringer.
+func (x ErrorClass) String() string {
+	switch x {
+	default:
+		return "UNKNOWN"
+	case ErrorClassTransport:
+		return "TRANSPORT"
+	case ErrorClassServer:
+		return "SERVER"
+	case ErrorClassLogical:
+		return "LOGICAL"
+	}
+}
+
+// SetErrorClassThreshold specifies the number of errors of the given class
+// on connection after which node is considered as unhealthy. Zero value
+// (default) disables separate accounting for the class.
+//
+// Regardless of the per-class thresholds, transport and server errors are also
+// counted together against the common threshold (see SetErrorThreshold),
+// while logical errors never affect node health unless threshold for
+// ErrorClassLogical is set.
+//
+// Unknown classes are ignored.
+func (x *InitParameters) SetErrorClassThreshold(class ErrorClass, threshold uint32) {
+	if class < errorClassNum {
+		x.errorClassThresholds[class] = threshold
+	}
+}
+
+// classifyError returns class of the non-nil error returned by the NeoFS API
+// client.
+func classifyError(err error) ErrorClass {
+	if errors.Is(err, apistatus.ErrServerInternal) ||
+		errors.Is(err, apistatus.ErrWrongMagicNumber) ||
+		errors.Is(err, apistatus.ErrSignatureVerification) ||
+		errors.Is(err, apistatus.ErrNodeUnderMaintenance) {
+		return ErrorClassServer
+	}
+
+	if errors.Is(err, apistatus.Error) {
+		return ErrorClassLogical
+	}
+
+	// non-status logic error that could be returned
+	// from the SDK client; should not be considered
+	// as a connection error
+	var siErr *object.SplitInfoError
+	if errors.As(err, &siErr) {
+		return ErrorClassLogical
+	}
+
+	return ErrorClassTransport
+}
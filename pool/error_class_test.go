@@ -0,0 +1,104 @@
+package pool
+
+import (
+	"errors"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyError(t *testing.T) {
+	for _, tc := range []struct {
+		err   error
+		class ErrorClass
+	}{
+		{err: errors.New("connection refused"), class: ErrorClassTransport},
+		{err: apistatus.ServerInternal{}, class: ErrorClassServer},
+		{err: apistatus.WrongMagicNumber{}, class: ErrorClassServer},
+		{err: apistatus.SignatureVerification{}, class: ErrorClassServer},
+		{err: apistatus.NodeUnderMaintenance{}, class: ErrorClassServer},
+		{err: apistatus.ObjectNotFound{}, class: ErrorClassLogical},
+		{err: apistatus.ObjectAccessDenied{}, class: ErrorClassLogical},
+		{err: object.NewSplitInfoError(object.NewSplitInfo()), class: ErrorClassLogical},
+	} {
+		require.Equal(t, tc.class, classifyError(tc.err), tc.err)
+	}
+}
+
+func TestErrorClassThresholds(t *testing.T) {
+	t.Run("logical storm", func(t *testing.T) {
+		monitor := newClientStatusMonitor("", 3)
+
+		for i := 0; i < 100; i++ {
+			monitor.updateErrorRate(apistatus.ObjectNotFound{})
+		}
+
+		require.True(t, monitor.isHealthy())
+		require.Zero(t, monitor.currentErrorRate())
+		require.Zero(t, monitor.overallErrorRate())
+	})
+
+	t.Run("logical threshold", func(t *testing.T) {
+		monitor := newClientStatusMonitor("", 3)
+		monitor.classThresholds[ErrorClassLogical] = 5
+
+		for i := 0; i < 4; i++ {
+			monitor.updateErrorRate(apistatus.ObjectNotFound{})
+		}
+
+		require.True(t, monitor.isHealthy())
+		require.Zero(t, monitor.currentErrorRate())
+
+		monitor.updateErrorRate(apistatus.ObjectNotFound{})
+		require.False(t, monitor.isHealthy())
+		require.EqualValues(t, 5, monitor.overallErrorRate())
+	})
+
+	t.Run("transport threshold", func(t *testing.T) {
+		monitor := newClientStatusMonitor("", 10)
+		monitor.classThresholds[ErrorClassTransport] = 2
+
+		monitor.updateErrorRate(apistatus.ServerInternal{})
+		monitor.updateErrorRate(apistatus.ServerInternal{})
+		monitor.updateErrorRate(errors.New("any"))
+		require.True(t, monitor.isHealthy())
+
+		monitor.updateErrorRate(errors.New("any"))
+		require.False(t, monitor.isHealthy())
+		require.Zero(t, monitor.currentErrorRate())
+	})
+
+	t.Run("common threshold", func(t *testing.T) {
+		monitor := newClientStatusMonitor("", 4)
+		monitor.classThresholds[ErrorClassTransport] = 3
+		monitor.classThresholds[ErrorClassServer] = 3
+
+		monitor.updateErrorRate(apistatus.ServerInternal{})
+		monitor.updateErrorRate(apistatus.ServerInternal{})
+		monitor.updateErrorRate(errors.New("any"))
+		require.True(t, monitor.isHealthy())
+
+		monitor.updateErrorRate(errors.New("any"))
+		require.False(t, monitor.isHealthy())
+	})
+
+	t.Run("init parameters", func(t *testing.T) {
+		var prm InitParameters
+		prm.SetErrorClassThreshold(ErrorClassLogical, 7)
+		prm.SetErrorClassThreshold(errorClassNum, 8)
+		require.Equal(t, [errorClassNum]uint32{0, 0, 7}, prm.errorClassThresholds)
+	})
+}
+
+func TestErrorClass_String(t *testing.T) {
+	for c, str := range map[ErrorClass]string{
+		ErrorClassTransport: "TRANSPORT",
+		ErrorClassServer:    "SERVER",
+		ErrorClassLogical:   "LOGICAL",
+		errorClassNum:       "UNKNOWN",
+	} {
+		require.Equal(t, str, c.String())
+	}
+}
@@ -0,0 +1,116 @@
+package pool
+
+// SetMinHealthyNodes specifies the minimum number of healthy nodes required for
+// the Pool to be considered healthy. Non-positive value means default (1).
+//
+// See also Pool.Healthy, Pool.Readiness.
+func (x *InitParameters) SetMinHealthyNodes(n int) {
+	x.minHealthyNodes = n
+}
+
+// NodeStatus describes current state of the single Pool node.
+type NodeStatus struct {
+	address       string
+	healthy       bool
+	currentErrors uint32
+	overallErrors uint64
+}
+
+// Address returns network address of the node.
+func (x NodeStatus) Address() string {
+	return x.address
+}
+
+// Healthy checks whether the node is able to handle requests.
+func (x NodeStatus) Healthy() bool {
+	return x.healthy
+}
+
+// CurrentErrors returns number of errors counted since the last health
+// status change. Reaching the error threshold makes the node unhealthy.
+//
+// See also InitParameters.SetErrorThreshold.
+func (x NodeStatus) CurrentErrors() uint32 {
+	return x.currentErrors
+}
+
+// OverallErrors returns number of all errors happened with the node.
+func (x NodeStatus) OverallErrors() uint64 {
+	return x.overallErrors
+}
+
+// Readiness is a report about Pool ability to serve requests. It is suitable
+// for readiness/liveness probes of the orchestration systems.
+type Readiness struct {
+	minHealthy int
+	healthy    int
+	nodes      []NodeStatus
+}
+
+// Ready checks whether number of healthy nodes reaches the configured minimum.
+//
+// See also InitParameters.SetMinHealthyNodes.
+func (x Readiness) Ready() bool {
+	return x.healthy >= x.minHealthy
+}
+
+// HealthyNodes returns number of currently healthy nodes.
+func (x Readiness) HealthyNodes() int {
+	return x.healthy
+}
+
+// MinHealthyNodes returns the minimum number of healthy nodes required for
+// the Pool to be ready.
+func (x Readiness) MinHealthyNodes() int {
+	return x.minHealthy
+}
+
+// Nodes returns statuses of all Pool nodes ordered by priority.
+func (x Readiness) Nodes() []NodeStatus {
+	return x.nodes
+}
+
+// Healthy checks whether the Pool has enough healthy nodes to serve requests.
+// Pool which is not yet dialed is never healthy.
+//
+// See also Readiness.
+func (p *Pool) Healthy() bool {
+	return p.Readiness().Ready()
+}
+
+// Readiness returns current report about Pool health. Health statuses of the
+// nodes are updated by the rebalance routine and by the error accounting on
+// requests.
+//
+// See also InitParameters.SetMinHealthyNodes, InitParameters.SetClientRebalanceInterval.
+func (p *Pool) Readiness() Readiness {
+	res := Readiness{minHealthy: p.minHealthyNodes}
+
+	for i, inner := range p.innerPools {
+		inner.lock.RLock()
+		for j, cli := range inner.clients {
+			if cli == nil { // failed to build
+				res.nodes = append(res.nodes, NodeStatus{
+					address: p.rebalanceParams.nodesParams[i].addresses[j],
+				})
+				continue
+			}
+
+			st := NodeStatus{
+				address:       cli.address(),
+				healthy:       cli.isHealthy(),
+				currentErrors: cli.currentErrorRate(),
+				overallErrors: cli.overallErrorRate(),
+			}
+
+			if st.healthy {
+				res.healthy++
+			}
+
+			res.nodes = append(res.nodes, st)
+		}
+		inner.lock.RUnlock()
+	}
+
+	return res
+}
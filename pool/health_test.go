@@ -0,0 +1,79 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolReadiness(t *testing.T) {
+	nodes := []NodeParam{
+		{1, "peer0", 1},
+		{1, "peer1", 1},
+		{2, "peer2", 1},
+	}
+
+	t.Run("threshold exceeds nodes", func(t *testing.T) {
+		opts := InitParameters{
+			signer:     test.RandomSignerRFC6979(t),
+			nodeParams: nodes,
+		}
+		opts.SetMinHealthyNodes(len(nodes) + 1)
+
+		_, err := NewPool(opts)
+		require.Error(t, err)
+	})
+
+	clients := make(map[string]*mockClient)
+	mockClientBuilder := func(addr string) (internalClient, error) {
+		cli := newMockClient(addr, test.RandomSignerRFC6979(t))
+		clients[addr] = cli
+		return cli, nil
+	}
+
+	opts := InitParameters{
+		signer:                  test.RandomSignerRFC6979(t),
+		nodeParams:              nodes,
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(mockClientBuilder)
+	opts.SetMinHealthyNodes(2)
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+
+	require.False(t, pool.Healthy())
+	require.Empty(t, pool.Readiness().Nodes())
+
+	err = pool.Dial(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	r := pool.Readiness()
+	require.True(t, r.Ready())
+	require.True(t, pool.Healthy())
+	require.Equal(t, 3, r.HealthyNodes())
+	require.Equal(t, 2, r.MinHealthyNodes())
+	require.Len(t, r.Nodes(), 3)
+	require.Equal(t, "peer2", r.Nodes()[2].Address())
+
+	clients["peer0"].incErrorRate()
+	clients["peer1"].setUnhealthy()
+
+	r = pool.Readiness()
+	require.True(t, r.Ready())
+	require.Equal(t, 2, r.HealthyNodes())
+	require.EqualValues(t, 1, r.Nodes()[0].CurrentErrors())
+	require.EqualValues(t, 1, r.Nodes()[0].OverallErrors())
+	require.False(t, r.Nodes()[1].Healthy())
+
+	clients["peer2"].setUnhealthy()
+
+	r = pool.Readiness()
+	require.False(t, r.Ready())
+	require.False(t, pool.Healthy())
+	require.Equal(t, 1, r.HealthyNodes())
+}
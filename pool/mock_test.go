@@ -28,6 +28,9 @@ type mockClient struct {
 	errorOnEndpointInfo  bool
 	errorOnNetworkInfo   bool
 	errOnGetObject       error
+
+	// onObjectHead is called on each objectHead call if set.
+	onObjectHead func()
 }
 
 func newMockClient(addr string, signer neofscrypto.Signer) *mockClient {
@@ -148,6 +151,10 @@ func (m *mockClient) objectGet(context.Context, cid.ID, oid.ID, neofscrypto.Sign
 }
 
 func (m *mockClient) objectHead(context.Context, cid.ID, oid.ID, neofscrypto.Signer, PrmObjectHead) (object.Object, error) {
+	if m.onObjectHead != nil {
+		m.onObjectHead()
+	}
+
 	return object.Object{}, nil
 }
 
@@ -200,6 +207,12 @@ func (m *mockClient) getClient() (*sdkClient.Client, error) {
 	return nil, errors.New("now supported to return sdkClient from mockClient")
 }
 
+func (m *mockClient) nodeKey() []byte {
+	pub := m.signer.Public()
+	b := make([]byte, pub.MaxEncodedSize())
+	return b[:pub.Encode(b)]
+}
+
 func (m *mockClient) SetNodeSession(*session.Object) {
 }
 
@@ -11,10 +11,11 @@ import (
 //
 // See details in [client.Client.NetworkInfo].
 func (p *Pool) NetworkInfo(ctx context.Context, prm client.PrmNetworkInfo) (netmap.NetworkInfo, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, nil)
 	if err != nil {
 		return netmap.NetworkInfo{}, err
 	}
+	defer c.finish()
 
 	return c.NetworkInfo(ctx, prm)
 }
@@ -23,10 +24,11 @@ func (p *Pool) NetworkInfo(ctx context.Context, prm client.PrmNetworkInfo) (netm
 //
 // See details in [client.Client.NetMapSnapshot].
 func (p *Pool) NetMapSnapshot(ctx context.Context, prm client.PrmNetMapSnapshot) (netmap.NetMap, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, nil)
 	if err != nil {
 		return netmap.NetMap{}, err
 	}
+	defer c.finish()
 
 	return c.NetMapSnapshot(ctx, prm)
 }
@@ -31,16 +31,17 @@ func (p *Pool) actualSigner(signer user.Signer) user.Signer {
 //
 // See details in [client.Client.ObjectPutInit].
 func (p *Pool) ObjectPutInit(ctx context.Context, hdr object.Object, signer user.Signer, prm client.PrmObjectPutInit) (client.ObjectWriter, error) {
-	c, err := p.sdkClient()
-	if err != nil {
-		return nil, err
-	}
-
 	cnr, isSet := hdr.ContainerID()
 	if !isSet {
 		return nil, errContainerRequired
 	}
 
+	c, err := p.sdkClient(ctx, &cnr)
+	if err != nil {
+		return nil, err
+	}
+	defer c.finish()
+
 	if err = p.withinContainerSession(
 		ctx,
 		c,
@@ -62,10 +63,12 @@ func (p *Pool) ObjectPutInit(ctx context.Context, hdr object.Object, signer user
 // See details in [client.Client.ObjectGetInit].
 func (p *Pool) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error) {
 	var hdr object.Object
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &containerID)
 	if err != nil {
 		return hdr, nil, err
 	}
+	defer c.finish()
+
 	if err = p.withinContainerSession(
 		ctx,
 		c,
@@ -86,10 +89,12 @@ func (p *Pool) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID o
 //
 // See details in [client.Client.ObjectHead].
 func (p *Pool) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHead) (*client.ResObjectHead, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &containerID)
 	if err != nil {
 		return nil, err
 	}
+	defer c.finish()
+
 	if err = p.withinContainerSession(
 		ctx,
 		c,
@@ -110,10 +115,12 @@ func (p *Pool) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.
 //
 // See details in [client.Client.ObjectRangeInit].
 func (p *Pool) ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID oid.ID, offset, length uint64, signer user.Signer, prm client.PrmObjectRange) (*client.ObjectRangeReader, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &containerID)
 	if err != nil {
 		return nil, err
 	}
+	defer c.finish()
+
 	if err = p.withinContainerSession(
 		ctx,
 		c,
@@ -134,10 +141,12 @@ func (p *Pool) ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID
 //
 // See details in [client.Client.ObjectDelete].
 func (p *Pool) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectDelete) (oid.ID, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &containerID)
 	if err != nil {
 		return oid.ID{}, err
 	}
+	defer c.finish()
+
 	if err = p.withinContainerSession(
 		ctx,
 		c,
@@ -158,10 +167,12 @@ func (p *Pool) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oi
 //
 // See details in [client.Client.ObjectHash].
 func (p *Pool) ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHash) ([][]byte, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &containerID)
 	if err != nil {
 		return [][]byte{}, err
 	}
+	defer c.finish()
+
 	if err = p.withinContainerSession(
 		ctx,
 		c,
@@ -182,10 +193,12 @@ func (p *Pool) ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.
 //
 // See details in [client.Client.ObjectSearchInit].
 func (p *Pool) ObjectSearchInit(ctx context.Context, containerID cid.ID, signer user.Signer, prm client.PrmObjectSearch) (*client.ObjectListReader, error) {
-	c, err := p.sdkClient()
+	c, err := p.sdkClient(ctx, &containerID)
 	if err != nil {
 		return nil, err
 	}
+	defer c.finish()
+
 	if err = p.withinContainerSession(
 		ctx,
 		c,
@@ -0,0 +1,81 @@
+package pool
+
+import (
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// containerPartitions maps containers to the sets of node addresses which are
+// allowed to serve requests for them.
+type containerPartitions map[cid.ID]map[string]struct{}
+
+// SetContainerNodes restricts requests related to the given container to the
+// specified subset of pool nodes. It allows to isolate different workloads
+// (e.g. hot and cold data) within one Pool instance. Each address MUST be
+// added to the pool via AddNode (or passed to New), otherwise Pool creation
+// fails. Repeated calls for the same container overwrite previous value.
+//
+// Requests for containers without partition are served by any pool node.
+// Note that container creation can't be routed this way since container ID
+// is unknown before the request.
+func (x *InitParameters) SetContainerNodes(cnr cid.ID, addresses []string) {
+	if x.containerNodes == nil {
+		x.containerNodes = make(map[cid.ID][]string)
+	}
+
+	x.containerNodes[cnr] = addresses
+}
+
+// buildContainerPartitions checks that partitions refer to the known nodes only
+// and converts them into the lookup-friendly form.
+func buildContainerPartitions(containerNodes map[cid.ID][]string, nodeParams []NodeParam) (containerPartitions, error) {
+	if len(containerNodes) == 0 {
+		return nil, nil
+	}
+
+	known := make(map[string]struct{}, len(nodeParams))
+	for i := range nodeParams {
+		known[nodeParams[i].address] = struct{}{}
+	}
+
+	res := make(containerPartitions, len(containerNodes))
+
+	for cnr, addresses := range containerNodes {
+		if len(addresses) == 0 {
+			return nil, fmt.Errorf("container %s: empty node list", cnr)
+		}
+
+		allowed := make(map[string]struct{}, len(addresses))
+		for _, addr := range addresses {
+			if _, ok := known[addr]; !ok {
+				return nil, fmt.Errorf("container %s: unknown node %s", cnr, addr)
+			}
+
+			allowed[addr] = struct{}{}
+		}
+
+		res[cnr] = allowed
+	}
+
+	return res, nil
+}
+
+// containerConnection returns connection to the healthy node which is allowed
+// to serve requests for the given container.
+func (p *Pool) containerConnection(cnr cid.ID) (internalClient, error) {
+	allowed, ok := p.partitions[cnr]
+	if !ok {
+		return p.connection()
+	}
+
+	cp, err := p.selectConnection(func(c internalClient) bool {
+		_, ok := allowed[c.address()]
+		return ok
+	})
+	if err != nil {
+		return nil, fmt.Errorf("container %s partition: %w", cnr, err)
+	}
+
+	return cp, nil
+}
@@ -0,0 +1,123 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// nodePin identifies the node which must execute the request.
+type nodePin struct {
+	address string
+	key     []byte
+}
+
+func (x nodePin) set() bool {
+	return x.address != "" || len(x.key) > 0
+}
+
+func (x nodePin) String() string {
+	if x.address != "" {
+		return x.address
+	}
+
+	return hex.EncodeToString(x.key)
+}
+
+func (x nodePin) matches(c internalClient) bool {
+	if x.address != "" {
+		return c.address() == x.address
+	}
+
+	return bytes.Equal(c.nodeKey(), x.key)
+}
+
+// UseNode forces the operation to be executed on the pool node with the
+// given address bypassing node selection. Session and signing settings are
+// applied as usual. Pinned node takes precedence over the container partitions
+// (see InitParameters.SetContainerNodes).
+//
+// Overrides UseNodeKey.
+func (x *prmCommon) UseNode(address string) {
+	x.node = nodePin{address: address}
+}
+
+// UseNodeKey is the same as UseNode but identifies the node by its binary
+// public key (see netmap.NodeInfo.PublicKey). Keys of the pool nodes are
+// learned from their responses, so the node may be unknown right after
+// it was (re-)connected.
+//
+// Overrides UseNode.
+func (x *prmCommon) UseNodeKey(key []byte) {
+	x.node = nodePin{key: key}
+}
+
+type nodePinContextKey struct{}
+
+// WithNode returns a copy of the parent context which forces Pool methods
+// accepting parameters from the client package (e.g. Pool.ObjectGetInit) to
+// be executed on the pool node with the given address. It is a context
+// alternative of the UseNode method of the Pool parameter types.
+func WithNode(parent context.Context, address string) context.Context {
+	return context.WithValue(parent, nodePinContextKey{}, nodePin{address: address})
+}
+
+// WithNodeKey is the same as WithNode but identifies the node by its binary
+// public key. It is a context alternative of the UseNodeKey method of the Pool
+// parameter types.
+func WithNodeKey(parent context.Context, key []byte) context.Context {
+	return context.WithValue(parent, nodePinContextKey{}, nodePin{key: key})
+}
+
+// requestConnection returns connection to execute the request: explicitly
+// pinned node has the highest priority, context-pinned one is the next. If
+// node is not pinned, it is selected among the nodes allowed to serve the
+// requests for the given container. Nil container means any node.
+func (p *Pool) requestConnection(ctx context.Context, pin nodePin, cnr *cid.ID) (internalClient, error) {
+	if !pin.set() {
+		pin, _ = ctx.Value(nodePinContextKey{}).(nodePin)
+	}
+
+	if pin.set() {
+		return p.pinnedConnection(pin)
+	}
+
+	if cnr != nil {
+		return p.containerConnection(*cnr)
+	}
+
+	return p.connection()
+}
+
+// pinnedConnection returns connection to the pinned node. Returns an error if
+// there is no such node in the pool or it is unhealthy.
+func (p *Pool) pinnedConnection(pin nodePin) (internalClient, error) {
+	for _, inner := range p.innerPools {
+		inner.lock.RLock()
+		for _, cp := range inner.clients {
+			if cp == nil || !pin.matches(cp) {
+				continue
+			}
+
+			inner.lock.RUnlock()
+
+			if !cp.isHealthy() {
+				return nil, fmt.Errorf("pinned node %s: %w", pin, errPoolClientUnhealthy)
+			}
+
+			cp.requestStarted()
+
+			return cp, nil
+		}
+		inner.lock.RUnlock()
+	}
+
+	return nil, fmt.Errorf("pinned node %s: %w", pin, errNodeNotFound)
+}
+
+// errNodeNotFound is returned when pinned node is missing in the pool.
+var errNodeNotFound = errors.New("node not found in pool")
@@ -0,0 +1,119 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodePinning(t *testing.T) {
+	nodes := []NodeParam{
+		{1, "peer0", 1},
+		{1, "peer1", 1},
+		{2, "peer2", 1},
+	}
+
+	clients := make(map[string]*mockClient)
+	mockClientBuilder := func(addr string) (internalClient, error) {
+		cli := newMockClient(addr, test.RandomSignerRFC6979(t))
+		clients[addr] = cli
+		return cli, nil
+	}
+
+	var cnr cid.ID
+	cnr[0] = 1
+
+	opts := InitParameters{
+		signer:                  test.RandomSignerRFC6979(t),
+		nodeParams:              nodes,
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(mockClientBuilder)
+	opts.SetContainerNodes(cnr, []string{"peer0"})
+
+	ctx := context.Background()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	t.Run("by address", func(t *testing.T) {
+		var prm PrmObjectGet
+		prm.UseNode("peer2")
+
+		for i := 0; i < 5; i++ {
+			var cc callContext
+			cc.Context = ctx
+
+			require.NoError(t, pool.initCallContext(&cc, prm.prmCommon, prmContext{}))
+			require.Equal(t, "peer2", cc.endpoint)
+			cc.client.requestFinished()
+		}
+	})
+
+	t.Run("by key", func(t *testing.T) {
+		var prm PrmObjectHead
+		prm.UseNode("peer2")
+		prm.UseNodeKey(clients["peer1"].nodeKey())
+
+		var cc callContext
+		cc.Context = ctx
+
+		require.NoError(t, pool.initCallContext(&cc, prm.prmCommon, prmContext{}))
+		require.Equal(t, "peer1", cc.endpoint)
+		cc.client.requestFinished()
+	})
+
+	t.Run("context", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			cp, err := pool.requestConnection(WithNode(ctx, "peer1"), nodePin{}, nil)
+			require.NoError(t, err)
+			require.Equal(t, "peer1", cp.address())
+			cp.requestFinished()
+
+			cp, err = pool.requestConnection(WithNodeKey(ctx, clients["peer2"].nodeKey()), nodePin{}, nil)
+			require.NoError(t, err)
+			require.Equal(t, "peer2", cp.address())
+			cp.requestFinished()
+		}
+
+		// explicit parameter has priority
+		cp, err := pool.requestConnection(WithNode(ctx, "peer1"), nodePin{address: "peer0"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, "peer0", cp.address())
+		cp.requestFinished()
+	})
+
+	t.Run("override partition", func(t *testing.T) {
+		cp, err := pool.requestConnection(ctx, nodePin{address: "peer1"}, &cnr)
+		require.NoError(t, err)
+		require.Equal(t, "peer1", cp.address())
+		cp.requestFinished()
+
+		cp, err = pool.requestConnection(ctx, nodePin{}, &cnr)
+		require.NoError(t, err)
+		require.Equal(t, "peer0", cp.address())
+		cp.requestFinished()
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		_, err := pool.requestConnection(ctx, nodePin{address: "peer3"}, nil)
+		require.ErrorIs(t, err, errNodeNotFound)
+
+		_, err = pool.requestConnection(ctx, nodePin{key: []byte("any")}, nil)
+		require.ErrorIs(t, err, errNodeNotFound)
+	})
+
+	t.Run("unhealthy node", func(t *testing.T) {
+		clients["peer2"].setUnhealthy()
+
+		_, err := pool.requestConnection(ctx, nodePin{address: "peer2"}, nil)
+		require.ErrorIs(t, err, errPoolClientUnhealthy)
+	})
+}
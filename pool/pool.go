@@ -22,6 +22,7 @@ import (
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	neofspolicy "github.com/nspcc-dev/neofs-sdk-go/crypto/policy"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -42,9 +43,15 @@ type sdkClientWrapper struct {
 	*sdkClient.Client
 
 	nodeSession nodeSessionContainer
+	status      clientStatus
 	addr        string
 }
 
+// finish marks the end of the request execution on the underlying connection.
+func (c *sdkClientWrapper) finish() {
+	c.status.requestFinished()
+}
+
 // nodeSessionContainer represents storage for a session token. It contains only basics session info: id, pub key, expiration.
 // This token is used for the final session tokens creation for specific verb. This token is 1:1 for each node.
 // Should be stored until token not expired.
@@ -100,6 +107,9 @@ type internalClient interface {
 	restartIfUnhealthy(ctx context.Context) (bool, bool)
 
 	getClient() (*sdkClient.Client, error)
+	// nodeKey returns binary public key of the NeoFS node. Returns nil if the
+	// key is not known yet.
+	nodeKey() []byte
 }
 
 type statisticUpdater interface {
@@ -120,6 +130,12 @@ type clientStatus interface {
 	currentErrorRate() uint32
 	// overallErrorRate returns the number of all happened errors.
 	overallErrorRate() uint64
+	// inFlightRequests returns the number of requests being executed at the moment.
+	inFlightRequests() int64
+	// requestStarted marks the start of the request execution.
+	requestStarted()
+	// requestFinished marks the end of the request execution.
+	requestFinished()
 }
 
 // errPoolClientUnhealthy is an error to indicate that client in pool is unhealthy.
@@ -129,17 +145,22 @@ var errPoolClientUnhealthy = errors.New("pool client unhealthy")
 type clientStatusMonitor struct {
 	addr           string
 	healthy        *atomic.Bool
+	inFlight       *atomic.Int64
 	errorThreshold uint32
+	// per-class thresholds, zero disables accounting
+	classThresholds [errorClassNum]uint32
 
 	mu                sync.RWMutex // protect counters
 	currentErrorCount uint32
 	overallErrorCount uint64
+	classErrorCount   [errorClassNum]uint32
 }
 
 func newClientStatusMonitor(addr string, errorThreshold uint32) clientStatusMonitor {
 	return clientStatusMonitor{
 		addr:           addr,
 		healthy:        atomic.NewBool(true),
+		inFlight:       atomic.NewInt64(0),
 		errorThreshold: errorThreshold,
 	}
 }
@@ -157,6 +178,8 @@ type clientWrapper struct {
 	nodeSession      *session.Object
 
 	epoch atomic.Uint64
+
+	key atomic.Value // []byte
 }
 
 // wrapperPrm is params to create clientWrapper.
@@ -166,6 +189,7 @@ type wrapperPrm struct {
 	dialTimeout          time.Duration
 	streamTimeout        time.Duration
 	errorThreshold       uint32
+	classThresholds      [errorClassNum]uint32
 	responseInfoCallback func(sdkClient.ResponseMetaInfo) error
 	statisticCallback    stat.OperationCallback
 }
@@ -196,6 +220,12 @@ func (x *wrapperPrm) setErrorThreshold(threshold uint32) {
 	x.errorThreshold = threshold
 }
 
+// setErrorClassThresholds sets per-class thresholds after reaching any of which
+// connection is considered unhealthy. Zero threshold disables accounting for the class.
+func (x *wrapperPrm) setErrorClassThresholds(thresholds [errorClassNum]uint32) {
+	x.classThresholds = thresholds
+}
+
 // setResponseInfoCallback sets callback that will be invoked after every response.
 func (x *wrapperPrm) setResponseInfoCallback(f func(sdkClient.ResponseMetaInfo) error) {
 	x.responseInfoCallback = f
@@ -221,6 +251,7 @@ func newWrapper(prm wrapperPrm) (*clientWrapper, error) {
 		clientStatusMonitor: newClientStatusMonitor(prm.address, prm.errorThreshold),
 		statisticCallback:   prm.statisticCallback,
 	}
+	res.classThresholds = prm.classThresholds
 
 	oldCallBack := prm.responseInfoCallback
 	prm.setResponseInfoCallback(func(info sdkClient.ResponseMetaInfo) error {
@@ -252,6 +283,10 @@ func newWrapper(prm wrapperPrm) (*clientWrapper, error) {
 func (c *clientWrapper) statisticMiddleware(nodeKey []byte, endpoint string, method stat.Method, duration time.Duration, err error) {
 	c.updateErrorRate(err)
 
+	if len(nodeKey) > 0 && c.key.Load() == nil {
+		c.key.Store(nodeKey)
+	}
+
 	if c.statisticCallback != nil {
 		c.statisticCallback(nodeKey, endpoint, method, duration, err)
 	}
@@ -320,6 +355,11 @@ func (c *clientWrapper) restartIfUnhealthy(ctx context.Context) (healthy, change
 	return true, !wasHealthy
 }
 
+func (c *clientWrapper) nodeKey() []byte {
+	key, _ := c.key.Load().([]byte)
+	return key
+}
+
 func (c *clientWrapper) getClient() (*sdkClient.Client, error) {
 	c.clientMutex.RLock()
 	defer c.clientMutex.RUnlock()
@@ -809,13 +849,37 @@ func (c *clientStatusMonitor) address() string {
 }
 
 func (c *clientStatusMonitor) incErrorRate() {
+	c.incClassErrorRate(ErrorClassTransport)
+}
+
+// incClassErrorRate counts error of the given class and marks the connection
+// unhealthy if any of the thresholds is reached.
+func (c *clientStatusMonitor) incClassErrorRate(class ErrorClass) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.currentErrorCount++
+
+	counted := class != ErrorClassLogical
+	if counted {
+		c.currentErrorCount++
+	}
+
+	classThreshold := c.classThresholds[class]
+	if classThreshold > 0 {
+		counted = true
+		c.classErrorCount[class]++
+	}
+
+	if !counted {
+		return
+	}
+
 	c.overallErrorCount++
-	if c.currentErrorCount >= c.errorThreshold {
+
+	if c.currentErrorCount >= c.errorThreshold ||
+		classThreshold > 0 && c.classErrorCount[class] >= classThreshold {
 		c.setUnhealthy()
 		c.currentErrorCount = 0
+		c.classErrorCount = [errorClassNum]uint32{}
 	}
 }
 
@@ -831,32 +895,24 @@ func (c *clientStatusMonitor) overallErrorRate() uint64 {
 	return c.overallErrorCount
 }
 
-func (c *clientStatusMonitor) updateErrorRate(err error) {
-	if err == nil {
-		return
-	}
+func (c *clientStatusMonitor) inFlightRequests() int64 {
+	return c.inFlight.Load()
+}
 
-	// count only this API errors
-	if errors.Is(err, apistatus.ErrServerInternal) ||
-		errors.Is(err, apistatus.ErrWrongMagicNumber) ||
-		errors.Is(err, apistatus.ErrSignatureVerification) ||
-		errors.Is(err, apistatus.ErrNodeUnderMaintenance) {
-		c.incErrorRate()
-		return
-	}
+func (c *clientStatusMonitor) requestStarted() {
+	c.inFlight.Inc()
+}
+
+func (c *clientStatusMonitor) requestFinished() {
+	c.inFlight.Dec()
+}
 
-	// don't count another API errors
-	if errors.Is(err, apistatus.Error) {
+func (c *clientStatusMonitor) updateErrorRate(err error) {
+	if err == nil {
 		return
 	}
 
-	// non-status logic error that could be returned
-	// from the SDK client; should not be considered
-	// as a connection error
-	var siErr *object.SplitInfoError
-	if !errors.As(err, &siErr) {
-		c.incErrorRate()
-	}
+	c.incClassErrorRate(classifyError(err))
 }
 
 // clientBuilder is a type alias of client constructors.
@@ -872,7 +928,11 @@ type InitParameters struct {
 	clientRebalanceInterval   time.Duration
 	sessionExpirationDuration uint64
 	errorThreshold            uint32
+	errorClassThresholds      [errorClassNum]uint32
+	minHealthyNodes           int
 	nodeParams                []NodeParam
+	containerNodes            map[cid.ID][]string
+	balancingStrategy         BalancingStrategy
 
 	clientBuilder clientBuilder
 
@@ -921,6 +981,9 @@ func (x *InitParameters) SetSessionExpirationDuration(expirationDuration uint64)
 }
 
 // SetErrorThreshold specifies the number of errors on connection after which node is considered as unhealthy.
+// Only transport and node-side server errors are counted.
+//
+// See also SetErrorClassThreshold.
 func (x *InitParameters) SetErrorThreshold(threshold uint32) {
 	x.errorThreshold = threshold
 }
@@ -1051,6 +1114,7 @@ type prmCommon struct {
 	signer user.Signer
 	btoken *bearer.Token
 	stoken *session.Object
+	node   nodePin
 }
 
 // UseSigner specifies private signer to sign the requests.
@@ -1273,19 +1337,25 @@ type Pool struct {
 	rebalanceParams rebalanceParameters
 	clientBuilder   clientBuilder
 	logger          *zap.Logger
+	partitions      containerPartitions
+	minHealthyNodes int
+	strategy        BalancingStrategy
 
 	statisticCallback stat.OperationCallback
 }
 
 type innerPool struct {
-	lock    sync.RWMutex
-	sampler *sampler
-	clients []internalClient
+	lock     sync.RWMutex
+	sampler  *sampler
+	clients  []internalClient
+	strategy BalancingStrategy
+	counter  atomic.Uint32 // used by balancing strategies selecting nodes in turn
 }
 
 const (
 	defaultSessionTokenExpirationDuration = 100 // in blocks
 	defaultErrorThreshold                 = 100
+	defaultMinHealthyNodes                = 1
 
 	defaultRebalanceInterval  = 25 * time.Second
 	defaultHealthcheckTimeout = 4 * time.Second
@@ -1303,6 +1373,7 @@ func DefaultOptions() InitParameters {
 		healthcheckTimeout:        defaultHealthcheckTimeout,
 		nodeDialTimeout:           defaultDialTimeout,
 		nodeStreamTimeout:         defaultStreamTimeout,
+		minHealthyNodes:           defaultMinHealthyNodes,
 	}
 
 	return params
@@ -1368,6 +1439,15 @@ func NewPool(options InitParameters) (*Pool, error) {
 		return nil, err
 	}
 
+	partitions, err := buildContainerPartitions(options.containerNodes, options.nodeParams)
+	if err != nil {
+		return nil, fmt.Errorf("container partitions: %w", err)
+	}
+
+	if options.minHealthyNodes > len(options.nodeParams) {
+		return nil, fmt.Errorf("min healthy nodes %d exceeds number of nodes %d", options.minHealthyNodes, len(options.nodeParams))
+	}
+
 	cache, err := newCache(defaultSessionCacheSize)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't create cache: %w", err)
@@ -1389,6 +1469,9 @@ func NewPool(options InitParameters) (*Pool, error) {
 	}
 	pool.clientBuilder = options.clientBuilder
 	pool.statisticCallback = options.statisticCallback
+	pool.partitions = partitions
+	pool.minHealthyNodes = options.minHealthyNodes
+	pool.strategy = options.balancingStrategy
 
 	return pool, nil
 }
@@ -1443,8 +1526,9 @@ func (p *Pool) Dial(ctx context.Context) error {
 		sampl := newSampler(params.weights, source)
 
 		inner[i] = &innerPool{
-			sampler: sampl,
-			clients: clients,
+			sampler:  sampl,
+			clients:  clients,
+			strategy: p.strategy,
 		}
 	}
 
@@ -1470,6 +1554,10 @@ func fillDefaultInitParams(params *InitParameters, cache *sessionCache, statisti
 		params.errorThreshold = defaultErrorThreshold
 	}
 
+	if params.minHealthyNodes <= 0 {
+		params.minHealthyNodes = defaultMinHealthyNodes
+	}
+
 	if params.clientRebalanceInterval <= 0 {
 		params.clientRebalanceInterval = defaultRebalanceInterval
 	}
@@ -1494,6 +1582,7 @@ func fillDefaultInitParams(params *InitParameters, cache *sessionCache, statisti
 			prm.setDialTimeout(params.nodeDialTimeout)
 			prm.setStreamTimeout(params.nodeStreamTimeout)
 			prm.setErrorThreshold(params.errorThreshold)
+			prm.setErrorClassThresholds(params.errorClassThresholds)
 			prm.setResponseInfoCallback(func(info sdkClient.ResponseMetaInfo) error {
 				cache.updateEpoch(info.Epoch())
 				return nil
@@ -1630,9 +1719,17 @@ func adjustWeights(weights []float64) []float64 {
 	return adjusted
 }
 
+// connection returns healthy connection to serve the request. Caller MUST call
+// requestFinished on the returned connection when request is done.
 func (p *Pool) connection() (internalClient, error) {
+	return p.selectConnection(nil)
+}
+
+// selectConnection returns healthy connection satisfying accept filter from
+// the inner pool with the highest priority. Nil filter accepts any connection.
+func (p *Pool) selectConnection(accept func(internalClient) bool) (internalClient, error) {
 	for _, inner := range p.innerPools {
-		cp, err := inner.connection()
+		cp, err := inner.connection(accept)
 		if err == nil {
 			return cp, nil
 		}
@@ -1641,25 +1738,53 @@ func (p *Pool) connection() (internalClient, error) {
 	return nil, errors.New("no healthy client")
 }
 
-func (p *innerPool) connection() (internalClient, error) {
+// connection returns healthy client satisfying accept filter. Nil filter
+// accepts any client. Returned client is marked as executing the request,
+// so caller MUST call requestFinished when request is done.
+func (p *innerPool) connection(accept func(internalClient) bool) (internalClient, error) {
+	cp := p.selectClient(accept)
+	if cp == nil {
+		return nil, errors.New("no healthy client")
+	}
+
+	cp.requestStarted()
+
+	return cp, nil
+}
+
+func (p *innerPool) selectClient(accept func(internalClient) bool) internalClient {
 	p.lock.RLock() // need lock because of using p.sampler
 	defer p.lock.RUnlock()
 	if len(p.clients) == 1 {
 		cp := p.clients[0]
-		if cp.isHealthy() {
-			return cp, nil
+		if cp.isHealthy() && (accept == nil || accept(cp)) {
+			return cp
 		}
-		return nil, errors.New("no healthy client")
+		return nil
 	}
+
+	if p.strategy == BalancingLeastInFlight {
+		return p.leastInFlight(accept)
+	}
+
 	attempts := 3 * len(p.clients)
 	for k := 0; k < attempts; k++ {
-		i := p.sampler.Next()
-		if cp := p.clients[i]; cp.isHealthy() {
-			return cp, nil
+		i := p.nextIndex()
+		if cp := p.clients[i]; cp.isHealthy() && (accept == nil || accept(cp)) {
+			return cp
 		}
 	}
 
-	return nil, errors.New("no healthy client")
+	if accept != nil {
+		// accepted clients may have low weights, so don't rely on sampling only
+		for _, cp := range p.clients {
+			if cp.isHealthy() && accept(cp) {
+				return cp
+			}
+		}
+	}
+
+	return nil
 }
 
 func formCacheKey(address string, signer neofscrypto.Signer) string {
@@ -1757,7 +1882,7 @@ type callContext struct {
 }
 
 func (p *Pool) initCallContext(ctx *callContext, cfg prmCommon, prmCtx prmContext) error {
-	cp, err := p.connection()
+	cp, err := p.requestConnection(ctx, cfg.node, &prmCtx.cnr)
 	if err != nil {
 		return err
 	}
@@ -1812,7 +1937,12 @@ func (p *Pool) openDefaultSession(ctx *callContext) error {
 	}
 
 	// sign the token
-	if err := tok.Sign(ctx.signer); err != nil {
+	signCtx := neofspolicy.WithOperation(ctx, neofspolicy.Operation{
+		Method:    neofspolicy.MethodSessionCreate,
+		Container: &ctx.sessionCnr,
+	})
+
+	if err := tok.SignContext(signCtx, ctx.signer); err != nil {
 		return fmt.Errorf("sign token of the opened session: %w", err)
 	}
 
@@ -1867,6 +1997,7 @@ func (p *Pool) PutObject(ctx context.Context, prm PrmObjectPut) (oid.ID, error)
 	if err := p.initCallContext(&ctxCall, prm.prmCommon, prmCtx); err != nil {
 		return oid.ID{}, fmt.Errorf("init call context: %w", err)
 	}
+	defer ctxCall.client.requestFinished()
 
 	if ctxCall.sessionDefault {
 		ctxCall.sessionTarget = prm.UseSession
@@ -1927,6 +2058,7 @@ func (p *Pool) DeleteObject(ctx context.Context, containerID cid.ID, objectID oi
 	if err != nil {
 		return err
 	}
+	defer cc.client.requestFinished()
 
 	return p.call(&cc, func() error {
 		if err = cc.client.objectDelete(ctx, containerID, objectID, prm.signer, prm); err != nil {
@@ -1944,6 +2076,9 @@ func (p *Pool) RawClient() (*sdkClient.Client, error) {
 		return nil, err
 	}
 
+	// request execution can't be tracked for the raw client
+	conn.requestFinished()
+
 	return conn.getClient()
 }
 
@@ -1981,10 +2116,14 @@ func (p *Pool) GetObject(ctx context.Context, containerID cid.ID, objectID oid.I
 
 	var res ResGetObject
 
-	err := p.initCallContext(&cc, prm.prmCommon, prmContext{})
+	var prmCtx prmContext
+	prmCtx.useContainer(containerID)
+
+	err := p.initCallContext(&cc, prm.prmCommon, prmCtx)
 	if err != nil {
 		return res, err
 	}
+	defer cc.client.requestFinished()
 
 	return res, p.call(&cc, func() error {
 		res, err = cc.client.objectGet(ctx, containerID, objectID, prm.signer, prm)
@@ -2006,10 +2145,14 @@ func (p *Pool) HeadObject(ctx context.Context, containerID cid.ID, objectID oid.
 
 	var obj object.Object
 
-	err := p.initCallContext(&cc, prm.prmCommon, prmContext{})
+	var prmCtx prmContext
+	prmCtx.useContainer(containerID)
+
+	err := p.initCallContext(&cc, prm.prmCommon, prmCtx)
 	if err != nil {
 		return obj, err
 	}
+	defer cc.client.requestFinished()
 
 	return obj, p.call(&cc, func() error {
 		obj, err = cc.client.objectHead(ctx, containerID, objectID, prm.signer, prm)
@@ -2051,10 +2194,14 @@ func (p *Pool) ObjectRange(ctx context.Context, containerID cid.ID, objectID oid
 
 	var res ResObjectRange
 
-	err := p.initCallContext(&cc, prm.prmCommon, prmContext{})
+	var prmCtx prmContext
+	prmCtx.useContainer(containerID)
+
+	err := p.initCallContext(&cc, prm.prmCommon, prmCtx)
 	if err != nil {
 		return res, err
 	}
+	defer cc.client.requestFinished()
 
 	return res, p.call(&cc, func() error {
 		res, err = cc.client.objectRange(ctx, containerID, objectID, offset, length, prm.signer, prm)
@@ -2115,10 +2262,14 @@ func (p *Pool) SearchObjects(ctx context.Context, containerID cid.ID, prm PrmObj
 
 	var res ResObjectSearch
 
-	err := p.initCallContext(&cc, prm.prmCommon, prmContext{})
+	var prmCtx prmContext
+	prmCtx.useContainer(containerID)
+
+	err := p.initCallContext(&cc, prm.prmCommon, prmCtx)
 	if err != nil {
 		return res, err
 	}
+	defer cc.client.requestFinished()
 
 	return res, p.call(&cc, func() error {
 		res, err = cc.client.objectSearch(ctx, containerID, prm.signer, prm)
@@ -2138,10 +2289,11 @@ func (p *Pool) SearchObjects(ctx context.Context, containerID cid.ID, prm PrmObj
 // Main return value MUST NOT be processed on an erroneous return.
 // Deprecated: use ContainerPut instead.
 func (p *Pool) PutContainer(ctx context.Context, cont container.Container, signer user.Signer, prm PrmContainerPut) (cid.ID, error) {
-	cp, err := p.connection()
+	cp, err := p.requestConnection(ctx, nodePin{}, nil)
 	if err != nil {
 		return cid.ID{}, err
 	}
+	defer cp.requestFinished()
 
 	return cp.containerPut(ctx, cont, signer, prm)
 }
@@ -2151,10 +2303,11 @@ func (p *Pool) PutContainer(ctx context.Context, cont container.Container, signe
 // Main return value MUST NOT be processed on an erroneous return.
 // Deprecated: use ContainerGet instead.
 func (p *Pool) GetContainer(ctx context.Context, id cid.ID) (container.Container, error) {
-	cp, err := p.connection()
+	cp, err := p.requestConnection(ctx, nodePin{}, &id)
 	if err != nil {
 		return container.Container{}, err
 	}
+	defer cp.requestFinished()
 
 	return cp.containerGet(ctx, id)
 }
@@ -2162,10 +2315,11 @@ func (p *Pool) GetContainer(ctx context.Context, id cid.ID) (container.Container
 // ListContainers requests identifiers of the account-owned containers.
 // Deprecated: use ContainerList instead.
 func (p *Pool) ListContainers(ctx context.Context, ownerID user.ID) ([]cid.ID, error) {
-	cp, err := p.connection()
+	cp, err := p.requestConnection(ctx, nodePin{}, nil)
 	if err != nil {
 		return nil, err
 	}
+	defer cp.requestFinished()
 
 	return cp.containerList(ctx, ownerID)
 }
@@ -2180,10 +2334,11 @@ func (p *Pool) ListContainers(ctx context.Context, ownerID user.ID) ([]cid.ID, e
 // Success can be verified by reading by identifier (see GetContainer).
 // Deprecated: use ContainerDelete instead.
 func (p *Pool) DeleteContainer(ctx context.Context, id cid.ID, signer neofscrypto.Signer, prm PrmContainerDelete) error {
-	cp, err := p.connection()
+	cp, err := p.requestConnection(ctx, nodePin{}, &id)
 	if err != nil {
 		return err
 	}
+	defer cp.requestFinished()
 
 	return cp.containerDelete(ctx, id, signer, prm)
 }
@@ -2193,10 +2348,11 @@ func (p *Pool) DeleteContainer(ctx context.Context, id cid.ID, signer neofscrypt
 // Main return value MUST NOT be processed on an erroneous return.
 // Deprecated: use ContainerEACL instead.
 func (p *Pool) GetEACL(ctx context.Context, id cid.ID) (eacl.Table, error) {
-	cp, err := p.connection()
+	cp, err := p.requestConnection(ctx, nodePin{}, &id)
 	if err != nil {
 		return eacl.Table{}, err
 	}
+	defer cp.requestFinished()
 
 	return cp.containerEACL(ctx, id)
 }
@@ -2211,10 +2367,16 @@ func (p *Pool) GetEACL(ctx context.Context, id cid.ID) (eacl.Table, error) {
 // Success can be verified by reading by identifier (see GetEACL).
 // Deprecated: use ContainerSetEACL instead.
 func (p *Pool) SetEACL(ctx context.Context, table eacl.Table, signer user.Signer, prm PrmContainerSetEACL) error {
-	cp, err := p.connection()
+	var cnr *cid.ID
+	if id, set := table.CID(); set {
+		cnr = &id
+	}
+
+	cp, err := p.requestConnection(ctx, nodePin{}, cnr)
 	if err != nil {
 		return err
 	}
+	defer cp.requestFinished()
 
 	return cp.containerSetEACL(ctx, table, signer, prm)
 }
@@ -2224,10 +2386,11 @@ func (p *Pool) SetEACL(ctx context.Context, table eacl.Table, signer user.Signer
 // Main return value MUST NOT be processed on an erroneous return.
 // Deprecated: use BalanceGet instead.
 func (p *Pool) Balance(ctx context.Context, prm PrmBalanceGet) (accounting.Decimal, error) {
-	cp, err := p.connection()
+	cp, err := p.requestConnection(ctx, nodePin{}, nil)
 	if err != nil {
 		return accounting.Decimal{}, err
 	}
+	defer cp.requestFinished()
 
 	return cp.balanceGet(ctx, prm)
 }
@@ -2288,20 +2451,25 @@ func (p *Pool) Close() {
 	<-p.closedCh
 }
 
-func (p *Pool) sdkClient() (*sdkClientWrapper, error) {
-	conn, err := p.connection()
+// sdkClient returns client to execute the request for the given container (nil
+// means any). Node pinned via context and container partitions are taken into
+// account.
+func (p *Pool) sdkClient(ctx context.Context, cnr *cid.ID) (*sdkClientWrapper, error) {
+	conn, err := p.requestConnection(ctx, nodePin{}, cnr)
 	if err != nil {
 		return nil, fmt.Errorf("connection: %w", err)
 	}
 
 	cl, err := conn.getClient()
 	if err != nil {
+		conn.requestFinished()
 		return nil, fmt.Errorf("get client: %w", err)
 	}
 
 	return &sdkClientWrapper{
 		Client:      cl,
 		nodeSession: conn,
+		status:      conn,
 		addr:        conn.address(),
 	}, nil
 }
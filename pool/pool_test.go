@@ -12,6 +12,7 @@ import (
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofspolicy "github.com/nspcc-dev/neofs-sdk-go/crypto/policy"
 	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -164,6 +165,36 @@ func TestOneNode(t *testing.T) {
 	require.True(t, st.AssertAuthKey(key1.Public()))
 }
 
+func TestInFlightRequests(t *testing.T) {
+	var mock *mockClient
+	var inFlight int64
+
+	mockClientBuilder := func(addr string) (internalClient, error) {
+		mock = newMockClient(addr, test.RandomSignerRFC6979(t))
+		mock.onObjectHead = func() { inFlight = mock.inFlightRequests() }
+		return mock, nil
+	}
+
+	opts := InitParameters{
+		signer:     test.RandomSignerRFC6979(t),
+		nodeParams: []NodeParam{{1, "peer0", 1}},
+	}
+	opts.setClientBuilder(mockClientBuilder)
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(context.Background()))
+	t.Cleanup(pool.Close)
+
+	require.Zero(t, mock.inFlightRequests())
+
+	_, err = pool.HeadObject(context.Background(), cid.ID{}, oid.ID{}, PrmObjectHead{})
+	require.NoError(t, err)
+
+	require.EqualValues(t, 1, inFlight)
+	require.Zero(t, mock.inFlightRequests())
+}
+
 func TestTwoNodes(t *testing.T) {
 	var clientKeys []neofscrypto.Signer
 	mockClientBuilder := func(addr string) (internalClient, error) {
@@ -794,3 +825,132 @@ func TestWritePayload(t *testing.T) {
 		require.NoError(t, writePayload(nil, &reader, 0))
 	})
 }
+
+func TestContainerPartition(t *testing.T) {
+	nodes := []NodeParam{
+		{1, "peer0", 1},
+		{1, "peer1", 1},
+		{2, "peer2", 1},
+	}
+
+	var cnrHot, cnrCold, cnrOther cid.ID
+	cnrHot[0], cnrCold[0], cnrOther[0] = 1, 2, 3
+
+	t.Run("unknown node", func(t *testing.T) {
+		opts := InitParameters{
+			signer:     test.RandomSignerRFC6979(t),
+			nodeParams: nodes,
+		}
+		opts.SetContainerNodes(cnrHot, []string{"peer3"})
+
+		_, err := NewPool(opts)
+		require.Error(t, err)
+	})
+
+	t.Run("empty node list", func(t *testing.T) {
+		opts := InitParameters{
+			signer:     test.RandomSignerRFC6979(t),
+			nodeParams: nodes,
+		}
+		opts.SetContainerNodes(cnrHot, nil)
+
+		_, err := NewPool(opts)
+		require.Error(t, err)
+	})
+
+	clients := make(map[string]*mockClient)
+	mockClientBuilder := func(addr string) (internalClient, error) {
+		cli := newMockClient(addr, test.RandomSignerRFC6979(t))
+		clients[addr] = cli
+		return cli, nil
+	}
+
+	opts := InitParameters{
+		signer:                  test.RandomSignerRFC6979(t),
+		nodeParams:              nodes,
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(mockClientBuilder)
+	opts.SetContainerNodes(cnrHot, []string{"peer1"})
+	opts.SetContainerNodes(cnrCold, []string{"peer2"})
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	for i := 0; i < 10; i++ {
+		conn, err := pool.containerConnection(cnrHot)
+		require.NoError(t, err)
+		require.Equal(t, "peer1", conn.address())
+
+		// lower priority group is used for the partition nodes as well
+		conn, err = pool.containerConnection(cnrCold)
+		require.NoError(t, err)
+		require.Equal(t, "peer2", conn.address())
+
+		conn, err = pool.containerConnection(cnrOther)
+		require.NoError(t, err)
+		require.Contains(t, []string{"peer0", "peer1"}, conn.address())
+	}
+
+	clients["peer1"].setUnhealthy()
+
+	_, err = pool.containerConnection(cnrHot)
+	require.Error(t, err)
+
+	conn, err := pool.containerConnection(cnrOther)
+	require.NoError(t, err)
+	require.Equal(t, "peer0", conn.address())
+}
+
+func TestSessionTokenSignerPolicy(t *testing.T) {
+	mockClientBuilder := func(addr string) (internalClient, error) {
+		return newMockClient(addr, test.RandomSignerRFC6979(t)), nil
+	}
+
+	opts := InitParameters{
+		signer: test.RandomSignerRFC6979(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+		},
+	}
+	opts.setClientBuilder(mockClientBuilder)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(ctx))
+	t.Cleanup(p.Close)
+
+	openSession := func(signer user.Signer) (session.Object, error) {
+		var prm prmCommon
+		prm.UseSigner(signer)
+		var prmCtx prmContext
+		prmCtx.useDefaultSession()
+
+		var tkn session.Object
+		var cc callContext
+		cc.Context = ctx
+		cc.sessionTarget = func(tok session.Object) {
+			tkn = tok
+		}
+		require.NoError(t, p.initCallContext(&cc, prm, prmCtx))
+
+		return tkn, p.openDefaultSession(&cc)
+	}
+
+	// token is signed in scope of the session creation
+	_, err = openSession(neofspolicy.NewUserSigner(test.RandomSignerRFC6979(t), neofspolicy.Policy{}))
+	require.ErrorIs(t, err, neofspolicy.ErrDenied)
+
+	var policy neofspolicy.Policy
+	policy.Allow(neofspolicy.MethodSessionCreate)
+
+	tkn, err := openSession(neofspolicy.NewUserSigner(test.RandomSignerRFC6979(t), policy))
+	require.NoError(t, err)
+	require.True(t, tkn.VerifySignature())
+}
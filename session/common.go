@@ -2,6 +2,7 @@ package session
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 
@@ -154,6 +155,10 @@ func (x commonData) signedData(w contextWriter) []byte {
 }
 
 func (x *commonData) sign(signer user.Signer, w contextWriter) error {
+	return x.signContext(context.Background(), signer, w)
+}
+
+func (x *commonData) signContext(ctx context.Context, signer user.Signer, w contextWriter) error {
 	if !x.issuerSet {
 		x.issuer = signer.UserID()
 		x.issuerSet = true
@@ -161,7 +166,7 @@ func (x *commonData) sign(signer user.Signer, w contextWriter) error {
 
 	var sig neofscrypto.Signature
 
-	err := sig.Calculate(signer, x.signedData(w))
+	err := sig.CalculateContext(ctx, signer, x.signedData(w))
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package session
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -134,11 +135,17 @@ func (x *Container) UnmarshalJSON(data []byte) error {
 // Note that any Container mutation is likely to break the signature, so it is
 // expected to be calculated as a final stage of Container formation.
 //
-// See also VerifySignature.
+// See also VerifySignature, SignContext.
 func (x *Container) Sign(signer user.Signer) error {
 	return x.sign(signer, x.writeContext)
 }
 
+// SignContext is the same as Sign but passes the context to the signer (see
+// [neofscrypto.Signature.CalculateContext]).
+func (x *Container) SignContext(ctx context.Context, signer user.Signer) error {
+	return x.signContext(ctx, signer, x.writeContext)
+}
+
 // VerifySignature checks if Container signature is presented and valid.
 //
 // Zero Container fails the check.
@@ -1,6 +1,7 @@
 package session
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -161,11 +162,17 @@ func (x *Object) UnmarshalJSON(data []byte) error {
 // Note that any Object mutation is likely to break the signature, so it is
 // expected to be calculated as a final stage of Object formation.
 //
-// See also VerifySignature.
+// See also VerifySignature, SignContext.
 func (x *Object) Sign(signer user.Signer) error {
 	return x.sign(signer, x.writeContext)
 }
 
+// SignContext is the same as Sign but passes the context to the signer (see
+// [neofscrypto.Signature.CalculateContext]).
+func (x *Object) SignContext(ctx context.Context, signer user.Signer) error {
+	return x.signContext(ctx, signer, x.writeContext)
+}
+
 // SignedData returns actual payload which would be signed, if you call [Object.Sign] method.
 func (x *Object) SignedData() []byte {
 	return x.signedData(x.writeContext)
@@ -0,0 +1,163 @@
+package storagegroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Source provides headers of the physically stored objects. Source is
+// implemented by [assembler.Source], so [assembler.NewClientSource] may be
+// used to collect storage group members via NeoFS client.
+//
+// [assembler.Source]: https://pkg.go.dev/github.com/nspcc-dev/neofs-sdk-go/object/assembler#Source
+// [assembler.NewClientSource]: https://pkg.go.dev/github.com/nspcc-dev/neofs-sdk-go/object/assembler#NewClientSource
+type Source interface {
+	// Head returns header of the physically stored object. Returns
+	// *object.SplitInfoError for objects sliced into split-chains.
+	Head(ctx context.Context, addr oid.Address) (objectSDK.Object, error)
+}
+
+// Collect reads headers of the given objects from the Source and returns
+// StorageGroup of them with the total payload size and, if homomorphic flag is
+// set, homomorphic hash of the payloads concatenated in the given order.
+// Objects sliced into split-chains are walked through all parts. Members MUST
+// be unique objects of the same container. Expiration epoch of the result is
+// not set.
+//
+// See also BuildObject.
+func Collect(ctx context.Context, src Source, members []oid.Address, homomorphic bool) (StorageGroup, error) {
+	var sg StorageGroup
+
+	if len(members) == 0 {
+		return sg, errors.New("no members")
+	}
+
+	var set oid.AddressSet
+	for i := range members {
+		if set.Add(members[i]) == 0 {
+			return sg, fmt.Errorf("duplicated member %s", members[i].Object())
+		}
+	}
+
+	_, ids, err := set.SingleContainer()
+	if err != nil {
+		return sg, fmt.Errorf("invalid members: %w", err)
+	}
+
+	var size uint64
+	var hashes []checksum.Checksum
+
+	for i := range members {
+		parts, err := collectParts(ctx, src, members[i])
+		if err != nil {
+			return sg, fmt.Errorf("member %s: %w", ids[i], err)
+		}
+
+		for j := range parts {
+			size += parts[j].PayloadSize()
+
+			if !homomorphic {
+				continue
+			}
+
+			cs, ok := parts[j].PayloadHomomorphicHash()
+			if !ok {
+				id, _ := parts[j].ID()
+				return sg, fmt.Errorf("member %s: object %s has no homomorphic hash", ids[i], id)
+			}
+
+			hashes = append(hashes, cs)
+		}
+	}
+
+	sg.SetMembers(ids)
+	sg.SetValidationDataSize(size)
+
+	if homomorphic {
+		cs, err := checksum.CombineTZ(hashes...)
+		if err != nil {
+			return sg, fmt.Errorf("combine homomorphic hashes: %w", err)
+		}
+
+		sg.SetValidationDataHash(cs)
+	}
+
+	return sg, nil
+}
+
+// collectParts returns headers of the physically stored objects carrying the
+// payload of the referenced object in order.
+func collectParts(ctx context.Context, src Source, addr oid.Address) ([]objectSDK.Object, error) {
+	hdr, err := src.Head(ctx, addr)
+	if err == nil {
+		return []objectSDK.Object{hdr}, nil
+	}
+
+	var errSplit *objectSDK.SplitInfoError
+	if !errors.As(err, &errSplit) {
+		return nil, fmt.Errorf("head object: %w", err)
+	}
+
+	chain := objectSDK.NewSplitChain()
+	if err = chain.AddSplitInfo(*errSplit.SplitInfo()); err != nil {
+		return nil, fmt.Errorf("invalid split info: %w", err)
+	}
+
+	var elemAddr oid.Address
+	elemAddr.SetContainer(addr.Container())
+
+	for missing := chain.Missing(); len(missing) > 0; missing = chain.Missing() {
+		for i := range missing {
+			elemAddr.SetObject(missing[i])
+
+			hdr, err := src.Head(ctx, elemAddr)
+			if err != nil {
+				return nil, fmt.Errorf("head split-chain element %s: %w", missing[i], err)
+			}
+
+			if err = chain.Add(missing[i], hdr); err != nil {
+				return nil, fmt.Errorf("invalid split-chain element: %w", err)
+			}
+		}
+	}
+
+	ids, err := chain.Parts()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]objectSDK.Object, len(ids))
+	for i := range ids {
+		res[i], _ = chain.Header(ids[i])
+	}
+
+	return res, nil
+}
+
+// BuildObject completes the object builder with the StorageGroup: sets
+// payload, object type and expiration attribute (if expiration epoch is set)
+// and builds the object ready to be stored in NeoFS. Container, owner and
+// other optional properties MUST be set in the builder by the caller.
+//
+// See also Collect, ReadFromObject.
+func BuildObject(b *objectSDK.Builder, sg StorageGroup, signer neofscrypto.Signer) (*objectSDK.Object, error) {
+	payload, err := sg.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal storage group: %w", err)
+	}
+
+	b.Type(objectSDK.TypeStorageGroup).Payload(payload)
+
+	if exp := sg.ExpirationEpoch(); exp > 0 {
+		b.Attribute(objectSDK.AttributeExpirationEpoch, strconv.FormatUint(exp, 10))
+	}
+
+	return b.Build(signer)
+}
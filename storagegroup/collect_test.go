@@ -0,0 +1,190 @@
+package storagegroup_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/storagegroup"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+type testSource struct {
+	objs  map[oid.ID]objectSDK.Object
+	split map[oid.ID]*objectSDK.SplitInfo
+}
+
+func (x *testSource) Head(_ context.Context, addr oid.Address) (objectSDK.Object, error) {
+	if si, ok := x.split[addr.Object()]; ok {
+		return objectSDK.Object{}, objectSDK.NewSplitInfoError(si)
+	}
+
+	hdr, ok := x.objs[addr.Object()]
+	if !ok {
+		return objectSDK.Object{}, errors.New("not found")
+	}
+
+	return hdr, nil
+}
+
+func (x *testSource) put(payload []byte) oid.ID {
+	var hdr objectSDK.Object
+	hdr.SetPayloadSize(uint64(len(payload)))
+
+	var cs checksum.Checksum
+	checksum.Calculate(&cs, checksum.TZ, payload)
+	hdr.SetPayloadHomomorphicHash(cs)
+
+	id := oidtest.ID()
+	hdr.SetID(id)
+	x.objs[id] = hdr
+
+	return id
+}
+
+func (x *testSource) putSplit(link bool, parts ...[]byte) oid.ID {
+	root := oidtest.ID()
+	splitID := objectSDK.NewSplitID()
+	ids := make([]oid.ID, len(parts))
+
+	var par objectSDK.Object
+	par.SetID(root)
+
+	for i := range parts {
+		ids[i] = x.put(parts[i])
+
+		hdr := x.objs[ids[i]]
+		hdr.SetSplitID(splitID)
+		if i > 0 && !link {
+			hdr.SetPreviousID(ids[i-1])
+		}
+		if i == len(parts)-1 {
+			hdr.SetParent(&par)
+			hdr.SetParentID(root)
+		}
+		x.objs[ids[i]] = hdr
+	}
+
+	si := objectSDK.NewSplitInfo()
+	si.SetSplitID(splitID)
+
+	if link {
+		var hdr objectSDK.Object
+		hdr.SetSplitID(splitID)
+		hdr.SetParent(&par)
+		hdr.SetParentID(root)
+		hdr.SetChildren(ids...)
+
+		linkID := oidtest.ID()
+		x.objs[linkID] = hdr
+		si.SetLink(linkID)
+	} else {
+		si.SetLastPart(ids[len(ids)-1])
+	}
+
+	x.split[root] = si
+
+	return root
+}
+
+func TestCollect(t *testing.T) {
+	cnr := cidtest.ID()
+	src := &testSource{
+		objs:  make(map[oid.ID]objectSDK.Object),
+		split: make(map[oid.ID]*objectSDK.SplitInfo),
+	}
+
+	members := make([]oid.Address, 3)
+	for i, id := range []oid.ID{
+		src.put([]byte("abc")),
+		src.putSplit(false, []byte("de"), []byte("fgh"), []byte("i")),
+		src.putSplit(true, []byte("jk"), []byte("l")),
+	} {
+		members[i].SetContainer(cnr)
+		members[i].SetObject(id)
+	}
+
+	sg, err := storagegroup.Collect(context.Background(), src, members, true)
+	require.NoError(t, err)
+	require.Equal(t, []oid.ID{members[0].Object(), members[1].Object(), members[2].Object()}, sg.Members())
+	require.EqualValues(t, 12, sg.ValidationDataSize())
+	require.Zero(t, sg.ExpirationEpoch())
+
+	var exp checksum.Checksum
+	exp.SetTillichZemor(tz.Sum([]byte("abcdefghijkl")))
+	cs, ok := sg.ValidationDataHash()
+	require.True(t, ok)
+	require.Equal(t, exp, cs)
+
+	sg, err = storagegroup.Collect(context.Background(), src, members, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 12, sg.ValidationDataSize())
+	_, ok = sg.ValidationDataHash()
+	require.False(t, ok)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := storagegroup.Collect(context.Background(), src, nil, false)
+		require.Error(t, err)
+
+		_, err = storagegroup.Collect(context.Background(), src, []oid.Address{members[0], members[0]}, false)
+		require.Error(t, err)
+
+		other := members[1]
+		other.SetContainer(cidtest.ID())
+		_, err = storagegroup.Collect(context.Background(), src, []oid.Address{members[0], other}, false)
+		require.Error(t, err)
+
+		missing := members[0]
+		missing.SetObject(oidtest.ID())
+		_, err = storagegroup.Collect(context.Background(), src, []oid.Address{missing}, false)
+		require.Error(t, err)
+
+		noHash := members[0]
+		noHash.SetObject(oidtest.ID())
+		src.objs[noHash.Object()] = objectSDK.Object{}
+		_, err = storagegroup.Collect(context.Background(), src, []oid.Address{noHash}, true)
+		require.Error(t, err)
+	})
+}
+
+func TestBuildObject(t *testing.T) {
+	signer := test.RandomSignerRFC6979(t)
+
+	var sg storagegroup.StorageGroup
+	sg.SetMembers([]oid.ID{oidtest.ID(), oidtest.ID()})
+	sg.SetValidationDataSize(42)
+	sg.SetExpirationEpoch(100)
+
+	obj, err := storagegroup.BuildObject(objectSDK.NewBuilder().Container(cidtest.ID()).Owner(signer.UserID()), sg, signer)
+	require.NoError(t, err)
+	require.NoError(t, objectSDK.VerifyIntegrity(*obj))
+	require.Equal(t, objectSDK.TypeStorageGroup, obj.Type())
+
+	exp, err := obj.ExpirationEpoch()
+	require.NoError(t, err)
+	require.EqualValues(t, 100, exp)
+
+	var res storagegroup.StorageGroup
+	require.NoError(t, storagegroup.ReadFromObject(&res, *obj))
+	require.Equal(t, sg, res)
+
+	t.Run("without expiration", func(t *testing.T) {
+		sg.SetExpirationEpoch(0)
+
+		obj, err := storagegroup.BuildObject(objectSDK.NewBuilder().Container(cidtest.ID()).Owner(signer.UserID()), sg, signer)
+		require.NoError(t, err)
+		_, err = obj.ExpirationEpoch()
+		require.ErrorIs(t, err, objectSDK.ErrAttributeNotFound)
+
+		var res storagegroup.StorageGroup
+		require.NoError(t, storagegroup.ReadFromObject(&res, *obj))
+		require.Equal(t, sg, res)
+	})
+}
@@ -11,6 +11,14 @@ StorageGroup type groups verification values for Data Audit sessions:
 	sg.ValidationDataHash() // hash for objects validation
 	sg.ValidationDataSize() // total objects' payload size
 
+Storage group of the stored objects can be collected and put into NeoFS:
+
+	src := assembler.NewClientSource(c, signer, relations.Tokens{})
+	sg, err := storagegroup.Collect(ctx, src, members, true)
+	// handle error
+	sg.SetExpirationEpoch(exp)
+	obj, err := storagegroup.BuildObject(object.NewBuilder().Container(cnr).Owner(signer.UserID()), sg, signer)
+
 Instances can be also used to process NeoFS API V2 protocol messages
 (see neo.fs.v2.storagegroup package in https://github.com/nspcc-dev/neofs-api).
 
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+)
+
+// NewSignerRFC6979FromNEP2 decrypts passphrase-protected private key encoded
+// according to NEP-2 standard and returns [SignerRFC6979] based on it. Scrypt
+// parameters specified in NEP-2 are used.
+//
+// See also SignerRFC6979.EncryptNEP2.
+func NewSignerRFC6979FromNEP2(encrypted, passphrase string) (*SignerRFC6979, error) {
+	k, err := keys.NEP2Decrypt(encrypted, passphrase, keys.NEP2ScryptParams())
+	if err != nil {
+		return nil, fmt.Errorf("decrypt NEP-2 key: %w", err)
+	}
+
+	return NewSignerRFC6979(k.PrivateKey), nil
+}
+
+// EncryptNEP2 encrypts private key of the signer with the passphrase
+// according to NEP-2 standard. Scrypt parameters specified in NEP-2 are used.
+//
+// See also NewSignerRFC6979FromNEP2.
+func (s SignerRFC6979) EncryptNEP2(passphrase string) (string, error) {
+	res, err := keys.NEP2Encrypt(&keys.PrivateKey{PrivateKey: ecdsa.PrivateKey(s.SignerRFC6979)}, passphrase, keys.NEP2ScryptParams())
+	if err != nil {
+		return "", fmt.Errorf("encrypt NEP-2 key: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNEP2(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	const passphrase = "TestingOneTwoThree"
+
+	signer := user.NewSignerRFC6979(k.PrivateKey)
+
+	encrypted, err := signer.EncryptNEP2(passphrase)
+	require.NoError(t, err)
+
+	// cross-check with neo-go
+	decrypted, err := keys.NEP2Decrypt(encrypted, passphrase, keys.NEP2ScryptParams())
+	require.NoError(t, err)
+	require.Equal(t, k.Bytes(), decrypted.Bytes())
+
+	restored, err := user.NewSignerRFC6979FromNEP2(encrypted, passphrase)
+	require.NoError(t, err)
+	require.Equal(t, signer.UserID(), restored.UserID())
+	require.Equal(t, signer.Public(), restored.Public())
+
+	_, err = user.NewSignerRFC6979FromNEP2(encrypted, "wrong")
+	require.Error(t, err)
+	_, err = user.NewSignerRFC6979FromNEP2("not a NEP-2 key", passphrase)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"crypto/ecdsa"
+	"time"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
@@ -48,3 +49,29 @@ func NewSignerRFC6979WithID(pk ecdsa.PrivateKey, id ID) *SignerRFC6979 {
 func (s SignerRFC6979) UserID() ID {
 	return s.userID
 }
+
+// CachingSigner is the same as [neofscrypto.CachingSigner] but wraps [Signer].
+// Provides [Signer] and [neofscrypto.SignerContext] interfaces.
+//
+// Instances MUST be constructed using [NewCachingSigner].
+type CachingSigner struct {
+	*neofscrypto.CachingSigner
+	userID ID
+}
+
+// NewCachingSigner constructs [CachingSigner] like
+// [neofscrypto.NewCachingSigner] does. User ID is taken from the given signer.
+func NewCachingSigner(signer Signer, ttl time.Duration, size int) (*CachingSigner, error) {
+	s, err := neofscrypto.NewCachingSigner(signer, ttl, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CachingSigner{CachingSigner: s, userID: signer.UserID()}, nil
+}
+
+// UserID returns [ID] of the underlying signer.
+// Implements [Signer].
+func (x *CachingSigner) UserID() ID {
+	return x.userID
+}
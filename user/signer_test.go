@@ -0,0 +1,37 @@
+package user_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	. "github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+var _ neofscrypto.SignerContext = (*CachingSigner)(nil)
+
+func TestCachingSigner(t *testing.T) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	id := *usertest.ID(t)
+	base := NewSignerRFC6979WithID(k.PrivateKey, id)
+
+	_, err = NewCachingSigner(base, 0, 10)
+	require.Error(t, err)
+
+	var signer Signer
+	signer, err = NewCachingSigner(base, time.Minute, 10)
+	require.NoError(t, err)
+	require.Equal(t, id, signer.UserID())
+	require.Equal(t, base.Scheme(), signer.Scheme())
+
+	data := []byte("Hello, world!")
+
+	sig, err := signer.Sign(data)
+	require.NoError(t, err)
+	require.True(t, base.Public().Verify(data, sig))
+}
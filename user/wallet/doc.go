@@ -0,0 +1,16 @@
+/*
+Package wallet provides NeoFS user signers stored in NEP-6 wallet files.
+
+	w, err := wallet.Open("/path/to/wallet.json")
+	// ...
+
+	for _, acc := range w.Accounts() {
+		fmt.Println(acc.Address(), acc.Label(), acc.Default())
+	}
+
+	signers, err := w.Unlock(w.Accounts()[0].ID(), "passphrase")
+	// ...
+
+	// use signers.RFC6979 or signers.WalletConnect for NeoFS operations
+*/
+package wallet
@@ -0,0 +1,124 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// ErrAccountNotFound is returned when requested account is missing in the
+// wallet.
+var ErrAccountNotFound = errors.New("account not found")
+
+// Wallet represents NEP-6 wallet file.
+//
+// Instances MUST be constructed using Open.
+type Wallet struct {
+	w *wallet.Wallet
+}
+
+// Account describes NEP-6 wallet account.
+type Account struct {
+	id      user.ID
+	address string
+	label   string
+	def     bool
+}
+
+// ID returns NeoFS user ID of the account.
+func (x Account) ID() user.ID {
+	return x.id
+}
+
+// Address returns Neo address of the account.
+func (x Account) Address() string {
+	return x.address
+}
+
+// Label returns user-defined name of the account.
+func (x Account) Label() string {
+	return x.label
+}
+
+// Default checks whether account is marked as the default one.
+func (x Account) Default() bool {
+	return x.def
+}
+
+// Signers groups NeoFS signers of the unlocked account.
+type Signers struct {
+	// ID is the NeoFS user ID of the account.
+	ID user.ID
+	// RFC6979 is the signer using deterministic ECDSA with SHA-256 hashing.
+	// Required for most NeoFS operations.
+	RFC6979 user.Signer
+	// WalletConnect is the signer using Wallet Connect signature scheme.
+	WalletConnect neofscrypto.Signer
+}
+
+// Open reads NEP-6 wallet file located at the given path.
+func Open(path string) (*Wallet, error) {
+	w, err := wallet.NewWalletFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read wallet file: %w", err)
+	}
+
+	return &Wallet{w: w}, nil
+}
+
+// Accounts returns list of the wallet accounts.
+func (x *Wallet) Accounts() []Account {
+	res := make([]Account, 0, len(x.w.Accounts))
+
+	for _, acc := range x.w.Accounts {
+		var a Account
+		a.id.SetScriptHash(acc.ScriptHash())
+		a.address = acc.Address
+		a.label = acc.Label
+		a.def = acc.Default
+
+		res = append(res, a)
+	}
+
+	return res
+}
+
+// Unlock decrypts private key of the account with the given ID using the
+// passphrase and returns signers based on it. Returns ErrAccountNotFound if
+// there is no such account in the wallet.
+//
+// See also Accounts.
+func (x *Wallet) Unlock(id user.ID, passphrase string) (*Signers, error) {
+	for _, acc := range x.w.Accounts {
+		var accID user.ID
+		accID.SetScriptHash(acc.ScriptHash())
+
+		if !accID.Equals(id) {
+			continue
+		}
+
+		if err := acc.Decrypt(passphrase, x.w.Scrypt); err != nil {
+			return nil, fmt.Errorf("decrypt account %s: %w", acc.Address, err)
+		}
+
+		pk := acc.PrivateKey().PrivateKey
+		pk.D = new(big.Int).Set(pk.D) // Close destroys the key
+
+		acc.Close()
+
+		signer := user.NewSignerRFC6979WithID(pk, id)
+
+		return &Signers{
+			ID:            id,
+			RFC6979:       signer,
+			WalletConnect: neofsecdsa.SignerWalletConnect(pk),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrAccountNotFound, id)
+}
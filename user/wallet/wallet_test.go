@@ -0,0 +1,70 @@
+package wallet_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neowallet "github.com/nspcc-dev/neo-go/pkg/wallet"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/user/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWallet(t *testing.T) {
+	const passphrase = "secret"
+
+	path := filepath.Join(t.TempDir(), "wallet.json")
+
+	w, err := neowallet.NewWallet(path)
+	require.NoError(t, err)
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	acc := neowallet.NewAccountFromPrivateKey(k)
+	acc.Label = "main"
+	acc.Default = true
+	require.NoError(t, acc.Encrypt(passphrase, w.Scrypt))
+	w.AddAccount(acc)
+	require.NoError(t, w.CreateAccount("other", passphrase))
+
+	_, err = wallet.Open(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+
+	wlt, err := wallet.Open(path)
+	require.NoError(t, err)
+
+	accs := wlt.Accounts()
+	require.Len(t, accs, 2)
+	require.Equal(t, "main", accs[0].Label())
+	require.True(t, accs[0].Default())
+	require.Equal(t, k.Address(), accs[0].Address())
+	require.Equal(t, "other", accs[1].Label())
+	require.False(t, accs[1].Default())
+
+	expectedID := user.NewSignerRFC6979(k.PrivateKey).UserID()
+	require.True(t, expectedID.Equals(accs[0].ID()))
+
+	_, err = wlt.Unlock(accs[0].ID(), "wrong")
+	require.Error(t, err)
+
+	var unknown user.ID
+	unknown.SetScriptHash(k.PublicKey().GetScriptHash().Reverse()) // some other account
+	_, err = wlt.Unlock(unknown, passphrase)
+	require.ErrorIs(t, err, wallet.ErrAccountNotFound)
+
+	signers, err := wlt.Unlock(accs[0].ID(), passphrase)
+	require.NoError(t, err)
+	require.True(t, expectedID.Equals(signers.ID))
+	require.True(t, expectedID.Equals(signers.RFC6979.UserID()))
+
+	data := []byte("Hello, world!")
+
+	for _, signer := range []neofscrypto.Signer{signers.RFC6979, signers.WalletConnect} {
+		var sig neofscrypto.Signature
+		require.NoError(t, sig.Calculate(signer, data))
+		require.True(t, sig.Verify(data))
+	}
+}
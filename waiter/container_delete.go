@@ -2,13 +2,10 @@ package waiter
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	"github.com/nspcc-dev/neofs-sdk-go/client"
-	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
-	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 )
@@ -17,23 +14,43 @@ import (
 // See documentation for functions in [client.Client]. The same semantics is expected.
 type ContainerDeleteExecutor interface {
 	ContainerDelete(ctx context.Context, id cid.ID, signer neofscrypto.Signer, prm client.PrmContainerDelete) error
-	ContainerGet(ctx context.Context, id cid.ID, prm client.PrmContainerGet) (container.Container, error)
+	ContainerGetter
 }
 
 // ContainerDeleteWaiter implements sync logic to container delete operation.
 type ContainerDeleteWaiter struct {
-	executor     ContainerDeleteExecutor
-	pollInterval time.Duration
+	executor   ContainerDeleteExecutor
+	confirmers []ContainerGetter
+	opts       options
 }
 
 // NewContainerDeleteWaiter is a constructor for ContainerDeleteWaiter.
 func NewContainerDeleteWaiter(executor ContainerDeleteExecutor, pollInterval time.Duration) ContainerDeleteWaiter {
-	return ContainerDeleteWaiter{executor: executor, pollInterval: pollInterval}
+	return ContainerDeleteWaiter{executor: executor, opts: options{pollInterval: pollInterval}}
 }
 
 // SetPollInterval allows rewrite default poll interval.
 func (w *ContainerDeleteWaiter) SetPollInterval(interval time.Duration) {
-	w.pollInterval = interval
+	w.opts.pollInterval = interval
+}
+
+// SetBackoff sets Backoff used instead of the fixed poll interval, see
+// [ContainerPutWaiter.SetBackoff].
+func (w *ContainerDeleteWaiter) SetBackoff(b Backoff) {
+	w.opts.backoff = b
+}
+
+// SetProgressHandler sets function called after each confirmation attempt,
+// see [ContainerPutWaiter.SetProgressHandler].
+func (w *ContainerDeleteWaiter) SetProgressHandler(f func(Progress)) {
+	w.opts.progress = f
+}
+
+// SetConfirmers sets nodes checked instead of the executor to confirm the
+// operation, see [ContainerPutWaiter.SetConfirmers].
+func (w *ContainerDeleteWaiter) SetConfirmers(quorum int, cs ...ContainerGetter) {
+	w.confirmers = cs
+	w.opts.quorum = quorum
 }
 
 // ContainerDelete sends request to remove the NeoFS container.
@@ -44,20 +61,5 @@ func (w ContainerDeleteWaiter) ContainerDelete(ctx context.Context, id cid.ID, s
 		return fmt.Errorf("delete: %w", err)
 	}
 
-	var prmGet client.PrmContainerGet
-
-	logic := func() error {
-		_, err := w.executor.ContainerGet(ctx, id, prmGet)
-		if err != nil {
-			if errors.Is(err, apistatus.ErrContainerNotFound) {
-				return nil
-			}
-
-			return fmt.Errorf("ContainerGet: %w", err)
-		}
-
-		return errRetry
-	}
-
-	return poll(ctx, w.pollInterval, logic)
+	return poll(ctx, w.opts, containerChecks(ctx, w.executor, w.confirmers, id, false))
 }
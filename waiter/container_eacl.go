@@ -14,27 +14,54 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
+// ContainerEACLGetter represents requirements to the node confirming
+// container setEACL operation.
+// See documentation for functions in [client.Client]. The same semantics is expected.
+type ContainerEACLGetter interface {
+	ContainerEACL(ctx context.Context, id cid.ID, prm client.PrmContainerEACL) (eacl.Table, error)
+}
+
 // ContainerSetEACLExecutor represents requirements to async container setEACL operation.
 // See documentation for functions in [client.Client]. The same semantics is expected.
 type ContainerSetEACLExecutor interface {
 	ContainerSetEACL(ctx context.Context, table eacl.Table, signer user.Signer, prm client.PrmContainerSetEACL) error
-	ContainerEACL(ctx context.Context, id cid.ID, prm client.PrmContainerEACL) (eacl.Table, error)
+	ContainerEACLGetter
 }
 
 // ContainerSetEACLWaiter implements sync logic to container setEACL operation.
 type ContainerSetEACLWaiter struct {
-	executor     ContainerSetEACLExecutor
-	pollInterval time.Duration
+	executor   ContainerSetEACLExecutor
+	confirmers []ContainerEACLGetter
+	opts       options
 }
 
 // NewContainerSetEACLWaiter is a constructor for NewContainerSetEACLWaiter.
 func NewContainerSetEACLWaiter(c ContainerSetEACLExecutor, pollInterval time.Duration) ContainerSetEACLWaiter {
-	return ContainerSetEACLWaiter{executor: c, pollInterval: pollInterval}
+	return ContainerSetEACLWaiter{executor: c, opts: options{pollInterval: pollInterval}}
 }
 
 // SetPollInterval allows rewrite default poll interval.
 func (w *ContainerSetEACLWaiter) SetPollInterval(interval time.Duration) {
-	w.pollInterval = interval
+	w.opts.pollInterval = interval
+}
+
+// SetBackoff sets Backoff used instead of the fixed poll interval, see
+// [ContainerPutWaiter.SetBackoff].
+func (w *ContainerSetEACLWaiter) SetBackoff(b Backoff) {
+	w.opts.backoff = b
+}
+
+// SetProgressHandler sets function called after each confirmation attempt,
+// see [ContainerPutWaiter.SetProgressHandler].
+func (w *ContainerSetEACLWaiter) SetProgressHandler(f func(Progress)) {
+	w.opts.progress = f
+}
+
+// SetConfirmers sets nodes checked instead of the executor to confirm the
+// operation, see [ContainerPutWaiter.SetConfirmers].
+func (w *ContainerSetEACLWaiter) SetConfirmers(quorum int, cs ...ContainerEACLGetter) {
+	w.confirmers = cs
+	w.opts.quorum = quorum
 }
 
 // ContainerSetEACL sends request to update eACL table of the NeoFS container.
@@ -55,29 +82,39 @@ func (w ContainerSetEACLWaiter) ContainerSetEACL(ctx context.Context, table eacl
 		return fmt.Errorf("newTable.Marshal: %w", err)
 	}
 
+	confirmers := w.confirmers
+	if len(confirmers) == 0 {
+		confirmers = []ContainerEACLGetter{w.executor}
+	}
+
 	var prmEacl client.PrmContainerEACL
+	checks := make([]checkFunc, len(confirmers))
+
+	for i := range confirmers {
+		c := confirmers[i]
+
+		checks[i] = func() error {
+			actualTable, err := c.ContainerEACL(ctx, contID, prmEacl)
+			if err != nil {
+				if errors.Is(err, apistatus.ErrEACLNotFound) {
+					return errRetry
+				}
 
-	logic := func() error {
-		actualTable, err := w.executor.ContainerEACL(ctx, contID, prmEacl)
-		if err != nil {
-			if errors.Is(err, apistatus.ErrEACLNotFound) {
-				return errRetry
+				return fmt.Errorf("ContainerEACL: %w", err)
 			}
 
-			return fmt.Errorf("ContainerEACL: %w", err)
-		}
+			actualBinary, err := actualTable.Marshal()
+			if err != nil {
+				return fmt.Errorf("table.Marshal: %w", err)
+			}
 
-		actualBinary, err := actualTable.Marshal()
-		if err != nil {
-			return fmt.Errorf("table.Marshal: %w", err)
-		}
+			if bytes.Equal(newBinary, actualBinary) {
+				return nil
+			}
 
-		if bytes.Equal(newBinary, actualBinary) {
-			return nil
+			return errRetry
 		}
-
-		return errRetry
 	}
 
-	return poll(ctx, w.pollInterval, logic)
+	return poll(ctx, w.opts, checks)
 }
@@ -0,0 +1,50 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// ContainerImport re-creates container from the signed [container.Bundle],
+// e.g. exported from another NeoFS network. The container is saved and, if
+// the Bundle has eACL, eACL is set after the container creation is
+// confirmed. Container owner is replaced with the signer's user if they
+// differ, so the Bundle can be imported by another account; in this case, the
+// container ID differs from the original one and eACL is bound to the new
+// container. Bundle signature MUST be valid, it is up to the caller to check
+// whether the Bundle signer is trusted.
+func (w Waiter) ContainerImport(ctx context.Context, b container.Bundle, signer user.Signer, prmPut client.PrmContainerPut, prmEACL client.PrmContainerSetEACL) (cid.ID, error) {
+	if !b.VerifySignature() {
+		return cid.ID{}, errors.New("invalid bundle signature")
+	}
+
+	cnr := b.Container()
+
+	if owner := signer.UserID(); !cnr.Owner().Equals(owner) {
+		cnr.SetOwner(owner)
+	}
+
+	id, err := w.ContainerPut(ctx, cnr, signer, prmPut)
+	if err != nil {
+		return id, fmt.Errorf("container: %w", err)
+	}
+
+	table, ok := b.EACL()
+	if !ok {
+		return id, nil
+	}
+
+	table.SetCID(id)
+
+	if err = w.ContainerSetEACL(ctx, table, signer, prmEACL); err != nil {
+		return id, fmt.Errorf("eACL: %w", err)
+	}
+
+	return id, nil
+}
@@ -13,27 +13,58 @@ import (
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 )
 
+// ContainerGetter represents requirements to the node confirming container
+// put and delete operations.
+// See documentation for functions in [client.Client]. The same semantics is expected.
+type ContainerGetter interface {
+	ContainerGet(ctx context.Context, id cid.ID, prm client.PrmContainerGet) (container.Container, error)
+}
+
 // ContainerPutExecutor represents requirements to async container put operation.
 // See documentation for functions in [client.Client]. The same semantics is expected.
 type ContainerPutExecutor interface {
 	ContainerPut(ctx context.Context, cont container.Container, signer neofscrypto.Signer, prm client.PrmContainerPut) (cid.ID, error)
-	ContainerGet(ctx context.Context, id cid.ID, prm client.PrmContainerGet) (container.Container, error)
+	ContainerGetter
 }
 
 // ContainerPutWaiter implements sync logic to container put operation.
 type ContainerPutWaiter struct {
-	executor     ContainerPutExecutor
-	pollInterval time.Duration
+	executor   ContainerPutExecutor
+	confirmers []ContainerGetter
+	opts       options
 }
 
 // NewContainerPutWaiter is a constructor for ContainerPutWaiter.
 func NewContainerPutWaiter(c ContainerPutExecutor, pollInterval time.Duration) ContainerPutWaiter {
-	return ContainerPutWaiter{executor: c, pollInterval: pollInterval}
+	return ContainerPutWaiter{executor: c, opts: options{pollInterval: pollInterval}}
 }
 
 // SetPollInterval allows rewrite default poll interval.
 func (w *ContainerPutWaiter) SetPollInterval(interval time.Duration) {
-	w.pollInterval = interval
+	w.opts.pollInterval = interval
+}
+
+// SetBackoff sets Backoff used instead of the fixed poll interval. Nil
+// Backoff returns the fixed interval back.
+func (w *ContainerPutWaiter) SetBackoff(b Backoff) {
+	w.opts.backoff = b
+}
+
+// SetProgressHandler sets function called after each confirmation attempt.
+// The handler is called synchronously, so it should not block.
+func (w *ContainerPutWaiter) SetProgressHandler(f func(Progress)) {
+	w.opts.progress = f
+}
+
+// SetConfirmers sets nodes checked instead of the executor to confirm the
+// operation, e.g. clients connected to different storage nodes. The operation
+// is confirmed when at least quorum of them confirm it, non-positive quorum
+// means all of them. Errors of separate confirmers are not fatal, they are
+// reported to the progress handler. Without confirmers, the executor is
+// checked.
+func (w *ContainerPutWaiter) SetConfirmers(quorum int, cs ...ContainerGetter) {
+	w.confirmers = cs
+	w.opts.quorum = quorum
 }
 
 // ContainerPut sends request to save container in NeoFS.
@@ -45,20 +76,43 @@ func (w ContainerPutWaiter) ContainerPut(ctx context.Context, cont container.Con
 		return cid.ID{}, fmt.Errorf("put: %w", err)
 	}
 
+	return id, poll(ctx, w.opts, containerChecks(ctx, w.executor, w.confirmers, id, true))
+}
+
+// containerChecks returns checks of the container presence or absence on the
+// confirmers or the executor if there are no confirmers.
+func containerChecks(ctx context.Context, executor ContainerGetter, confirmers []ContainerGetter, id cid.ID, present bool) []checkFunc {
+	if len(confirmers) == 0 {
+		confirmers = []ContainerGetter{executor}
+	}
+
 	var prmGet client.PrmContainerGet
+	res := make([]checkFunc, len(confirmers))
+
+	for i := range confirmers {
+		c := confirmers[i]
 
-	logic := func() error {
-		_, err = w.executor.ContainerGet(ctx, id, prmGet)
-		if err != nil {
-			if errors.Is(err, apistatus.ErrContainerNotFound) {
-				return errRetry
+		res[i] = func() error {
+			_, err := c.ContainerGet(ctx, id, prmGet)
+			if err != nil {
+				if errors.Is(err, apistatus.ErrContainerNotFound) {
+					if present {
+						return errRetry
+					}
+
+					return nil
+				}
+
+				return fmt.Errorf("ContainerGet: %w", err)
 			}
 
-			return fmt.Errorf("ContainerGet: %w", err)
-		}
+			if present {
+				return nil
+			}
 
-		return nil
+			return errRetry
+		}
 	}
 
-	return id, poll(ctx, w.pollInterval, logic)
+	return res
 }
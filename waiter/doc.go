@@ -5,8 +5,14 @@ Supported operations:
   - Container put
   - Container setEacl
   - Container delete
+  - Container import from the [container.Bundle]
 
 The main component is [Waiter] type. It is using [client.Client] or [pool.Pool] as [Executor] implementation
 for querying async operation and wait some time, to be sure it has effect like container created/deleted etc.
+
+By default, the operation result is checked on the executor with the fixed poll interval. [Backoff] allows to change
+the interval between checks, e.g. [ExponentialBackoff]. Since a single node may respond before the operation is
+persisted in the network, waiters can also confirm the operation by checking several nodes, see
+[Waiter.SetConfirmers]. The progress of the confirmation can be tracked using [Waiter.SetProgressHandler].
 */
 package waiter
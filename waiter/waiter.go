@@ -21,7 +21,7 @@ var (
 	// sent without any errors).
 	ErrConfirmationTimeout = errors.New("confirmation timeout")
 
-	// errRetry is a special error for using with pollLogic. It tells to some waiter to wait one more tick.
+	// errRetry is a special error for using with checkFunc. It tells to some waiter to wait one more tick.
 	errRetry = errors.New("retry")
 )
 
@@ -39,13 +39,95 @@ type Waiter struct {
 	ContainerDeleteWaiter
 }
 
-// The function implements poll logic for each waiter.
+// Backoff returns a duration to wait before the given confirmation attempt.
+// Attempts are numbered from 1.
+type Backoff func(attempt int) time.Duration
+
+// ExponentialBackoff returns Backoff starting from the initial interval and
+// multiplying it by the factor after each attempt until the max interval is
+// reached. Zero initial interval means DefaultPollInterval, factor less than 1
+// means constant interval, zero max interval means no limit.
+func ExponentialBackoff(initial, maxInterval time.Duration, factor float64) Backoff {
+	if initial == 0 {
+		initial = DefaultPollInterval
+	}
+
+	if factor < 1 {
+		factor = 1
+	}
+
+	return func(attempt int) time.Duration {
+		d := float64(initial)
+		for i := 1; i < attempt; i++ {
+			d *= factor
+			if maxInterval > 0 && d >= float64(maxInterval) {
+				return maxInterval
+			}
+		}
+
+		return time.Duration(d)
+	}
+}
+
+// Progress describes the state of the operation confirmation after the next
+// attempt.
+type Progress struct {
+	// Attempt is a number of the attempt starting from 1.
+	Attempt int
+
+	// Elapsed is a time passed since the confirmation start.
+	Elapsed time.Duration
+
+	// Confirmed is a number of the nodes confirmed the operation.
+	Confirmed int
+
+	// Required is a number of the nodes required to confirm the operation.
+	Required int
+
+	// Err is the last error returned by the nodes which did not confirm the
+	// operation. Nil if there were no errors.
+	Err error
+}
+
+// options groups parameters common to all waiters.
+type options struct {
+	pollInterval time.Duration
+
+	backoff Backoff
+
+	progress func(Progress)
+
+	// quorum of confirmations from the separate nodes, 0 means all of them
+	quorum int
+}
+
+func (x options) delay(attempt int) time.Duration {
+	if x.backoff != nil {
+		return x.backoff(attempt)
+	}
+
+	if x.pollInterval == 0 {
+		return DefaultPollInterval
+	}
+
+	return x.pollInterval
+}
+
+func (x options) required(nodes int) int {
+	if x.quorum <= 0 || x.quorum > nodes {
+		return nodes
+	}
+
+	return x.quorum
+}
+
+// checkFunc implements confirmation logic of the particular node.
 //
 // The return value means:
 //   - nil is a total success.
 //   - errRetry means waiter should wait one more tick.
-//   - another error means a fatal problem.
-type pollLogic func() error
+//   - another error means a problem with the node.
+type checkFunc func() error
 
 // NewWaiter is a constructor for [waiter.Waiter].
 //
@@ -61,29 +143,112 @@ func NewWaiter(executor Executor, pollInterval time.Duration) *Waiter {
 	return w
 }
 
-func poll(ctx context.Context, pollInterval time.Duration, callBack pollLogic) error {
-	if pollInterval == 0 {
-		pollInterval = DefaultPollInterval
+// Confirmer describes requirements to the nodes confirming results of all
+// operations supported by the [Waiter].
+type Confirmer interface {
+	ContainerGetter
+	ContainerEACLGetter
+}
+
+// SetPollInterval allows rewrite default poll interval of all operations.
+func (w *Waiter) SetPollInterval(interval time.Duration) {
+	w.ContainerPutWaiter.SetPollInterval(interval)
+	w.ContainerSetEACLWaiter.SetPollInterval(interval)
+	w.ContainerDeleteWaiter.SetPollInterval(interval)
+}
+
+// SetBackoff sets Backoff of all operations, see
+// [ContainerPutWaiter.SetBackoff].
+func (w *Waiter) SetBackoff(b Backoff) {
+	w.ContainerPutWaiter.SetBackoff(b)
+	w.ContainerSetEACLWaiter.SetBackoff(b)
+	w.ContainerDeleteWaiter.SetBackoff(b)
+}
+
+// SetProgressHandler sets progress handler of all operations, see
+// [ContainerPutWaiter.SetProgressHandler].
+func (w *Waiter) SetProgressHandler(f func(Progress)) {
+	w.ContainerPutWaiter.SetProgressHandler(f)
+	w.ContainerSetEACLWaiter.SetProgressHandler(f)
+	w.ContainerDeleteWaiter.SetProgressHandler(f)
+}
+
+// SetConfirmers sets nodes confirming results of all operations, see
+// [ContainerPutWaiter.SetConfirmers].
+func (w *Waiter) SetConfirmers(quorum int, cs ...Confirmer) {
+	getters := make([]ContainerGetter, len(cs))
+	eaclGetters := make([]ContainerEACLGetter, len(cs))
+
+	for i := range cs {
+		getters[i] = cs[i]
+		eaclGetters[i] = cs[i]
 	}
 
-	t := time.NewTicker(pollInterval)
-	defer t.Stop()
+	w.ContainerPutWaiter.SetConfirmers(quorum, getters...)
+	w.ContainerSetEACLWaiter.SetConfirmers(quorum, eaclGetters...)
+	w.ContainerDeleteWaiter.SetConfirmers(quorum, getters...)
+}
+
+// poll runs checks until the required number of them succeeds. Checks are
+// repeated with delays according to the options until success, context
+// cancellation or an error. With single check any its error except errRetry is
+// returned immediately, otherwise the errors are treated as not yet confirmed
+// operation and reported to the progress handler.
+func poll(ctx context.Context, opts options, checks []checkFunc) error {
+	required := opts.required(len(checks))
+	confirmed := make([]bool, len(checks))
+	start := time.Now()
+
+	var n int
+
+	for attempt := 1; ; attempt++ {
+		t := time.NewTimer(opts.delay(attempt))
 
-	for {
 		select {
 		case <-t.C:
-			if err := callBack(); err != nil {
-				if errors.Is(err, errRetry) {
-					// wait one more tick
-					continue
-				}
+		case <-ctx.Done():
+			t.Stop()
+			return ErrConfirmationTimeout
+		}
+
+		var lastErr error
+
+		for i := range checks {
+			if confirmed[i] {
+				continue
+			}
 
+			err := checks[i]()
+			if err == nil {
+				confirmed[i] = true
+				n++
+
+				continue
+			}
+
+			if errors.Is(err, errRetry) {
+				continue
+			}
+
+			if len(checks) == 1 {
 				return fmt.Errorf("poller: %w", err)
 			}
 
+			lastErr = err
+		}
+
+		if opts.progress != nil {
+			opts.progress(Progress{
+				Attempt:   attempt,
+				Elapsed:   time.Since(start),
+				Confirmed: n,
+				Required:  required,
+				Err:       lastErr,
+			})
+		}
+
+		if n >= required {
 			return nil
-		case <-ctx.Done():
-			return ErrConfirmationTimeout
 		}
 	}
 }
@@ -0,0 +1,218 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	eacltest "github.com/nspcc-dev/neofs-sdk-go/eacl/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode is a node which stores the container after the given number of
+// ContainerGet requests.
+type testNode struct {
+	mtx sync.Mutex
+
+	id cid.ID
+
+	calls, storeAfter int
+
+	err error
+}
+
+func (x *testNode) ContainerPut(context.Context, container.Container, neofscrypto.Signer, client.PrmContainerPut) (cid.ID, error) {
+	return x.id, nil
+}
+
+func (x *testNode) ContainerGet(_ context.Context, id cid.ID, _ client.PrmContainerGet) (container.Container, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.calls++
+
+	if x.err != nil {
+		return container.Container{}, x.err
+	}
+
+	if id != x.id || x.calls <= x.storeAfter {
+		return container.Container{}, apistatus.ErrContainerNotFound
+	}
+
+	return container.Container{}, nil
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff(time.Second, 5*time.Second, 2)
+	require.Equal(t, time.Second, b(1))
+	require.Equal(t, 2*time.Second, b(2))
+	require.Equal(t, 4*time.Second, b(3))
+	require.Equal(t, 5*time.Second, b(4))
+	require.Equal(t, 5*time.Second, b(100))
+
+	b = ExponentialBackoff(0, 0, 0)
+	require.Equal(t, DefaultPollInterval, b(1))
+	require.Equal(t, DefaultPollInterval, b(10))
+}
+
+func TestContainerPutWaiter(t *testing.T) {
+	ctx := context.Background()
+	id := cidtest.ID()
+
+	t.Run("executor", func(t *testing.T) {
+		node := &testNode{id: id, storeAfter: 2}
+
+		var progress []Progress
+
+		w := NewContainerPutWaiter(node, time.Millisecond)
+		w.SetBackoff(ExponentialBackoff(time.Millisecond, 3*time.Millisecond, 2))
+		w.SetProgressHandler(func(p Progress) { progress = append(progress, p) })
+
+		res, err := w.ContainerPut(ctx, container.Container{}, nil, client.PrmContainerPut{})
+		require.NoError(t, err)
+		require.Equal(t, id, res)
+		require.Equal(t, 3, node.calls)
+
+		require.Len(t, progress, 3)
+		for i := range progress {
+			require.Equal(t, i+1, progress[i].Attempt)
+			require.Equal(t, 1, progress[i].Required)
+			require.NoError(t, progress[i].Err)
+		}
+		require.Zero(t, progress[1].Confirmed)
+		require.Equal(t, 1, progress[2].Confirmed)
+	})
+
+	t.Run("executor failure", func(t *testing.T) {
+		node := &testNode{id: id, err: errors.New("any error")}
+
+		w := NewContainerPutWaiter(node, time.Millisecond)
+
+		_, err := w.ContainerPut(ctx, container.Container{}, nil, client.PrmContainerPut{})
+		require.ErrorIs(t, err, node.err)
+	})
+
+	t.Run("confirmers", func(t *testing.T) {
+		executor := &testNode{id: id}
+		fast := &testNode{id: id}
+		slow := &testNode{id: id, storeAfter: 3}
+		broken := &testNode{id: id, err: errors.New("any error")}
+
+		var last Progress
+
+		w := NewContainerPutWaiter(executor, time.Millisecond)
+		w.SetProgressHandler(func(p Progress) { last = p })
+		w.SetConfirmers(2, fast, broken, slow)
+
+		_, err := w.ContainerPut(ctx, container.Container{}, nil, client.PrmContainerPut{})
+		require.NoError(t, err)
+		require.Zero(t, executor.calls)
+		require.Equal(t, 1, fast.calls)
+		require.Equal(t, 4, slow.calls)
+		require.Equal(t, 4, broken.calls)
+
+		require.Equal(t, 4, last.Attempt)
+		require.Equal(t, 2, last.Confirmed)
+		require.Equal(t, 2, last.Required)
+		require.ErrorIs(t, last.Err, broken.err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		node := &testNode{id: id, storeAfter: 1 << 30}
+
+		ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		w := NewContainerPutWaiter(node, time.Millisecond)
+		w.SetConfirmers(0, node, &testNode{id: id})
+
+		_, err := w.ContainerPut(ctx, container.Container{}, nil, client.PrmContainerPut{})
+		require.ErrorIs(t, err, ErrConfirmationTimeout)
+	})
+}
+
+func TestContainerDeleteWaiter(t *testing.T) {
+	id := cidtest.ID()
+	node := &testNode{id: cidtest.ID()}
+
+	w := NewContainerDeleteWaiter(deleteExecutor{node}, time.Millisecond)
+
+	require.NoError(t, w.ContainerDelete(context.Background(), id, nil, client.PrmContainerDelete{}))
+}
+
+type deleteExecutor struct {
+	*testNode
+}
+
+func (deleteExecutor) ContainerDelete(context.Context, cid.ID, neofscrypto.Signer, client.PrmContainerDelete) error {
+	return nil
+}
+
+// importNode is an Executor storing the container and eACL immediately.
+type importNode struct {
+	deleteExecutor
+
+	cnr container.Container
+
+	table *eacl.Table
+}
+
+func (x *importNode) ContainerPut(_ context.Context, cnr container.Container, _ neofscrypto.Signer, _ client.PrmContainerPut) (cid.ID, error) {
+	x.cnr = cnr
+	cnr.CalculateID(&x.id)
+
+	return x.id, nil
+}
+
+func (x *importNode) ContainerSetEACL(_ context.Context, table eacl.Table, _ user.Signer, _ client.PrmContainerSetEACL) error {
+	x.table = &table
+	return nil
+}
+
+func (x *importNode) ContainerEACL(context.Context, cid.ID, client.PrmContainerEACL) (eacl.Table, error) {
+	if x.table == nil {
+		return eacl.Table{}, apistatus.ErrEACLNotFound
+	}
+
+	return *x.table, nil
+}
+
+func TestWaiter_ContainerImport(t *testing.T) {
+	ctx := context.Background()
+	cnr := containertest.Container(t)
+	table := eacltest.Table(t)
+	signer := test.RandomSignerRFC6979(t)
+
+	b := container.NewBundle(cnr, table)
+
+	node := &importNode{deleteExecutor: deleteExecutor{&testNode{}}}
+	w := NewWaiter(node, time.Millisecond)
+
+	_, err := w.ContainerImport(ctx, b, signer, client.PrmContainerPut{}, client.PrmContainerSetEACL{})
+	require.Error(t, err)
+
+	require.NoError(t, b.Sign(test.RandomSignerRFC6979(t)))
+
+	id, err := w.ContainerImport(ctx, b, signer, client.PrmContainerPut{}, client.PrmContainerSetEACL{})
+	require.NoError(t, err)
+	require.Equal(t, node.id, id)
+	require.True(t, signer.UserID().Equals(node.cnr.Owner()))
+
+	require.NotNil(t, node.table)
+	tableCnr, ok := node.table.CID()
+	require.True(t, ok)
+	require.Equal(t, id, tableCnr)
+	require.Equal(t, table.ToV2().GetRecords(), node.table.ToV2().GetRecords())
+}